@@ -46,15 +46,19 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/oidc"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
 	"github.com/superseriousbusiness/gotosocial/internal/router"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	gtsstorage "github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/web"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 
 	// Inherit memory limit if set from cgroup
 	_ "github.com/KimMachineGun/automemlimit"
@@ -67,6 +71,12 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("failed to set CPU limits from cgroup: %s", err)
 	}
 
+	// Set up tracing before anything
+	// that might want to create spans
+	if err := tracing.Initialize(); err != nil {
+		return fmt.Errorf("error initializing tracing: %w", err)
+	}
+
 	var state state.State
 
 	// Initialize caches
@@ -89,6 +99,10 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		return fmt.Errorf("error creating instance instance: %s", err)
 	}
 
+	if err := dbService.CreateInstanceVAPIDKeyPair(ctx); err != nil {
+		return fmt.Errorf("error creating instance vapid key pair: %s", err)
+	}
+
 	// Create the client API and federator worker pools
 	// NOTE: these MUST NOT be used until they are passed to the
 	// processor and it is started. The reason being that the processor
@@ -117,6 +131,9 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	}
 	oauthServer := oauth.New(ctx, dbService)
 	transportController := transport.NewController(dbService, federatingDB, &federation.Clock{}, client)
+	if err := transportController.Start(); err != nil {
+		return fmt.Errorf("error starting transport controller: %s", err)
+	}
 	federator := federation.NewFederator(dbService, federatingDB, transportController, typeConverter, mediaManager)
 
 	// decide whether to create a noop email sender (won't send emails) or a real one
@@ -135,8 +152,11 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		}
 	}
 
+	// web push notifications are delivered using the same http client as federation
+	webPushSender := webpush.NewSender(client, dbService)
+
 	// create the message processor using the other services we've created so far
-	processor := processing.NewProcessor(typeConverter, federator, oauthServer, mediaManager, storage, dbService, emailSender, clientWorker, fedWorker)
+	processor := processing.NewProcessor(typeConverter, federator, oauthServer, mediaManager, storage, dbService, emailSender, webPushSender, relme.NewVerifier(client), clientWorker, fedWorker)
 	if err := processor.Start(); err != nil {
 		return fmt.Errorf("error creating processor: %s", err)
 	}
@@ -158,6 +178,11 @@ var Start action.GTSAction = func(ctx context.Context) error {
 		middleware.ExtraHeaders(),
 	)
 
+	// only record request metrics if they can actually be scraped
+	if config.GetMetricsEnabled() {
+		router.AttachGlobalMiddleware(middleware.Metrics())
+	}
+
 	// attach global no route / 404 handler to the router
 	router.AttachNoRouteHandler(func(c *gin.Context) {
 		apiutil.ErrorHandler(c, gtserror.NewErrorNotFound(errors.New(http.StatusText(http.StatusNotFound))), processor.InstanceGet)
@@ -217,6 +242,23 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	activityPubModule.Route(router, s2sLimit, s2sThrottle, gzip)
 	webModule.Route(router, fsLimit, fsThrottle, gzip)
 
+	// serve metrics either on their own listener, or on the
+	// main router (where validation ensures a token is set)
+	var metricsServer *http.Server
+	if config.GetMetricsEnabled() {
+		if addr := config.GetMetricsBindAddress(); addr != "" {
+			metricsServer = metrics.NewServer(addr, config.GetMetricsAuthToken())
+			go func() {
+				log.Infof("metrics listening on %s", addr)
+				if err := metricsServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+					log.Fatalf("metrics: listen: %s", err)
+				}
+			}()
+		} else {
+			api.NewMetrics().Route(router)
+		}
+	}
+
 	gts, err := gotosocial.NewServer(dbService, router, federator, mediaManager)
 	if err != nil {
 		return fmt.Errorf("error creating gotosocial service: %s", err)
@@ -238,10 +280,25 @@ var Start action.GTSAction = func(ctx context.Context) error {
 	log.Infof("received signal %s, shutting down", sig)
 
 	// close down all running services in order
+	if metricsServer != nil {
+		if err := metricsServer.Shutdown(ctx); err != nil {
+			return fmt.Errorf("error closing metrics server: %s", err)
+		}
+	}
+
 	if err := gts.Stop(ctx); err != nil {
 		return fmt.Errorf("error closing gotosocial service: %s", err)
 	}
 
+	if err := transportController.Stop(); err != nil {
+		return fmt.Errorf("error stopping transport controller: %s", err)
+	}
+
+	// flush any spans still waiting to be exported
+	if err := tracing.Shutdown(ctx); err != nil {
+		return fmt.Errorf("error shutting down tracing: %s", err)
+	}
+
 	log.Info("done! exiting...")
 	return nil
 }
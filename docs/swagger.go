@@ -35,10 +35,15 @@
 //	      read: grants read access to everything
 //	      read:accounts: grants read access to accounts
 //	      read:blocks: grant read access to blocks
+//	      read:bookmarks: grant read access to bookmarks
 //	      read:custom_emojis: grant read access to custom_emojis
 //	      read:favourites: grant read access to favourites
+//	      read:filters: grant read access to filters
 //	      read:follows: grant read access to follows
+//	      read:lists: grant read access to lists
 //	      read:media: grant read access to media
+//	      read:mutes: grant read access to mutes
+//	      read:reports: grant read access to reports
 //	      read:search: grant read access to searches
 //	      read:statuses: grants read access to statuses
 //	      read:streaming: grants read access to streaming api
@@ -47,12 +52,28 @@
 //	      write: grants write access to everything
 //	      write:accounts: grants write access to accounts
 //	      write:blocks: grants write access to blocks
+//	      write:conversations: grants write access to conversations
+//	      write:filters: grants write access to filters
 //	      write:follows: grants write access to follows
+//	      write:lists: grants write access to lists
 //	      write:media: grants write access to media
+//	      write:mutes: grants write access to mutes
+//	      write:notifications: grants write access to notifications
+//	      write:reports: grants write access to reports
 //	      write:statuses: grants write access to statuses
 //	      write:user: grants write access to user-level info
-//	      admin: grants admin access to everything
-//	      admin:accounts: grants admin access to accounts
+//	      follow: grants read and write access to follows, blocks, and mutes (deprecated)
+//	      push: grants access to web push subscriptions and notifications
+//	      admin:read: grants admin read access to everything
+//	      admin:read:accounts: grants admin read access to accounts
+//	      admin:read:domain_allows: grants admin read access to domain allows
+//	      admin:read:domain_blocks: grants admin read access to domain blocks and domain block subscriptions
+//	      admin:read:reports: grants admin read access to reports
+//	      admin:write: grants admin write access to everything
+//	      admin:write:accounts: grants admin write access to accounts
+//	      admin:write:domain_allows: grants admin write access to domain allows
+//	      admin:write:domain_blocks: grants admin write access to domain blocks and domain block subscriptions
+//	      admin:write:reports: grants admin write access to reports
 //	  OAuth2 Application:
 //	    type: oauth2
 //	    flow: application
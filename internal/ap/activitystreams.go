@@ -70,3 +70,16 @@ const (
 	ObjectCollection     = "Collection"     // ActivityStreamsCollection https://www.w3.org/TR/activitystreams-vocabulary/#dfn-collection
 	ObjectCollectionPage = "CollectionPage" // ActivityStreamsCollectionPage https://www.w3.org/TR/activitystreams-vocabulary/#dfn-collectionpage
 )
+
+// Properties that aren't part of the activitystreams vocabulary
+// known to go-fed, but which are widely used by other software.
+const (
+	PropertyAlsoKnownAs = "alsoKnownAs" // https://www.w3.org/TR/did-core/#also-known-as
+	PropertyMovedTo     = "movedTo"     // https://docs.joinmastodon.org/spec/activitypub/#as
+)
+
+// Types that aren't part of the activitystreams vocabulary
+// known to go-fed, but which are widely used by other software.
+const (
+	ObjectPropertyValue = "PropertyValue" // https://schema.org/PropertyValue, used for profile fields https://docs.joinmastodon.org/spec/activitypub/#PropertyValue
+)
@@ -33,6 +33,7 @@ import (
 	"time"
 
 	"github.com/superseriousbusiness/activity/pub"
+	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
@@ -168,6 +169,16 @@ func ExtractPublished(i WithPublished) (time.Time, error) {
 	return t, nil
 }
 
+// ExtractUpdated extracts the updated time from the given
+// WithUpdated. If no updated time is set, the zero time is returned.
+func ExtractUpdated(i WithUpdated) time.Time {
+	updatedProp := i.GetActivityStreamsUpdated()
+	if updatedProp == nil || !updatedProp.IsXMLSchemaDateTime() {
+		return time.Time{}
+	}
+	return updatedProp.Get()
+}
+
 // ExtractIconURL extracts a URL to a supported image file from something like:
 //
 //	"icon": {
@@ -635,6 +646,20 @@ func ExtractObject(i WithObject) (*url.URL, error) {
 	return nil, errors.New("no iri found for object prop")
 }
 
+// ExtractTarget extracts the first URL target from a WithTarget interface.
+func ExtractTarget(i WithTarget) (*url.URL, error) {
+	targetProp := i.GetActivityStreamsTarget()
+	if targetProp == nil {
+		return nil, errors.New("target property was nil")
+	}
+	for iter := targetProp.Begin(); iter != targetProp.End(); iter = iter.Next() {
+		if iter.IsIRI() && iter.GetIRI() != nil {
+			return iter.GetIRI(), nil
+		}
+	}
+	return nil, errors.New("no iri found for target prop")
+}
+
 // ExtractObjects extracts a slice of URL objects from a WithObject interface.
 func ExtractObjects(i WithObject) ([]*url.URL, error) {
 	objectProp := i.GetActivityStreamsObject()
@@ -763,3 +788,206 @@ func ExtractSharedInbox(withEndpoints WithEndpoints) *url.URL {
 
 	return nil
 }
+
+// ExtractPoll extracts a placeholder Poll from the given Pollable, with its
+// options, vote counts, and flags populated. The returned poll will not have
+// an ID or StatusID set yet, that's up to the caller to do.
+func ExtractPoll(pollable Pollable) (*gtsmodel.Poll, error) {
+	var (
+		options  []string
+		votes    []int
+		multiple bool
+	)
+
+	// A poll with oneOf options is single choice,
+	// and a poll with anyOf options is multiple choice.
+	appendOptions := func(iter interface{ GetType() vocab.Type }) {
+		optionable, ok := iter.GetType().(PollOptionable)
+		if !ok {
+			return
+		}
+
+		name, err := ExtractName(optionable)
+		if err != nil {
+			return
+		}
+
+		options = append(options, name)
+		votes = append(votes, ExtractRepliesCount(optionable))
+	}
+
+	if oneOf := pollable.GetActivityStreamsOneOf(); oneOf != nil {
+		for iter := oneOf.Begin(); iter != oneOf.End(); iter = iter.Next() {
+			appendOptions(iter)
+		}
+	}
+
+	if anyOf := pollable.GetActivityStreamsAnyOf(); anyOf != nil && len(options) == 0 {
+		multiple = true
+		for iter := anyOf.Begin(); iter != anyOf.End(); iter = iter.Next() {
+			appendOptions(iter)
+		}
+	}
+
+	if len(options) == 0 {
+		return nil, errors.New("ExtractPoll: no poll options found")
+	}
+
+	hideCounts := false
+	poll := &gtsmodel.Poll{
+		Multiple:   &multiple,
+		HideCounts: &hideCounts,
+		Options:    options,
+		Votes:      votes,
+	}
+
+	if endTime := pollable.GetActivityStreamsEndTime(); endTime != nil && endTime.IsXMLSchemaDateTime() {
+		poll.ExpiresAt = endTime.Get()
+	}
+
+	if closed := pollable.GetActivityStreamsClosed(); closed != nil {
+		for iter := closed.Begin(); iter != closed.End(); iter = iter.Next() {
+			switch {
+			case iter.IsXMLSchemaDateTime():
+				poll.ClosedAt = iter.GetXMLSchemaDateTime()
+			case iter.IsXMLSchemaBoolean() && iter.GetXMLSchemaBoolean():
+				// Closed without a date, fall back to
+				// the end time if we have one, or now.
+				poll.ClosedAt = poll.ExpiresAt
+				if poll.ClosedAt.IsZero() {
+					poll.ClosedAt = time.Now()
+				}
+			}
+		}
+	}
+
+	if votersCount := pollable.GetTootVotersCount(); votersCount != nil && votersCount.IsXMLSchemaNonNegativeInteger() {
+		poll.Voters = votersCount.Get()
+	}
+
+	return poll, nil
+}
+
+// ExtractRepliesCount extracts the totalItems of the replies collection
+// of the given item, as used to count votes on poll options. If no such
+// count can be found, 0 will be returned.
+func ExtractRepliesCount(withReplies WithReplies) int {
+	repliesProp := withReplies.GetActivityStreamsReplies()
+	if repliesProp == nil || !repliesProp.IsActivityStreamsCollection() {
+		return 0
+	}
+
+	totalItems := repliesProp.GetActivityStreamsCollection().GetActivityStreamsTotalItems()
+	if totalItems == nil || !totalItems.IsXMLSchemaNonNegativeInteger() {
+		return 0
+	}
+
+	return totalItems.Get()
+}
+
+// ExtractAlsoKnownAs extracts the alsoKnownAs URIs of an account, ie., the
+// aliases of an account that it might have moved from or to.
+//
+// alsoKnownAs isn't part of the activitystreams vocabulary that we know about,
+// so it has to be taken from the unknown properties of the given type.
+func ExtractAlsoKnownAs(i WithUnknownProperties) []*url.URL {
+	return extractUnknownIRIs(i, PropertyAlsoKnownAs)
+}
+
+// ExtractMovedTo extracts the movedTo URI of an account, ie., the
+// account that it has moved to, or nil if it hasn't moved anywhere.
+//
+// Like alsoKnownAs, movedTo has to be taken from the unknown properties of the given type.
+func ExtractMovedTo(i WithUnknownProperties) *url.URL {
+	iris := extractUnknownIRIs(i, PropertyMovedTo)
+	if len(iris) == 0 {
+		return nil
+	}
+	return iris[0]
+}
+
+// ExtractFields extracts the profile fields of an account, which are attached to the account
+// as PropertyValues. The values of the returned fields are html, as served by the remote.
+//
+// PropertyValue isn't part of the activitystreams vocabulary that we know about, so each
+// field has to be taken from the raw representation of an otherwise unknown attachment.
+func ExtractFields(i WithAttachment) []gtsmodel.Field {
+	attachmentProp := i.GetActivityStreamsAttachment()
+	if attachmentProp == nil || attachmentProp.Len() == 0 {
+		return nil
+	}
+
+	raw, err := attachmentProp.Serialize()
+	if err != nil {
+		return nil
+	}
+
+	// serialized property is either one
+	// attachment or an array of them
+	var values []interface{}
+	switch v := raw.(type) {
+	case []interface{}:
+		values = v
+	default:
+		values = []interface{}{v}
+	}
+
+	fields := make([]gtsmodel.Field, 0, len(values))
+	for _, v := range values {
+		m, ok := v.(map[string]interface{})
+		if !ok || m["type"] != ObjectPropertyValue {
+			continue
+		}
+
+		name, _ := m["name"].(string)
+		value, _ := m["value"].(string)
+		if name == "" || value == "" {
+			continue
+		}
+
+		fields = append(fields, gtsmodel.Field{
+			Name:  name,
+			Value: value,
+		})
+	}
+
+	return fields
+}
+
+// extractUnknownIRIs parses the unknown property with the given name
+// as either a single IRI, or an array of IRIs, skipping anything else.
+func extractUnknownIRIs(i WithUnknownProperties, name string) []*url.URL {
+	unknown := i.GetUnknownProperties()
+	if unknown == nil {
+		return nil
+	}
+
+	var values []interface{}
+	switch v := unknown[name].(type) {
+	case string:
+		values = []interface{}{v}
+	case []interface{}:
+		values = v
+	case []string:
+		for _, s := range v {
+			values = append(values, s)
+		}
+	default:
+		return nil
+	}
+
+	iris := make([]*url.URL, 0, len(values))
+	for _, v := range values {
+		s, ok := v.(string)
+		if !ok {
+			continue
+		}
+		iri, err := url.Parse(s)
+		if err != nil || iri.Scheme == "" || iri.Host == "" {
+			continue
+		}
+		iris = append(iris, iri)
+	}
+
+	return iris
+}
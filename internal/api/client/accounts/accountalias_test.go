@@ -0,0 +1,83 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package accounts_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type AccountAliasTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountAliasTestSuite) TestAccountAliasPOSTHandler() {
+	form := url.Values{
+		"also_known_as_uris[]": []string{
+			"http://localhost:8080/users/1happyturtle",
+			"http://localhost:8080/users/admin",
+		},
+	}
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(form.Encode()), accounts.AliasPath, "application/x-www-form-urlencoded")
+
+	// call the handler
+	suite.accountsModule.AccountAliasPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiAccount := &apimodel.Account{}
+	err = json.Unmarshal(b, apiAccount)
+	suite.NoError(err)
+
+	suite.Equal([]string{
+		"http://localhost:8080/users/1happyturtle",
+		"http://localhost:8080/users/admin",
+	}, apiAccount.Source.AlsoKnownAsURIs)
+}
+
+func (suite *AccountAliasTestSuite) TestAccountAliasPOSTHandlerBadURI() {
+	form := url.Values{
+		"also_known_as_uris[]": []string{"not a uri"},
+	}
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(form.Encode()), accounts.AliasPath, "application/x-www-form-urlencoded")
+
+	// call the handler
+	suite.accountsModule.AccountAliasPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: alias not a uri was not a valid http(s) uri"}`, string(b))
+}
+
+func TestAccountAliasTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountAliasTestSuite))
+}
@@ -0,0 +1,100 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package accounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type AccountMoveTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandler() {
+	// turtle needs to list zork as an alias first
+	targetAccount, err := suite.db.GetAccountByID(context.Background(), suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	targetAccount.AlsoKnownAs = []string{suite.testAccounts["local_account_1"].URI}
+	err = suite.db.UpdateAccount(context.Background(), targetAccount)
+	suite.NoError(err)
+
+	form := url.Values{
+		"password":     []string{"password"},
+		"moved_to_uri": []string{targetAccount.URI},
+	}
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(form.Encode()), accounts.MovePath, "application/x-www-form-urlencoded")
+
+	// call the handler
+	suite.accountsModule.AccountMovePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiAccount := &apimodel.Account{}
+	err = json.Unmarshal(b, apiAccount)
+	suite.NoError(err)
+
+	suite.NotNil(apiAccount.Moved)
+	suite.Equal("1happyturtle", apiAccount.Moved.Acct)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandlerNotAliased() {
+	form := url.Values{
+		"password":     []string{"password"},
+		"moved_to_uri": []string{"http://localhost:8080/users/1happyturtle"},
+	}
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(form.Encode()), accounts.MovePath, "application/x-www-form-urlencoded")
+
+	// call the handler
+	suite.accountsModule.AccountMovePOSTHandler(ctx)
+	suite.Equal(http.StatusUnprocessableEntity, recorder.Code)
+}
+
+func (suite *AccountMoveTestSuite) TestAccountMovePOSTHandlerNoPassword() {
+	form := url.Values{
+		"moved_to_uri": []string{"http://localhost:8080/users/1happyturtle"},
+	}
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(form.Encode()), accounts.MovePath, "application/x-www-form-urlencoded")
+
+	// call the handler
+	suite.accountsModule.AccountMovePOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: no password provided in account move request"}`, string(b))
+}
+
+func TestAccountMoveTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountMoveTestSuite))
+}
@@ -115,6 +115,22 @@ import (
 //		in: formData
 //		description: Enable RSS feed for this account's Public posts at `/[username]/feed.rss`
 //		type: boolean
+//	-
+//		name: fields_attributes[0][name]
+//		in: formData
+//		description: >-
+//			Name of the first profile field, up to 255 characters.
+//			Up to 4 fields can be set, using indexes 0 to 3.
+//			Submitting any field replaces all existing fields of the account.
+//		type: string
+//	-
+//		name: fields_attributes[0][value]
+//		in: formData
+//		description: >-
+//			Value of the first profile field, up to 255 characters.
+//			If the value is a link to a page containing a rel="me" link back
+//			to this account's profile, the field will be marked as verified.
+//		type: string
 //
 //	security:
 //	- OAuth2 Bearer:
@@ -129,6 +145,8 @@ import (
 //			description: bad request
 //		'401':
 //			description: unauthorized
+//		'403':
+//			description: forbidden
 //		'404':
 //			description: not found
 //		'406':
@@ -142,6 +160,11 @@ func (m *Module) AccountUpdateCredentialsPATCHHandler(c *gin.Context) {
 		return
 	}
 
+	if err := authed.CheckScope(oauth.ScopeWriteAccounts); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
 	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
 		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
 		return
@@ -194,6 +217,28 @@ func parseUpdateAccountForm(c *gin.Context) (*apimodel.UpdateCredentialsRequest,
 		form.Source.StatusFormat = &statusFormat
 	}
 
+	// parse fields attributes (fields_attributes[0][name] etc), since
+	// these indexed form keys can't be bound into a slice of fields
+	if form.FieldsAttributes == nil {
+		fieldsAttributes := []apimodel.UpdateField{}
+		for i := 0; ; i++ {
+			name, nameOK := c.GetPostForm(fmt.Sprintf("fields_attributes[%d][name]", i))
+			value, valueOK := c.GetPostForm(fmt.Sprintf("fields_attributes[%d][value]", i))
+			if !nameOK && !valueOK {
+				break
+			}
+
+			fieldsAttributes = append(fieldsAttributes, apimodel.UpdateField{
+				Name:  &name,
+				Value: &value,
+			})
+		}
+
+		if len(fieldsAttributes) != 0 {
+			form.FieldsAttributes = &fieldsAttributes
+		}
+	}
+
 	if form == nil ||
 		(form.Discoverable == nil &&
 			form.Bot == nil &&
@@ -417,6 +417,53 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateCredentialsPATCHHandlerUpd
 	suite.Equal(dbAccount.StatusFormat, "markdown")
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateCredentialsPATCHHandlerUpdateFields() {
+	// set up the request
+	// we're setting two profile fields on zork
+	requestBody, w, err := testrig.CreateMultipartFormData(
+		"", "",
+		map[string]string{
+			"fields_attributes[0][name]":  "pronouns",
+			"fields_attributes[0][value]": "they/them",
+			"fields_attributes[1][name]":  "website",
+			"fields_attributes[1][value]": "https://example.org",
+		})
+	if err != nil {
+		panic(err)
+	}
+	bodyBytes := requestBody.Bytes()
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPatch, bodyBytes, accounts.UpdateCredentialsPath, w.FormDataContentType())
+
+	// call the handler
+	suite.accountsModule.AccountUpdateCredentialsPATCHHandler(ctx)
+
+	// we should have OK because our request was valid
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	apimodelAccount := &apimodel.Account{}
+	err = json.Unmarshal(b, apimodelAccount)
+	suite.NoError(err)
+
+	// fields should be set in order
+	suite.Equal([]apimodel.Field{
+		{Name: "pronouns", Value: "they/them"},
+		{Name: "website", Value: "https://example.org"},
+	}, apimodelAccount.Fields)
+
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(dbAccount.Fields, 2)
+}
+
 func (suite *AccountUpdateTestSuite) TestAccountUpdateCredentialsPATCHHandlerUpdateStatusFormatBad() {
 	// set up the request
 	// we're updating the language of zork
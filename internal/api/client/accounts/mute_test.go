@@ -0,0 +1,123 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package accounts_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/accounts"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type MuteTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *MuteTestSuite) TestMuteUnmute() {
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	form := url.Values{
+		"notifications": []string{"false"},
+		"duration":      []string{"3600"},
+	}
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, []byte(form.Encode()), strings.Replace(accounts.MutePath, ":id", targetAccount.ID, 1), "application/x-www-form-urlencoded")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   accounts.IDKey,
+			Value: targetAccount.ID,
+		},
+	}
+
+	// call the handler
+	suite.accountsModule.AccountMutePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	relationship := &apimodel.Relationship{}
+	err = json.Unmarshal(b, relationship)
+	suite.NoError(err)
+	suite.True(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+
+	// the mute should be listed with its expiry time
+	mutes, errWithCode := suite.processor.MutesGet(context.Background(), &oauth.Auth{Account: suite.testAccounts["local_account_1"]}, "", "", 20)
+	suite.NoError(errWithCode)
+	suite.Len(mutes.Items, 1)
+	mutedAccount, ok := mutes.Items[0].(*apimodel.Account)
+	suite.True(ok)
+	suite.Equal(targetAccount.ID, mutedAccount.ID)
+	suite.NotEmpty(mutedAccount.MuteExpiresAt)
+
+	// now unmute the account
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodPost, nil, strings.Replace(accounts.UnmutePath, ":id", targetAccount.ID, 1), "")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   accounts.IDKey,
+			Value: targetAccount.ID,
+		},
+	}
+
+	suite.accountsModule.AccountUnmutePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err = io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	relationship = &apimodel.Relationship{}
+	err = json.Unmarshal(b, relationship)
+	suite.NoError(err)
+	suite.False(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+}
+
+func (suite *MuteTestSuite) TestMuteSelf() {
+	testAcct := suite.testAccounts["local_account_1"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, nil, strings.Replace(accounts.MutePath, ":id", testAcct.ID, 1), "")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   accounts.IDKey,
+			Value: testAcct.ID,
+		},
+	}
+
+	suite.accountsModule.AccountMutePOSTHandler(ctx)
+
+	// status should be Not Acceptable due to attempted self-mute
+	suite.Equal(http.StatusNotAcceptable, recorder.Code)
+}
+
+func TestMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(MuteTestSuite))
+}
@@ -54,7 +54,13 @@ import (
 //	-
 //		name: type
 //		in: formData
-//		description: Type of action to be taken (`disable`, `silence`, or `suspend`).
+//		description: |-
+//			Type of action to be taken. One of:
+//			- `none` -- don't take any action, but you can still warn the user by email.
+//			- `disable` -- prevent the user of a local account from logging in, and hide their posts.
+//			- `sensitive` -- force all media of the account to be marked as sensitive.
+//			- `silence` -- only show the account's posts and interactions to accounts that already follow it.
+//			- `suspend` -- remove the account and its posts and media, and federate the removal.
 //		type: string
 //		required: true
 //	-
@@ -62,10 +68,21 @@ import (
 //		in: formData
 //		description: Optional text describing why this action was taken.
 //		type: string
+//	-
+//		name: send_email_notification
+//		in: formData
+//		description: Email the owner of the account about this action, if it's a local account.
+//		type: boolean
+//		default: false
+//	-
+//		name: report_id
+//		in: formData
+//		description: ID of a report about the account to mark as resolved.
+//		type: string
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:write:accounts
 //
 //	responses:
 //		'200':
@@ -89,6 +106,11 @@ func (m *Module) AccountActionPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if err := authed.CheckScope(oauth.ScopeAdminWriteAccounts); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
 	if !*authed.User.Admin {
 		err := fmt.Errorf("user %s not an admin", authed.User.ID)
 		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
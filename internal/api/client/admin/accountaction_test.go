@@ -0,0 +1,125 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type AccountActionTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountActionTestSuite) SetupTest() {
+	suite.AdminStandardTestSuite.SetupTest()
+
+	// actions are finished off asynchronously
+	suite.NoError(suite.processor.Start())
+}
+
+func (suite *AccountActionTestSuite) TearDownTest() {
+	suite.NoError(suite.processor.Stop())
+	suite.AdminStandardTestSuite.TearDownTest()
+}
+
+func (suite *AccountActionTestSuite) TestAccountActionSensitive() {
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+targetAccount.ID+"/action", "")
+	ctx.Request.Method = http.MethodPost
+	ctx.Request.Form = url.Values{"type": {"sensitive"}, "text": {"please use content warnings"}}
+	ctx.AddParam(admin.IDKey, targetAccount.ID)
+
+	suite.adminModule.AccountActionPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), targetAccount.ID)
+	suite.NoError(err)
+	suite.False(dbAccount.SensitizedAt.IsZero())
+
+	// undo it again
+	recorder = httptest.NewRecorder()
+	ctx = suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+targetAccount.ID+"/unsensitive", "")
+	ctx.Request.Method = http.MethodPost
+	ctx.AddParam(admin.IDKey, targetAccount.ID)
+
+	suite.adminModule.AccountUnsensitivePOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+
+	apiAccount := &apimodel.AdminAccountInfo{}
+	err = json.Unmarshal(b, apiAccount)
+	suite.NoError(err)
+	suite.Equal(targetAccount.ID, apiAccount.ID)
+	suite.False(apiAccount.Sensitized)
+
+	dbAccount, err = suite.db.GetAccountByID(context.Background(), targetAccount.ID)
+	suite.NoError(err)
+	suite.True(dbAccount.SensitizedAt.IsZero())
+}
+
+func (suite *AccountActionTestSuite) TestAccountActionUnsupportedType() {
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+targetAccount.ID+"/action", "")
+	ctx.Request.Method = http.MethodPost
+	ctx.Request.Form = url.Values{"type": {"unsilence"}}
+	ctx.AddParam(admin.IDKey, targetAccount.ID)
+
+	suite.adminModule.AccountActionPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: admin action type unsilence is not supported for this endpoint"}`, string(b))
+}
+
+func (suite *AccountActionTestSuite) TestAccountUnsuspendLocal() {
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodPost, nil, admin.AccountsPath+"/"+targetAccount.ID+"/unsuspend", "")
+	ctx.Request.Method = http.MethodPost
+	ctx.AddParam(admin.IDKey, targetAccount.ID)
+
+	suite.adminModule.AccountUnsuspendPOSTHandler(ctx)
+	suite.Equal(http.StatusConflict, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Conflict: account 01F8MH5NBDF2MV7CTC4Q5128HF is not suspended"}`, string(b))
+}
+
+func TestAccountActionTestSuite(t *testing.T) {
+	suite.Run(t, &AccountActionTestSuite{})
+}
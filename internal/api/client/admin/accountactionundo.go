@@ -0,0 +1,347 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// AccountEnablePOSTHandler swagger:operation POST /api/v1/admin/accounts/{id}/enable adminAccountEnable
+//
+// Re-enable the login of a local account that was disabled.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		required: true
+//		in: path
+//		description: ID of the account.
+//		type: string
+//	-
+//		name: text
+//		in: formData
+//		description: Optional text describing why this action was taken.
+//		type: string
+//	-
+//		name: send_email_notification
+//		in: formData
+//		description: Email the owner of the account about this action, if it's a local account.
+//		type: boolean
+//		default: false
+//	-
+//		name: report_id
+//		in: formData
+//		description: ID of a report about the account to mark as resolved.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:accounts
+//
+//	responses:
+//		'200':
+//			description: The account.
+//			schema:
+//				"$ref": "#/definitions/adminAccountInfo"
+//		'400':
+//			description: bad request (account is not a local account)
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) AccountEnablePOSTHandler(c *gin.Context) {
+	m.accountActionUndo(c, gtsmodel.AdminActionEnable)
+}
+
+// AccountUnsensitivePOSTHandler swagger:operation POST /api/v1/admin/accounts/{id}/unsensitive adminAccountUnsensitive
+//
+// Stop forcing the media of an account to be marked as sensitive.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		required: true
+//		in: path
+//		description: ID of the account.
+//		type: string
+//	-
+//		name: text
+//		in: formData
+//		description: Optional text describing why this action was taken.
+//		type: string
+//	-
+//		name: send_email_notification
+//		in: formData
+//		description: Email the owner of the account about this action, if it's a local account.
+//		type: boolean
+//		default: false
+//	-
+//		name: report_id
+//		in: formData
+//		description: ID of a report about the account to mark as resolved.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:accounts
+//
+//	responses:
+//		'200':
+//			description: The account.
+//			schema:
+//				"$ref": "#/definitions/adminAccountInfo"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) AccountUnsensitivePOSTHandler(c *gin.Context) {
+	m.accountActionUndo(c, gtsmodel.AdminActionUnsensitive)
+}
+
+// AccountUnsilencePOSTHandler swagger:operation POST /api/v1/admin/accounts/{id}/unsilence adminAccountUnsilence
+//
+// Lift the silence of an account.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		required: true
+//		in: path
+//		description: ID of the account.
+//		type: string
+//	-
+//		name: text
+//		in: formData
+//		description: Optional text describing why this action was taken.
+//		type: string
+//	-
+//		name: send_email_notification
+//		in: formData
+//		description: Email the owner of the account about this action, if it's a local account.
+//		type: boolean
+//		default: false
+//	-
+//		name: report_id
+//		in: formData
+//		description: ID of a report about the account to mark as resolved.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:accounts
+//
+//	responses:
+//		'200':
+//			description: The account.
+//			schema:
+//				"$ref": "#/definitions/adminAccountInfo"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'500':
+//			description: internal server error
+func (m *Module) AccountUnsilencePOSTHandler(c *gin.Context) {
+	m.accountActionUndo(c, gtsmodel.AdminActionUnsilence)
+}
+
+// AccountUnsuspendPOSTHandler swagger:operation POST /api/v1/admin/accounts/{id}/unsuspend adminAccountUnsuspend
+//
+// Lift the suspension of a remote account.
+//
+// Local accounts can't be unsuspended, since their posts, media and user are removed when they're suspended.
+// The remote account will be dereferenced again the next time it's encountered.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: id
+//		required: true
+//		in: path
+//		description: ID of the account.
+//		type: string
+//	-
+//		name: text
+//		in: formData
+//		description: Optional text describing why this action was taken.
+//		type: string
+//	-
+//		name: send_email_notification
+//		in: formData
+//		description: Email the owner of the account about this action, if it's a local account.
+//		type: boolean
+//		default: false
+//	-
+//		name: report_id
+//		in: formData
+//		description: ID of a report about the account to mark as resolved.
+//		type: string
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:accounts
+//
+//	responses:
+//		'200':
+//			description: The account.
+//			schema:
+//				"$ref": "#/definitions/adminAccountInfo"
+//		'400':
+//			description: bad request (account is a local account)
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: conflict (account is not suspended)
+//		'500':
+//			description: internal server error
+func (m *Module) AccountUnsuspendPOSTHandler(c *gin.Context) {
+	m.accountActionUndo(c, gtsmodel.AdminActionUnsuspend)
+}
+
+// accountActionUndo handles the requests that reverse a previous action on an account.
+func (m *Module) accountActionUndo(c *gin.Context, actionType gtsmodel.AdminActionType) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if err := authed.CheckScope(oauth.ScopeAdminWriteAccounts); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	targetAcctID := c.Param(IDKey)
+	if targetAcctID == "" {
+		err := errors.New("no account id specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &apimodel.AdminAccountActionRequest{}
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBind(form); err != nil {
+			apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+			return
+		}
+	}
+	form.Type = string(actionType)
+	form.TargetAccountID = targetAcctID
+
+	account, errWithCode := m.processor.AdminAccountActionUndo(c.Request.Context(), authed, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, account)
+}
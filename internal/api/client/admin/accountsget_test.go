@@ -0,0 +1,124 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+)
+
+type AccountsGetTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsGetPending() {
+	recorder := httptest.NewRecorder()
+	path := admin.AccountsPath + "?status=pending"
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+	ctx.Request.URL.RawQuery = "status=pending"
+
+	suite.adminModule.AccountsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`[
+  {
+    "id": "01F8MH0BBE4FHXPH513MBVFHB0",
+    "username": "weed_lord420",
+    "domain": null,
+    "created_at": "2022-06-04T13:12:00.000Z",
+    "email": "weed_lord420@example.org",
+    "ip": null,
+    "ips": [],
+    "locale": "en",
+    "invite_request": "hi, please let me in! I'm looking for somewhere neato bombeato to hang out.",
+    "role": "user",
+    "confirmed": false,
+    "approved": false,
+    "disabled": false,
+    "silenced": false,
+    "sensitized": false,
+    "suspended": false,
+    "account": {
+      "id": "01F8MH0BBE4FHXPH513MBVFHB0",
+      "username": "weed_lord420",
+      "acct": "weed_lord420",
+      "display_name": "",
+      "locked": false,
+      "bot": false,
+      "created_at": "2022-06-04T13:12:00.000Z",
+      "note": "",
+      "url": "http://localhost:8080/@weed_lord420",
+      "avatar": "",
+      "avatar_static": "",
+      "header": "http://localhost:8080/assets/default_header.png",
+      "header_static": "http://localhost:8080/assets/default_header.png",
+      "followers_count": 0,
+      "following_count": 0,
+      "statuses_count": 0,
+      "last_status_at": null,
+      "emojis": [],
+      "fields": [],
+      "role": "user"
+    },
+    "created_by_application_id": "01F8MGY43H3N2C8EWPR2FPYEXG"
+  }
+]`, dst.String())
+	suite.Equal(`<http://localhost:8080/api/v1/admin/accounts?limit=20&max_id=01F8MH0BBE4FHXPH513MBVFHB0&status=pending>; rel="next", <http://localhost:8080/api/v1/admin/accounts?limit=20&min_id=01F8MH0BBE4FHXPH513MBVFHB0&status=pending>; rel="prev"`, recorder.Header().Get("Link"))
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsGetNoStatus() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.AccountsPath, "application/json")
+
+	suite.adminModule.AccountsGETHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: no status specified"}`, string(b))
+}
+
+func (suite *AccountsGetTestSuite) TestAccountsGetUnsupportedStatus() {
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.AccountsPath+"?status=active", "application/json")
+	ctx.Request.URL.RawQuery = "status=active"
+
+	suite.adminModule.AccountsGETHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: account status \"active\" is not supported, only \"pending\" is"}`, string(b))
+}
+
+func TestAccountsGetTestSuite(t *testing.T) {
+	suite.Run(t, &AccountsGetTestSuite{})
+}
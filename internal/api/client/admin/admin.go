@@ -34,16 +34,44 @@ const (
 	EmojiPathWithID = EmojiPath + "/:" + IDKey
 	// EmojiCategoriesPath is used for interacting with emoji categories.
 	EmojiCategoriesPath = EmojiPath + "/categories"
+	// DomainAllowsPath is used for posting domain allows.
+	DomainAllowsPath = BasePath + "/domain_allows"
+	// DomainAllowsPathWithID is used for interacting with a single domain allow.
+	DomainAllowsPathWithID = DomainAllowsPath + "/:" + IDKey
 	// DomainBlocksPath is used for posting domain blocks.
 	DomainBlocksPath = BasePath + "/domain_blocks"
 	// DomainBlocksPathWithID is used for interacting with a single domain block.
 	DomainBlocksPathWithID = DomainBlocksPath + "/:" + IDKey
+	// DomainBlockSubscriptionsPath is used for posting and listing domain block subscriptions.
+	DomainBlockSubscriptionsPath = BasePath + "/domain_block_subscriptions"
+	// DomainBlockSubscriptionsPathWithID is used for interacting with a single domain block subscription.
+	DomainBlockSubscriptionsPathWithID = DomainBlockSubscriptionsPath + "/:" + IDKey
+	// DomainBlockSubscriptionsSyncPath is used for syncing a single domain block subscription right away.
+	DomainBlockSubscriptionsSyncPath = DomainBlockSubscriptionsPathWithID + "/sync"
+	// DeliveriesPath is used for viewing and purging the queue of federation deliveries waiting to be retried.
+	DeliveriesPath = BasePath + "/deliveries"
+	// InstancesPath is used for viewing known remote instances.
+	InstancesPath = BasePath + "/instances"
+	// InstancesPathWithDomain is used for viewing a single remote instance.
+	InstancesPathWithDomain = InstancesPath + "/:" + DomainKey
 	// AccountsPath is used for listing + acting on accounts.
 	AccountsPath = BasePath + "/accounts"
 	// AccountsPathWithID is used for interacting with a single account.
 	AccountsPathWithID = AccountsPath + "/:" + IDKey
 	// AccountsActionPath is used for taking action on a single account.
 	AccountsActionPath = AccountsPathWithID + "/action"
+	// AccountsEnablePath is used for re-enabling the login of a single disabled account.
+	AccountsEnablePath = AccountsPathWithID + "/enable"
+	// AccountsUnsensitivePath is used for undoing the sensitive action on a single account.
+	AccountsUnsensitivePath = AccountsPathWithID + "/unsensitive"
+	// AccountsUnsilencePath is used for lifting the silence of a single account.
+	AccountsUnsilencePath = AccountsPathWithID + "/unsilence"
+	// AccountsUnsuspendPath is used for lifting the suspension of a single account.
+	AccountsUnsuspendPath = AccountsPathWithID + "/unsuspend"
+	// AccountsApprovePath is used for approving the pending sign-up of a single account.
+	AccountsApprovePath = AccountsPathWithID + "/approve"
+	// AccountsRejectPath is used for rejecting the pending sign-up of a single account.
+	AccountsRejectPath = AccountsPathWithID + "/reject"
 	MediaCleanupPath   = BasePath + "/media_cleanup"
 	MediaRefetchPath   = BasePath + "/media_refetch"
 	// ReportsPath is for serving admin view of user reports.
@@ -55,10 +83,16 @@ const (
 
 	// ExportQueryKey is for requesting a public export of some data.
 	ExportQueryKey = "export"
+	// RemoveBlocksQueryKey is for also removing the domain blocks created through a domain block subscription when deleting it.
+	RemoveBlocksQueryKey = "remove_blocks"
 	// ImportQueryKey is for submitting an import of some data.
 	ImportQueryKey = "import"
 	// IDKey specifies the ID of a single item being interacted with.
 	IDKey = "id"
+	// DomainKey specifies the domain of a single instance being interacted with.
+	DomainKey = "domain"
+	// FailingKey is for filtering instances on whether requests to them are currently failing.
+	FailingKey = "failing"
 	// FilterKey is for applying filters to admin views of accounts, emojis, etc.
 	FilterQueryKey = "filter"
 	// MaxShortcodeDomainKey is the url query for returning emoji results lower (alphabetically)
@@ -71,6 +105,8 @@ const (
 	LimitKey = "limit"
 	// DomainQueryKey is for specifying a domain during admin actions.
 	DomainQueryKey = "domain"
+	// StatusKey is for filtering accounts by their status, eg., pending approval.
+	StatusKey = "status"
 	// ResolvedKey is for filtering reports by their resolved status
 	ResolvedKey = "resolved"
 	// AccountIDKey is for selecting account in API paths.
@@ -101,14 +137,43 @@ func (m *Module) Route(attachHandler func(method string, path string, f ...gin.H
 	attachHandler(http.MethodPatch, EmojiPathWithID, m.EmojiPATCHHandler)
 	attachHandler(http.MethodGet, EmojiCategoriesPath, m.EmojiCategoriesGETHandler)
 
+	// domain allow stuff
+	attachHandler(http.MethodPost, DomainAllowsPath, m.DomainAllowsPOSTHandler)
+	attachHandler(http.MethodGet, DomainAllowsPath, m.DomainAllowsGETHandler)
+	attachHandler(http.MethodGet, DomainAllowsPathWithID, m.DomainAllowGETHandler)
+	attachHandler(http.MethodDelete, DomainAllowsPathWithID, m.DomainAllowDELETEHandler)
+
 	// domain block stuff
 	attachHandler(http.MethodPost, DomainBlocksPath, m.DomainBlocksPOSTHandler)
 	attachHandler(http.MethodGet, DomainBlocksPath, m.DomainBlocksGETHandler)
 	attachHandler(http.MethodGet, DomainBlocksPathWithID, m.DomainBlockGETHandler)
 	attachHandler(http.MethodDelete, DomainBlocksPathWithID, m.DomainBlockDELETEHandler)
 
+	// domain block subscription stuff
+	attachHandler(http.MethodPost, DomainBlockSubscriptionsPath, m.DomainBlockSubscriptionPOSTHandler)
+	attachHandler(http.MethodGet, DomainBlockSubscriptionsPath, m.DomainBlockSubscriptionsGETHandler)
+	attachHandler(http.MethodGet, DomainBlockSubscriptionsPathWithID, m.DomainBlockSubscriptionGETHandler)
+	attachHandler(http.MethodPatch, DomainBlockSubscriptionsPathWithID, m.DomainBlockSubscriptionPATCHHandler)
+	attachHandler(http.MethodDelete, DomainBlockSubscriptionsPathWithID, m.DomainBlockSubscriptionDELETEHandler)
+	attachHandler(http.MethodPost, DomainBlockSubscriptionsSyncPath, m.DomainBlockSubscriptionSyncPOSTHandler)
+
 	// accounts stuff
+	attachHandler(http.MethodGet, AccountsPath, m.AccountsGETHandler)
 	attachHandler(http.MethodPost, AccountsActionPath, m.AccountActionPOSTHandler)
+	attachHandler(http.MethodPost, AccountsEnablePath, m.AccountEnablePOSTHandler)
+	attachHandler(http.MethodPost, AccountsUnsensitivePath, m.AccountUnsensitivePOSTHandler)
+	attachHandler(http.MethodPost, AccountsUnsilencePath, m.AccountUnsilencePOSTHandler)
+	attachHandler(http.MethodPost, AccountsUnsuspendPath, m.AccountUnsuspendPOSTHandler)
+	attachHandler(http.MethodPost, AccountsApprovePath, m.AccountApprovePOSTHandler)
+	attachHandler(http.MethodPost, AccountsRejectPath, m.AccountRejectPOSTHandler)
+
+	// delivery queue stuff
+	attachHandler(http.MethodGet, DeliveriesPath, m.DeliveriesGETHandler)
+	attachHandler(http.MethodDelete, DeliveriesPath, m.DeliveriesDELETEHandler)
+
+	// instances stuff
+	attachHandler(http.MethodGet, InstancesPath, m.InstancesGETHandler)
+	attachHandler(http.MethodGet, InstancesPathWithDomain, m.InstanceGETHandler)
 
 	// media stuff
 	attachHandler(http.MethodPost, MediaCleanupPath, m.MediaCleanupPOSTHandler)
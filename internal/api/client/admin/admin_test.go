@@ -53,16 +53,17 @@ type AdminStandardTestSuite struct {
 	sentEmails   map[string]string
 
 	// standard suite models
-	testTokens          map[string]*gtsmodel.Token
-	testClients         map[string]*gtsmodel.Client
-	testApplications    map[string]*gtsmodel.Application
-	testUsers           map[string]*gtsmodel.User
-	testAccounts        map[string]*gtsmodel.Account
-	testAttachments     map[string]*gtsmodel.MediaAttachment
-	testStatuses        map[string]*gtsmodel.Status
-	testEmojis          map[string]*gtsmodel.Emoji
-	testEmojiCategories map[string]*gtsmodel.EmojiCategory
-	testReports         map[string]*gtsmodel.Report
+	testTokens                   map[string]*gtsmodel.Token
+	testClients                  map[string]*gtsmodel.Client
+	testApplications             map[string]*gtsmodel.Application
+	testUsers                    map[string]*gtsmodel.User
+	testAccounts                 map[string]*gtsmodel.Account
+	testAttachments              map[string]*gtsmodel.MediaAttachment
+	testStatuses                 map[string]*gtsmodel.Status
+	testEmojis                   map[string]*gtsmodel.Emoji
+	testEmojiCategories          map[string]*gtsmodel.EmojiCategory
+	testReports                  map[string]*gtsmodel.Report
+	testDomainBlockSubscriptions map[string]*gtsmodel.DomainBlockSubscription
 
 	// module being tested
 	adminModule *admin.Module
@@ -79,6 +80,7 @@ func (suite *AdminStandardTestSuite) SetupSuite() {
 	suite.testEmojis = testrig.NewTestEmojis()
 	suite.testEmojiCategories = testrig.NewTestEmojiCategories()
 	suite.testReports = testrig.NewTestReports()
+	suite.testDomainBlockSubscriptions = testrig.NewTestDomainBlockSubscriptions()
 }
 
 func (suite *AdminStandardTestSuite) SetupTest() {
@@ -0,0 +1,110 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type DeliveriesTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DeliveriesTestSuite) putDelivery(id string, domain string) {
+	createdAt := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	suite.NoError(suite.db.PutDelivery(context.Background(), &gtsmodel.Delivery{
+		ID:            id,
+		CreatedAt:     createdAt,
+		UpdatedAt:     createdAt,
+		PubKeyID:      suite.testAccounts["local_account_1"].PublicKeyURI,
+		TargetURI:     "https://" + domain + "/inbox",
+		TargetDomain:  domain,
+		Payload:       []byte(`{"type":"Create"}`),
+		Attempts:      3,
+		NextAttemptAt: createdAt.Add(35 * time.Minute),
+		LastError:     `http response "503 Service Unavailable"`,
+	}))
+}
+
+func (suite *DeliveriesTestSuite) TestDeliveriesGet() {
+	suite.putDelivery("01GTDM3ZAFSR6D2JBY4A7TE3A6", "example.org")
+	suite.putDelivery("01GTDM5B1QF8EQZ8C63JR3MHV0", "fossbros-anonymous.io")
+
+	recorder := httptest.NewRecorder()
+	path := admin.DeliveriesPath + "?domain=example.org"
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+
+	suite.adminModule.DeliveriesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`[
+  {
+    "id": "01GTDM3ZAFSR6D2JBY4A7TE3A6",
+    "target_uri": "https://example.org/inbox",
+    "target_domain": "example.org",
+    "key_id": "http://localhost:8080/users/the_mighty_zork/main-key",
+    "attempts": 3,
+    "last_error": "http response \"503 Service Unavailable\"",
+    "created_at": "2023-03-01T12:00:00.000Z",
+    "next_attempt_at": "2023-03-01T12:35:00.000Z"
+  }
+]`, dst.String())
+	suite.Equal(`<http://localhost:8080/api/v1/admin/deliveries?limit=20&max_id=01GTDM3ZAFSR6D2JBY4A7TE3A6&domain=example.org>; rel="next", <http://localhost:8080/api/v1/admin/deliveries?limit=20&min_id=01GTDM3ZAFSR6D2JBY4A7TE3A6&domain=example.org>; rel="prev"`, recorder.Header().Get("Link"))
+}
+
+func (suite *DeliveriesTestSuite) TestDeliveriesPurge() {
+	suite.putDelivery("01GTDM3ZAFSR6D2JBY4A7TE3A6", "example.org")
+	suite.putDelivery("01GTDM4P0MT2SHB5JVZ1GX3Q5N", "example.org")
+	suite.putDelivery("01GTDM5B1QF8EQZ8C63JR3MHV0", "fossbros-anonymous.io")
+
+	recorder := httptest.NewRecorder()
+	path := admin.DeliveriesPath + "?domain=example.org"
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, path, "")
+
+	suite.adminModule.DeliveriesDELETEHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"purged":2}`, string(b))
+
+	count, err := suite.db.CountDeliveries(context.Background(), "")
+	suite.NoError(err)
+	suite.Equal(1, count)
+}
+
+func TestDeliveriesTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveriesTestSuite))
+}
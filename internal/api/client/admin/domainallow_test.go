@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+)
+
+type DomainAllowTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainAllowTestSuite) TestDomainAllowsGet() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.DomainAllowsPath
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+
+	suite.adminModule.DomainAllowsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`[
+  {
+    "domain": "fossbros-anonymous.io",
+    "id": "01GT5C2H6W0B9D4Z3XJY8RMQKE",
+    "private_comment": "they're annoying but mostly harmless",
+    "created_by": "01F8MH17FWEB39HZJ76B6VXSKF",
+    "created_at": "2023-02-26T09:12:03.000Z"
+  }
+]`, dst.String())
+}
+
+func (suite *DomainAllowTestSuite) TestDomainAllowCreate() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.DomainAllowsPath
+	body := []byte(`{"domain":"Friendly.Example.org","public_comment":"good neighbours"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, body, path, "application/json")
+
+	suite.adminModule.DomainAllowsPOSTHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiAllow := make(map[string]interface{})
+	err := json.NewDecoder(recorder.Body).Decode(&apiAllow)
+	suite.NoError(err)
+	suite.Equal("friendly.example.org", apiAllow["domain"])
+	suite.Equal("good neighbours", apiAllow["public_comment"])
+	suite.NotEmpty(apiAllow["id"])
+
+	allowed, err := suite.db.IsDomainAllowed(context.Background(), "friendly.example.org")
+	suite.NoError(err)
+	suite.True(allowed)
+}
+
+func TestDomainAllowTestSuite(t *testing.T) {
+	suite.Run(t, &DomainAllowTestSuite{})
+}
@@ -36,11 +36,16 @@ import (
 // Create one or more domain blocks, from a string or a file.
 //
 // You have two options when using this endpoint: either you can set `import` to `true` and
-// upload a file containing multiple domain blocks, JSON-formatted, or you can leave import as
+// upload a file containing multiple domain blocks, or you can leave import as
 // `false`, and just add one domain block.
 //
 // The format of the json file should be something like: `[{"domain":"example.org"},{"domain":"whatever.com","public_comment":"they smell"}]`
 //
+// Domain block exports from Mastodon are also accepted, either the CSV format used by admins
+// (`#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate`), or the one
+// domain per line format used for blocked domains of individual users. Only entries with
+// severity `suspend` are imported from the admin format.
+//
 //	---
 //	tags:
 //	- admin
@@ -57,7 +62,7 @@ import (
 //		in: query
 //		description: >-
 //			Signal that a list of domain blocks is being imported as a file.
-//			If set to `true`, then 'domains' must be present as a JSON-formatted file.
+//			If set to `true`, then 'domains' must be present as a JSON or CSV formatted file.
 //			If set to `false`, then `domains` will be ignored, and `domain` must be present.
 //		type: boolean
 //		default: false
@@ -65,7 +70,7 @@ import (
 //		name: domains
 //		in: formData
 //		description: >-
-//			JSON-formatted list of domain blocks to import.
+//			JSON or CSV formatted list of domain blocks to import.
 //			This is only used if `import` is set to `true`.
 //		type: file
 //	-
@@ -102,7 +107,7 @@ import (
 //
 //	security:
 //	- OAuth2 Bearer:
-//		- admin
+//		- admin:write:domain_blocks
 //
 //	responses:
 //		'200':
@@ -130,6 +135,11 @@ func (m *Module) DomainBlocksPOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if err := authed.CheckScope(oauth.ScopeAdminWriteDomainBlocks); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
 	if !*authed.User.Admin {
 		err := fmt.Errorf("user %s not an admin", authed.User.ID)
 		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
@@ -0,0 +1,125 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+)
+
+type DomainBlockSubscriptionTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) TestDomainBlockSubscriptionsGet() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.DomainBlockSubscriptionsPath
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+
+	suite.adminModule.DomainBlockSubscriptionsGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`[
+  {
+    "id": "01GT3DV5Q8M2S7HKW0YB6JX4NC",
+    "title": "Shared blocklist",
+    "uri": "https://blocklists.example.org/blocklist.txt",
+    "content_type": "text/plain",
+    "obfuscate": false,
+    "created_by": "01F8MH17FWEB39HZJ76B6VXSKF",
+    "created_at": "2023-02-25T09:41:16.000Z",
+    "conflicts": [],
+    "count": 0
+  }
+]`, dst.String())
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) TestDomainBlockSubscriptionCreateBadContentType() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.DomainBlockSubscriptionsPath
+	body := []byte(`{"uri":"https://example.org/blocklist.yaml","content_type":"application/yaml"}`)
+	ctx := suite.newContext(recorder, http.MethodPost, body, path, "application/json")
+
+	suite.adminModule.DomainBlockSubscriptionPOSTHandler(ctx)
+	suite.Equal(http.StatusBadRequest, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: subscription content_type 'application/yaml' was not recognized, valid options are 'text/plain', 'text/csv', 'application/json'"}`, string(b))
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) TestDomainBlockSubscriptionUpdate() {
+	recorder := httptest.NewRecorder()
+
+	subscription := suite.testDomainBlockSubscriptions["admin_plaintext"]
+	path := admin.DomainBlockSubscriptionsPath + "/" + subscription.ID
+	body := []byte(`{"title":"Shared blocklist (csv)","uri":"https://blocklists.example.org/blocklist.csv","content_type":"text/csv"}`)
+	ctx := suite.newContext(recorder, http.MethodPatch, body, path, "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   admin.IDKey,
+			Value: subscription.ID,
+		},
+	}
+
+	suite.adminModule.DomainBlockSubscriptionPATCHHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	apiSubscription := make(map[string]interface{})
+	err := json.NewDecoder(recorder.Body).Decode(&apiSubscription)
+	suite.NoError(err)
+	suite.Equal("Shared blocklist (csv)", apiSubscription["title"])
+	suite.Equal("https://blocklists.example.org/blocklist.csv", apiSubscription["uri"])
+	suite.Equal("text/csv", apiSubscription["content_type"])
+}
+
+func (suite *DomainBlockSubscriptionTestSuite) TestDomainBlockSubscriptionDeleteNotFound() {
+	recorder := httptest.NewRecorder()
+
+	path := admin.DomainBlockSubscriptionsPath + "/01GT3F4R6JQW8C2YJ4ZP0N9V5E"
+	ctx := suite.newContext(recorder, http.MethodDelete, nil, path, "")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   admin.IDKey,
+			Value: "01GT3F4R6JQW8C2YJ4ZP0N9V5E",
+		},
+	}
+
+	suite.adminModule.DomainBlockSubscriptionDELETEHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestDomainBlockSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, &DomainBlockSubscriptionTestSuite{})
+}
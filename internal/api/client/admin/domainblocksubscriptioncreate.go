@@ -0,0 +1,174 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+// DomainBlockSubscriptionPOSTHandler swagger:operation POST /api/v1/admin/domain_block_subscriptions domainBlockSubscriptionCreate
+//
+// Subscribe to a blocklist.
+//
+// The blocklist will be fetched right away, and then periodically. Domains in the blocklist will be
+// blocked, and blocks created through the subscription will be removed again once their domain is
+// no longer listed. Domains which are already blocked manually or through another subscription are
+// left alone, and reported in the `conflicts` of the subscription.
+//
+// The blocklist can be in one of the following formats:
+//
+// `text/plain`: one domain per line. Empty lines, and anything following a `#`, are ignored.
+//
+// `text/csv`: a Mastodon domain blocks export, with columns `#domain`, `#severity`, `#public_comment`, `#obfuscate`.
+// Only domains with severity `suspend` will be blocked. A CSV file without a header row is read as one domain per row.
+//
+// `application/json`: a GoToSocial domain blocks export, eg., `[{"domain":"example.org","public_comment":"they smell"}]`.
+//
+//	---
+//	tags:
+//	- admin
+//
+//	consumes:
+//	- application/json
+//	- application/xml
+//	- application/x-www-form-urlencoded
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: title
+//		in: formData
+//		description: Title of the subscription, viewable to admins.
+//		type: string
+//	-
+//		name: uri
+//		in: formData
+//		description: >-
+//			URI of the blocklist. Must be an http, https, or file URI,
+//			eg., `https://example.org/blocklist.csv` or `file:///etc/gotosocial/blocklist.txt`.
+//		type: string
+//		required: true
+//	-
+//		name: content_type
+//		in: formData
+//		description: Format of the blocklist, one of `text/plain`, `text/csv`, `application/json`.
+//		type: string
+//		required: true
+//	-
+//		name: obfuscate
+//		in: formData
+//		description: >-
+//			Obfuscate the names of domains blocked through this subscription when serving them publicly,
+//			unless the blocklist says otherwise for a domain.
+//		type: boolean
+//		default: false
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- admin:write:domain_blocks
+//
+//	responses:
+//		'200':
+//			description: The newly created domain block subscription.
+//			schema:
+//				"$ref": "#/definitions/domainBlockSubscription"
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'404':
+//			description: not found
+//		'406':
+//			description: not acceptable
+//		'409':
+//			description: conflict -- a subscription for this uri already exists
+//		'500':
+//			description: internal server error
+func (m *Module) DomainBlockSubscriptionPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if err := authed.CheckScope(oauth.ScopeAdminWriteDomainBlocks); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if !*authed.User.Admin {
+		err := fmt.Errorf("user %s not an admin", authed.User.ID)
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &apimodel.DomainBlockSubscriptionCreateRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if err := validateCreateDomainBlockSubscription(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	subscription, errWithCode := m.processor.AdminDomainBlockSubscriptionCreate(c.Request.Context(), authed, form)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusOK, subscription)
+}
+
+func validateCreateDomainBlockSubscription(form *apimodel.DomainBlockSubscriptionCreateRequest) error {
+	if err := validate.DomainBlockSubscriptionTitle(form.Title); err != nil {
+		return err
+	}
+
+	if err := validate.DomainBlockSubscriptionURI(form.URI); err != nil {
+		return err
+	}
+
+	if form.ContentType == "" {
+		return errors.New("subscription content_type must be provided")
+	}
+
+	return validate.DomainBlockSubscriptionContentType(gtsmodel.DomainBlockSubscriptionContent(form.ContentType))
+}
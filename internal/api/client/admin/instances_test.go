@@ -0,0 +1,175 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type InstancesTestSuite struct {
+	AdminStandardTestSuite
+}
+
+func (suite *InstancesTestSuite) failInstance(domain string) {
+	ctx := context.Background()
+
+	instance, err := suite.db.GetInstance(ctx, domain)
+	suite.NoError(err)
+
+	instance.Failures = 12
+	instance.FailingSince = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	instance.LastFailedAt = time.Date(2023, 3, 3, 18, 30, 0, 0, time.UTC)
+	instance.LastFailure = "dial tcp: connect: connection refused"
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "failures", "failing_since", "last_failed_at", "last_failure"))
+}
+
+func (suite *InstancesTestSuite) TestInstancesGetFailing() {
+	suite.failInstance("example.org")
+
+	recorder := httptest.NewRecorder()
+	path := admin.InstancesPath + "?failing=true"
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+
+	suite.adminModule.InstancesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	dst := new(bytes.Buffer)
+	err = json.Indent(dst, b, "", "  ")
+	suite.NoError(err)
+	suite.Equal(`[
+  {
+    "id": "01G5H71G52DJKVBYKXPNPNDN1G",
+    "domain": "example.org",
+    "uri": "http://example.org",
+    "title": "",
+    "version": "",
+    "created_at": "2020-05-13T13:29:12.000Z",
+    "suspended_at": null,
+    "failures": 12,
+    "failing_since": "2023-03-01T12:00:00.000Z",
+    "last_failed_at": "2023-03-03T18:30:00.000Z",
+    "last_failure": "dial tcp: connect: connection refused",
+    "unreachable": true
+  }
+]`, dst.String())
+}
+
+func (suite *InstancesTestSuite) TestInstancesGetAll() {
+	suite.failInstance("example.org")
+
+	recorder := httptest.NewRecorder()
+	ctx := suite.newContext(recorder, http.MethodGet, nil, admin.InstancesPath, "application/json")
+
+	suite.adminModule.InstancesGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	instances := []map[string]interface{}{}
+	suite.NoError(json.Unmarshal(b, &instances))
+
+	domains := []string{}
+	for _, instance := range instances {
+		domains = append(domains, instance["domain"].(string))
+	}
+	suite.ElementsMatch([]string{"example.org", "fossbros-anonymous.io"}, domains)
+}
+
+func (suite *InstancesTestSuite) TestInstanceGet() {
+	recorder := httptest.NewRecorder()
+	path := admin.InstancesPath + "/fossbros-anonymous.io"
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   admin.DomainKey,
+			Value: "fossbros-anonymous.io",
+		},
+	}
+
+	suite.adminModule.InstanceGETHandler(ctx)
+	suite.Equal(http.StatusOK, recorder.Code)
+
+	b, err := io.ReadAll(recorder.Body)
+	suite.NoError(err)
+	suite.Equal(`{"id":"01G5H6YMJQKR86QZKXXQ2S95FZ","domain":"fossbros-anonymous.io","uri":"http://fossbros-anonymous.io","title":"","version":"","created_at":"2021-09-20T10:40:37.000Z","suspended_at":null,"failures":0,"failing_since":null,"last_failed_at":null,"last_failure":"","unreachable":false}`, string(b))
+}
+
+func (suite *InstancesTestSuite) TestInstanceGetScopes() {
+	for _, test := range []struct {
+		scope  string
+		expect int
+	}{
+		{"read write follow push admin:read admin:write", http.StatusOK},
+		{"read write follow push admin", http.StatusForbidden},
+		{"admin:read", http.StatusOK},
+		{"read admin:read:reports", http.StatusForbidden},
+		{"admin:write", http.StatusForbidden},
+		{"read write follow push", http.StatusForbidden},
+	} {
+		recorder := httptest.NewRecorder()
+		path := admin.InstancesPath + "/fossbros-anonymous.io"
+		ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+		ctx.Params = gin.Params{
+			gin.Param{
+				Key:   admin.DomainKey,
+				Value: "fossbros-anonymous.io",
+			},
+		}
+
+		token := oauth.DBTokenToToken(suite.testTokens["admin_account"])
+		token.SetScope(test.scope)
+		ctx.Set(oauth.SessionAuthorizedToken, token)
+
+		suite.adminModule.InstanceGETHandler(ctx)
+		suite.Equal(test.expect, recorder.Code, "scope %q", test.scope)
+	}
+}
+
+func (suite *InstancesTestSuite) TestInstanceGetUnknown() {
+	recorder := httptest.NewRecorder()
+	path := admin.InstancesPath + "/not.known.example.org"
+	ctx := suite.newContext(recorder, http.MethodGet, nil, path, "application/json")
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   admin.DomainKey,
+			Value: "not.known.example.org",
+		},
+	}
+
+	suite.adminModule.InstanceGETHandler(ctx)
+	suite.Equal(http.StatusNotFound, recorder.Code)
+}
+
+func TestInstancesTestSuite(t *testing.T) {
+	suite.Run(t, new(InstancesTestSuite))
+}
@@ -161,6 +161,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet1() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -199,6 +200,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet1() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -239,6 +241,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet1() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -280,6 +283,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet1() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -334,6 +338,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet1() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -374,6 +379,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet1() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -477,7 +483,8 @@ func (suite *ReportsGetTestSuite) TestReportsGet1() {
         "tags": [],
         "emojis": [],
         "card": null,
-        "poll": null
+        "poll": null,
+        "edited_at": null
       }
     ],
     "rule_ids": [],
@@ -526,6 +533,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet2() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -566,6 +574,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet2() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -669,7 +678,8 @@ func (suite *ReportsGetTestSuite) TestReportsGet2() {
         "tags": [],
         "emojis": [],
         "card": null,
-        "poll": null
+        "poll": null,
+        "edited_at": null
       }
     ],
     "rule_ids": [],
@@ -718,6 +728,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet3() {
       "approved": true,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -758,6 +769,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet3() {
       "approved": false,
       "disabled": false,
       "silenced": false,
+      "sensitized": false,
       "suspended": false,
       "account": {
         "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -861,7 +873,8 @@ func (suite *ReportsGetTestSuite) TestReportsGet3() {
         "tags": [],
         "emojis": [],
         "card": null,
-        "poll": null
+        "poll": null,
+        "edited_at": null
       }
     ],
     "rule_ids": [],
@@ -895,7 +908,7 @@ func (suite *ReportsGetTestSuite) TestReportsGet6() {
 	testToken := suite.testTokens["local_account_1"]
 	testUser := suite.testUsers["local_account_1"]
 
-	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: user 01F8MGVGPHQ2D3P3X0454H54Z5 not an admin"}`, nil, "", "", "", "", "", 20)
+	reports, _, err := suite.getReports(testAccount, testToken, testUser, http.StatusForbidden, `{"error":"Forbidden: token scope \"read write follow push\" does not include required scope \"admin:read:reports\""}`, nil, "", "", "", "", "", 20)
 	suite.NoError(err)
 	suite.Empty(reports)
 }
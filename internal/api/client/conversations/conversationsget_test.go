@@ -0,0 +1,111 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversations_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/conversations"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ConversationsGetTestSuite struct {
+	ConversationsStandardTestSuite
+}
+
+func (suite *ConversationsGetTestSuite) request(method string, path string, id string, handler gin.HandlerFunc, expectedHTTPStatus int) []byte {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	if id != "" {
+		ctx.AddParam(conversations.IDKey, id)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+
+	return b
+}
+
+func (suite *ConversationsGetTestSuite) TestGetConversations() {
+	testConversation := suite.testConversations["local_account_1_conversation_1"]
+
+	b := suite.request(http.MethodGet, conversations.BasePath, "", suite.conversationsModule.ConversationsGETHandler, http.StatusOK)
+
+	apiConversations := []*apimodel.Conversation{}
+	suite.NoError(json.Unmarshal(b, &apiConversations))
+	suite.Len(apiConversations, 1)
+	suite.Equal(testConversation.ID, apiConversations[0].ID)
+	suite.True(apiConversations[0].Unread)
+	suite.Len(apiConversations[0].Accounts, 1)
+	suite.Equal(suite.testAccounts["local_account_2"].ID, apiConversations[0].Accounts[0].ID)
+	suite.Equal(suite.testStatuses["local_account_2_status_6"].ID, apiConversations[0].LastStatus.ID)
+}
+
+func (suite *ConversationsGetTestSuite) TestReadDeleteConversation() {
+	testConversation := suite.testConversations["local_account_1_conversation_1"]
+	path := "/v1/conversations/" + testConversation.ID
+
+	b := suite.request(http.MethodPost, path+"/read", testConversation.ID, suite.conversationsModule.ConversationReadPOSTHandler, http.StatusOK)
+
+	apiConversation := &apimodel.Conversation{}
+	suite.NoError(json.Unmarshal(b, apiConversation))
+	suite.Equal(testConversation.ID, apiConversation.ID)
+	suite.False(apiConversation.Unread)
+
+	b = suite.request(http.MethodDelete, path, testConversation.ID, suite.conversationsModule.ConversationDELETEHandler, http.StatusOK)
+	suite.Equal("{}", string(b))
+
+	b = suite.request(http.MethodGet, conversations.BasePath, "", suite.conversationsModule.ConversationsGETHandler, http.StatusOK)
+	suite.Equal("[]", string(b))
+
+	// other accounts' conversations can't be touched
+	otherConversation := suite.testConversations["local_account_2_conversation_1"]
+	b = suite.request(http.MethodDelete, "/v1/conversations/"+otherConversation.ID, otherConversation.ID, suite.conversationsModule.ConversationDELETEHandler, http.StatusNotFound)
+	suite.Equal(`{"error":"Not Found"}`, string(b))
+}
+
+func TestConversationsGetTestSuite(t *testing.T) {
+	suite.Run(t, &ConversationsGetTestSuite{})
+}
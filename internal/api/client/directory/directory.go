@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package directory
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base URI path for serving the profile directory, minus the api prefix.
+	BasePath = "/v1/directory"
+
+	// OffsetKey is the url query for skipping a number of results, for paging
+	OffsetKey = "offset"
+	// LimitKey is for specifying maximum number of results to return.
+	LimitKey = "limit"
+	// OrderKey is the url query for specifying the order of results
+	OrderKey = "order"
+	// LocalKey is the url query for only returning local accounts
+	LocalKey = "local"
+
+	// OrderActive orders accounts by most recent status.
+	OrderActive = "active"
+	// OrderNew orders accounts by most recently created.
+	OrderNew = "new"
+)
+
+type Module struct {
+	processor processing.Processor
+}
+
+func New(processor processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePath, m.DirectoryGETHandler)
+}
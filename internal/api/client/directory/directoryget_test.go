@@ -0,0 +1,106 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package directory_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/directory"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type DirectoryGetTestSuite struct {
+	DirectoryStandardTestSuite
+}
+
+func (suite *DirectoryGetTestSuite) getDirectory(requestingAccount string, query string, expectedHTTPStatus int) []apimodel.Account {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[requestingAccount])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[requestingAccount]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[requestingAccount])
+	ctx.Request = httptest.NewRequest(http.MethodGet, config.GetProtocol()+"://"+config.GetHost()+"/api"+directory.BasePath+"?"+query, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+
+	suite.directoryModule.DirectoryGETHandler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(expectedHTTPStatus, result.StatusCode, string(b))
+
+	accounts := []apimodel.Account{}
+	if expectedHTTPStatus == http.StatusOK {
+		suite.NoError(json.Unmarshal(b, &accounts))
+	}
+
+	return accounts
+}
+
+func accountNames(accounts []apimodel.Account) []string {
+	names := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		names = append(names, account.Acct)
+	}
+	return names
+}
+
+func (suite *DirectoryGetTestSuite) TestGetDirectory() {
+	accounts := suite.getDirectory("local_account_1", "", http.StatusOK)
+	suite.Equal([]string{
+		"admin",
+		"foss_satan@fossbros-anonymous.io",
+		"the_mighty_zork",
+		"her_fuckin_maj@thequeenisstillalive.technology",
+		"Some_User@example.org",
+	}, accountNames(accounts))
+}
+
+func (suite *DirectoryGetTestSuite) TestGetDirectoryLocalNew() {
+	accounts := suite.getDirectory("local_account_1", "local=true&order=new&limit=1", http.StatusOK)
+	suite.Equal([]string{"the_mighty_zork"}, accountNames(accounts))
+
+	accounts = suite.getDirectory("local_account_1", "local=true&order=new&offset=1", http.StatusOK)
+	suite.Equal([]string{"admin"}, accountNames(accounts))
+}
+
+func (suite *DirectoryGetTestSuite) TestGetDirectoryBlocked() {
+	// 1happyturtle blocks foss_satan in the test fixtures
+	accounts := suite.getDirectory("local_account_2", "", http.StatusOK)
+	suite.NotContains(accountNames(accounts), "foss_satan@fossbros-anonymous.io")
+}
+
+func (suite *DirectoryGetTestSuite) TestGetDirectoryBadOrder() {
+	suite.getDirectory("local_account_1", "order=popular", http.StatusBadRequest)
+}
+
+func TestDirectoryGetTestSuite(t *testing.T) {
+	suite.Run(t, &DirectoryGetTestSuite{})
+}
@@ -0,0 +1,105 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package exports_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/exports"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ExportTestSuite struct {
+	ExportsStandardTestSuite
+}
+
+func (suite *ExportTestSuite) request(method string, path string, exportID string, handler gin.HandlerFunc, expectedHTTPStatus int) []byte {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	if exportID != "" {
+		ctx.AddParam(exports.IDKey, exportID)
+	}
+
+	handler(ctx)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(expectedHTTPStatus, result.StatusCode, string(b))
+
+	return b
+}
+
+func (suite *ExportTestSuite) TestCreateAndDownloadExport() {
+	b := suite.request(http.MethodPost, exports.BasePath, "", suite.exportsModule.ExportPOSTHandler, http.StatusOK)
+
+	export := &apimodel.AccountExport{}
+	suite.NoError(json.Unmarshal(b, export))
+	suite.Equal("processing", export.State)
+
+	// not ready yet
+	suite.request(http.MethodGet, exports.ArchivePath, export.ID, suite.exportsModule.ExportArchiveGETHandler, http.StatusNotFound)
+
+	suite.Eventually(func() bool {
+		exportList := []*apimodel.AccountExport{}
+		b := suite.request(http.MethodGet, exports.BasePath, "", suite.exportsModule.ExportsGETHandler, http.StatusOK)
+		if err := json.Unmarshal(b, &exportList); err != nil || len(exportList) != 1 {
+			return false
+		}
+		export = exportList[0]
+		return export.State == "complete"
+	}, 10*time.Second, 10*time.Millisecond)
+
+	suite.Equal("http://localhost:8080/api/v1/exports/"+export.ID+"/archive", export.URL)
+	suite.NotZero(export.Size)
+
+	// requesting another one straight away isn't allowed
+	suite.request(http.MethodPost, exports.BasePath, "", suite.exportsModule.ExportPOSTHandler, http.StatusUnprocessableEntity)
+
+	archive := suite.request(http.MethodGet, exports.ArchivePath, export.ID, suite.exportsModule.ExportArchiveGETHandler, http.StatusOK)
+	suite.EqualValues(export.Size, len(archive))
+
+	zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	suite.NoError(err)
+	suite.Equal("actor.json", zr.File[0].Name)
+}
+
+func TestExportTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportTestSuite))
+}
@@ -26,8 +26,21 @@ import (
 )
 
 const (
-	// BasePath is the base path for serving the filters API, minus the 'api' prefix
+	// BasePath is the base path for serving the v1 filters API, minus the 'api' prefix
 	BasePath = "/v1/filters"
+	// IDKey is the key for a filter or filter keyword ID in the URL path
+	IDKey = "id"
+	// BasePathWithID is the base path with the filter ID key in it
+	BasePathWithID = BasePath + "/:" + IDKey
+
+	// BasePathV2 is the base path for serving the v2 filters API, minus the 'api' prefix
+	BasePathV2 = "/v2/filters"
+	// BasePathV2WithID is the v2 base path with the filter ID key in it
+	BasePathV2WithID = BasePathV2 + "/:" + IDKey
+	// KeywordsPathV2 is the path for viewing and adding keywords of one v2 filter
+	KeywordsPathV2 = BasePathV2WithID + "/keywords"
+	// KeywordPathV2WithID is the path for viewing, updating, and deleting one filter keyword
+	KeywordPathV2WithID = BasePathV2 + "/keywords/:" + IDKey
 )
 
 type Module struct {
@@ -41,5 +54,24 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// create / get / update / delete v1 filters
 	attachHandler(http.MethodGet, BasePath, m.FiltersGETHandler)
+	attachHandler(http.MethodPost, BasePath, m.FilterPOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, m.FilterGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, m.FilterPUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.FilterDELETEHandler)
+
+	// create / get / update / delete v2 filters
+	attachHandler(http.MethodGet, BasePathV2, m.FiltersV2GETHandler)
+	attachHandler(http.MethodPost, BasePathV2, m.FilterV2POSTHandler)
+	attachHandler(http.MethodGet, BasePathV2WithID, m.FilterV2GETHandler)
+	attachHandler(http.MethodPut, BasePathV2WithID, m.FilterV2PUTHandler)
+	attachHandler(http.MethodDelete, BasePathV2WithID, m.FilterV2DELETEHandler)
+
+	// create / get / update / delete v2 filter keywords
+	attachHandler(http.MethodGet, KeywordsPathV2, m.FilterKeywordsGETHandler)
+	attachHandler(http.MethodPost, KeywordsPathV2, m.FilterKeywordPOSTHandler)
+	attachHandler(http.MethodGet, KeywordPathV2WithID, m.FilterKeywordGETHandler)
+	attachHandler(http.MethodPut, KeywordPathV2WithID, m.FilterKeywordPUTHandler)
+	attachHandler(http.MethodDelete, KeywordPathV2WithID, m.FilterKeywordDELETEHandler)
 }
@@ -0,0 +1,154 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FilterPostTestSuite struct {
+	FiltersStandardTestSuite
+}
+
+func (suite *FilterPostTestSuite) postFilter(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	expectedHTTPStatus int,
+	form url.Values,
+) (*apimodel.Filter, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	requestURI := config.GetProtocol() + "://" + config.GetHost() + "/api" + filter.BasePath
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, strings.NewReader(form.Encode()))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+
+	// trigger the handler
+	suite.filtersModule.FilterPOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		return nil, fmt.Errorf("expected %d got %d: %s", expectedHTTPStatus, resultCode, string(b))
+	}
+
+	resp := &apimodel.Filter{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *FilterPostTestSuite) TestPostFilter() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testToken := suite.testTokens["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	form := url.Values{
+		"phrase":       []string{"turtles"},
+		"context[]":    []string{"home", "notifications"},
+		"irreversible": []string{"true"},
+		"whole_word":   []string{"false"},
+		"expires_in":   []string{"86400"},
+	}
+
+	apiFilter, err := suite.postFilter(testAccount, testToken, testUser, http.StatusOK, form)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(apiFilter.ID)
+	suite.Equal("turtles", apiFilter.Phrase)
+	suite.Equal([]string{"home", "notifications"}, apiFilter.Context)
+	suite.True(apiFilter.Irreversible)
+	suite.False(apiFilter.WholeWord)
+	suite.NotNil(apiFilter.ExpiresAt)
+
+	// The v1 filter should be backed by a
+	// hide filter containing a single keyword.
+	keyword, err := suite.db.GetFilterKeywordByID(context.Background(), apiFilter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(gtsmodel.FilterActionHide, keyword.Filter.Action)
+	suite.Len(keyword.Filter.Keywords, 1)
+}
+
+func (suite *FilterPostTestSuite) TestPostFilterInvalidContext() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testToken := suite.testTokens["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	form := url.Values{
+		"phrase":    []string{"turtles"},
+		"context[]": []string{"everywhere"},
+	}
+
+	_, err := suite.postFilter(testAccount, testToken, testUser, http.StatusBadRequest, form)
+	suite.NoError(err)
+}
+
+func (suite *FilterPostTestSuite) TestPostFilterNoPhrase() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testToken := suite.testTokens["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	form := url.Values{
+		"context[]": []string{"home"},
+	}
+
+	_, err := suite.postFilter(testAccount, testToken, testUser, http.StatusBadRequest, form)
+	suite.NoError(err)
+}
+
+func TestFilterPostTestSuite(t *testing.T) {
+	suite.Run(t, &FilterPostTestSuite{})
+}
@@ -0,0 +1,190 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	filter "github.com/superseriousbusiness/gotosocial/internal/api/client/filters"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type FilterV2PostTestSuite struct {
+	FiltersStandardTestSuite
+}
+
+func (suite *FilterV2PostTestSuite) postFilterV2(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	expectedHTTPStatus int,
+	body []byte,
+) (*apimodel.FilterV2, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	requestURI := config.GetProtocol() + "://" + config.GetHost() + "/api" + filter.BasePathV2
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, bytes.NewReader(body))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/json")
+
+	// trigger the handler
+	suite.filtersModule.FilterV2POSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		return nil, fmt.Errorf("expected %d got %d: %s", expectedHTTPStatus, resultCode, string(b))
+	}
+
+	resp := &apimodel.FilterV2{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *FilterV2PostTestSuite) postFilterKeyword(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	expectedHTTPStatus int,
+	filterID string,
+	keyword string,
+) error {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	form := url.Values{"keyword": []string{keyword}}
+	requestURI := config.GetProtocol() + "://" + config.GetHost() + "/api" + filter.BasePathV2 + "/" + filterID + "/keywords"
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, strings.NewReader(form.Encode()))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx.AddParam(filter.IDKey, filterID)
+
+	// trigger the handler
+	suite.filtersModule.FilterKeywordPOSTHandler(ctx)
+
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		return fmt.Errorf("expected %d got %d", expectedHTTPStatus, resultCode)
+	}
+
+	return nil
+}
+
+func (suite *FilterV2PostTestSuite) TestPostFilterV2WithKeywords() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testToken := suite.testTokens["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	body := []byte(`{
+  "title": "Reptiles",
+  "context": ["home", "public"],
+  "filter_action": "hide",
+  "keywords_attributes": [
+    {"keyword": "turtle", "whole_word": true},
+    {"keyword": "tortoise"}
+  ]
+}`)
+
+	apiFilter, err := suite.postFilterV2(testAccount, testToken, testUser, http.StatusOK, body)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotEmpty(apiFilter.ID)
+	suite.Equal("Reptiles", apiFilter.Title)
+	suite.Equal([]string{"home", "public"}, apiFilter.Context)
+	suite.Equal("hide", apiFilter.FilterAction)
+	suite.Nil(apiFilter.ExpiresAt)
+	if suite.Len(apiFilter.Keywords, 2) {
+		suite.Equal("turtle", apiFilter.Keywords[0].Keyword)
+		suite.True(apiFilter.Keywords[0].WholeWord)
+		suite.Equal("tortoise", apiFilter.Keywords[1].Keyword)
+		suite.False(apiFilter.Keywords[1].WholeWord)
+	}
+}
+
+func (suite *FilterV2PostTestSuite) TestPostFilterV2InvalidAction() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testToken := suite.testTokens["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+
+	body := []byte(`{"title": "Reptiles", "context": ["home"], "filter_action": "explode"}`)
+
+	_, err := suite.postFilterV2(testAccount, testToken, testUser, http.StatusBadRequest, body)
+	suite.NoError(err)
+}
+
+func (suite *FilterV2PostTestSuite) TestPostFilterKeywordDuplicate() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+	testUser := suite.testUsers["local_account_1"]
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	err := suite.postFilterKeyword(testAccount, testToken, testUser, http.StatusUnprocessableEntity, testFilter.ID, "fnord")
+	suite.NoError(err)
+}
+
+func (suite *FilterV2PostTestSuite) TestPostFilterKeywordNotOwned() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testToken := suite.testTokens["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	err := suite.postFilterKeyword(testAccount, testToken, testUser, http.StatusNotFound, testFilter.ID, "turtle")
+	suite.NoError(err)
+}
+
+func TestFilterV2PostTestSuite(t *testing.T) {
+	suite.Run(t, &FilterV2PostTestSuite{})
+}
@@ -0,0 +1,143 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package imports
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+// ImportPOSTHandler swagger:operation POST /api/v1/imports accountImport
+//
+// Import follows or blocks into your account from a CSV file.
+//
+// The file should be in the format of a Mastodon export, or of the CSV files in an export
+// archive from this instance: one account address like `someone@example.org` per row,
+// optionally with a header row. For follows, the `Show boosts` and `Notify on new posts`
+// columns are used if present.
+//
+// The import is processed in the background, since it may involve looking up a lot of
+// remote accounts. Accounts that can't be found are skipped.
+//
+//	---
+//	tags:
+//	- imports
+//
+//	consumes:
+//	- multipart/form-data
+//
+//	produces:
+//	- application/json
+//
+//	parameters:
+//	-
+//		name: data
+//		in: formData
+//		description: The CSV file to import.
+//		type: file
+//		required: true
+//	-
+//		name: type
+//		in: formData
+//		description: Type of data in the file.
+//		type: string
+//		enum:
+//			- following
+//			- blocks
+//		required: true
+//	-
+//		name: mode
+//		in: formData
+//		description: >-
+//			`merge` to add the imported follows or blocks to your existing ones,
+//			or `overwrite` to also remove existing ones that aren't in the file.
+//		type: string
+//		enum:
+//			- merge
+//			- overwrite
+//		default: merge
+//
+//	security:
+//	- OAuth2 Bearer:
+//		- write:follows
+//		- write:blocks
+//
+//	responses:
+//		'202':
+//			description: The import was accepted, and will be processed in the background.
+//		'400':
+//			description: bad request
+//		'401':
+//			description: unauthorized
+//		'403':
+//			description: forbidden
+//		'406':
+//			description: not acceptable
+//		'422':
+//			description: The file couldn't be parsed, or contained too many accounts.
+//		'500':
+//			description: internal server error
+func (m *Module) ImportPOSTHandler(c *gin.Context) {
+	authed, err := oauth.Authed(c, true, true, true, true)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorUnauthorized(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	form := &apimodel.AccountImportRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if form.Data == nil || form.Data.Size == 0 {
+		err := fmt.Errorf("no file provided, or file was empty")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	// the scope needed depends on what's being imported
+	scope := oauth.ScopeWriteFollows
+	if form.Type == apimodel.AccountImportTypeBlocks {
+		scope = oauth.ScopeWriteBlocks
+	}
+
+	if err := authed.CheckScope(scope); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	if errWithCode := m.processor.AccountImport(c.Request.Context(), authed, form); errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+		return
+	}
+
+	c.JSON(http.StatusAccepted, gin.H{})
+}
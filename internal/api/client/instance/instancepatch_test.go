@@ -78,7 +78,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -91,7 +91,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch1() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/quicktime",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/x-flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -187,7 +192,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -200,7 +205,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch2() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/quicktime",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/x-flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -296,7 +306,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -309,7 +319,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch3() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/quicktime",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/x-flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -424,7 +439,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch5() {
 	b, err := io.ReadAll(result.Body)
 	suite.NoError(err)
 
-	suite.Equal(`{"error":"Forbidden: user is not an admin so cannot update instance settings"}`, string(b))
+	suite.Equal(`{"error":"Forbidden: token scope \"read write follow push\" does not include required scope \"admin:write\""}`, string(b))
 }
 
 func (suite *InstancePatchTestSuite) TestInstancePatch6() {
@@ -466,7 +481,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -479,7 +494,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch6() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/quicktime",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/x-flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
@@ -608,7 +628,7 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
   "version": "0.0.0-testrig",
   "registrations": true,
   "approval_required": true,
-  "invites_enabled": false,
+  "invites_enabled": true,
   "configuration": {
     "statuses": {
       "max_characters": 5000,
@@ -621,7 +641,12 @@ func (suite *InstancePatchTestSuite) TestInstancePatch8() {
         "image/gif",
         "image/png",
         "image/webp",
-        "video/mp4"
+        "video/mp4",
+        "video/quicktime",
+        "video/webm",
+        "audio/mpeg",
+        "audio/ogg",
+        "audio/x-flac"
       ],
       "image_size_limit": 10485760,
       "image_matrix_limit": 16777216,
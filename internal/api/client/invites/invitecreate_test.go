@@ -0,0 +1,152 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invites_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/invites"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type InviteCreateTestSuite struct {
+	InvitesStandardTestSuite
+}
+
+func (suite *InviteCreateTestSuite) createInvite(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	body string,
+	expectedHTTPStatus int,
+) (*apimodel.Invite, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	requestURI := config.GetProtocol() + "://" + config.GetHost() + "/api" + invites.BasePath
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, strings.NewReader(body))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+
+	// trigger the handler
+	suite.invitesModule.InviteCreatePOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		return nil, fmt.Errorf("expected %d got %d: %s", expectedHTTPStatus, resultCode, string(b))
+	}
+
+	resp := &apimodel.Invite{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *InviteCreateTestSuite) TestCreateInvite() {
+	invite, err := suite.createInvite(
+		suite.testAccounts["local_account_1"],
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		"max_uses=5&expires_in=86400",
+		http.StatusOK,
+	)
+	suite.NoError(err)
+	suite.NotEmpty(invite.ID)
+	suite.NotEmpty(invite.Code)
+	suite.Equal("http://localhost:8080/invite/"+invite.Code, invite.URL)
+	suite.Equal(5, invite.MaxUses)
+	suite.Equal(0, invite.Uses)
+	suite.NotNil(invite.ExpiresAt)
+	suite.True(invite.Usable)
+}
+
+func (suite *InviteCreateTestSuite) TestCreateInviteNegativeMaxUses() {
+	_, err := suite.createInvite(
+		suite.testAccounts["local_account_1"],
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		"max_uses=-1",
+		http.StatusBadRequest,
+	)
+	suite.NoError(err)
+}
+
+func (suite *InviteCreateTestSuite) TestCreateInviteExpiresInTooLong() {
+	_, err := suite.createInvite(
+		suite.testAccounts["local_account_1"],
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		"expires_in=9223372036854775807",
+		http.StatusBadRequest,
+	)
+	suite.NoError(err)
+}
+
+func (suite *InviteCreateTestSuite) TestCreateInviteAdminOnly() {
+	config.SetAccountsInvitesAdminOnly(true)
+
+	_, err := suite.createInvite(
+		suite.testAccounts["local_account_1"],
+		suite.testTokens["local_account_1"],
+		suite.testUsers["local_account_1"],
+		"",
+		http.StatusForbidden,
+	)
+	suite.NoError(err)
+
+	_, err = suite.createInvite(
+		suite.testAccounts["admin_account"],
+		suite.testTokens["admin_account"],
+		suite.testUsers["admin_account"],
+		"",
+		http.StatusOK,
+	)
+	suite.NoError(err)
+}
+
+func TestInviteCreateTestSuite(t *testing.T) {
+	suite.Run(t, &InviteCreateTestSuite{})
+}
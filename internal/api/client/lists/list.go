@@ -28,6 +28,17 @@ import (
 const (
 	// BasePath is the base path for serving the lists API, minus the 'api' prefix
 	BasePath = "/v1/lists"
+	// IDKey is the key for the list ID in the URL path
+	IDKey = "id"
+	// BasePathWithID is the base path with the list ID key in it
+	BasePathWithID = BasePath + "/:" + IDKey
+	// AccountsPath is the path for adding, removing, and viewing accounts in a list
+	AccountsPath = BasePathWithID + "/accounts"
+
+	MaxIDKey   = "max_id"
+	SinceIDKey = "since_id"
+	MinIDKey   = "min_id"
+	LimitKey   = "limit"
 )
 
 type Module struct {
@@ -41,5 +52,15 @@ func New(processor processing.Processor) *Module {
 }
 
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	// create / get / update / delete lists
 	attachHandler(http.MethodGet, BasePath, m.ListsGETHandler)
+	attachHandler(http.MethodPost, BasePath, m.ListCreatePOSTHandler)
+	attachHandler(http.MethodGet, BasePathWithID, m.ListGETHandler)
+	attachHandler(http.MethodPut, BasePathWithID, m.ListUpdatePUTHandler)
+	attachHandler(http.MethodDelete, BasePathWithID, m.ListDELETEHandler)
+
+	// get / add / remove list accounts
+	attachHandler(http.MethodGet, AccountsPath, m.ListAccountsGETHandler)
+	attachHandler(http.MethodPost, AccountsPath, m.ListAccountsPOSTHandler)
+	attachHandler(http.MethodDelete, AccountsPath, m.ListAccountsDELETEHandler)
 }
@@ -0,0 +1,141 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package lists_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/lists"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ListAccountsAddTestSuite struct {
+	ListsStandardTestSuite
+}
+
+func (suite *ListAccountsAddTestSuite) postListAccounts(
+	account *gtsmodel.Account,
+	token *gtsmodel.Token,
+	user *gtsmodel.User,
+	expectedHTTPStatus int,
+	listID string,
+	accountIDs []string,
+) (string, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, account)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	form := url.Values{}
+	for _, accountID := range accountIDs {
+		form.Add("account_ids[]", accountID)
+	}
+
+	requestURI := config.GetProtocol() + "://" + config.GetHost() + "/api" + lists.BasePath + "/" + listID + "/accounts"
+	ctx.Request = httptest.NewRequest(http.MethodPost, requestURI, strings.NewReader(form.Encode()))
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Header.Set("content-type", "application/x-www-form-urlencoded")
+	ctx.AddParam(lists.IDKey, listID)
+
+	// trigger the handler
+	suite.listsModule.ListAccountsPOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return "", err
+	}
+
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		return string(b), fmt.Errorf("expected %d got %d", expectedHTTPStatus, resultCode)
+	}
+
+	return string(b), nil
+}
+
+func (suite *ListAccountsAddTestSuite) TestAddNotFollowed() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+	testUser := suite.testUsers["local_account_1"]
+	testList := suite.testLists["local_account_1_list_1"]
+
+	// zork doesn't follow remote_account_1
+	targetAccountID := suite.testAccounts["remote_account_1"].ID
+
+	_, err := suite.postListAccounts(testAccount, testToken, testUser, http.StatusNotFound, testList.ID, []string{targetAccountID})
+	suite.NoError(err)
+}
+
+func (suite *ListAccountsAddTestSuite) TestAddAlreadyInList() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+	testUser := suite.testUsers["local_account_1"]
+	testList := suite.testLists["local_account_1_list_1"]
+
+	_, err := suite.postListAccounts(testAccount, testToken, testUser, http.StatusUnprocessableEntity, testList.ID, []string{suite.testAccounts["local_account_2"].ID})
+	suite.NoError(err)
+}
+
+func (suite *ListAccountsAddTestSuite) TestAddOtherAccountsList() {
+	testAccount := suite.testAccounts["local_account_2"]
+	testToken := suite.testTokens["local_account_2"]
+	testUser := suite.testUsers["local_account_2"]
+	testList := suite.testLists["local_account_1_list_1"]
+
+	_, err := suite.postListAccounts(testAccount, testToken, testUser, http.StatusNotFound, testList.ID, []string{suite.testAccounts["local_account_1"].ID})
+	suite.NoError(err)
+}
+
+func (suite *ListAccountsAddTestSuite) TestAddNoAccounts() {
+	testAccount := suite.testAccounts["local_account_1"]
+	testToken := suite.testTokens["local_account_1"]
+	testUser := suite.testUsers["local_account_1"]
+	testList := suite.testLists["local_account_1_list_1"]
+
+	resp, err := suite.postListAccounts(testAccount, testToken, testUser, http.StatusBadRequest, testList.ID, nil)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: no account IDs given"}`, resp)
+
+	// nothing should have changed
+	entries, err := suite.db.GetListEntries(context.Background(), testList.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(entries, 2)
+}
+
+func TestListAccountsAddTestSuite(t *testing.T) {
+	suite.Run(t, &ListAccountsAddTestSuite{})
+}
@@ -0,0 +1,141 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package polls_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/polls"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollGetTestSuite struct {
+	PollsStandardTestSuite
+}
+
+func (suite *PollGetTestSuite) getPoll(requestingAccount *gtsmodel.Account, token *gtsmodel.Token, user *gtsmodel.User, expectedHTTPStatus int, expectedBody string, pollID string) (*apimodel.Poll, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, requestingAccount)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodGet, config.GetProtocol()+"://"+config.GetHost()+"/api/"+polls.BasePath+"/"+pollID, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam("id", pollID)
+
+	// trigger the handler
+	suite.pollsModule.PollGETHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.Poll{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (suite *PollGetTestSuite) TestGetPoll() {
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.getPoll(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusOK, "", targetPoll.ID)
+	suite.NoError(err)
+	suite.NotNil(poll)
+
+	b, err := json.MarshalIndent(&poll, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "id": "01GV1X9K0J8PX1MEGQNWD7Q3XZ",
+  "expires_at": "2099-10-20T10:40:37.000Z",
+  "expired": false,
+  "multiple": false,
+  "votes_count": 1,
+  "voters_count": 1,
+  "options": [
+    {
+      "title": "yes",
+      "votes_count": 1
+    },
+    {
+      "title": "no",
+      "votes_count": 0
+    }
+  ],
+  "emojis": []
+}`, string(b))
+}
+
+func (suite *PollGetTestSuite) TestGetPollNotVisible() {
+	// admin_account doesn't follow local_account_2,
+	// so they can't see the followers-only status
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.getPoll(suite.testAccounts["admin_account"], suite.testTokens["admin_account"], suite.testUsers["admin_account"], http.StatusNotFound, `{"error":"Not Found"}`, targetPoll.ID)
+	suite.NoError(err)
+	suite.Nil(poll)
+}
+
+func (suite *PollGetTestSuite) TestGetPollNotFound() {
+	poll, err := suite.getPoll(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusNotFound, `{"error":"Not Found"}`, "01GPJWHQS1BG0SF0WZ1SABC4RZ")
+	suite.NoError(err)
+	suite.Nil(poll)
+}
+
+func TestPollGetTestSuite(t *testing.T) {
+	suite.Run(t, &PollGetTestSuite{})
+}
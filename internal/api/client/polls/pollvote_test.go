@@ -0,0 +1,181 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package polls_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/polls"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollVoteTestSuite struct {
+	PollsStandardTestSuite
+}
+
+func (suite *PollVoteTestSuite) vote(requestingAccount *gtsmodel.Account, token *gtsmodel.Token, user *gtsmodel.User, expectedHTTPStatus int, expectedBody string, pollID string, choices []int) (*apimodel.Poll, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, requestingAccount)
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(token))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, user)
+
+	// create the request
+	ctx.Request = httptest.NewRequest(http.MethodPost, config.GetProtocol()+"://"+config.GetHost()+"/api/"+polls.BasePath+"/"+pollID+"/votes", nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.AddParam("id", pollID)
+	formChoices := make([]string, 0, len(choices))
+	for _, c := range choices {
+		formChoices = append(formChoices, strconv.Itoa(c))
+	}
+	ctx.Request.Form = url.Values{
+		"choices[]": formChoices,
+	}
+
+	// trigger the handler
+	suite.pollsModule.PollVotePOSTHandler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.Poll{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, errs.Combine()
+}
+
+func (suite *PollVoteTestSuite) TestVote() {
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.vote(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusOK, "", targetPoll.ID, []int{1})
+	suite.NoError(err)
+	suite.NotNil(poll)
+
+	b, err := json.MarshalIndent(&poll, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "id": "01GV1X9K0J8PX1MEGQNWD7Q3XZ",
+  "expires_at": "2099-10-20T10:40:37.000Z",
+  "expired": false,
+  "multiple": false,
+  "votes_count": 2,
+  "voters_count": 2,
+  "voted": true,
+  "own_votes": [
+    1
+  ],
+  "options": [
+    {
+      "title": "yes",
+      "votes_count": 1
+    },
+    {
+      "title": "no",
+      "votes_count": 1
+    }
+  ],
+  "emojis": []
+}`, string(b))
+}
+
+func (suite *PollVoteTestSuite) TestVoteTwice() {
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	_, err := suite.vote(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusOK, "", targetPoll.ID, []int{0})
+	suite.NoError(err)
+
+	poll, err := suite.vote(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: you have already voted in this poll"}`, targetPoll.ID, []int{0})
+	suite.NoError(err)
+	suite.Nil(poll)
+}
+
+func (suite *PollVoteTestSuite) TestVoteOwnPoll() {
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.vote(suite.testAccounts["local_account_2"], suite.testTokens["local_account_2"], suite.testUsers["local_account_2"], http.StatusUnprocessableEntity, `{"error":"Unprocessable Entity: you can't vote in your own poll"}`, targetPoll.ID, []int{0})
+	suite.NoError(err)
+	suite.Nil(poll)
+}
+
+func (suite *PollVoteTestSuite) TestVoteChoiceOutOfRange() {
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.vote(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusBadRequest, `{"error":"Bad Request: choice 2 is not a valid poll option"}`, targetPoll.ID, []int{2})
+	suite.NoError(err)
+	suite.Nil(poll)
+}
+
+func (suite *PollVoteTestSuite) TestVoteMultipleChoicesSingleChoicePoll() {
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.vote(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusBadRequest, `{"error":"Bad Request: poll only allows one choice"}`, targetPoll.ID, []int{0, 1})
+	suite.NoError(err)
+	suite.Nil(poll)
+}
+
+func (suite *PollVoteTestSuite) TestVoteNoChoices() {
+	targetPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.vote(suite.testAccounts["local_account_1"], suite.testTokens["local_account_1"], suite.testUsers["local_account_1"], http.StatusBadRequest, `{"error":"Bad Request: no choices provided"}`, targetPoll.ID, nil)
+	suite.NoError(err)
+	suite.Nil(poll)
+}
+
+func TestPollVoteTestSuite(t *testing.T) {
+	suite.Run(t, &PollVoteTestSuite{})
+}
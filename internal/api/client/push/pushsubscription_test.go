@@ -0,0 +1,240 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/push"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+const (
+	// a valid P-256 public key and auth secret, as a browser would send them
+	testP256dh = "BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcxaOzi6-AYWXvTBHm4bjyPjs7Vd8pZGH6SRpkNtoIAiw4"
+	testAuth   = "BTBZMqHH6r4Tts7J_aSIgg"
+)
+
+type PushSubscriptionTestSuite struct {
+	PushStandardTestSuite
+}
+
+func (suite *PushSubscriptionTestSuite) request(method string, handler gin.HandlerFunc, form url.Values, jsonBody string, expectedHTTPStatus int, expectedBody string) (*apimodel.PushSubscription, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	var body io.Reader
+	if jsonBody != "" {
+		body = strings.NewReader(jsonBody)
+	}
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+push.BasePath, body)
+	ctx.Request.Header.Set("accept", "application/json")
+	if jsonBody != "" {
+		ctx.Request.Header.Set("content-type", "application/json")
+	} else if form != nil {
+		ctx.Request.Form = form
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	// if we got an expected body, return early
+	if expectedBody != "" {
+		if string(b) != expectedBody {
+			errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+		}
+		return nil, errs.Combine()
+	}
+
+	resp := &apimodel.PushSubscription{}
+	if err := json.Unmarshal(b, resp); err != nil {
+		return nil, err
+	}
+
+	return resp, errs.Combine()
+}
+
+func (suite *PushSubscriptionTestSuite) TestGet() {
+	testSubscription := suite.testWebPushSubs["local_account_1_token_1"]
+
+	subscription, err := suite.request(http.MethodGet, suite.pushModule.PushSubscriptionGETHandler, nil, "", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal(testSubscription.ID, subscription.ID)
+	suite.Equal(testSubscription.Endpoint, subscription.Endpoint)
+	suite.NotEmpty(subscription.ServerKey)
+	suite.Equal(&apimodel.PushSubscriptionAlerts{
+		Follow:        true,
+		FollowRequest: true,
+		Favourite:     true,
+		Mention:       true,
+	}, subscription.Alerts)
+}
+
+func (suite *PushSubscriptionTestSuite) TestGetNone() {
+	_, err := suite.request(http.MethodDelete, suite.pushModule.PushSubscriptionDELETEHandler, nil, "", http.StatusOK, "{}")
+	suite.NoError(err)
+
+	_, err = suite.request(http.MethodGet, suite.pushModule.PushSubscriptionGETHandler, nil, "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateForm() {
+	testSubscription := suite.testWebPushSubs["local_account_1_token_1"]
+
+	subscription, err := suite.request(http.MethodPost, suite.pushModule.PushSubscriptionPOSTHandler, url.Values{
+		"subscription[endpoint]":       {"https://push.example.org/send/somewhere-else"},
+		"subscription[keys][p256dh]":   {testP256dh},
+		"subscription[keys][auth]":     {testAuth},
+		"data[alerts][reblog]":         {"true"},
+		"data[alerts][follow]":         {"false"},
+		"data[alerts][poll]":           {"true"},
+		"data[alerts][not_an_alert]":   {"true"},
+		"data[alerts][follow_request]": {"false"},
+	}, "", http.StatusOK, "")
+	suite.NoError(err)
+
+	// the old subscription for this token should have been replaced
+	suite.NotEqual(testSubscription.ID, subscription.ID)
+	suite.Equal("https://push.example.org/send/somewhere-else", subscription.Endpoint)
+	suite.Equal(&apimodel.PushSubscriptionAlerts{
+		Reblog: true,
+		Poll:   true,
+	}, subscription.Alerts)
+
+	got, err := suite.request(http.MethodGet, suite.pushModule.PushSubscriptionGETHandler, nil, "", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal(subscription, got)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateJSON() {
+	subscription, err := suite.request(http.MethodPost, suite.pushModule.PushSubscriptionPOSTHandler, nil, `{
+  "subscription": {
+    "endpoint": "https://push.example.org/send/json",
+    "keys": {
+      "p256dh": "`+testP256dh+`",
+      "auth": "`+testAuth+`"
+    }
+  },
+  "data": {
+    "alerts": {
+      "mention": true,
+      "status": true
+    }
+  }
+}`, http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal("https://push.example.org/send/json", subscription.Endpoint)
+	suite.Equal(&apimodel.PushSubscriptionAlerts{
+		Mention: true,
+		Status:  true,
+	}, subscription.Alerts)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateInvalidKeys() {
+	_, err := suite.request(http.MethodPost, suite.pushModule.PushSubscriptionPOSTHandler, url.Values{
+		"subscription[endpoint]":     {"https://push.example.org/send/somewhere-else"},
+		"subscription[keys][p256dh]": {"BCVxsr7N_eNgVRqvHtD0zTZsEc6-VV-JvLexhqUzORcx"},
+		"subscription[keys][auth]":   {testAuth},
+	}, "", http.StatusBadRequest, `{"error":"Bad Request: p256dh key was not a valid P-256 public key"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateInvalidEndpoint() {
+	_, err := suite.request(http.MethodPost, suite.pushModule.PushSubscriptionPOSTHandler, url.Values{
+		"subscription[endpoint]":     {"ftp://push.example.org/send/somewhere-else"},
+		"subscription[keys][p256dh]": {testP256dh},
+		"subscription[keys][auth]":   {testAuth},
+	}, "", http.StatusBadRequest, `{"error":"Bad Request: subscription endpoint ftp://push.example.org/send/somewhere-else is not an http(s) URL"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestCreateMissingKeys() {
+	_, err := suite.request(http.MethodPost, suite.pushModule.PushSubscriptionPOSTHandler, url.Values{
+		"subscription[endpoint]": {"https://push.example.org/send/somewhere-else"},
+	}, "", http.StatusBadRequest, `{"error":"Bad Request: subscription keys must include both p256dh and auth"}`)
+	suite.NoError(err)
+}
+
+func (suite *PushSubscriptionTestSuite) TestUpdate() {
+	testSubscription := suite.testWebPushSubs["local_account_1_token_1"]
+
+	// alerts that aren't given should be left alone
+	subscription, err := suite.request(http.MethodPut, suite.pushModule.PushSubscriptionPUTHandler, url.Values{
+		"data[alerts][mention]": {"false"},
+		"data[alerts][reblog]":  {"true"},
+	}, "", http.StatusOK, "")
+	suite.NoError(err)
+	suite.Equal(testSubscription.ID, subscription.ID)
+	suite.Equal(&apimodel.PushSubscriptionAlerts{
+		Follow:        true,
+		FollowRequest: true,
+		Favourite:     true,
+		Reblog:        true,
+	}, subscription.Alerts)
+}
+
+func (suite *PushSubscriptionTestSuite) TestUpdateNone() {
+	_, err := suite.request(http.MethodDelete, suite.pushModule.PushSubscriptionDELETEHandler, nil, "", http.StatusOK, "{}")
+	suite.NoError(err)
+
+	_, err = suite.request(http.MethodPut, suite.pushModule.PushSubscriptionPUTHandler, url.Values{
+		"data[alerts][mention]": {"false"},
+	}, "", http.StatusNotFound, `{"error":"Not Found"}`)
+	suite.NoError(err)
+}
+
+func TestPushSubscriptionTestSuite(t *testing.T) {
+	suite.Run(t, new(PushSubscriptionTestSuite))
+}
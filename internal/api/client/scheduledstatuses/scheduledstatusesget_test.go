@@ -0,0 +1,105 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatuses_test
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/scheduledstatuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ScheduledStatusesGetTestSuite struct {
+	ScheduledStatusesStandardTestSuite
+}
+
+func (suite *ScheduledStatusesGetTestSuite) request(method string, path string, id string, handler gin.HandlerFunc, expectedHTTPStatus int) []byte {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	if id != "" {
+		ctx.AddParam(scheduledstatuses.IDKey, id)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(expectedHTTPStatus, recorder.Code, string(b))
+
+	return b
+}
+
+func (suite *ScheduledStatusesGetTestSuite) TestGetScheduledStatuses() {
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+
+	b := suite.request(http.MethodGet, scheduledstatuses.BasePath, "", suite.scheduledStatusesModule.ScheduledStatusesGETHandler, http.StatusOK)
+
+	apiScheduledStatuses := []*apimodel.ScheduledStatus{}
+	suite.NoError(json.Unmarshal(b, &apiScheduledStatuses))
+	suite.Len(apiScheduledStatuses, 1)
+	suite.Equal(testScheduledStatus.ID, apiScheduledStatuses[0].ID)
+	suite.Equal("2099-05-14T11:21:09.000Z", apiScheduledStatuses[0].ScheduledAt)
+	suite.Equal("hello from the future!", apiScheduledStatuses[0].Params.Text)
+	suite.Equal("public", apiScheduledStatuses[0].Params.Visibility)
+	suite.Empty(apiScheduledStatuses[0].MediaAttachments)
+}
+
+func (suite *ScheduledStatusesGetTestSuite) TestGetDeleteScheduledStatus() {
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+	path := "/v1/scheduled_statuses/" + testScheduledStatus.ID
+
+	b := suite.request(http.MethodGet, path, testScheduledStatus.ID, suite.scheduledStatusesModule.ScheduledStatusGETHandler, http.StatusOK)
+
+	apiScheduledStatus := &apimodel.ScheduledStatus{}
+	suite.NoError(json.Unmarshal(b, apiScheduledStatus))
+	suite.Equal(testScheduledStatus.ID, apiScheduledStatus.ID)
+
+	b = suite.request(http.MethodDelete, path, testScheduledStatus.ID, suite.scheduledStatusesModule.ScheduledStatusDELETEHandler, http.StatusOK)
+	suite.Equal("{}", string(b))
+
+	b = suite.request(http.MethodGet, path, testScheduledStatus.ID, suite.scheduledStatusesModule.ScheduledStatusGETHandler, http.StatusNotFound)
+	suite.Equal(`{"error":"Not Found"}`, string(b))
+}
+
+func TestScheduledStatusesGetTestSuite(t *testing.T) {
+	suite.Run(t, &ScheduledStatusesGetTestSuite{})
+}
@@ -57,6 +57,7 @@ type SearchStandardTestSuite struct {
 	testApplications map[string]*gtsmodel.Application
 	testUsers        map[string]*gtsmodel.User
 	testAccounts     map[string]*gtsmodel.Account
+	testFollows      map[string]*gtsmodel.Follow
 
 	// module being tested
 	searchModule *search.Module
@@ -68,6 +69,7 @@ func (suite *SearchStandardTestSuite) SetupSuite() {
 	suite.testApplications = testrig.NewTestApplications()
 	suite.testUsers = testrig.NewTestUsers()
 	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testFollows = testrig.NewTestFollows()
 }
 
 func (suite *SearchStandardTestSuite) SetupTest() {
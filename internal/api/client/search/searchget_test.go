@@ -19,16 +19,19 @@
 package search_test
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/search"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type SearchGetTestSuite struct {
@@ -37,6 +40,20 @@ type SearchGetTestSuite struct {
 
 func (suite *SearchGetTestSuite) testSearch(query string, resolve bool, expectedHTTPStatus int) (*apimodel.SearchResult, error) {
 	requestPath := fmt.Sprintf("%s?q=%s&resolve=%t", search.BasePathV1, query, resolve)
+	return suite.testSearchPath(requestPath, expectedHTTPStatus)
+}
+
+func (suite *SearchGetTestSuite) testSearchStatuses(query string, params string, expectedHTTPStatus int) (*apimodel.SearchResult, error) {
+	requestPath := fmt.Sprintf("%s?q=%s&type=%s&%s", search.BasePathV2, url.QueryEscape(query), search.TypeStatuses, params)
+	return suite.testSearchPath(requestPath, expectedHTTPStatus)
+}
+
+func (suite *SearchGetTestSuite) testSearchType(query string, searchType string, expectedHTTPStatus int) (*apimodel.SearchResult, error) {
+	requestPath := fmt.Sprintf("%s?q=%s&type=%s", search.BasePathV2, url.QueryEscape(query), searchType)
+	return suite.testSearchPath(requestPath, expectedHTTPStatus)
+}
+
+func (suite *SearchGetTestSuite) testSearchPath(requestPath string, expectedHTTPStatus int) (*apimodel.SearchResult, error) {
 	recorder := httptest.NewRecorder()
 
 	ctx := suite.newContext(recorder, requestPath)
@@ -252,6 +269,138 @@ func (suite *SearchGetTestSuite) TestSearchStatusByURL() {
 	suite.NotNil(gotStatus)
 }
 
+func (suite *SearchGetTestSuite) TestSearchStatusesByText() {
+	searchResult, err := suite.testSearchStatuses("introduction post", "limit=10", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(searchResult.Accounts)
+	if !suite.Len(searchResult.Statuses, 2) {
+		suite.FailNow("expected 2 statuses in search results")
+	}
+
+	// newest first
+	suite.Equal("01F8MHBQCBTDKN6X5VHGMMN4MA", searchResult.Statuses[0].ID)
+	suite.Equal("01F8MHAMCHF6Y650WCRSCP4WMY", searchResult.Statuses[1].ID)
+}
+
+func (suite *SearchGetTestSuite) TestSearchStatusesByTextPaging() {
+	searchResult, err := suite.testSearchStatuses("hi", "limit=2&max_id=01FN3VJGFH10KR7S2PB0GFJZYG", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if !suite.Len(searchResult.Statuses, 2) {
+		suite.FailNow("expected 2 statuses in search results")
+	}
+	suite.Equal("01FF25D5Q0DH7CHD57CTRS6WK0", searchResult.Statuses[0].ID)
+	suite.Equal("01FCTA44PW9H1TB328S9AQXKDS", searchResult.Statuses[1].ID)
+
+	searchResult, err = suite.testSearchStatuses("hi", "limit=2&offset=1", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if !suite.Len(searchResult.Statuses, 2) {
+		suite.FailNow("expected 2 statuses in search results")
+	}
+	suite.Equal("01FN3VJGFH10KR7S2PB0GFJZYG", searchResult.Statuses[0].ID)
+	suite.Equal("01FF25D5Q0DH7CHD57CTRS6WK0", searchResult.Statuses[1].ID)
+}
+
+func (suite *SearchGetTestSuite) TestSearchStatusesByTextNotVisible() {
+	// zork can see this followers-only status while following local_account_2...
+	searchResult, err := suite.testSearchStatuses("did u know", "", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	if !suite.Len(searchResult.Statuses, 1) {
+		suite.FailNow("expected 1 status in search results")
+	}
+	suite.Equal("01G20ZM733MGN8J344T4ZDDFY1", searchResult.Statuses[0].ID)
+
+	// ... but not any more after unfollowing
+	if err := suite.db.DeleteByID(context.Background(), suite.testFollows["local_account_1_local_account_2"].ID, &gtsmodel.Follow{}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	searchResult, err = suite.testSearchStatuses("did u know", "", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(searchResult.Statuses)
+}
+
+func (suite *SearchGetTestSuite) TestSearchStatusesByTextLocalAccountName() {
+	// the_mighty_zork is also a local account, but
+	// when only searching statuses we still want to
+	// find statuses that mention zork by name
+	searchResult, err := suite.testSearchStatuses("the_mighty_zork", "limit=10", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(searchResult.Accounts)
+	suite.Len(searchResult.Statuses, 3)
+}
+
+func (suite *SearchGetTestSuite) TestSearchAccountsByName() {
+	searchResult, err := suite.testSearchType("some", search.TypeAccounts, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(searchResult.Statuses)
+	suite.Empty(searchResult.Hashtags)
+	if !suite.Len(searchResult.Accounts, 1) {
+		suite.FailNow("expected 1 account in search results")
+	}
+	suite.Equal("Some_User@example.org", searchResult.Accounts[0].Acct)
+}
+
+func (suite *SearchGetTestSuite) TestSearchAccountsByNameNoDuplicates() {
+	// the exact mention lookup and the name search both find zork
+	searchResult, err := suite.testSearchType("@the_mighty_zork", search.TypeAccounts, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if !suite.Len(searchResult.Accounts, 1) {
+		suite.FailNow("expected 1 account in search results")
+	}
+	suite.Equal("the_mighty_zork", searchResult.Accounts[0].Acct)
+}
+
+func (suite *SearchGetTestSuite) TestSearchHashtags() {
+	searchResult, err := suite.testSearchType("#wel", search.TypeHashtags, http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(searchResult.Accounts)
+	suite.Empty(searchResult.Statuses)
+	if !suite.Len(searchResult.Hashtags, 1) {
+		suite.FailNow("expected 1 hashtag in search results")
+	}
+
+	tag := searchResult.Hashtags[0]
+	suite.Equal("welcome", tag.Name)
+	suite.Equal("http://localhost:8080/tags/welcome", tag.URL)
+	suite.Len(tag.History, 7)
+	suite.Equal("0", tag.History[0].Uses)
+}
+
+func (suite *SearchGetTestSuite) TestSearchAllTypes() {
+	// "welcome" is a hashtag, and appears in the text of
+	// statuses, but there are no accounts by that name
+	searchResult, err := suite.testSearchType("welcome", "", http.StatusOK)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(searchResult.Accounts)
+	suite.Len(searchResult.Statuses, 2)
+	suite.Len(searchResult.Hashtags, 1)
+}
+
 func TestSearchGetTestSuite(t *testing.T) {
 	suite.Run(t, &SearchGetTestSuite{})
 }
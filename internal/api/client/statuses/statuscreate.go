@@ -32,6 +32,11 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
+const (
+	pollMinExpiration = 300     // seconds
+	pollMaxExpiration = 2629746 // seconds
+)
+
 // StatusCreatePOSTHandler swagger:operation POST /api/v1/statuses statusCreate
 //
 // Create a new status.
@@ -57,7 +62,9 @@ import (
 //
 //	responses:
 //		'200':
-//			description: "The newly created status."
+//			description: >-
+//				The newly created status, or, if scheduled_at was set,
+//				the newly created scheduled status.
 //			schema:
 //				"$ref": "#/definitions/status"
 //		'400':
@@ -70,6 +77,8 @@ import (
 //			description: not found
 //		'406':
 //			description: not acceptable
+//		'422':
+//			description: unprocessable
 //		'500':
 //			description: internal server error
 func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
@@ -79,6 +88,11 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if err := authed.CheckScope(oauth.ScopeWriteStatuses); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorForbidden(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
 	if _, err := apiutil.NegotiateAccept(c, apiutil.JSONAcceptHeaders...); err != nil {
 		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), m.processor.InstanceGet)
 		return
@@ -104,6 +118,19 @@ func (m *Module) StatusCreatePOSTHandler(c *gin.Context) {
 		return
 	}
 
+	if form.ScheduledAt != "" {
+		// status should be published later,
+		// so return the scheduled status instead
+		apiScheduledStatus, errWithCode := m.processor.ScheduledStatusCreate(c.Request.Context(), authed, form)
+		if errWithCode != nil {
+			apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
+			return
+		}
+
+		c.JSON(http.StatusOK, apiScheduledStatus)
+		return
+	}
+
 	apiStatus, errWithCode := m.processor.StatusCreate(c.Request.Context(), authed, form)
 	if errWithCode != nil {
 		apiutil.ErrorHandler(c, errWithCode, m.processor.InstanceGet)
@@ -146,6 +173,9 @@ func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 		if form.Poll.Options == nil {
 			return errors.New("poll with no options")
 		}
+		if len(form.Poll.Options) < 2 {
+			return errors.New("poll must have at least 2 options")
+		}
 		if len(form.Poll.Options) > maxPollOptions {
 			return fmt.Errorf("too many poll options provided, %d provided but limit is %d", len(form.Poll.Options), maxPollOptions)
 		}
@@ -154,6 +184,9 @@ func validateCreateStatus(form *apimodel.AdvancedStatusCreateForm) error {
 				return fmt.Errorf("poll option too long, %d characters provided but limit is %d", length, maxPollChars)
 			}
 		}
+		if form.Poll.ExpiresIn < pollMinExpiration || form.Poll.ExpiresIn > pollMaxExpiration {
+			return fmt.Errorf("poll expires_in must be between %d and %d seconds, %d provided", pollMinExpiration, pollMaxExpiration, form.Poll.ExpiresIn)
+		}
 	}
 
 	if form.SpoilerText != "" {
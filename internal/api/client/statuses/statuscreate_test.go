@@ -27,6 +27,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
@@ -102,6 +103,34 @@ func (suite *StatusCreateTestSuite) TestPostNewStatus() {
 	suite.Equal(statusReply.Account.ID, gtsTag.FirstSeenFromAccountID)
 }
 
+func (suite *StatusCreateTestSuite) TestPostNewStatusReadOnlyToken() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	oauthToken.SetScope("read")
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status": {"this should never be posted"},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	suite.EqualValues(http.StatusForbidden, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Forbidden: token scope \"read\" does not include required scope \"write:statuses\""}`, string(b))
+}
+
 func (suite *StatusCreateTestSuite) TestPostNewStatusMarkdown() {
 	// set default post language of account 1 to markdown
 	testAccount := suite.testAccounts["local_account_1"]
@@ -393,6 +422,49 @@ func (suite *StatusCreateTestSuite) TestAttachNewMediaSuccess() {
 	suite.Equal(statusResponse.ID, gtsAttachment.StatusID)
 }
 
+func (suite *StatusCreateTestSuite) TestPostScheduledStatus() {
+	t := suite.testTokens["local_account_1"]
+	oauthToken := oauth.DBTokenToToken(t)
+	scheduledAt := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080/%s", statuses.BasePath), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = url.Values{
+		"status":       {"see you tomorrow!"},
+		"scheduled_at": {scheduledAt.Format(time.RFC3339)},
+	}
+	suite.statusModule.StatusCreatePOSTHandler(ctx)
+
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	// we should get a scheduled status back instead of a status
+	scheduledStatusReply := &apimodel.ScheduledStatus{}
+	err = json.Unmarshal(b, scheduledStatusReply)
+	suite.NoError(err)
+
+	suite.NotEmpty(scheduledStatusReply.ID)
+	suite.Equal(scheduledAt.Format("2006-01-02T15:04:05.000Z"), scheduledStatusReply.ScheduledAt)
+	suite.Equal("see you tomorrow!", scheduledStatusReply.Params.Text)
+	suite.Equal(suite.testApplications["application_1"].ID, scheduledStatusReply.Params.ApplicationID)
+
+	// and it should be stored for later
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(context.Background(), scheduledStatusReply.ID)
+	suite.NoError(err)
+	suite.Equal(suite.testAccounts["local_account_1"].ID, dbScheduledStatus.AccountID)
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
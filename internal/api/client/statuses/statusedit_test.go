@@ -0,0 +1,119 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statuses_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusEditTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) editStatus(accountKey string, targetStatusID string, form url.Values) *httptest.ResponseRecorder {
+	t := suite.testTokens[accountKey]
+	oauthToken := oauth.DBTokenToToken(t)
+
+	// setup
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauthToken)
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[accountKey])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[accountKey])
+	ctx.Request = httptest.NewRequest(http.MethodPut, fmt.Sprintf("http://localhost:8080%s", strings.Replace(statuses.BasePathWithID, ":id", targetStatusID, 1)), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+	ctx.Request.Form = form
+	ctx.AddParam(statuses.IDKey, targetStatusID)
+
+	suite.statusModule.StatusEditPUTHandler(ctx)
+	return recorder
+}
+
+func (suite *StatusEditTestSuite) TestEditStatus() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	recorder := suite.editStatus("local_account_1", targetStatus.ID, url.Values{
+		"status":       {"hello everyone! this is an edit #helloworld"},
+		"spoiler_text": {"edited"},
+		"sensitive":    {"true"},
+	})
+
+	// check response
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply := &apimodel.Status{}
+	err = json.Unmarshal(b, statusReply)
+	suite.NoError(err)
+
+	suite.Equal(targetStatus.ID, statusReply.ID)
+	suite.Equal("<p>hello everyone! this is an edit <a href=\"http://localhost:8080/tags/helloworld\" class=\"mention hashtag\" rel=\"tag nofollow noreferrer noopener\" target=\"_blank\">#<span>helloworld</span></a></p>", statusReply.Content)
+	suite.Equal("edited", statusReply.SpoilerText)
+	suite.True(statusReply.Sensitive)
+	suite.NotNil(statusReply.EditedAt)
+	suite.Len(statusReply.Tags, 1)
+
+	// the previous revision should be stored
+	edits, err := suite.db.GetStatusEdits(context.Background(), targetStatus.ID)
+	suite.NoError(err)
+	suite.Len(edits, 1)
+	suite.Equal(targetStatus.Content, edits[0].Content)
+}
+
+func (suite *StatusEditTestSuite) TestEditStatusEmpty() {
+	recorder := suite.editStatus("local_account_1", suite.testStatuses["local_account_1_status_1"].ID, url.Values{
+		"spoiler_text": {"nothing else though"},
+	})
+
+	suite.EqualValues(http.StatusBadRequest, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Bad Request: no status or media provided"}`, string(b))
+}
+
+func (suite *StatusEditTestSuite) TestEditSomeoneElsesStatus() {
+	recorder := suite.editStatus("local_account_2", suite.testStatuses["local_account_1_status_1"].ID, url.Values{
+		"status": {"muahahaha"},
+	})
+
+	suite.EqualValues(http.StatusForbidden, recorder.Code)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
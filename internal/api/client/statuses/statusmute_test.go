@@ -0,0 +1,129 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statuses_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/statuses"
+	"github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type StatusMuteTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusMuteTestSuite) postMute(requestingAccount string, targetStatus *gtsmodel.Status, path string, handler gin.HandlerFunc) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens[requestingAccount]))
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers[requestingAccount])
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts[requestingAccount])
+	ctx.Request = httptest.NewRequest(http.MethodPost, fmt.Sprintf("http://localhost:8080%s", strings.Replace(path, ":id", targetStatus.ID, 1)), nil) // the endpoint we're hitting
+	ctx.Request.Header.Set("accept", "application/json")
+
+	// normally the router would populate these params from the path values,
+	// but because we're calling the function directly, we need to set them manually.
+	ctx.Params = gin.Params{
+		gin.Param{
+			Key:   statuses.IDKey,
+			Value: targetStatus.ID,
+		},
+	}
+
+	handler(ctx)
+	return recorder
+}
+
+func (suite *StatusMuteTestSuite) TestMuteUnmuteOwnStatus() {
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// mute the thread
+	recorder := suite.postMute("local_account_1", targetStatus, statuses.MutePath, suite.statusModule.StatusMutePOSTHandler)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply := &model.Status{}
+	err = json.Unmarshal(b, statusReply)
+	suite.NoError(err)
+	suite.True(statusReply.Muted)
+
+	// now unmute it again
+	recorder = suite.postMute("local_account_1", targetStatus, statuses.UnmutePath, suite.statusModule.StatusUnmutePOSTHandler)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result = recorder.Result()
+	defer result.Body.Close()
+	b, err = ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply = &model.Status{}
+	err = json.Unmarshal(b, statusReply)
+	suite.NoError(err)
+	suite.False(statusReply.Muted)
+}
+
+func (suite *StatusMuteTestSuite) TestMuteMentioningStatus() {
+	// turtle mentions zork in this one, so zork can mute it
+	targetStatus := suite.testStatuses["local_account_2_status_5"]
+
+	recorder := suite.postMute("local_account_1", targetStatus, statuses.MutePath, suite.statusModule.StatusMutePOSTHandler)
+	suite.EqualValues(http.StatusOK, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+
+	statusReply := &model.Status{}
+	err = json.Unmarshal(b, statusReply)
+	suite.NoError(err)
+	suite.True(statusReply.Muted)
+}
+
+func (suite *StatusMuteTestSuite) TestMuteNotParticipating() {
+	// zork isn't mentioned in this status, so can't mute it
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	recorder := suite.postMute("local_account_1", targetStatus, statuses.MutePath, suite.statusModule.StatusMutePOSTHandler)
+	suite.EqualValues(http.StatusForbidden, recorder.Code)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+	b, err := ioutil.ReadAll(result.Body)
+	suite.NoError(err)
+	suite.Equal(`{"error":"Forbidden: you can only mute conversations you are part of"}`, string(b))
+}
+
+func TestStatusMuteTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusMuteTestSuite))
+}
@@ -0,0 +1,220 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/api/client/tags"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type TagFollowTestSuite struct {
+	TagsStandardTestSuite
+}
+
+func (suite *TagFollowTestSuite) tagRequest(method string, path string, tagName string, handler gin.HandlerFunc, expectedHTTPStatus int, expectedBody string) ([]byte, error) {
+	// instantiate recorder + test context
+	recorder := httptest.NewRecorder()
+	ctx, _ := testrig.CreateGinTestContext(recorder, nil)
+	ctx.Set(oauth.SessionAuthorizedAccount, suite.testAccounts["local_account_1"])
+	ctx.Set(oauth.SessionAuthorizedToken, oauth.DBTokenToToken(suite.testTokens["local_account_1"]))
+	ctx.Set(oauth.SessionAuthorizedApplication, suite.testApplications["application_1"])
+	ctx.Set(oauth.SessionAuthorizedUser, suite.testUsers["local_account_1"])
+
+	// create the request
+	ctx.Request = httptest.NewRequest(method, config.GetProtocol()+"://"+config.GetHost()+"/api"+path, nil)
+	ctx.Request.Header.Set("accept", "application/json")
+	if tagName != "" {
+		ctx.AddParam(tags.TagNameKey, tagName)
+	}
+
+	// trigger the handler
+	handler(ctx)
+
+	// read the response
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := ioutil.ReadAll(result.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	errs := gtserror.MultiError{}
+
+	// check code + body
+	if resultCode := recorder.Code; expectedHTTPStatus != resultCode {
+		errs = append(errs, fmt.Sprintf("expected %d got %d", expectedHTTPStatus, resultCode))
+	}
+
+	if expectedBody != "" && string(b) != expectedBody {
+		errs = append(errs, fmt.Sprintf("expected %s got %s", expectedBody, string(b)))
+	}
+
+	return b, errs.Combine()
+}
+
+func (suite *TagFollowTestSuite) getTag(tagName string, expectedHTTPStatus int, expectedBody string) (*apimodel.Tag, error) {
+	b, err := suite.tagRequest(http.MethodGet, "/v1/tags/"+tagName, tagName, suite.tagsModule.TagGETHandler, expectedHTTPStatus, expectedBody)
+	if err != nil || expectedBody != "" {
+		return nil, err
+	}
+
+	tag := &apimodel.Tag{}
+	if err := json.Unmarshal(b, tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func (suite *TagFollowTestSuite) followTag(tagName string, follow bool, expectedHTTPStatus int, expectedBody string) (*apimodel.Tag, error) {
+	path := "/v1/tags/" + tagName + "/follow"
+	handler := suite.tagsModule.TagFollowPOSTHandler
+	if !follow {
+		path = "/v1/tags/" + tagName + "/unfollow"
+		handler = suite.tagsModule.TagUnfollowPOSTHandler
+	}
+
+	b, err := suite.tagRequest(http.MethodPost, path, tagName, handler, expectedHTTPStatus, expectedBody)
+	if err != nil || expectedBody != "" {
+		return nil, err
+	}
+
+	tag := &apimodel.Tag{}
+	if err := json.Unmarshal(b, tag); err != nil {
+		return nil, err
+	}
+
+	return tag, nil
+}
+
+func (suite *TagFollowTestSuite) getFollowedTags() ([]*apimodel.Tag, error) {
+	b, err := suite.tagRequest(http.MethodGet, tags.FollowedTagsPath, "", suite.tagsModule.FollowedTagsGETHandler, http.StatusOK, "")
+	if err != nil {
+		return nil, err
+	}
+
+	followedTags := []*apimodel.Tag{}
+	if err := json.Unmarshal(b, &followedTags); err != nil {
+		return nil, err
+	}
+
+	return followedTags, nil
+}
+
+func (suite *TagFollowTestSuite) TestGetTag() {
+	tag, err := suite.getTag("Welcome", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal("welcome", tag.Name)
+	suite.Equal("http://localhost:8080/tags/welcome", tag.URL)
+	suite.NotNil(tag.Following)
+	suite.False(*tag.Following)
+}
+
+func (suite *TagFollowTestSuite) TestGetTagNotFound() {
+	_, err := suite.getTag("nosuchtag", http.StatusNotFound, `{"error":"Not Found"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *TagFollowTestSuite) TestGetTagInvalid() {
+	_, err := suite.getTag("not-a-tag", http.StatusBadRequest, `{"error":"Bad Request: tag name 'not-a-tag' contained character '-', which is not permitted in hashtags"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func (suite *TagFollowTestSuite) TestFollowUnfollowTag() {
+	tag, err := suite.followTag("welcome", true, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("welcome", tag.Name)
+	suite.True(*tag.Following)
+
+	// following again should be a no-op
+	if _, err := suite.followTag("welcome", true, http.StatusOK, ""); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	tag, err = suite.getTag("welcome", http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.True(*tag.Following)
+
+	followedTags, err := suite.getFollowedTags()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(followedTags, 1)
+	suite.Equal("welcome", followedTags[0].Name)
+	suite.True(*followedTags[0].Following)
+
+	tag, err = suite.followTag("welcome", false, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.False(*tag.Following)
+
+	followedTags, err = suite.getFollowedTags()
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(followedTags)
+}
+
+func (suite *TagFollowTestSuite) TestFollowNewTag() {
+	// nobody has used this tag yet, so it should be created
+	tag, err := suite.followTag("gardening", true, http.StatusOK, "")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal("gardening", tag.Name)
+	suite.Equal("http://localhost:8080/tags/gardening", tag.URL)
+	suite.True(*tag.Following)
+}
+
+func (suite *TagFollowTestSuite) TestUnfollowTagNotFound() {
+	_, err := suite.followTag("nosuchtag", false, http.StatusNotFound, `{"error":"Not Found"}`)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+func TestTagFollowTestSuite(t *testing.T) {
+	suite.Run(t, &TagFollowTestSuite{})
+}
@@ -0,0 +1,65 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tags
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+)
+
+const (
+	// BasePath is the base path for serving the tags API, minus the 'api' prefix
+	BasePath = "/v1/tags"
+	// TagNameKey is the url path key for the name of a tag
+	TagNameKey = "tag_name"
+	// BasePathWithName is the base path with the tag_name key in it
+	BasePathWithName = BasePath + "/:" + TagNameKey
+	// FollowPath is used for following a tag
+	FollowPath = BasePathWithName + "/follow"
+	// UnfollowPath is used for unfollowing a tag
+	UnfollowPath = BasePathWithName + "/unfollow"
+	// FollowedTagsPath is used for viewing tags followed by the requesting account
+	FollowedTagsPath = "/v1/followed_tags"
+
+	// MaxIDKey is the url query for setting a max ID to return
+	MaxIDKey = "max_id"
+	// MinIDKey is the url query for returning results immediately newer than the given ID
+	MinIDKey = "min_id"
+	// LimitKey is for specifying maximum number of results to return.
+	LimitKey = "limit"
+)
+
+type Module struct {
+	processor processing.Processor
+}
+
+func New(processor processing.Processor) *Module {
+	return &Module{
+		processor: processor,
+	}
+}
+
+func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
+	attachHandler(http.MethodGet, BasePathWithName, m.TagGETHandler)
+	attachHandler(http.MethodPost, FollowPath, m.TagFollowPOSTHandler)
+	attachHandler(http.MethodPost, UnfollowPath, m.TagUnfollowPOSTHandler)
+	attachHandler(http.MethodGet, FollowedTagsPath, m.FollowedTagsGETHandler)
+}
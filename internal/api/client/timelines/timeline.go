@@ -32,6 +32,14 @@ const (
 	HomeTimeline = BasePath + "/home"
 	// PublicTimeline is the path for the public (and public local) timeline
 	PublicTimeline = BasePath + "/public"
+	// ListTimeline is the path for the timeline of one list, specified by ID
+	ListTimeline = BasePath + "/list/:" + IDKey
+	// TagTimeline is the path for the timeline of one hashtag, specified by name
+	TagTimeline = BasePath + "/tag/:" + TagNameKey
+	// IDKey is the url path key for the ID of a list
+	IDKey = "id"
+	// TagNameKey is the url path key for the name of a hashtag
+	TagNameKey = "tag_name"
 	// MaxIDKey is the url query for setting a max status ID to return
 	MaxIDKey = "max_id"
 	// SinceIDKey is the url query for returning results newer than the given ID
@@ -57,4 +65,6 @@ func New(processor processing.Processor) *Module {
 func (m *Module) Route(attachHandler func(method string, path string, f ...gin.HandlerFunc) gin.IRoutes) {
 	attachHandler(http.MethodGet, HomeTimeline, m.HomeTimelineGETHandler)
 	attachHandler(http.MethodGet, PublicTimeline, m.PublicTimelineGETHandler)
+	attachHandler(http.MethodGet, ListTimeline, m.ListTimelineGETHandler)
+	attachHandler(http.MethodGet, TagTimeline, m.TagTimelineGETHandler)
 }
@@ -0,0 +1,45 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package api
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+	"github.com/superseriousbusiness/gotosocial/internal/router"
+)
+
+type Metrics struct {
+	handler gin.HandlerFunc
+}
+
+func (m *Metrics) Route(r router.Router, mw ...gin.HandlerFunc) {
+	// attach middlewares appropriate for this group
+	metricsGroup := r.AttachGroup(metrics.Path)
+	metricsGroup.Use(mw...)
+	metricsGroup.Handle(http.MethodGet, "", m.handler)
+}
+
+func NewMetrics() *Metrics {
+	return &Metrics{
+		handler: gin.WrapH(metrics.Handler(config.GetMetricsAuthToken())),
+	}
+}
@@ -98,6 +98,8 @@ type Account struct {
 	// Omitted for remote accounts.
 	// example: user
 	Role AccountRole `json:"role,omitempty"`
+	// If this account has moved to another account, the account it has moved to.
+	Moved *Account `json:"moved,omitempty"`
 }
 
 // AccountCreateRequest models account creation parameters.
@@ -202,6 +204,18 @@ type AccountFollowRequest struct {
 	Notify *bool `form:"notify" json:"notify" xml:"notify"`
 }
 
+// AccountMuteRequest models a request to mute an account.
+//
+// swagger:ignore
+type AccountMuteRequest struct {
+	// The id of the account to mute.
+	ID string `form:"-" json:"-" xml:"-"`
+	// Mute notifications as well as posts.
+	Notifications *bool `form:"notifications" json:"notifications" xml:"notifications"`
+	// How long the mute should last, in seconds. If 0 or not provided, mute indefinitely.
+	Duration *int `form:"duration" json:"duration" xml:"duration"`
+}
+
 // AccountDeleteRequest models a request to delete an account.
 //
 // swagger:ignore
@@ -213,6 +227,25 @@ type AccountDeleteRequest struct {
 	DeleteOriginID string `form:"-" json:"-" xml:"-"`
 }
 
+// AccountAliasRequest models a request to set the aliases of an account.
+//
+// swagger:ignore
+type AccountAliasRequest struct {
+	// ActivityPub URIs of accounts that this account is also known as.
+	// An empty list removes all aliases.
+	AlsoKnownAsURIs []string `form:"also_known_as_uris[]" json:"also_known_as_uris" xml:"also_known_as_uris"`
+}
+
+// AccountMoveRequest models a request to move an account to another account.
+//
+// swagger:ignore
+type AccountMoveRequest struct {
+	// Password of the account's user, for confirmation.
+	Password string `form:"password" json:"password" xml:"password"`
+	// ActivityPub URI of the account to move to.
+	MovedToURI string `form:"moved_to_uri" json:"moved_to_uri" xml:"moved_to_uri"`
+}
+
 // AccountRole models the role of an account.
 //
 // swagger:enum accountRole
@@ -0,0 +1,80 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+import "mime/multipart"
+
+// AccountExport represents an archive of all of an account's data,
+// which the account has requested for download.
+//
+// swagger:model accountExport
+type AccountExport struct {
+	// The ID of the export.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	ID string `json:"id"`
+	// When the export was requested (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// State of the export.
+	// enum:
+	//   - processing
+	//   - complete
+	//   - failed
+	State string `json:"state"`
+	// Size of the archive in bytes. Only set once the export is complete.
+	Size int64 `json:"size,omitempty"`
+	// When the archive was completed (ISO 8601 Datetime). Only set once the export is complete.
+	// example: 2021-07-30T09:20:25+00:00
+	CompletedAt string `json:"completed_at,omitempty"`
+	// URL at which the zip archive can be downloaded. Only set once the export is complete.
+	// example: https://example.org/api/v1/exports/01FBW21XJA09XYX51KV5JVBW0F/archive
+	URL string `json:"url,omitempty"`
+}
+
+// AccountImportRequest represents a request to import data into
+// an account, from a CSV file in the format used by Mastodon.
+//
+// swagger:ignore
+type AccountImportRequest struct {
+	// The CSV file to import.
+	Data *multipart.FileHeader `form:"data" validation:"required"`
+	// Type of data being imported.
+	Type AccountImportType `form:"type" validation:"required"`
+	// Whether to merge the imported data with existing data,
+	// or to overwrite existing data with the imported data.
+	Mode AccountImportMode `form:"mode"`
+}
+
+// AccountImportType describes the type of data being imported.
+type AccountImportType string
+
+// AccountImportType values.
+const (
+	AccountImportTypeFollowing AccountImportType = "following" // accounts to follow, eg following_accounts.csv
+	AccountImportTypeBlocks    AccountImportType = "blocks"    // accounts to block, eg blocked_accounts.csv
+)
+
+// AccountImportMode describes how imported data is combined with existing data.
+type AccountImportMode string
+
+// AccountImportMode values.
+const (
+	AccountImportModeMerge     AccountImportMode = "merge"     // add imported entries to existing ones
+	AccountImportModeOverwrite AccountImportMode = "overwrite" // remove existing entries that weren't imported
+)
@@ -65,6 +65,8 @@ type AdminAccountInfo struct {
 	Disabled bool `json:"disabled"`
 	// Whether the account is currently silenced
 	Silenced bool `json:"silenced"`
+	// Whether the account's media is currently forced to be marked as sensitive.
+	Sensitized bool `json:"sensitized"`
 	// Whether the account is currently suspended.
 	Suspended bool `json:"suspended"`
 	// User-level information about the account.
@@ -127,6 +129,54 @@ type AdminReport struct {
 	ActionTakenComment *string `json:"action_taken_comment"`
 }
 
+// AdminInstance models the admin view of a remote instance,
+// including how reachable it has been lately.
+//
+// swagger:model adminInstance
+type AdminInstance struct {
+	// The ID of the instance in the database.
+	// example: 01GTE1E4S3R0YH2KE7H9JV1D3T
+	ID string `json:"id"`
+	// The domain of the instance.
+	// example: example.org
+	Domain string `json:"domain"`
+	// Base URI of the instance.
+	// example: https://example.org
+	URI string `json:"uri"`
+	// Title of the instance, if known.
+	// example: Example Instance
+	Title string `json:"title"`
+	// Version of the software used by the instance, if known.
+	// example: 4.1.0
+	Version string `json:"version"`
+	// When the instance was first discovered (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// When the instance was suspended (ISO 8601 Datetime).
+	// Null if the instance is not suspended.
+	// example: 2021-07-30T09:20:25+00:00
+	SuspendedAt *string `json:"suspended_at"`
+	// Number of consecutive failed requests to the instance.
+	// 0 if the most recent request succeeded.
+	// example: 12
+	Failures int `json:"failures"`
+	// When the current run of failed requests started (ISO 8601 Datetime).
+	// Null if the most recent request succeeded.
+	// example: 2021-07-30T09:20:25+00:00
+	FailingSince *string `json:"failing_since"`
+	// When a request to the instance last failed (ISO 8601 Datetime).
+	// Null if no request has ever failed.
+	// example: 2021-07-30T09:20:25+00:00
+	LastFailedAt *string `json:"last_failed_at"`
+	// Error returned by the last failed request to the instance.
+	// example: dial tcp: lookup example.org: no such host
+	LastFailure string `json:"last_failure"`
+	// Whether the instance has been failing for so long that it's considered unreachable.
+	// Requests to unreachable instances are skipped, apart from an occasional probe.
+	// example: false
+	Unreachable bool `json:"unreachable"`
+}
+
 // AdminReportResolveRequest can be submitted along with a POST to /api/v1/admin/reports/{id}/resolve
 //
 // swagger:ignore
@@ -168,14 +218,26 @@ type AdminEmoji struct {
 //
 // swagger:ignore
 type AdminAccountActionRequest struct {
-	// Type of the account action. One of disable, silence, suspend.
+	// Type of the account action. One of none, disable, sensitive, silence, suspend.
 	Type string `form:"type" json:"type" xml:"type"`
 	// Text describing why an action was taken.
 	Text string `form:"text" json:"text" xml:"text"`
+	// Email the owner of the account about the action, if it's a local account.
+	SendEmailNotification bool `form:"send_email_notification" json:"send_email_notification" xml:"send_email_notification"`
+	// ID of a report to mark as resolved by this action.
+	ReportID string `form:"report_id" json:"report_id" xml:"report_id"`
 	// ID of the account to be acted on.
 	TargetAccountID string `form:"-" json:"-" xml:"-"`
 }
 
+// AdminAccountApprovalRequest models a request to approve or reject a pending sign-up.
+//
+// swagger:ignore
+type AdminAccountApprovalRequest struct {
+	// Optional message to include in the email sent to the user.
+	Message string `form:"message" json:"message" xml:"message"`
+}
+
 // MediaCleanupRequest models admin media cleanup parameters
 //
 // swagger:parameters mediaCleanup
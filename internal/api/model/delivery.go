@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// AdminDelivery models the admin view of an outgoing federation
+// delivery which failed, and is queued to be retried.
+//
+// swagger:model adminDelivery
+type AdminDelivery struct {
+	// The ID of the queued delivery.
+	// example: 01GTDM3ZAFSR6D2JBY4A7TE3A6
+	ID string `json:"id"`
+	// Inbox the delivery will be POSTed to.
+	// example: https://example.org/users/someone/inbox
+	TargetURI string `json:"target_uri"`
+	// Domain of the target inbox.
+	// example: example.org
+	TargetDomain string `json:"target_domain"`
+	// URI of the public key the delivery will be signed with.
+	// example: https://example.com/users/admin/main-key
+	KeyID string `json:"key_id"`
+	// Number of attempts made so far, including the initial one.
+	// example: 3
+	Attempts int `json:"attempts"`
+	// Error returned by the most recent attempt.
+	// example: http response "503 Service Unavailable"
+	LastError string `json:"last_error"`
+	// Time at which the delivery was first attempted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the delivery will next be attempted (ISO 8601 Datetime).
+	// example: 2021-07-30T09:40:25+00:00
+	NextAttemptAt string `json:"next_attempt_at"`
+}
+
+// AdminDeliveriesPurgeResponse is returned after purging queued deliveries.
+//
+// swagger:model adminDeliveriesPurgeResponse
+type AdminDeliveriesPurgeResponse struct {
+	// Number of queued deliveries that were removed.
+	// example: 42
+	Purged int `json:"purged"`
+}
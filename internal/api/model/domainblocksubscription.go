@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// DomainBlockSubscription represents a subscription to a blocklist, which is
+// periodically fetched to create or remove domain blocks.
+//
+// swagger:model domainBlockSubscription
+type DomainBlockSubscription struct {
+	// The ID of the subscription.
+	// example: 01FBW21XJA09XYX51KV5JVBW0F
+	// readonly: true
+	ID string `json:"id"`
+	// Title of the subscription, viewable to admins.
+	// example: Shared blocklist
+	Title string `json:"title"`
+	// URI of the blocklist.
+	// example: https://example.org/blocklist.csv
+	URI string `json:"uri"`
+	// Format of the blocklist: one of text/plain, text/csv, application/json.
+	// example: text/csv
+	ContentType string `json:"content_type"`
+	// Obfuscate domain blocks created through this subscription, if the blocklist doesn't say whether to.
+	// example: false
+	Obfuscate bool `json:"obfuscate"`
+	// ID of the account that created this subscription.
+	// example: 01FBW2758ZB6PBR200YPDDJK4C
+	CreatedBy string `json:"created_by"`
+	// Time at which this subscription was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the blocklist was last fetched, successfully or not (ISO 8601 Datetime).
+	// Key will not be present if the blocklist has never been fetched.
+	// example: 2021-07-30T09:20:25+00:00
+	FetchedAt string `json:"fetched_at,omitempty"`
+	// Time at which the blocklist was last fetched successfully (ISO 8601 Datetime).
+	// Key will not be present if the blocklist has never been fetched successfully.
+	// example: 2021-07-30T09:20:25+00:00
+	SuccessfullyFetchedAt string `json:"successfully_fetched_at,omitempty"`
+	// Error encountered the last time the blocklist was fetched, if any.
+	// example: GET request to https://example.org/blocklist.csv failed (404): 404 Not Found
+	Error string `json:"error,omitempty"`
+	// Domains in the blocklist which could not be blocked through this subscription,
+	// because they were already blocked manually or through another subscription.
+	Conflicts []string `json:"conflicts"`
+	// Number of domain blocks currently owned by this subscription.
+	// example: 42
+	Count int `json:"count"`
+}
+
+// DomainBlockSubscriptionCreateRequest is the form submitted as a POST to /api/v1/admin/domain_block_subscriptions to create a new subscription.
+//
+// swagger:ignore
+type DomainBlockSubscriptionCreateRequest struct {
+	// Title of the subscription.
+	Title string `form:"title" json:"title" xml:"title"`
+	// URI of the blocklist.
+	URI string `form:"uri" json:"uri" xml:"uri"`
+	// Format of the blocklist.
+	ContentType string `form:"content_type" json:"content_type" xml:"content_type"`
+	// Obfuscate domain blocks created through this subscription.
+	Obfuscate bool `form:"obfuscate" json:"obfuscate" xml:"obfuscate"`
+}
+
+// DomainBlockSubscriptionUpdateRequest is the form submitted as a PATCH to /api/v1/admin/domain_block_subscriptions/{id} to update a subscription.
+//
+// swagger:ignore
+type DomainBlockSubscriptionUpdateRequest struct {
+	// Title of the subscription.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// URI of the blocklist.
+	URI *string `form:"uri" json:"uri" xml:"uri"`
+	// Format of the blocklist.
+	ContentType *string `form:"content_type" json:"content_type" xml:"content_type"`
+	// Obfuscate domain blocks created through this subscription.
+	Obfuscate *bool `form:"obfuscate" json:"obfuscate" xml:"obfuscate"`
+}
@@ -25,22 +25,153 @@ package model
 // If the phrase starts with a word character, and if the previous character before matched range is a word character, its matched range should be treated to not match.
 // If the phrase ends with a word character, and if the next character after matched range is a word character, its matched range should be treated to not match.
 // Please check app/javascript/mastodon/selectors/index.js and app/lib/feed_manager.rb in the Mastodon source code for more details.
+//
+// swagger:model filterV1
 type Filter struct {
 	// The ID of the filter in the database.
+	// example: 01HN277FSPQAWXZXK92QPPYF79
 	ID string `json:"id"`
 	// The text to be filtered.
-	Phrase string `json:"text"`
+	// example: fnord
+	Phrase string `json:"phrase"`
 	// The contexts in which the filter should be applied.
 	// Array of String (Enumerable anyOf)
 	// 	home = home timeline and lists
 	// 	notifications = notifications timeline
 	// 	public = public timelines
 	// 	thread = expanded thread of a detailed status
+	// 	account = account profile statuses
+	// example: ["home", "public"]
 	Context []string `json:"context"`
 	// Should the filter consider word boundaries?
+	// example: true
 	WholeWord bool `json:"whole_word"`
-	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire
-	ExpiresAt string `json:"expires_at,omitempty"`
+	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire.
+	// example: 2024-02-01T02:57:26Z
+	ExpiresAt *string `json:"expires_at"`
 	// Should matching entities in home and notifications be dropped by the server?
+	// example: false
 	Irreversible bool `json:"irreversible"`
 }
+
+// FilterCreateUpdateRequestV1 captures params for creating or updating a v1 filter.
+//
+// swagger:ignore
+type FilterCreateUpdateRequestV1 struct {
+	// The text to be filtered.
+	Phrase string `form:"phrase" json:"phrase" xml:"phrase"`
+	// The contexts in which the filter should be applied.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// Should the server irreversibly drop matching entities from home and notifications?
+	Irreversible *bool `form:"irreversible" json:"irreversible" xml:"irreversible"`
+	// Should the filter consider word boundaries?
+	WholeWord *bool `form:"whole_word" json:"whole_word" xml:"whole_word"`
+	// Number of seconds from now that the filter should expire.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
+
+// FilterV2 represents a user-defined filter for determining which statuses should not be shown to the user.
+// Contains one or more keywords.
+//
+// swagger:model filterV2
+type FilterV2 struct {
+	// The ID of the filter in the database.
+	// example: 01HN277FSPQAWXZXK92QPPYF79
+	ID string `json:"id"`
+	// The name of the filter.
+	// example: Linux Words
+	Title string `json:"title"`
+	// The contexts in which the filter should be applied.
+	// Array of String (Enumerable anyOf)
+	// 	home = home timeline and lists
+	// 	notifications = notifications timeline
+	// 	public = public timelines
+	// 	thread = expanded thread of a detailed status
+	// 	account = account profile statuses
+	// example: ["home", "public"]
+	Context []string `json:"context"`
+	// When the filter should no longer be applied (ISO 8601 Datetime), or null if the filter does not expire.
+	// example: 2024-02-01T02:57:26Z
+	ExpiresAt *string `json:"expires_at"`
+	// The action to be taken when a status matches this filter.
+	// 	warn = show a warning that identifies the matching filter by title, and allow the user to expand the filtered status
+	// 	hide = do not show this status if it is received
+	// example: warn
+	FilterAction string `json:"filter_action"`
+	// The keywords grouped under this filter.
+	Keywords []FilterKeyword `json:"keywords"`
+	// The statuses grouped under this filter.
+	// Always empty, since status filters are not supported.
+	Statuses []interface{} `json:"statuses"`
+}
+
+// FilterKeyword represents text to filter within a v2 filter.
+//
+// swagger:model filterKeyword
+type FilterKeyword struct {
+	// The ID of the filter keyword entry in the database.
+	// example: 01HN277MSEGCCYX2V2Z2RXCNWS
+	ID string `json:"id"`
+	// The text to be filtered.
+	// example: fnord
+	Keyword string `json:"keyword"`
+	// Should the filter consider word boundaries?
+	// example: true
+	WholeWord bool `json:"whole_word"`
+}
+
+// FilterResult is returned along with a filtered status to explain why it was filtered.
+//
+// swagger:model filterResult
+type FilterResult struct {
+	// The filter that was matched.
+	Filter FilterV2 `json:"filter"`
+	// The keywords within the filter that were matched.
+	KeywordMatches []string `json:"keyword_matches"`
+	// The status IDs within the filter that were matched.
+	StatusMatches []string `json:"status_matches"`
+}
+
+// FilterKeywordCreateUpdateRequest captures params for creating or updating a v2 filter keyword.
+//
+// swagger:ignore
+type FilterKeywordCreateUpdateRequest struct {
+	// The text to be filtered.
+	Keyword string `form:"keyword" json:"keyword" xml:"keyword"`
+	// Should the filter consider word boundaries?
+	WholeWord *bool `form:"whole_word" json:"whole_word" xml:"whole_word"`
+}
+
+// FilterCreateRequestV2 captures params for creating a v2 filter.
+//
+// swagger:ignore
+type FilterCreateRequestV2 struct {
+	// The name of the filter.
+	Title string `form:"title" json:"title" xml:"title"`
+	// The contexts in which the filter should be applied.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// The action to be taken when a status matches this filter.
+	FilterAction string `form:"filter_action" json:"filter_action" xml:"filter_action"`
+	// Number of seconds from now that the filter should expire.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+	// Keywords to be added to the newly created filter.
+	// Only supported in JSON request bodies.
+	Keywords []FilterKeywordCreateUpdateRequest `form:"-" json:"keywords_attributes" xml:"keywords_attributes"`
+}
+
+// FilterUpdateRequestV2 captures params for updating a v2 filter.
+// Keywords are managed separately, using the filter keyword endpoints.
+//
+// swagger:ignore
+type FilterUpdateRequestV2 struct {
+	// The name of the filter.
+	Title *string `form:"title" json:"title" xml:"title"`
+	// The contexts in which the filter should be applied.
+	// If empty, contexts will not be changed.
+	Context []string `form:"context[]" json:"context" xml:"context"`
+	// The action to be taken when a status matches this filter.
+	FilterAction *string `form:"filter_action" json:"filter_action" xml:"filter_action"`
+	// Number of seconds from now that the filter should expire.
+	// Set to 0 to remove the expiry.
+	ExpiresIn *int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
@@ -0,0 +1,66 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package model
+
+// Invite represents an invite code that lets people sign up on this instance,
+// even when registration is closed.
+//
+// swagger:model invite
+type Invite struct {
+	// The ID of the invite.
+	// example: 01GTFWQ2TJ9Q0DZ3A5ZFY8V5MZ
+	ID string `json:"id"`
+	// The code used to redeem the invite.
+	// example: 2a4b0f5e-6a0c-4a6c-9c3e-0e2f4b8f1c7d
+	Code string `json:"code"`
+	// Web URL of the sign-up page for this invite, which can be shared with invitees.
+	// example: https://example.org/invite/2a4b0f5e-6a0c-4a6c-9c3e-0e2f4b8f1c7d
+	URL string `json:"url"`
+	// How many times the invite can be used. 0 means there is no limit.
+	// example: 5
+	MaxUses int `json:"max_uses"`
+	// How many times the invite has been used so far.
+	// example: 2
+	Uses int `json:"uses"`
+	// Time at which the invite was created (ISO 8601 Datetime).
+	// example: 2021-07-30T09:20:25+00:00
+	CreatedAt string `json:"created_at"`
+	// Time at which the invite expires (ISO 8601 Datetime), if it expires at all.
+	// example: 2021-08-06T09:20:25+00:00
+	ExpiresAt *string `json:"expires_at"`
+	// Whether the invite can still be used to sign up.
+	// example: true
+	Usable bool `json:"usable"`
+}
+
+// InviteCreateRequest models invite creation parameters.
+//
+// swagger:parameters inviteCreate
+type InviteCreateRequest struct {
+	// How many times the invite can be used. 0 means there is no limit.
+	// example: 5
+	// default: 0
+	// in: formData
+	MaxUses int `form:"max_uses" json:"max_uses" xml:"max_uses"`
+	// Number of seconds from now after which the invite will expire, at most one year (31536000). 0 means it never expires.
+	// example: 604800
+	// default: 0
+	// in: formData
+	ExpiresIn int `form:"expires_in" json:"expires_in" xml:"expires_in"`
+}
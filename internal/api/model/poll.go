@@ -53,7 +53,7 @@ type PollOptions struct {
 	Title string `json:"title"`
 	// The number of received votes for this option.
 	// Number, or null if results are not published yet.
-	VotesCount int `json:"votes_count,omitempty"`
+	VotesCount *int `json:"votes_count"`
 }
 
 // PollRequest models a request to create a poll.
@@ -72,3 +72,11 @@ type PollRequest struct {
 	// Hide vote counts until the poll ends.
 	HideTotals bool `form:"hide_totals" json:"hide_totals" xml:"hide_totals"`
 }
+
+// PollVoteRequest models a request to vote in a poll.
+//
+// swagger:ignore
+type PollVoteRequest struct {
+	// Indices of the chosen poll options.
+	Choices []int `form:"choices[]" json:"choices" xml:"choices"`
+}
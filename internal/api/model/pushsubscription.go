@@ -19,6 +19,8 @@
 package model
 
 // PushSubscription represents a subscription to the push streaming server.
+//
+// swagger:model pushSubscription
 type PushSubscription struct {
 	// The id of the push subscription in the database.
 	ID string `json:"id"`
@@ -34,6 +36,8 @@ type PushSubscription struct {
 type PushSubscriptionAlerts struct {
 	// Receive a push notification when someone has followed you?
 	Follow bool `json:"follow"`
+	// Receive a push notification when someone has requested to follow you?
+	FollowRequest bool `json:"follow_request"`
 	// Receive a push notification when a status you created has been favourited by someone else?
 	Favourite bool `json:"favourite"`
 	// Receive a push notification when someone else has mentioned you in a status?
@@ -42,4 +46,104 @@ type PushSubscriptionAlerts struct {
 	Reblog bool `json:"reblog"`
 	// Receive a push notification when a poll you voted in or created has ended?
 	Poll bool `json:"poll"`
+	// Receive a push notification when a subscribed account posts a status?
+	Status bool `json:"status"`
+}
+
+// PushSubscriptionCreateRequest models a request to create a push subscription.
+//
+// The nested fields are used when the request is given as JSON; the
+// flattened fields are used when the request is given as a form.
+//
+// swagger:ignore
+type PushSubscriptionCreateRequest struct {
+	Subscription *PushSubscriptionRequestSubscription `form:"-" json:"subscription"`
+
+	SubscriptionEndpoint   *string `form:"subscription[endpoint]" json:"-"`
+	SubscriptionKeysP256dh *string `form:"subscription[keys][p256dh]" json:"-"`
+	SubscriptionKeysAuth   *string `form:"subscription[keys][auth]" json:"-"`
+	PushSubscriptionUpdateRequest
+}
+
+// PushSubscriptionUpdateRequest models a request to update the alerts of a push subscription.
+//
+// The nested fields are used when the request is given as JSON; the
+// flattened fields are used when the request is given as a form.
+//
+// swagger:ignore
+type PushSubscriptionUpdateRequest struct {
+	Data *PushSubscriptionRequestData `form:"-" json:"data"`
+
+	DataAlertsFollow        *bool `form:"data[alerts][follow]" json:"-"`
+	DataAlertsFollowRequest *bool `form:"data[alerts][follow_request]" json:"-"`
+	DataAlertsFavourite     *bool `form:"data[alerts][favourite]" json:"-"`
+	DataAlertsMention       *bool `form:"data[alerts][mention]" json:"-"`
+	DataAlertsReblog        *bool `form:"data[alerts][reblog]" json:"-"`
+	DataAlertsPoll          *bool `form:"data[alerts][poll]" json:"-"`
+	DataAlertsStatus        *bool `form:"data[alerts][status]" json:"-"`
+}
+
+// PushSubscriptionRequestSubscription models the subscription part of a push subscription create request.
+//
+// swagger:ignore
+type PushSubscriptionRequestSubscription struct {
+	// Where push alerts will be sent to.
+	Endpoint string `json:"endpoint"`
+	// Keys used to encrypt pushes for the client.
+	Keys PushSubscriptionRequestKeys `json:"keys"`
+}
+
+// PushSubscriptionRequestKeys models the keys of a push subscription create request.
+//
+// swagger:ignore
+type PushSubscriptionRequestKeys struct {
+	// The client's P-256 ECDH public key, base64url encoded.
+	P256dh string `json:"p256dh"`
+	// The client's authentication secret, base64url encoded.
+	Auth string `json:"auth"`
+}
+
+// PushSubscriptionRequestData models the data part of a push subscription create or update request.
+//
+// swagger:ignore
+type PushSubscriptionRequestData struct {
+	// Which alerts should be delivered to the endpoint.
+	Alerts *PushSubscriptionRequestAlerts `json:"alerts"`
+}
+
+// PushSubscriptionRequestAlerts models the alerts of a push subscription create or update request.
+// Alerts which are not set are left unchanged on update, or disabled on create.
+//
+// swagger:ignore
+type PushSubscriptionRequestAlerts struct {
+	Follow        *bool `json:"follow"`
+	FollowRequest *bool `json:"follow_request"`
+	Favourite     *bool `json:"favourite"`
+	Mention       *bool `json:"mention"`
+	Reblog        *bool `json:"reblog"`
+	Poll          *bool `json:"poll"`
+	Status        *bool `json:"status"`
+}
+
+// WebPushNotification is the payload delivered to a client by Web Push when
+// a notification is created for it. It contains just enough of the notification
+// for the client to show a preview, and fetch the full notification with the
+// included access token.
+//
+// swagger:ignore
+type WebPushNotification struct {
+	// Access token of the subscription that this push was delivered to.
+	AccessToken string `json:"access_token"`
+	// Preferred locale of the account receiving the push.
+	PreferredLocale string `json:"preferred_locale"`
+	// ID of the notification.
+	NotificationID string `json:"notification_id"`
+	// Type of the notification.
+	NotificationType string `json:"notification_type"`
+	// URL of the avatar of the account that triggered the notification.
+	Icon string `json:"icon"`
+	// Title of the push, eg "@someone mentioned you".
+	Title string `json:"title"`
+	// Short plaintext preview of the notification.
+	Body string `json:"body"`
 }
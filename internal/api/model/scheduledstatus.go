@@ -19,6 +19,8 @@
 package model
 
 // ScheduledStatus represents a status that will be published at a future scheduled date.
+//
+// swagger:model scheduledStatus
 type ScheduledStatus struct {
 	ID               string        `json:"id"`
 	ScheduledAt      string        `json:"scheduled_at"`
@@ -27,13 +29,26 @@ type ScheduledStatus struct {
 }
 
 // StatusParams represents parameters for a scheduled status.
+//
+// swagger:model statusParams
 type StatusParams struct {
-	Text          string   `json:"text"`
-	InReplyToID   string   `json:"in_reply_to_id,omitempty"`
-	MediaIDs      []string `json:"media_ids,omitempty"`
-	Sensitive     bool     `json:"sensitive,omitempty"`
-	SpoilerText   string   `json:"spoiler_text,omitempty"`
-	Visibility    string   `json:"visibility"`
-	ScheduledAt   string   `json:"scheduled_at,omitempty"`
-	ApplicationID string   `json:"application_id"`
+	Text          string       `json:"text"`
+	InReplyToID   string       `json:"in_reply_to_id,omitempty"`
+	MediaIDs      []string     `json:"media_ids,omitempty"`
+	Sensitive     bool         `json:"sensitive,omitempty"`
+	SpoilerText   string       `json:"spoiler_text,omitempty"`
+	Visibility    string       `json:"visibility"`
+	Language      string       `json:"language,omitempty"`
+	Poll          *PollRequest `json:"poll,omitempty"`
+	ScheduledAt   string       `json:"scheduled_at,omitempty"`
+	ApplicationID string       `json:"application_id"`
+}
+
+// ScheduledStatusUpdateRequest models a request to move a scheduled status to a new date.
+//
+// swagger:ignore
+type ScheduledStatusUpdateRequest struct {
+	// ISO 8601 Datetime at which the status will be published.
+	// Must be at least 5 minutes in the future.
+	ScheduledAt string `form:"scheduled_at" json:"scheduled_at" xml:"scheduled_at"`
 }
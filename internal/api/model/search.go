@@ -58,6 +58,13 @@ type SearchQuery struct {
 	//
 	// For a status, this can be in the format: `https://some.instance.com/@someaccount/SOME_ID_OF_A_STATUS`
 	//
+	// Any other query will be used to search through the text and content warnings of statuses on this instance,
+	// returning statuses that contain all the words of the query and that are visible to the searching account.
+	//
+	// Known accounts with a username or display name starting with the query, and hashtags starting with the
+	// query (with or without the leading `#`), are also returned. Only accounts that have chosen to be
+	// discoverable, or that the searching account follows, are returned this way.
+	//
 	// required: true
 	// in: query
 	Query string `json:"q"`
@@ -72,6 +79,8 @@ type SearchQuery struct {
 	Limit int `json:"limit"`
 	// Offset for paginating search results.
 	//
+	// When searching statuses by text, max_id and min_id can also be used for paging.
+	//
 	// default: 0
 	// in: query
 	Offset int `json:"offset"`
@@ -39,4 +39,6 @@ type Source struct {
 	Fields []Field `json:"fields"`
 	// The number of pending follow requests.
 	FollowRequestsCount int `json:"follow_requests_count"`
+	// ActivityPub URIs of accounts that this account is also known as, ie., its aliases.
+	AlsoKnownAsURIs []string `json:"also_known_as_uris,omitempty"`
 }
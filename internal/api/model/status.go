@@ -95,10 +95,16 @@ type Status struct {
 	// The poll attached to the status.
 	// nullable: true
 	Poll *Poll `json:"poll"`
+	// The date when this status was last edited (ISO 8601 Datetime), or null if it has never been edited.
+	// example: 2021-07-30T09:20:25+00:00
+	// nullable: true
+	EditedAt *string `json:"edited_at"`
 	// Plain-text source of a status. Returned instead of content when status is deleted,
 	// so the user may redraft from the source text without the client having to reverse-engineer
 	// the original text from the HTML content.
 	Text string `json:"text,omitempty"`
+	// Keyword filters of the account viewing this status that it matched, if any.
+	Filtered []FilterResult `json:"filtered,omitempty"`
 }
 
 /*
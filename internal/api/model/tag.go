@@ -28,4 +28,26 @@ type Tag struct {
 	// Web link to the hashtag.
 	// example: https://example.org/tags/helloworld
 	URL string `json:"url"`
+	// Whether the requesting account follows this tag.
+	// Only set when viewing a tag directly, or when viewing followed tags.
+	// example: true
+	Following *bool `json:"following,omitempty"`
+	// Usage statistics of the hashtag over the last week, most recent day first.
+	// Only set when searching for hashtags.
+	History []TagHistory `json:"history,omitempty"`
+}
+
+// TagHistory represents the usage of a hashtag on one day.
+//
+// swagger:model tagHistory
+type TagHistory struct {
+	// UNIX timestamp of midnight (UTC) at the start of the day.
+	// example: 1677542400
+	Day string `json:"day"`
+	// Number of statuses using the hashtag on this day.
+	// example: 12
+	Uses string `json:"uses"`
+	// Number of distinct accounts using the hashtag on this day.
+	// example: 5
+	Accounts string `json:"accounts"`
 }
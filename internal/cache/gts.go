@@ -42,6 +42,9 @@ type GTSCaches interface {
 	// Block provides access to the gtsmodel Block (account) database cache.
 	Block() *result.Cache[*gtsmodel.Block]
 
+	// DomainAllow provides access to the domain allow database cache.
+	DomainAllow() *domain.BlockCache
+
 	// DomainBlock provides access to the domain block database cache.
 	DomainBlock() *domain.BlockCache
 
@@ -78,6 +81,7 @@ func NewGTS() GTSCaches {
 type gtsCaches struct {
 	account       *result.Cache[*gtsmodel.Account]
 	block         *result.Cache[*gtsmodel.Block]
+	domainAllow   *domain.BlockCache
 	domainBlock   *domain.BlockCache
 	emoji         *result.Cache[*gtsmodel.Emoji]
 	emojiCategory *result.Cache[*gtsmodel.EmojiCategory]
@@ -92,6 +96,7 @@ type gtsCaches struct {
 func (c *gtsCaches) Init() {
 	c.initAccount()
 	c.initBlock()
+	c.initDomainAllow()
 	c.initDomainBlock()
 	c.initEmoji()
 	c.initEmojiCategory()
@@ -110,6 +115,9 @@ func (c *gtsCaches) Start() {
 	tryUntil("starting gtsmodel.Block cache", 5, func() bool {
 		return c.block.Start(config.GetCacheGTSBlockSweepFreq())
 	})
+	tryUntil("starting gtsmodel.DomainAllow cache", 5, func() bool {
+		return c.domainAllow.Start(config.GetCacheGTSDomainBlockSweepFreq())
+	})
 	tryUntil("starting gtsmodel.DomainBlock cache", 5, func() bool {
 		return c.domainBlock.Start(config.GetCacheGTSDomainBlockSweepFreq())
 	})
@@ -142,6 +150,7 @@ func (c *gtsCaches) Start() {
 func (c *gtsCaches) Stop() {
 	tryUntil("stopping gtsmodel.Account cache", 5, c.account.Stop)
 	tryUntil("stopping gtsmodel.Block cache", 5, c.block.Stop)
+	tryUntil("stopping gtsmodel.DomainAllow cache", 5, c.domainAllow.Stop)
 	tryUntil("stopping gtsmodel.DomainBlock cache", 5, c.domainBlock.Stop)
 	tryUntil("stopping gtsmodel.Emoji cache", 5, c.emoji.Stop)
 	tryUntil("stopping gtsmodel.EmojiCategory cache", 5, c.emojiCategory.Stop)
@@ -161,6 +170,10 @@ func (c *gtsCaches) Block() *result.Cache[*gtsmodel.Block] {
 	return c.block
 }
 
+func (c *gtsCaches) DomainAllow() *domain.BlockCache {
+	return c.domainAllow
+}
+
 func (c *gtsCaches) DomainBlock() *domain.BlockCache {
 	return c.domainBlock
 }
@@ -225,6 +238,15 @@ func (c *gtsCaches) initBlock() {
 	c.block.SetTTL(config.GetCacheGTSBlockTTL(), true)
 }
 
+func (c *gtsCaches) initDomainAllow() {
+	// domain allows are matched exactly like
+	// domain blocks, so share their cache config
+	c.domainAllow = domain.New(
+		config.GetCacheGTSDomainBlockMaxSize(),
+		config.GetCacheGTSDomainBlockTTL(),
+	)
+}
+
 func (c *gtsCaches) initDomainBlock() {
 	c.domainBlock = domain.New(
 		config.GetCacheGTSDomainBlockMaxSize(),
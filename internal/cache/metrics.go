@@ -0,0 +1,39 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package cache
+
+import (
+	"codeberg.org/gruf/go-cache/v3/result"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+)
+
+// Load wraps result.Cache{}.Load(), recording whether the lookup was
+// a hit or a miss against the named cache in the metrics registry.
+func Load[Value any](name string, c *result.Cache[Value], lookup string, load func() (Value, error), keyParts ...any) (Value, error) {
+	hit := true
+
+	value, err := c.Load(lookup, func() (Value, error) {
+		// Loader is only called on a miss
+		hit = false
+		return load()
+	}, keyParts...)
+
+	metrics.RecordCacheLookup(name, hit)
+	return value, err
+}
@@ -0,0 +1,108 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package concurrency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// TickerWorker calls a function at a regular interval, in its own goroutine, between Start and Stop.
+type TickerWorker struct {
+	// RunOnStart, if set, makes the worker call its
+	// function once straight away when started,
+	// rather than waiting for the first interval.
+	RunOnStart bool
+
+	name     string
+	interval time.Duration
+	fn       func(context.Context) error
+	mu       sync.Mutex
+	stop     chan struct{}
+}
+
+// NewTickerWorker returns a new TickerWorker which calls fn every interval once started.
+// Errors returned by fn are logged as "error <name>: <err>", so name should describe
+// what fn does, eg., "closing expired polls".
+func NewTickerWorker(name string, interval time.Duration, fn func(context.Context) error) *TickerWorker {
+	return &TickerWorker{
+		name:     name,
+		interval: interval,
+		fn:       fn,
+	}
+}
+
+// Start starts calling the worker's function at its interval, or returns error if already started.
+func (t *TickerWorker) Start() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stop != nil {
+		return errors.New("ticker worker already started")
+	}
+
+	// The goroutine gets its own reference to the
+	// stop channel, so it never has to read the
+	// field again after Stop has reset it.
+	stop := make(chan struct{})
+	t.stop = stop
+
+	go func() {
+		if t.RunOnStart {
+			t.run()
+		}
+
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				t.run()
+			}
+		}
+	}()
+
+	return nil
+}
+
+// Stop stops the worker, leaving any in-progress call of its function to finish. It is safe to call more than once.
+func (t *TickerWorker) Stop() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.stop != nil {
+		close(t.stop)
+		t.stop = nil
+	}
+
+	return nil
+}
+
+func (t *TickerWorker) run() {
+	if err := t.fn(context.Background()); err != nil {
+		log.Errorf("error %s: %s", t.name, err)
+	}
+}
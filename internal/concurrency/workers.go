@@ -25,18 +25,30 @@ import (
 	"path"
 	"reflect"
 	"runtime"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-runners"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// traceable is implemented by message types which carry the span
+// context of the request that caused them to be queued.
+type traceable interface {
+	SpanContext() trace.SpanContext
+}
+
 // WorkerPool represents a proccessor for MsgType objects, using a worker pool to allocate resources.
 type WorkerPool[MsgType any] struct {
 	workers runners.WorkerPool
 	process func(context.Context, MsgType) error
 	nw, nq  int
 	wtype   string // contains worker type for logging
+	mtype   string // contains msg type for metrics
 }
 
 // New returns a new WorkerPool[MsgType] with given number of workers and queue ratio,
@@ -63,8 +75,12 @@ func NewWorkerPool[MsgType any](workers int, queueRatio int) *WorkerPool[MsgType
 		nw:      workers,
 		nq:      workers * queueRatio,
 		wtype:   fmt.Sprintf("worker.Worker[%s]", msgType),
+		mtype:   msgType,
 	}
 
+	// Report queue depth at scrape time
+	metrics.RegisterWorkerQueue(msgType, w.workers.Queue)
+
 	// Log new worker creation with worker type prefix
 	log.Infof("%s created with workers=%d queue=%d",
 		w.wtype,
@@ -118,11 +134,28 @@ func (w *WorkerPool[MsgType]) Queue(msg MsgType) {
 
 	// Create new process function for msg
 	process := func(ctx context.Context) {
+		before := time.Now()
+		defer func() { metrics.ObserveWorkerProcess(w.mtype, time.Since(before)) }()
+
+		if t, ok := any(msg).(traceable); ok {
+			// Continue the trace of the request which queued this
+			// message, so that async work shows up as part of it.
+			ctx = trace.ContextWithSpanContext(ctx, t.SpanContext())
+		}
+
+		ctx, span := tracing.Tracer().Start(ctx, w.wtype,
+			trace.WithSpanKind(trace.SpanKindConsumer),
+		)
+		defer span.End()
+
 		if err := w.process(ctx, msg); err != nil {
 			log.WithFields(kv.Fields{
 				kv.Field{K: "type", V: w.wtype},
 				kv.Field{K: "error", V: err},
 			}...).Error("message processing error")
+
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
 		}
 	}
 
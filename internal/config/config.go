@@ -75,19 +75,22 @@ type Configuration struct {
 	WebTemplateBaseDir string `name:"web-template-base-dir" usage:"Basedir for html templating files for rendering pages and composing emails."`
 	WebAssetBaseDir    string `name:"web-asset-base-dir" usage:"Directory to serve static assets from, accessible at example.org/assets/"`
 
-	InstanceExposePeers            bool `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
-	InstanceExposeSuspended        bool `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
-	InstanceExposeSuspendedWeb     bool `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
-	InstanceExposePublicTimeline   bool `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
-	InstanceDeliverToSharedInboxes bool `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceExposePeers            bool   `name:"instance-expose-peers" usage:"Allow unauthenticated users to query /api/v1/instance/peers?filter=open"`
+	InstanceExposeSuspended        bool   `name:"instance-expose-suspended" usage:"Expose suspended instances via web UI, and allow unauthenticated users to query /api/v1/instance/peers?filter=suspended"`
+	InstanceExposeSuspendedWeb     bool   `name:"instance-expose-suspended-web" usage:"Expose list of suspended instances as webpage on /about/suspended"`
+	InstanceExposePublicTimeline   bool   `name:"instance-expose-public-timeline" usage:"Allow unauthenticated users to query /api/v1/timelines/public"`
+	InstanceDeliverToSharedInboxes bool   `name:"instance-deliver-to-shared-inboxes" usage:"Deliver federated messages to shared inboxes, if they're available."`
+	InstanceFederationMode         string `name:"instance-federation-mode" usage:"Set instance federation mode: 'blocklist' federates with every domain that isn't blocked, 'allowlist' only federates with domains that are explicitly allowed (and not blocked)."`
 
 	AccountsRegistrationOpen bool `name:"accounts-registration-open" usage:"Allow anyone to submit an account signup request. If false, server will be invite-only."`
 	AccountsApprovalRequired bool `name:"accounts-approval-required" usage:"Do account signups require approval by an admin or moderator before user can log in? If false, new registrations will be automatically approved."`
 	AccountsReasonRequired   bool `name:"accounts-reason-required" usage:"Do new account signups require a reason to be submitted on registration?"`
 	AccountsAllowCustomCSS   bool `name:"accounts-allow-custom-css" usage:"Allow accounts to enable custom CSS for their profile pages and statuses."`
+	AccountsInvitesEnabled   bool `name:"accounts-invites-enabled" usage:"Allow invite codes to be created, which let people sign up even when registration is closed."`
+	AccountsInvitesAdminOnly bool `name:"accounts-invites-admin-only" usage:"Only allow admins to create invite codes. If false, any user can create them."`
 
 	MediaImageMaxSize        bytesize.Size `name:"media-image-max-size" usage:"Max size of accepted images in bytes"`
-	MediaVideoMaxSize        bytesize.Size `name:"media-video-max-size" usage:"Max size of accepted videos in bytes"`
+	MediaVideoMaxSize        bytesize.Size `name:"media-video-max-size" usage:"Max size of accepted videos and audio in bytes"`
 	MediaDescriptionMinChars int           `name:"media-description-min-chars" usage:"Min required chars for an image description"`
 	MediaDescriptionMaxChars int           `name:"media-description-max-chars" usage:"Max permitted chars for an image description"`
 	MediaRemoteCacheDays     int           `name:"media-remote-cache-days" usage:"Number of days to locally cache media from remote instances. If set to 0, remote media will be kept indefinitely."`
@@ -133,6 +136,15 @@ type Configuration struct {
 	SyslogProtocol string `name:"syslog-protocol" usage:"Protocol to use when directing logs to syslog. Leave empty to connect to local syslog."`
 	SyslogAddress  string `name:"syslog-address" usage:"Address:port to send syslog logs to. Leave empty to connect to local syslog."`
 
+	MetricsEnabled     bool   `name:"metrics-enabled" usage:"Enable the Prometheus metrics endpoint at /metrics."`
+	MetricsBindAddress string `name:"metrics-bind-address" usage:"Address:port to serve /metrics on with a separate listener, eg., 'localhost:9090'. Leave empty to serve metrics on the main listener."`
+	MetricsAuthToken   string `name:"metrics-auth-token" usage:"Bearer token that scrapers must send in the Authorization header to access /metrics. Required when metrics are served on the main listener."`
+
+	TracingEnabled           bool   `name:"tracing-enabled" usage:"Enable OpenTelemetry tracing, exporting spans over OTLP/HTTP."`
+	TracingEndpoint          string `name:"tracing-endpoint" usage:"Host:port of the OTLP/HTTP collector to export spans to, eg., 'localhost:4318'. Leave empty to use the OTEL_EXPORTER_OTLP_ENDPOINT env var, or localhost:4318 if that's not set either."`
+	TracingInsecureTransport bool   `name:"tracing-insecure-transport" usage:"Export spans over plain HTTP instead of HTTPS. Only use this if the collector is on localhost or a trusted private network."`
+	TracingTrustIncoming     bool   `name:"tracing-trust-incoming" usage:"Continue traces from the W3C traceparent header of incoming requests. Only enable this if GoToSocial is behind a reverse proxy which sets or strips that header, or anyone can add their own trace and span IDs to your traces."`
+
 	AdvancedCookiesSamesite      string `name:"advanced-cookies-samesite" usage:"'strict' or 'lax', see https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Set-Cookie/SameSite"`
 	AdvancedRateLimitRequests    int    `name:"advanced-rate-limit-requests" usage:"Amount of HTTP requests to permit within a 5 minute window. 0 or less turns rate limiting off."`
 	AdvancedThrottlingMultiplier int    `name:"advanced-throttling-multiplier" usage:"Multiplier to use per cpu for http request throttling. 0 or less turns throttling off."`
@@ -61,11 +61,14 @@ var Defaults = Configuration{
 	InstanceExposeSuspended:        false,
 	InstanceExposeSuspendedWeb:     false,
 	InstanceDeliverToSharedInboxes: true,
+	InstanceFederationMode:         InstanceFederationModeBlocklist,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
 	AccountsReasonRequired:   true,
 	AccountsAllowCustomCSS:   false,
+	AccountsInvitesEnabled:   false,
+	AccountsInvitesAdminOnly: true,
 
 	MediaImageMaxSize:        10 * bytesize.MiB,
 	MediaVideoMaxSize:        40 * bytesize.MiB,
@@ -110,6 +113,15 @@ var Defaults = Configuration{
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	MetricsEnabled:     false,
+	MetricsBindAddress: "",
+	MetricsAuthToken:   "",
+
+	TracingEnabled:           false,
+	TracingEndpoint:          "",
+	TracingInsecureTransport: false,
+	TracingTrustIncoming:     false,
+
 	AdvancedCookiesSamesite:      "lax",
 	AdvancedRateLimitRequests:    300, // 1 per second per 5 minutes
 	AdvancedThrottlingMultiplier: 8,   // 8 open requests per CPU
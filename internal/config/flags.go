@@ -81,12 +81,15 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().Bool(InstanceExposeSuspendedFlag(), cfg.InstanceExposeSuspended, fieldtag("InstanceExposeSuspended", "usage"))
 		cmd.Flags().Bool(InstanceExposeSuspendedWebFlag(), cfg.InstanceExposeSuspendedWeb, fieldtag("InstanceExposeSuspendedWeb", "usage"))
 		cmd.Flags().Bool(InstanceDeliverToSharedInboxesFlag(), cfg.InstanceDeliverToSharedInboxes, fieldtag("InstanceDeliverToSharedInboxes", "usage"))
+		cmd.Flags().String(InstanceFederationModeFlag(), cfg.InstanceFederationMode, fieldtag("InstanceFederationMode", "usage"))
 
 		// Accounts
 		cmd.Flags().Bool(AccountsRegistrationOpenFlag(), cfg.AccountsRegistrationOpen, fieldtag("AccountsRegistrationOpen", "usage"))
 		cmd.Flags().Bool(AccountsApprovalRequiredFlag(), cfg.AccountsApprovalRequired, fieldtag("AccountsApprovalRequired", "usage"))
 		cmd.Flags().Bool(AccountsReasonRequiredFlag(), cfg.AccountsReasonRequired, fieldtag("AccountsReasonRequired", "usage"))
 		cmd.Flags().Bool(AccountsAllowCustomCSSFlag(), cfg.AccountsAllowCustomCSS, fieldtag("AccountsAllowCustomCSS", "usage"))
+		cmd.Flags().Bool(AccountsInvitesEnabledFlag(), cfg.AccountsInvitesEnabled, fieldtag("AccountsInvitesEnabled", "usage"))
+		cmd.Flags().Bool(AccountsInvitesAdminOnlyFlag(), cfg.AccountsInvitesAdminOnly, fieldtag("AccountsInvitesAdminOnly", "usage"))
 
 		// Media
 		cmd.Flags().Uint64(MediaImageMaxSizeFlag(), uint64(cfg.MediaImageMaxSize), fieldtag("MediaImageMaxSize", "usage"))
@@ -135,6 +138,17 @@ func (s *ConfigState) AddServerFlags(cmd *cobra.Command) {
 		cmd.Flags().String(SyslogProtocolFlag(), cfg.SyslogProtocol, fieldtag("SyslogProtocol", "usage"))
 		cmd.Flags().String(SyslogAddressFlag(), cfg.SyslogAddress, fieldtag("SyslogAddress", "usage"))
 
+		// Metrics
+		cmd.Flags().Bool(MetricsEnabledFlag(), cfg.MetricsEnabled, fieldtag("MetricsEnabled", "usage"))
+		cmd.Flags().String(MetricsBindAddressFlag(), cfg.MetricsBindAddress, fieldtag("MetricsBindAddress", "usage"))
+		cmd.Flags().String(MetricsAuthTokenFlag(), cfg.MetricsAuthToken, fieldtag("MetricsAuthToken", "usage"))
+
+		// Tracing
+		cmd.Flags().Bool(TracingEnabledFlag(), cfg.TracingEnabled, fieldtag("TracingEnabled", "usage"))
+		cmd.Flags().String(TracingEndpointFlag(), cfg.TracingEndpoint, fieldtag("TracingEndpoint", "usage"))
+		cmd.Flags().Bool(TracingInsecureTransportFlag(), cfg.TracingInsecureTransport, fieldtag("TracingInsecureTransport", "usage"))
+		cmd.Flags().Bool(TracingTrustIncomingFlag(), cfg.TracingTrustIncoming, fieldtag("TracingTrustIncoming", "usage"))
+
 		// Advanced flags
 		cmd.Flags().String(AdvancedCookiesSamesiteFlag(), cfg.AdvancedCookiesSamesite, fieldtag("AdvancedCookiesSamesite", "usage"))
 		cmd.Flags().Int(AdvancedRateLimitRequestsFlag(), cfg.AdvancedRateLimitRequests, fieldtag("AdvancedRateLimitRequests", "usage"))
@@ -824,6 +824,31 @@ func GetInstanceDeliverToSharedInboxes() bool { return global.GetInstanceDeliver
 // SetInstanceDeliverToSharedInboxes safely sets the value for global configuration 'InstanceDeliverToSharedInboxes' field
 func SetInstanceDeliverToSharedInboxes(v bool) { global.SetInstanceDeliverToSharedInboxes(v) }
 
+// GetInstanceFederationMode safely fetches the Configuration value for state's 'InstanceFederationMode' field
+func (st *ConfigState) GetInstanceFederationMode() (v string) {
+	st.mutex.Lock()
+	v = st.config.InstanceFederationMode
+	st.mutex.Unlock()
+	return
+}
+
+// SetInstanceFederationMode safely sets the Configuration value for state's 'InstanceFederationMode' field
+func (st *ConfigState) SetInstanceFederationMode(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.InstanceFederationMode = v
+	st.reloadToViper()
+}
+
+// InstanceFederationModeFlag returns the flag name for the 'InstanceFederationMode' field
+func InstanceFederationModeFlag() string { return "instance-federation-mode" }
+
+// GetInstanceFederationMode safely fetches the value for global configuration 'InstanceFederationMode' field
+func GetInstanceFederationMode() string { return global.GetInstanceFederationMode() }
+
+// SetInstanceFederationMode safely sets the value for global configuration 'InstanceFederationMode' field
+func SetInstanceFederationMode(v string) { global.SetInstanceFederationMode(v) }
+
 // GetAccountsRegistrationOpen safely fetches the Configuration value for state's 'AccountsRegistrationOpen' field
 func (st *ConfigState) GetAccountsRegistrationOpen() (v bool) {
 	st.mutex.Lock()
@@ -924,6 +949,56 @@ func GetAccountsAllowCustomCSS() bool { return global.GetAccountsAllowCustomCSS(
 // SetAccountsAllowCustomCSS safely sets the value for global configuration 'AccountsAllowCustomCSS' field
 func SetAccountsAllowCustomCSS(v bool) { global.SetAccountsAllowCustomCSS(v) }
 
+// GetAccountsInvitesEnabled safely fetches the Configuration value for state's 'AccountsInvitesEnabled' field
+func (st *ConfigState) GetAccountsInvitesEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.AccountsInvitesEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsInvitesEnabled safely sets the Configuration value for state's 'AccountsInvitesEnabled' field
+func (st *ConfigState) SetAccountsInvitesEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsInvitesEnabled = v
+	st.reloadToViper()
+}
+
+// AccountsInvitesEnabledFlag returns the flag name for the 'AccountsInvitesEnabled' field
+func AccountsInvitesEnabledFlag() string { return "accounts-invites-enabled" }
+
+// GetAccountsInvitesEnabled safely fetches the value for global configuration 'AccountsInvitesEnabled' field
+func GetAccountsInvitesEnabled() bool { return global.GetAccountsInvitesEnabled() }
+
+// SetAccountsInvitesEnabled safely sets the value for global configuration 'AccountsInvitesEnabled' field
+func SetAccountsInvitesEnabled(v bool) { global.SetAccountsInvitesEnabled(v) }
+
+// GetAccountsInvitesAdminOnly safely fetches the Configuration value for state's 'AccountsInvitesAdminOnly' field
+func (st *ConfigState) GetAccountsInvitesAdminOnly() (v bool) {
+	st.mutex.Lock()
+	v = st.config.AccountsInvitesAdminOnly
+	st.mutex.Unlock()
+	return
+}
+
+// SetAccountsInvitesAdminOnly safely sets the Configuration value for state's 'AccountsInvitesAdminOnly' field
+func (st *ConfigState) SetAccountsInvitesAdminOnly(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.AccountsInvitesAdminOnly = v
+	st.reloadToViper()
+}
+
+// AccountsInvitesAdminOnlyFlag returns the flag name for the 'AccountsInvitesAdminOnly' field
+func AccountsInvitesAdminOnlyFlag() string { return "accounts-invites-admin-only" }
+
+// GetAccountsInvitesAdminOnly safely fetches the value for global configuration 'AccountsInvitesAdminOnly' field
+func GetAccountsInvitesAdminOnly() bool { return global.GetAccountsInvitesAdminOnly() }
+
+// SetAccountsInvitesAdminOnly safely sets the value for global configuration 'AccountsInvitesAdminOnly' field
+func SetAccountsInvitesAdminOnly(v bool) { global.SetAccountsInvitesAdminOnly(v) }
+
 // GetMediaImageMaxSize safely fetches the Configuration value for state's 'MediaImageMaxSize' field
 func (st *ConfigState) GetMediaImageMaxSize() (v bytesize.Size) {
 	st.mutex.Lock()
@@ -1924,6 +1999,181 @@ func GetSyslogAddress() string { return global.GetSyslogAddress() }
 // SetSyslogAddress safely sets the value for global configuration 'SyslogAddress' field
 func SetSyslogAddress(v string) { global.SetSyslogAddress(v) }
 
+// GetMetricsEnabled safely fetches the Configuration value for state's 'MetricsEnabled' field
+func (st *ConfigState) GetMetricsEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.MetricsEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetMetricsEnabled safely sets the Configuration value for state's 'MetricsEnabled' field
+func (st *ConfigState) SetMetricsEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MetricsEnabled = v
+	st.reloadToViper()
+}
+
+// MetricsEnabledFlag returns the flag name for the 'MetricsEnabled' field
+func MetricsEnabledFlag() string { return "metrics-enabled" }
+
+// GetMetricsEnabled safely fetches the value for global configuration 'MetricsEnabled' field
+func GetMetricsEnabled() bool { return global.GetMetricsEnabled() }
+
+// SetMetricsEnabled safely sets the value for global configuration 'MetricsEnabled' field
+func SetMetricsEnabled(v bool) { global.SetMetricsEnabled(v) }
+
+// GetMetricsBindAddress safely fetches the Configuration value for state's 'MetricsBindAddress' field
+func (st *ConfigState) GetMetricsBindAddress() (v string) {
+	st.mutex.Lock()
+	v = st.config.MetricsBindAddress
+	st.mutex.Unlock()
+	return
+}
+
+// SetMetricsBindAddress safely sets the Configuration value for state's 'MetricsBindAddress' field
+func (st *ConfigState) SetMetricsBindAddress(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MetricsBindAddress = v
+	st.reloadToViper()
+}
+
+// MetricsBindAddressFlag returns the flag name for the 'MetricsBindAddress' field
+func MetricsBindAddressFlag() string { return "metrics-bind-address" }
+
+// GetMetricsBindAddress safely fetches the value for global configuration 'MetricsBindAddress' field
+func GetMetricsBindAddress() string { return global.GetMetricsBindAddress() }
+
+// SetMetricsBindAddress safely sets the value for global configuration 'MetricsBindAddress' field
+func SetMetricsBindAddress(v string) { global.SetMetricsBindAddress(v) }
+
+// GetMetricsAuthToken safely fetches the Configuration value for state's 'MetricsAuthToken' field
+func (st *ConfigState) GetMetricsAuthToken() (v string) {
+	st.mutex.Lock()
+	v = st.config.MetricsAuthToken
+	st.mutex.Unlock()
+	return
+}
+
+// SetMetricsAuthToken safely sets the Configuration value for state's 'MetricsAuthToken' field
+func (st *ConfigState) SetMetricsAuthToken(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.MetricsAuthToken = v
+	st.reloadToViper()
+}
+
+// MetricsAuthTokenFlag returns the flag name for the 'MetricsAuthToken' field
+func MetricsAuthTokenFlag() string { return "metrics-auth-token" }
+
+// GetMetricsAuthToken safely fetches the value for global configuration 'MetricsAuthToken' field
+func GetMetricsAuthToken() string { return global.GetMetricsAuthToken() }
+
+// SetMetricsAuthToken safely sets the value for global configuration 'MetricsAuthToken' field
+func SetMetricsAuthToken(v string) { global.SetMetricsAuthToken(v) }
+
+// GetTracingEnabled safely fetches the Configuration value for state's 'TracingEnabled' field
+func (st *ConfigState) GetTracingEnabled() (v bool) {
+	st.mutex.Lock()
+	v = st.config.TracingEnabled
+	st.mutex.Unlock()
+	return
+}
+
+// SetTracingEnabled safely sets the Configuration value for state's 'TracingEnabled' field
+func (st *ConfigState) SetTracingEnabled(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TracingEnabled = v
+	st.reloadToViper()
+}
+
+// TracingEnabledFlag returns the flag name for the 'TracingEnabled' field
+func TracingEnabledFlag() string { return "tracing-enabled" }
+
+// GetTracingEnabled safely fetches the value for global configuration 'TracingEnabled' field
+func GetTracingEnabled() bool { return global.GetTracingEnabled() }
+
+// SetTracingEnabled safely sets the value for global configuration 'TracingEnabled' field
+func SetTracingEnabled(v bool) { global.SetTracingEnabled(v) }
+
+// GetTracingEndpoint safely fetches the Configuration value for state's 'TracingEndpoint' field
+func (st *ConfigState) GetTracingEndpoint() (v string) {
+	st.mutex.Lock()
+	v = st.config.TracingEndpoint
+	st.mutex.Unlock()
+	return
+}
+
+// SetTracingEndpoint safely sets the Configuration value for state's 'TracingEndpoint' field
+func (st *ConfigState) SetTracingEndpoint(v string) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TracingEndpoint = v
+	st.reloadToViper()
+}
+
+// TracingEndpointFlag returns the flag name for the 'TracingEndpoint' field
+func TracingEndpointFlag() string { return "tracing-endpoint" }
+
+// GetTracingEndpoint safely fetches the value for global configuration 'TracingEndpoint' field
+func GetTracingEndpoint() string { return global.GetTracingEndpoint() }
+
+// SetTracingEndpoint safely sets the value for global configuration 'TracingEndpoint' field
+func SetTracingEndpoint(v string) { global.SetTracingEndpoint(v) }
+
+// GetTracingInsecureTransport safely fetches the Configuration value for state's 'TracingInsecureTransport' field
+func (st *ConfigState) GetTracingInsecureTransport() (v bool) {
+	st.mutex.Lock()
+	v = st.config.TracingInsecureTransport
+	st.mutex.Unlock()
+	return
+}
+
+// SetTracingInsecureTransport safely sets the Configuration value for state's 'TracingInsecureTransport' field
+func (st *ConfigState) SetTracingInsecureTransport(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TracingInsecureTransport = v
+	st.reloadToViper()
+}
+
+// TracingInsecureTransportFlag returns the flag name for the 'TracingInsecureTransport' field
+func TracingInsecureTransportFlag() string { return "tracing-insecure-transport" }
+
+// GetTracingInsecureTransport safely fetches the value for global configuration 'TracingInsecureTransport' field
+func GetTracingInsecureTransport() bool { return global.GetTracingInsecureTransport() }
+
+// SetTracingInsecureTransport safely sets the value for global configuration 'TracingInsecureTransport' field
+func SetTracingInsecureTransport(v bool) { global.SetTracingInsecureTransport(v) }
+
+// GetTracingTrustIncoming safely fetches the Configuration value for state's 'TracingTrustIncoming' field
+func (st *ConfigState) GetTracingTrustIncoming() (v bool) {
+	st.mutex.Lock()
+	v = st.config.TracingTrustIncoming
+	st.mutex.Unlock()
+	return
+}
+
+// SetTracingTrustIncoming safely sets the Configuration value for state's 'TracingTrustIncoming' field
+func (st *ConfigState) SetTracingTrustIncoming(v bool) {
+	st.mutex.Lock()
+	defer st.mutex.Unlock()
+	st.config.TracingTrustIncoming = v
+	st.reloadToViper()
+}
+
+// TracingTrustIncomingFlag returns the flag name for the 'TracingTrustIncoming' field
+func TracingTrustIncomingFlag() string { return "tracing-trust-incoming" }
+
+// GetTracingTrustIncoming safely fetches the value for global configuration 'TracingTrustIncoming' field
+func GetTracingTrustIncoming() bool { return global.GetTracingTrustIncoming() }
+
+// SetTracingTrustIncoming safely sets the value for global configuration 'TracingTrustIncoming' field
+func SetTracingTrustIncoming(v bool) { global.SetTracingTrustIncoming(v) }
+
 // GetAdvancedCookiesSamesite safely fetches the Configuration value for state's 'AdvancedCookiesSamesite' field
 func (st *ConfigState) GetAdvancedCookiesSamesite() (v string) {
 	st.mutex.Lock()
@@ -2952,3 +3202,4 @@ func GetAdminMediaPruneDryRun() bool { return global.GetAdminMediaPruneDryRun()
 
 // SetAdminMediaPruneDryRun safely sets the value for global configuration 'AdminMediaPruneDryRun' field
 func SetAdminMediaPruneDryRun(v bool) { global.SetAdminMediaPruneDryRun(v) }
+
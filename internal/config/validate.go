@@ -62,6 +62,23 @@ func Validate() error {
 		errs = append(errs, fmt.Errorf("%s must be set to either http or https, provided value was %s", ProtocolFlag(), proto))
 	}
 
+	// federation mode
+	switch federationMode := GetInstanceFederationMode(); federationMode {
+	case InstanceFederationModeBlocklist, InstanceFederationModeAllowlist:
+		// no problem
+		break
+	case "":
+		errs = append(errs, fmt.Errorf("%s must be set", InstanceFederationModeFlag()))
+	default:
+		errs = append(errs, fmt.Errorf("%s must be set to either %s or %s, provided value was %s", InstanceFederationModeFlag(), InstanceFederationModeBlocklist, InstanceFederationModeAllowlist, federationMode))
+	}
+
+	// metrics; when served on the main listener, /metrics is reachable
+	// by anyone who can reach the instance, so require a token
+	if GetMetricsEnabled() && GetMetricsBindAddress() == "" && GetMetricsAuthToken() == "" {
+		errs = append(errs, fmt.Errorf("%s must be set when %s is true and %s is not set", MetricsAuthTokenFlag(), MetricsEnabledFlag(), MetricsBindAddressFlag()))
+	}
+
 	webAssetsBaseDir := GetWebAssetBaseDir()
 	if webAssetsBaseDir == "" {
 		errs = append(errs, fmt.Errorf("%s must be set", WebAssetBaseDirFlag()))
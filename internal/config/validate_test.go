@@ -103,6 +103,15 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigNoProtocol() {
 	suite.EqualError(err, "protocol must be set")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigBadFederationMode() {
+	testrig.InitTestConfig()
+
+	config.SetInstanceFederationMode("wildcard")
+
+	err := config.Validate()
+	suite.EqualError(err, "instance-federation-mode must be set to either blocklist or allowlist, provided value was wildcard")
+}
+
 func (suite *ConfigValidateTestSuite) TestValidateConfigNoWebAssetBaseDir() {
 	testrig.InitTestConfig()
 
@@ -141,6 +150,35 @@ func (suite *ConfigValidateTestSuite) TestValidateConfigBadProtocolNoHost() {
 	suite.EqualError(err, "host must be set; protocol must be set to either http or https, provided value was foo")
 }
 
+func (suite *ConfigValidateTestSuite) TestValidateConfigMetricsNoToken() {
+	testrig.InitTestConfig()
+
+	config.SetMetricsEnabled(true)
+
+	err := config.Validate()
+	suite.EqualError(err, "metrics-auth-token must be set when metrics-enabled is true and metrics-bind-address is not set")
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigMetricsToken() {
+	testrig.InitTestConfig()
+
+	config.SetMetricsEnabled(true)
+	config.SetMetricsAuthToken("scrapescrapescrape")
+
+	err := config.Validate()
+	suite.NoError(err)
+}
+
+func (suite *ConfigValidateTestSuite) TestValidateConfigMetricsBindAddress() {
+	testrig.InitTestConfig()
+
+	config.SetMetricsEnabled(true)
+	config.SetMetricsBindAddress("localhost:9090")
+
+	err := config.Validate()
+	suite.NoError(err)
+}
+
 func TestConfigValidateTestSuite(t *testing.T) {
 	suite.Run(t, &ConfigValidateTestSuite{})
 }
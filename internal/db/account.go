@@ -48,6 +48,10 @@ type Account interface {
 	// UpdateAccount updates one account by ID.
 	UpdateAccount(ctx context.Context, account *gtsmodel.Account) Error
 
+	// GetRemoteAccountsWithFields returns a page of remote accounts which have at least one profile
+	// field set, ordered by ID descending, starting below maxID (if set). Limit 0 means no limit.
+	GetRemoteAccountsWithFields(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Account, Error)
+
 	// DeleteAccount deletes one account from the database by its ID.
 	// DO NOT USE THIS WHEN SUSPENDING ACCOUNTS! In that case you should mark the
 	// account as suspended instead, rather than deleting from the db entirely.
@@ -84,6 +88,11 @@ type Account interface {
 	// The returned time will be zero if account has never posted anything.
 	GetAccountLastPosted(ctx context.Context, accountID string, webOnly bool) (time.Time, Error)
 
+	// GetDirectoryAccounts returns a page of discoverable, non-suspended accounts for the profile directory.
+	// If local is true, only local accounts are returned. Accounts are ordered by most recent status, or
+	// by most recently created account if newest is true.
+	GetDirectoryAccounts(ctx context.Context, local bool, newest bool, offset int, limit int) ([]*gtsmodel.Account, Error)
+
 	// SetAccountHeaderOrAvatar sets the header or avatar for the given accountID to the given media attachment.
 	SetAccountHeaderOrAvatar(ctx context.Context, mediaAttachment *gtsmodel.MediaAttachment, accountID string) Error
 
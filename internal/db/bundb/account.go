@@ -25,6 +25,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -131,7 +132,7 @@ func (a *accountDB) GetInstanceAccount(ctx context.Context, domain string) (*gts
 
 func (a *accountDB) getAccount(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Account) error, keyParts ...any) (*gtsmodel.Account, db.Error) {
 	// Fetch account from database cache with loader callback
-	account, err := a.state.Caches.GTS.Account().Load(lookup, func() (*gtsmodel.Account, error) {
+	account, err := cache.Load("account", a.state.Caches.GTS.Account(), lookup, func() (*gtsmodel.Account, error) {
 		var account gtsmodel.Account
 
 		// Not cached! Perform database query
@@ -271,6 +272,78 @@ func (a *accountDB) GetAccountLastPosted(ctx context.Context, accountID string,
 	return createdAt, nil
 }
 
+func (a *accountDB) GetDirectoryAccounts(ctx context.Context, local bool, newest bool, offset int, limit int) ([]*gtsmodel.Account, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	accountIDs := make([]string, 0, limit)
+
+	q := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		// Select only IDs from table
+		Column("account.id").
+		Where("? = ?", bun.Ident("account.discoverable"), true).
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		// Leave out the instance account; usernames can't
+		// otherwise contain the characters of a hostname.
+		Where("? != ?", bun.Ident("account.username"), config.GetHost())
+
+	if local {
+		// return only local accounts
+		q = q.Where("? IS NULL", bun.Ident("account.domain"))
+	}
+
+	if newest {
+		// Sort by most recently created account
+		q = q.Order("account.created_at DESC")
+	} else {
+		// Sort by most recent status; accounts
+		// that haven't posted anything come last
+		q = q.OrderExpr("(SELECT MAX(?) FROM ? AS ? WHERE ? = ?) DESC NULLS LAST",
+			bun.Ident("status.id"),
+			bun.Ident("statuses"),
+			bun.Ident("status"),
+			bun.Ident("status.account_id"),
+			bun.Ident("account.id"))
+	}
+
+	// Tie-break on ID so that paging is stable
+	q = q.Order("account.id DESC")
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if limit > 0 {
+		// limit amount of accounts returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	accounts := make([]*gtsmodel.Account, 0, len(accountIDs))
+
+	for _, id := range accountIDs {
+		// Fetch account from db for ID
+		account, err := a.state.DB.GetAccountByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetDirectoryAccounts: error fetching account %q: %v", id, err)
+			continue
+		}
+
+		// Append account to slice
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
 func (a *accountDB) SetAccountHeaderOrAvatar(ctx context.Context, mediaAttachment *gtsmodel.MediaAttachment, accountID string) db.Error {
 	if *mediaAttachment.Avatar && *mediaAttachment.Header {
 		return errors.New("one media attachment cannot be both header and avatar")
@@ -514,6 +587,48 @@ func (a *accountDB) GetAccountBlocks(ctx context.Context, accountID string, maxI
 	return accounts, nextMaxID, prevMinID, nil
 }
 
+func (a *accountDB) GetRemoteAccountsWithFields(ctx context.Context, maxID string, limit int) ([]*gtsmodel.Account, db.Error) {
+	accountIDs := []string{}
+
+	q := a.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		Column("account.id").
+		Where("? IS NOT NULL", bun.Ident("account.domain")).
+		Where("? IS NOT NULL", bun.Ident("account.fields")).
+		Where("? NOT IN (?)", bun.Ident("account.fields"), bun.In([]string{"null", "[]"})).
+		Order("account.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("account.id"), maxID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, a.conn.ProcessError(err)
+	}
+
+	if len(accountIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	accounts := make([]*gtsmodel.Account, 0, len(accountIDs))
+	for _, id := range accountIDs {
+		account, err := a.GetAccountByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetRemoteAccountsWithFields: error getting account %q: %v", id, err)
+			continue
+		}
+
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
 func (a *accountDB) statusesFromIDs(ctx context.Context, statusIDs []string) ([]*gtsmodel.Status, db.Error) {
 	// Catch case of no statuses early
 	if len(statusIDs) == 0 {
@@ -28,6 +28,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/db/bundb"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/uptrace/bun"
@@ -180,6 +181,26 @@ func (suite *AccountTestSuite) TestGetAccountLastPostedWebOnly() {
 	suite.EqualValues(1634726437, lastPosted.Unix())
 }
 
+func (suite *AccountTestSuite) TestGetDirectoryAccounts() {
+	accounts, err := suite.db.GetDirectoryAccounts(context.Background(), false, false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"admin", "foss_satan", "the_mighty_zork", "her_fuckin_maj", "Some_User"}, accountUsernames(accounts))
+
+	accounts, err = suite.db.GetDirectoryAccounts(context.Background(), true, false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"admin", "the_mighty_zork"}, accountUsernames(accounts))
+
+	accounts, err = suite.db.GetDirectoryAccounts(context.Background(), false, false, 1, 2)
+	suite.NoError(err)
+	suite.Equal([]string{"foss_satan", "the_mighty_zork"}, accountUsernames(accounts))
+}
+
+func (suite *AccountTestSuite) TestGetDirectoryAccountsNewest() {
+	accounts, err := suite.db.GetDirectoryAccounts(context.Background(), true, true, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"the_mighty_zork", "admin"}, accountUsernames(accounts))
+}
+
 func (suite *AccountTestSuite) TestInsertAccountWithDefaults() {
 	key, err := rsa.GenerateKey(rand.Reader, 2048)
 	suite.NoError(err)
@@ -214,6 +235,36 @@ func (suite *AccountTestSuite) TestGettingBookmarksWithNoAccount() {
 	suite.Nil(statuses)
 }
 
+func (suite *AccountTestSuite) TestGetRemoteAccountsWithFields() {
+	ctx := context.Background()
+
+	// no remote test accounts have fields
+	_, err := suite.db.GetRemoteAccountsWithFields(ctx, "", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	remoteAccount := suite.testAccounts["remote_account_1"]
+	remoteAccount.Fields = []gtsmodel.Field{{Name: "website", Value: "https://example.org"}}
+	err = suite.db.UpdateAccount(ctx, remoteAccount)
+	suite.NoError(err)
+
+	// local accounts with fields aren't returned
+	localAccount := suite.testAccounts["local_account_1"]
+	localAccount.Fields = []gtsmodel.Field{{Name: "website", Value: "https://example.org"}}
+	err = suite.db.UpdateAccount(ctx, localAccount)
+	suite.NoError(err)
+
+	accounts, err := suite.db.GetRemoteAccountsWithFields(ctx, "", 0)
+	suite.NoError(err)
+	if suite.Len(accounts, 1) {
+		suite.Equal(remoteAccount.ID, accounts[0].ID)
+		suite.Equal("https://example.org", accounts[0].Fields[0].Value)
+	}
+
+	// nothing below the last account
+	_, err = suite.db.GetRemoteAccountsWithFields(ctx, remoteAccount.ID, 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func TestAccountTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountTestSuite))
 }
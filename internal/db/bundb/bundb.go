@@ -43,6 +43,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/dialect/sqlitedialect"
@@ -62,19 +63,30 @@ type DBService struct {
 	db.Account
 	db.Admin
 	db.Basic
+	db.Conversation
+	db.Delivery
 	db.Domain
 	db.Emoji
+	db.Export
+	db.Filter
+	db.Invite
 	db.Instance
+	db.List
 	db.Media
 	db.Mention
 	db.Notification
+	db.Poll
 	db.Relationship
 	db.Report
+	db.ScheduledStatus
+	db.Search
 	db.Session
 	db.Status
+	db.Tag
 	db.Timeline
 	db.User
 	db.Tombstone
+	db.WebPush
 	conn *DBConn
 }
 
@@ -133,6 +145,11 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 	// Add database query hook
 	conn.DB.AddQueryHook(queryHook{})
 
+	if config.GetTracingEnabled() {
+		// Add database tracing hook
+		conn.DB.AddQueryHook(tracing.BunHook(t))
+	}
+
 	// execute sqlite pragmas *after* adding database hook;
 	// this allows the pragma queries to be logged
 	if t == "sqlite" {
@@ -166,6 +183,12 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 		Basic: &basicDB{
 			conn: conn,
 		},
+		Conversation: &conversationDB{
+			conn: conn,
+		},
+		Delivery: &deliveryDB{
+			conn: conn,
+		},
 		Domain: &domainDB{
 			conn:  conn,
 			state: state,
@@ -174,9 +197,22 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Export: &exportDB{
+			conn: conn,
+		},
+		Filter: &filterDB{
+			conn: conn,
+		},
+		Invite: &inviteDB{
+			conn: conn,
+		},
 		Instance: &instanceDB{
 			conn: conn,
 		},
+		List: &listDB{
+			conn:  conn,
+			state: state,
+		},
 		Media: &mediaDB{
 			conn: conn,
 		},
@@ -188,6 +224,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Poll: &pollDB{
+			conn: conn,
+		},
 		Relationship: &relationshipDB{
 			conn:  conn,
 			state: state,
@@ -196,6 +235,13 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		ScheduledStatus: &scheduledStatusDB{
+			conn: conn,
+		},
+		Search: &searchDB{
+			conn:  conn,
+			state: state,
+		},
 		Session: &sessionDB{
 			conn: conn,
 		},
@@ -203,6 +249,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		Tag: &tagDB{
+			conn: conn,
+		},
 		Timeline: &timelineDB{
 			conn:  conn,
 			state: state,
@@ -215,6 +264,9 @@ func NewBunDBService(ctx context.Context, state *state.State) (db.DB, error) {
 			conn:  conn,
 			state: state,
 		},
+		WebPush: &webPushDB{
+			conn: conn,
+		},
 		conn: conn,
 	}
 
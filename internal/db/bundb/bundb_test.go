@@ -31,18 +31,28 @@ type BunDBStandardTestSuite struct {
 	db db.DB
 
 	// standard suite models
-	testTokens       map[string]*gtsmodel.Token
-	testClients      map[string]*gtsmodel.Client
-	testApplications map[string]*gtsmodel.Application
-	testUsers        map[string]*gtsmodel.User
-	testAccounts     map[string]*gtsmodel.Account
-	testAttachments  map[string]*gtsmodel.MediaAttachment
-	testStatuses     map[string]*gtsmodel.Status
-	testTags         map[string]*gtsmodel.Tag
-	testMentions     map[string]*gtsmodel.Mention
-	testFollows      map[string]*gtsmodel.Follow
-	testEmojis       map[string]*gtsmodel.Emoji
-	testReports      map[string]*gtsmodel.Report
+	testTokens            map[string]*gtsmodel.Token
+	testClients           map[string]*gtsmodel.Client
+	testApplications      map[string]*gtsmodel.Application
+	testUsers             map[string]*gtsmodel.User
+	testAccounts          map[string]*gtsmodel.Account
+	testAttachments       map[string]*gtsmodel.MediaAttachment
+	testStatuses          map[string]*gtsmodel.Status
+	testTags              map[string]*gtsmodel.Tag
+	testMentions          map[string]*gtsmodel.Mention
+	testFollows           map[string]*gtsmodel.Follow
+	testEmojis            map[string]*gtsmodel.Emoji
+	testReports           map[string]*gtsmodel.Report
+	testLists             map[string]*gtsmodel.List
+	testListEntries       map[string]*gtsmodel.ListEntry
+	testFilters           map[string]*gtsmodel.Filter
+	testFilterKeywords    map[string]*gtsmodel.FilterKeyword
+	testPolls             map[string]*gtsmodel.Poll
+	testPollVotes         map[string]*gtsmodel.PollVote
+	testScheduledStatuses map[string]*gtsmodel.ScheduledStatus
+	testConversations     map[string]*gtsmodel.Conversation
+	testWebPushSubs       map[string]*gtsmodel.WebPushSubscription
+	testDomainBlockSubs   map[string]*gtsmodel.DomainBlockSubscription
 }
 
 func (suite *BunDBStandardTestSuite) SetupSuite() {
@@ -58,6 +68,16 @@ func (suite *BunDBStandardTestSuite) SetupSuite() {
 	suite.testFollows = testrig.NewTestFollows()
 	suite.testEmojis = testrig.NewTestEmojis()
 	suite.testReports = testrig.NewTestReports()
+	suite.testLists = testrig.NewTestLists()
+	suite.testListEntries = testrig.NewTestListEntries()
+	suite.testFilters = testrig.NewTestFilters()
+	suite.testFilterKeywords = testrig.NewTestFilterKeywords()
+	suite.testPolls = testrig.NewTestPolls()
+	suite.testPollVotes = testrig.NewTestPollVotes()
+	suite.testScheduledStatuses = testrig.NewTestScheduledStatuses()
+	suite.testConversations = testrig.NewTestConversations()
+	suite.testWebPushSubs = testrig.NewTestWebPushSubscriptions()
+	suite.testDomainBlockSubs = testrig.NewTestDomainBlockSubscriptions()
 }
 
 func (suite *BunDBStandardTestSuite) SetupTest() {
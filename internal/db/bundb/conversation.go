@@ -0,0 +1,268 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type conversationDB struct {
+	conn *DBConn
+}
+
+func (c *conversationDB) GetConversationByID(ctx context.Context, id string) (*gtsmodel.Conversation, db.Error) {
+	conversation := &gtsmodel.Conversation{}
+
+	if err := c.conn.
+		NewSelect().
+		Model(conversation).
+		Where("? = ?", bun.Ident("conversation.id"), id).
+		Scan(ctx); err != nil {
+		return nil, c.conn.ProcessError(err)
+	}
+
+	return conversation, nil
+}
+
+func (c *conversationDB) GetConversationByThreadAndAccountIDs(ctx context.Context, accountID string, threadID string, otherAccountsKey string) (*gtsmodel.Conversation, db.Error) {
+	conversation := &gtsmodel.Conversation{}
+
+	if err := c.conn.
+		NewSelect().
+		Model(conversation).
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID).
+		Where("? = ?", bun.Ident("conversation.thread_id"), threadID).
+		Where("? = ?", bun.Ident("conversation.other_accounts_key"), otherAccountsKey).
+		Scan(ctx); err != nil {
+		return nil, c.conn.ProcessError(err)
+	}
+
+	return conversation, nil
+}
+
+func (c *conversationDB) GetConversationsForAccountID(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Conversation, db.Error) {
+	conversations := []*gtsmodel.Conversation{}
+
+	q := c.conn.
+		NewSelect().
+		Model(&conversations).
+		Where("? = ?", bun.Ident("conversation.account_id"), accountID)
+
+	if maxID != "" {
+		// return only conversations with a last status LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("conversation.last_status_id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only conversations with a last status HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("conversation.last_status_id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only conversations with a last status HIGHER (ie., newer) than minID,
+		// starting from the oldest, so we page up from minID
+		q = q.
+			Where("? > ?", bun.Ident("conversation.last_status_id"), minID).
+			Order("conversation.last_status_id ASC")
+	} else {
+		q = q.Order("conversation.last_status_id DESC")
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, c.conn.ProcessError(err)
+	}
+
+	if minID != "" {
+		// we selected oldest first, so reverse
+		// the slice to return newest first
+		for i, j := 0, len(conversations)-1; i < j; i, j = i+1, j-1 {
+			conversations[i], conversations[j] = conversations[j], conversations[i]
+		}
+	}
+
+	return conversations, nil
+}
+
+func (c *conversationDB) PutConversation(ctx context.Context, conversation *gtsmodel.Conversation) db.Error {
+	_, err := c.conn.
+		NewInsert().
+		Model(conversation).
+		Exec(ctx)
+	return c.conn.ProcessError(err)
+}
+
+func (c *conversationDB) UpdateConversation(ctx context.Context, conversation *gtsmodel.Conversation, columns ...string) db.Error {
+	conversation.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := c.conn.
+		NewUpdate().
+		Model(conversation).
+		Where("? = ?", bun.Ident("conversation.id"), conversation.ID).
+		Column(columns...).
+		Exec(ctx)
+	return c.conn.ProcessError(err)
+}
+
+func (c *conversationDB) AddStatusToConversation(ctx context.Context, conversationID string, statusID string) db.Error {
+	if _, err := c.conn.
+		NewInsert().
+		Model(&gtsmodel.ConversationToStatus{
+			ConversationID: conversationID,
+			StatusID:       statusID,
+		}).
+		Exec(ctx); err != nil {
+		err = c.conn.ProcessError(err)
+		if !errors.Is(err, db.ErrAlreadyExists) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *conversationDB) DeleteConversationByID(ctx context.Context, id string) db.Error {
+	return c.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		return deleteConversationsWhere(ctx, tx, "? = ?", bun.Ident("conversation.id"), id)
+	})
+}
+
+func (c *conversationDB) DeleteConversationsByAccountID(ctx context.Context, accountID string) db.Error {
+	return c.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		return deleteConversationsWhere(ctx, tx, "? = ?", bun.Ident("conversation.account_id"), accountID)
+	})
+}
+
+func (c *conversationDB) DeleteStatusFromConversations(ctx context.Context, statusID string) db.Error {
+	return c.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// find the conversations this status is part of
+		conversationIDs := []string{}
+		if err := tx.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+			Column("conversation_to_status.conversation_id").
+			Where("? = ?", bun.Ident("conversation_to_status.status_id"), statusID).
+			Scan(ctx, &conversationIDs); err != nil {
+			return err
+		}
+
+		if len(conversationIDs) == 0 {
+			return nil
+		}
+
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+			Where("? = ?", bun.Ident("conversation_to_status.status_id"), statusID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// only conversations that ended on this status need their last status moved
+		conversations := []*gtsmodel.Conversation{}
+		if err := tx.
+			NewSelect().
+			Model(&conversations).
+			Where("? IN (?)", bun.Ident("conversation.id"), bun.In(conversationIDs)).
+			Where("? = ?", bun.Ident("conversation.last_status_id"), statusID).
+			Scan(ctx); err != nil {
+			return err
+		}
+
+		for _, conversation := range conversations {
+			previousStatusIDs := []string{}
+			if err := tx.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+				Column("conversation_to_status.status_id").
+				Where("? = ?", bun.Ident("conversation_to_status.conversation_id"), conversation.ID).
+				Order("conversation_to_status.status_id DESC").
+				Limit(1).
+				Scan(ctx, &previousStatusIDs); err != nil {
+				return err
+			}
+
+			if len(previousStatusIDs) == 0 {
+				// nothing left in this conversation, so remove it entirely
+				if err := deleteConversationsWhere(ctx, tx, "? = ?", bun.Ident("conversation.id"), conversation.ID); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if _, err := tx.
+				NewUpdate().
+				TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+				Set("? = ?", bun.Ident("last_status_id"), previousStatusIDs[0]).
+				Set("? = ?", bun.Ident("updated_at"), time.Now()).
+				Where("? = ?", bun.Ident("conversation.id"), conversation.ID).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+// deleteConversationsWhere deletes conversations matching the given
+// where clause, along with their links to statuses, using the given tx.
+func deleteConversationsWhere(ctx context.Context, tx bun.Tx, query string, args ...interface{}) error {
+	conversationIDs := []string{}
+	if err := tx.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+		Column("conversation.id").
+		Where(query, args...).
+		Scan(ctx, &conversationIDs); err != nil {
+		return err
+	}
+
+	if len(conversationIDs) == 0 {
+		return nil
+	}
+
+	if _, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("conversation_to_statuses"), bun.Ident("conversation_to_status")).
+		Where("? IN (?)", bun.Ident("conversation_to_status.conversation_id"), bun.In(conversationIDs)).
+		Exec(ctx); err != nil {
+		return err
+	}
+
+	_, err := tx.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("conversations"), bun.Ident("conversation")).
+		Where("? IN (?)", bun.Ident("conversation.id"), bun.In(conversationIDs)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,115 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ConversationTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ConversationTestSuite) TestGetConversationByThreadAndAccountIDs() {
+	testConversation := suite.testConversations["local_account_1_conversation_1"]
+
+	conversation, err := suite.db.GetConversationByThreadAndAccountIDs(context.Background(), testConversation.AccountID, testConversation.ThreadID, testConversation.OtherAccountsKey)
+	suite.NoError(err)
+	suite.Equal(testConversation.ID, conversation.ID)
+	suite.Equal([]string{suite.testAccounts["local_account_2"].ID}, conversation.OtherAccountIDs)
+	suite.False(*conversation.Read)
+
+	// same thread, different participants
+	_, err = suite.db.GetConversationByThreadAndAccountIDs(context.Background(), testConversation.AccountID, testConversation.ThreadID, "")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ConversationTestSuite) TestGetConversationsForAccountID() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	conversations, err := suite.db.GetConversationsForAccountID(ctx, testAccount.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(conversations, 1)
+
+	// paging is done by last status
+	conversations, err = suite.db.GetConversationsForAccountID(ctx, testAccount.ID, conversations[0].LastStatusID, "", "", 0)
+	suite.NoError(err)
+	suite.Empty(conversations)
+}
+
+func (suite *ConversationTestSuite) TestDeleteStatusFromConversations() {
+	ctx := context.Background()
+	testConversation := suite.testConversations["local_account_1_conversation_1"]
+	testStatus := suite.testStatuses["local_account_2_status_6"]
+
+	// add a later status to the conversation
+	laterStatusID := suite.testStatuses["local_account_2_status_7"].ID
+	conversation, err := suite.db.GetConversationByID(ctx, testConversation.ID)
+	suite.NoError(err)
+	conversation.LastStatusID = laterStatusID
+	suite.NoError(suite.db.UpdateConversation(ctx, conversation, "last_status_id"))
+	suite.NoError(suite.db.AddStatusToConversation(ctx, conversation.ID, laterStatusID))
+
+	// adding it twice is fine
+	suite.NoError(suite.db.AddStatusToConversation(ctx, conversation.ID, laterStatusID))
+
+	// removing the later status moves the conversation back to the earlier one
+	suite.NoError(suite.db.DeleteStatusFromConversations(ctx, laterStatusID))
+	conversation, err = suite.db.GetConversationByID(ctx, testConversation.ID)
+	suite.NoError(err)
+	suite.Equal(testStatus.ID, conversation.LastStatusID)
+
+	// removing the only remaining status removes the conversations entirely
+	suite.NoError(suite.db.DeleteStatusFromConversations(ctx, testStatus.ID))
+	_, err = suite.db.GetConversationByID(ctx, testConversation.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	_, err = suite.db.GetConversationByID(ctx, suite.testConversations["local_account_2_conversation_1"].ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *ConversationTestSuite) TestDeleteConversationsByAccountID() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	suite.NoError(suite.db.DeleteConversationsByAccountID(ctx, testAccount.ID))
+
+	conversations, err := suite.db.GetConversationsForAccountID(ctx, testAccount.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Empty(conversations)
+
+	// the other participant's view of the conversation is untouched
+	_, err = suite.db.GetConversationByID(ctx, suite.testConversations["local_account_2_conversation_1"].ID)
+	suite.NoError(err)
+
+	// as are the join rows for it
+	links := []*gtsmodel.ConversationToStatus{}
+	suite.NoError(suite.db.GetAll(ctx, &links))
+	suite.Len(links, len(testrig.NewTestConversationToStatuses())-1)
+}
+
+func TestConversationTestSuite(t *testing.T) {
+	suite.Run(t, new(ConversationTestSuite))
+}
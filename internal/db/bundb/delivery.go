@@ -0,0 +1,198 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type deliveryDB struct {
+	conn *DBConn
+}
+
+func (d *deliveryDB) GetDeliveryByID(ctx context.Context, id string) (*gtsmodel.Delivery, db.Error) {
+	delivery := &gtsmodel.Delivery{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(delivery).
+		Where("? = ?", bun.Ident("delivery.id"), id).
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return delivery, nil
+}
+
+func (d *deliveryDB) GetDeliveries(ctx context.Context, domain string, maxID string, minID string, limit int) ([]*gtsmodel.Delivery, db.Error) {
+	deliveries := []*gtsmodel.Delivery{}
+
+	q := d.conn.
+		NewSelect().
+		Model(&deliveries)
+
+	if domain != "" {
+		q = q.Where("? = ?", bun.Ident("delivery.target_domain"), domain)
+	}
+
+	if maxID != "" {
+		// return only deliveries LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("delivery.id"), maxID)
+	}
+
+	if minID != "" {
+		// return only deliveries HIGHER (ie., newer) than minID,
+		// starting from the oldest, so we page up from minID
+		q = q.
+			Where("? > ?", bun.Ident("delivery.id"), minID).
+			Order("delivery.id ASC")
+	} else {
+		q = q.Order("delivery.id DESC")
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	if minID != "" {
+		// we selected oldest first, so reverse
+		// the slice to return newest first
+		for i, j := 0, len(deliveries)-1; i < j; i, j = i+1, j-1 {
+			deliveries[i], deliveries[j] = deliveries[j], deliveries[i]
+		}
+	}
+
+	return deliveries, nil
+}
+
+func (d *deliveryDB) GetDeliveriesDueBefore(ctx context.Context, t time.Time, limit int) ([]*gtsmodel.Delivery, db.Error) {
+	deliveries := []*gtsmodel.Delivery{}
+
+	q := d.conn.
+		NewSelect().
+		Model(&deliveries).
+		Where("? <= ?", bun.Ident("delivery.next_attempt_at"), t).
+		Order("delivery.next_attempt_at ASC")
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return deliveries, nil
+}
+
+func (d *deliveryDB) CountDeliveries(ctx context.Context, domain string) (int, db.Error) {
+	q := d.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery"))
+
+	if domain != "" {
+		q = q.Where("? = ?", bun.Ident("delivery.target_domain"), domain)
+	}
+
+	count, err := q.Count(ctx)
+	if err != nil {
+		return 0, d.conn.ProcessError(err)
+	}
+
+	return count, nil
+}
+
+func (d *deliveryDB) PutDelivery(ctx context.Context, delivery *gtsmodel.Delivery) db.Error {
+	_, err := d.conn.
+		NewInsert().
+		Model(delivery).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) UpdateDelivery(ctx context.Context, delivery *gtsmodel.Delivery, columns ...string) db.Error {
+	delivery.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.conn.
+		NewUpdate().
+		Model(delivery).
+		Where("? = ?", bun.Ident("delivery.id"), delivery.ID).
+		Column(columns...).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) RescheduleDeliveriesForDomain(ctx context.Context, domain string, t time.Time) db.Error {
+	_, err := d.conn.
+		NewUpdate().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		Set("? = ?", bun.Ident("next_attempt_at"), t).
+		Set("? = ?", bun.Ident("updated_at"), time.Now()).
+		Where("? = ?", bun.Ident("delivery.target_domain"), domain).
+		Where("? > ?", bun.Ident("delivery.next_attempt_at"), t).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) DeleteDeliveryByID(ctx context.Context, id string) db.Error {
+	_, err := d.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery")).
+		Where("? = ?", bun.Ident("delivery.id"), id).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *deliveryDB) DeleteDeliveries(ctx context.Context, domain string) (int, db.Error) {
+	q := d.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("deliveries"), bun.Ident("delivery"))
+
+	if domain != "" {
+		q = q.Where("? = ?", bun.Ident("delivery.target_domain"), domain)
+	} else {
+		// bun refuses to delete without a where clause
+		q = q.Where("1 = 1")
+	}
+
+	res, err := q.Exec(ctx)
+	if err != nil {
+		return 0, d.conn.ProcessError(err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return 0, d.conn.ProcessError(err)
+	}
+
+	return int(rows), nil
+}
@@ -0,0 +1,144 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type DeliveryTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *DeliveryTestSuite) putDelivery(id string, domain string, nextAttemptAt time.Time) *gtsmodel.Delivery {
+	delivery := &gtsmodel.Delivery{
+		ID:            id,
+		PubKeyID:      suite.testAccounts["local_account_1"].PublicKeyURI,
+		TargetURI:     "https://" + domain + "/inbox",
+		TargetDomain:  domain,
+		Payload:       []byte(`{"type":"Create"}`),
+		Attempts:      1,
+		NextAttemptAt: nextAttemptAt,
+	}
+	suite.NoError(suite.db.PutDelivery(context.Background(), delivery))
+	return delivery
+}
+
+func (suite *DeliveryTestSuite) TestPutGetDelivery() {
+	ctx := context.Background()
+	delivery := suite.putDelivery("01GTDM3ZAFSR6D2JBY4A7TE3A6", "example.org", time.Now())
+
+	dbDelivery, err := suite.db.GetDeliveryByID(ctx, delivery.ID)
+	suite.NoError(err)
+	suite.Equal("https://example.org/inbox", dbDelivery.TargetURI)
+	suite.Equal(`{"type":"Create"}`, string(dbDelivery.Payload))
+	suite.Equal(1, dbDelivery.Attempts)
+
+	dbDelivery.Attempts = 2
+	dbDelivery.LastError = "oh no"
+	suite.NoError(suite.db.UpdateDelivery(ctx, dbDelivery, "attempts", "last_error"))
+
+	dbDelivery, err = suite.db.GetDeliveryByID(ctx, delivery.ID)
+	suite.NoError(err)
+	suite.Equal(2, dbDelivery.Attempts)
+	suite.Equal("oh no", dbDelivery.LastError)
+
+	suite.NoError(suite.db.DeleteDeliveryByID(ctx, delivery.ID))
+	_, err = suite.db.GetDeliveryByID(ctx, delivery.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *DeliveryTestSuite) TestGetDeliveries() {
+	ctx := context.Background()
+	now := time.Now()
+	suite.putDelivery("01GTDM3ZAFSR6D2JBY4A7TE3A6", "example.org", now.Add(-time.Hour))
+	suite.putDelivery("01GTDM4P0MT2SHB5JVZ1GX3Q5N", "example.org", now.Add(time.Hour))
+	suite.putDelivery("01GTDM5B1QF8EQZ8C63JR3MHV0", "fossbros-anonymous.io", now.Add(-time.Minute))
+
+	deliveries, err := suite.db.GetDeliveries(ctx, "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(deliveries, 3)
+	suite.Equal("01GTDM5B1QF8EQZ8C63JR3MHV0", deliveries[0].ID)
+
+	deliveries, err = suite.db.GetDeliveries(ctx, "example.org", "01GTDM4P0MT2SHB5JVZ1GX3Q5N", "", 0)
+	suite.NoError(err)
+	suite.Len(deliveries, 1)
+	suite.Equal("01GTDM3ZAFSR6D2JBY4A7TE3A6", deliveries[0].ID)
+
+	// page up from the oldest, still newest first
+	deliveries, err = suite.db.GetDeliveries(ctx, "", "", "01GTDM3ZAFSR6D2JBY4A7TE3A6", 1)
+	suite.NoError(err)
+	suite.Len(deliveries, 1)
+	suite.Equal("01GTDM4P0MT2SHB5JVZ1GX3Q5N", deliveries[0].ID)
+
+	// most overdue first, and nothing in the future
+	deliveries, err = suite.db.GetDeliveriesDueBefore(ctx, now, 0)
+	suite.NoError(err)
+	suite.Len(deliveries, 2)
+	suite.Equal("01GTDM3ZAFSR6D2JBY4A7TE3A6", deliveries[0].ID)
+	suite.Equal("01GTDM5B1QF8EQZ8C63JR3MHV0", deliveries[1].ID)
+
+	count, err := suite.db.CountDeliveries(ctx, "example.org")
+	suite.NoError(err)
+	suite.Equal(2, count)
+}
+
+func (suite *DeliveryTestSuite) TestRescheduleDeliveriesForDomain() {
+	ctx := context.Background()
+	now := time.Now()
+	suite.putDelivery("01GTDM4P0MT2SHB5JVZ1GX3Q5N", "example.org", now.Add(time.Hour))
+	suite.putDelivery("01GTDM5B1QF8EQZ8C63JR3MHV0", "fossbros-anonymous.io", now.Add(time.Hour))
+
+	suite.NoError(suite.db.RescheduleDeliveriesForDomain(ctx, "example.org", now))
+
+	deliveries, err := suite.db.GetDeliveriesDueBefore(ctx, now, 0)
+	suite.NoError(err)
+	suite.Len(deliveries, 1)
+	suite.Equal("example.org", deliveries[0].TargetDomain)
+}
+
+func (suite *DeliveryTestSuite) TestDeleteDeliveries() {
+	ctx := context.Background()
+	now := time.Now()
+	suite.putDelivery("01GTDM3ZAFSR6D2JBY4A7TE3A6", "example.org", now)
+	suite.putDelivery("01GTDM4P0MT2SHB5JVZ1GX3Q5N", "example.org", now)
+	suite.putDelivery("01GTDM5B1QF8EQZ8C63JR3MHV0", "fossbros-anonymous.io", now)
+
+	deleted, err := suite.db.DeleteDeliveries(ctx, "example.org")
+	suite.NoError(err)
+	suite.Equal(2, deleted)
+
+	deleted, err = suite.db.DeleteDeliveries(ctx, "")
+	suite.NoError(err)
+	suite.Equal(1, deleted)
+
+	count, err := suite.db.CountDeliveries(ctx, "")
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
+func TestDeliveryTestSuite(t *testing.T) {
+	suite.Run(t, new(DeliveryTestSuite))
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -133,6 +134,19 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, db
 		return false, nil
 	}
 
+	// In allowlist mode, any domain that isn't
+	// explicitly allowed is blocked by default
+	if config.GetInstanceFederationMode() == config.InstanceFederationModeAllowlist {
+		allowed, err := d.isDomainAllowed(ctx, domain)
+		if err != nil {
+			return false, err
+		}
+
+		if !allowed {
+			return true, nil
+		}
+	}
+
 	// Check the cache for a domain block (hydrating the cache with callback if necessary)
 	return d.state.Caches.GTS.DomainBlock().IsBlocked(domain, func() ([]string, error) {
 		var domains []string
@@ -149,6 +163,113 @@ func (d *domainDB) IsDomainBlocked(ctx context.Context, domain string) (bool, db
 	})
 }
 
+func (d *domainDB) CreateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow) db.Error {
+	var err error
+
+	// Normalize the domain as punycode
+	allow.Domain, err = normalizeDomain(allow.Domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to store domain in DB
+	if _, err := d.conn.NewInsert().
+		Model(allow).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	// Clear the domain allow cache (for later reload)
+	d.state.Caches.GTS.DomainAllow().Clear()
+
+	return nil
+}
+
+func (d *domainDB) GetDomainAllow(ctx context.Context, domain string) (*gtsmodel.DomainAllow, db.Error) {
+	var err error
+
+	// Normalize the domain as punycode
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return nil, db.ErrNoEntries
+	}
+
+	var allow gtsmodel.DomainAllow
+
+	// Look for allow matching domain in DB
+	q := d.conn.
+		NewSelect().
+		Model(&allow).
+		Where("? = ?", bun.Ident("domain_allow.domain"), domain)
+	if err := q.Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return &allow, nil
+}
+
+func (d *domainDB) DeleteDomainAllow(ctx context.Context, domain string) db.Error {
+	var err error
+
+	domain, err = normalizeDomain(domain)
+	if err != nil {
+		return err
+	}
+
+	// Attempt to delete domain allow
+	if _, err := d.conn.NewDelete().
+		Model((*gtsmodel.DomainAllow)(nil)).
+		Where("? = ?", bun.Ident("domain_allow.domain"), domain).
+		Exec(ctx); err != nil {
+		return d.conn.ProcessError(err)
+	}
+
+	// Clear the domain allow cache (for later reload)
+	d.state.Caches.GTS.DomainAllow().Clear()
+
+	return nil
+}
+
+func (d *domainDB) IsDomainAllowed(ctx context.Context, domain string) (bool, db.Error) {
+	// Normalize the domain as punycode
+	domain, err := normalizeDomain(domain)
+	if err != nil {
+		return false, err
+	}
+
+	// Check for easy case, domain referencing *us*
+	if domain == "" || domain == config.GetAccountDomain() ||
+		domain == config.GetHost() {
+		return true, nil
+	}
+
+	return d.isDomainAllowed(ctx, domain)
+}
+
+// isDomainAllowed checks the domain allow cache for an already normalized domain.
+func (d *domainDB) isDomainAllowed(ctx context.Context, domain string) (bool, db.Error) {
+	// Check the cache for a domain allow (hydrating the cache with callback if necessary)
+	return d.state.Caches.GTS.DomainAllow().IsBlocked(domain, func() ([]string, error) {
+		var domains []string
+
+		// Scan list of all allowed domains from DB
+		q := d.conn.NewSelect().
+			Table("domain_allows").
+			Column("domain")
+		if err := q.Scan(ctx, &domains); err != nil {
+			return nil, d.conn.ProcessError(err)
+		}
+
+		return domains, nil
+	})
+}
+
 func (d *domainDB) AreDomainsBlocked(ctx context.Context, domains []string) (bool, db.Error) {
 	for _, domain := range domains {
 		if blocked, err := d.IsDomainBlocked(ctx, domain); err != nil {
@@ -174,3 +295,91 @@ func (d *domainDB) AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, d
 	}
 	return false, nil
 }
+
+func (d *domainDB) GetDomainBlocksBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainBlock, db.Error) {
+	blocks := []*gtsmodel.DomainBlock{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(&blocks).
+		Where("? = ?", bun.Ident("domain_block.subscription_id"), subscriptionID).
+		Order("domain_block.domain ASC").
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return blocks, nil
+}
+
+func (d *domainDB) GetDomainBlockSubscriptionByID(ctx context.Context, id string) (*gtsmodel.DomainBlockSubscription, db.Error) {
+	subscription := &gtsmodel.DomainBlockSubscription{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(subscription).
+		Where("? = ?", bun.Ident("domain_block_subscription.id"), id).
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return subscription, nil
+}
+
+func (d *domainDB) GetDomainBlockSubscriptions(ctx context.Context) ([]*gtsmodel.DomainBlockSubscription, db.Error) {
+	subscriptions := []*gtsmodel.DomainBlockSubscription{}
+
+	if err := d.conn.
+		NewSelect().
+		Model(&subscriptions).
+		Order("domain_block_subscription.id ASC").
+		Scan(ctx); err != nil {
+		return nil, d.conn.ProcessError(err)
+	}
+
+	return subscriptions, nil
+}
+
+func (d *domainDB) PutDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) db.Error {
+	_, err := d.conn.
+		NewInsert().
+		Model(subscription).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *domainDB) UpdateDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription, columns ...string) db.Error {
+	subscription.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := d.conn.
+		NewUpdate().
+		Model(subscription).
+		Where("? = ?", bun.Ident("domain_block_subscription.id"), subscription.ID).
+		Column(columns...).
+		Exec(ctx)
+	return d.conn.ProcessError(err)
+}
+
+func (d *domainDB) DeleteDomainBlockSubscriptionByID(ctx context.Context, id string) db.Error {
+	return d.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// orphan any blocks created through this subscription
+		if _, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("domain_blocks"), bun.Ident("domain_block")).
+			Set("? = NULL", bun.Ident("subscription_id")).
+			Where("? = ?", bun.Ident("domain_block.subscription_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("domain_block_subscriptions"), bun.Ident("domain_block_subscription")).
+			Where("? = ?", bun.Ident("domain_block_subscription.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
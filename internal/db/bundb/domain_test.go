@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -160,6 +162,146 @@ func (suite *DomainTestSuite) TestIsDomainBlockedNonASCII2() {
 	suite.True(blocked)
 }
 
+func (suite *DomainTestSuite) TestGetDomainBlockSubscriptions() {
+	ctx := context.Background()
+
+	subscriptions, err := suite.db.GetDomainBlockSubscriptions(ctx)
+	suite.NoError(err)
+	suite.Len(subscriptions, len(suite.testDomainBlockSubs))
+
+	subscription, err := suite.db.GetDomainBlockSubscriptionByID(ctx, suite.testDomainBlockSubs["admin_plaintext"].ID)
+	suite.NoError(err)
+	suite.Equal("https://blocklists.example.org/blocklist.txt", subscription.URI)
+	suite.Equal(gtsmodel.DomainBlockSubscriptionContentPlain, subscription.ContentType)
+	suite.Empty(subscription.Conflicts)
+}
+
+func (suite *DomainTestSuite) TestUpdateDomainBlockSubscription() {
+	ctx := context.Background()
+
+	subscription := &gtsmodel.DomainBlockSubscription{}
+	*subscription = *suite.testDomainBlockSubs["admin_plaintext"]
+
+	subscription.FetchedAt = time.Now()
+	subscription.Conflicts = []string{"bad.apples", "worse.apples"}
+	err := suite.db.UpdateDomainBlockSubscription(ctx, subscription, "fetched_at", "conflicts")
+	suite.NoError(err)
+
+	dbSubscription, err := suite.db.GetDomainBlockSubscriptionByID(ctx, subscription.ID)
+	suite.NoError(err)
+	suite.WithinDuration(subscription.FetchedAt, dbSubscription.FetchedAt, time.Second)
+	suite.Equal([]string{"bad.apples", "worse.apples"}, dbSubscription.Conflicts)
+}
+
+func (suite *DomainTestSuite) TestDeleteDomainBlockSubscriptionKeepsBlocks() {
+	ctx := context.Background()
+	subscription := suite.testDomainBlockSubs["admin_plaintext"]
+
+	domainBlock := &gtsmodel.DomainBlock{
+		ID:                 "01G204214Y9TNJEBX39C7G88SW",
+		Domain:             "some.bad.apples",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+		SubscriptionID:     subscription.ID,
+	}
+
+	err := suite.db.CreateDomainBlock(ctx, domainBlock)
+	suite.NoError(err)
+
+	blocks, err := suite.db.GetDomainBlocksBySubscriptionID(ctx, subscription.ID)
+	suite.NoError(err)
+	suite.Len(blocks, 1)
+
+	err = suite.db.DeleteDomainBlockSubscriptionByID(ctx, subscription.ID)
+	suite.NoError(err)
+
+	_, err = suite.db.GetDomainBlockSubscriptionByID(ctx, subscription.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// the block is still there, but no longer owned by the subscription
+	block, err := suite.db.GetDomainBlock(ctx, domainBlock.Domain)
+	suite.NoError(err)
+	suite.Empty(block.SubscriptionID)
+
+	blocks, err = suite.db.GetDomainBlocksBySubscriptionID(ctx, subscription.ID)
+	suite.NoError(err)
+	suite.Empty(blocks)
+}
+
+func (suite *DomainTestSuite) TestIsDomainBlockedAllowlistMode() {
+	ctx := context.Background()
+
+	config.SetInstanceFederationMode(config.InstanceFederationModeAllowlist)
+
+	// explicitly allowed domain + subdomains aren't blocked
+	for _, domain := range []string{
+		"fossbros-anonymous.io",
+		"sub.fossbros-anonymous.io",
+	} {
+		blocked, err := suite.db.IsDomainBlocked(ctx, domain)
+		suite.NoError(err)
+		suite.False(blocked, domain)
+	}
+
+	// every other domain is blocked
+	blocked, err := suite.db.IsDomainBlocked(ctx, "some.other.domain")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// but we can never block ourselves
+	blocked, err = suite.db.IsDomainBlocked(ctx, config.GetHost())
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// allow the other domain, it's no longer blocked
+	err = suite.db.CreateDomainAllow(ctx, &gtsmodel.DomainAllow{
+		ID:                 "01GT5E1W0Y4T3H9TGJ3G4PN2RB",
+		Domain:             "some.other.domain",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.NoError(err)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, "some.other.domain")
+	suite.NoError(err)
+	suite.False(blocked)
+
+	// an explicit domain block still wins over an allow
+	err = suite.db.CreateDomainBlock(ctx, &gtsmodel.DomainBlock{
+		ID:                 "01G204214Y9TNJEBX39C7G88SW",
+		Domain:             "some.other.domain",
+		CreatedByAccountID: suite.testAccounts["admin_account"].ID,
+	})
+	suite.NoError(err)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, "some.other.domain")
+	suite.NoError(err)
+	suite.True(blocked)
+
+	// in blocklist mode, the allow list is ignored
+	config.SetInstanceFederationMode(config.InstanceFederationModeBlocklist)
+
+	blocked, err = suite.db.IsDomainBlocked(ctx, "yet.another.domain")
+	suite.NoError(err)
+	suite.False(blocked)
+}
+
+func (suite *DomainTestSuite) TestDeleteDomainAllow() {
+	ctx := context.Background()
+
+	allowed, err := suite.db.IsDomainAllowed(ctx, "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.True(allowed)
+
+	err = suite.db.DeleteDomainAllow(ctx, "fossbros-anonymous.io")
+	suite.NoError(err)
+
+	_, err = suite.db.GetDomainAllow(ctx, "fossbros-anonymous.io")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	allowed, err = suite.db.IsDomainAllowed(ctx, "fossbros-anonymous.io")
+	suite.NoError(err)
+	suite.False(allowed)
+}
+
 func TestDomainTestSuite(t *testing.T) {
 	suite.Run(t, new(DomainTestSuite))
 }
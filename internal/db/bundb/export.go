@@ -0,0 +1,103 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type exportDB struct {
+	conn *DBConn
+}
+
+func (e *exportDB) GetAccountExportByID(ctx context.Context, id string) (*gtsmodel.AccountExport, db.Error) {
+	export := &gtsmodel.AccountExport{}
+
+	if err := e.conn.
+		NewSelect().
+		Model(export).
+		Where("? = ?", bun.Ident("account_export.id"), id).
+		Scan(ctx); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	return export, nil
+}
+
+func (e *exportDB) GetAccountExportsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.AccountExport, db.Error) {
+	exports := []*gtsmodel.AccountExport{}
+
+	if err := e.conn.
+		NewSelect().
+		Model(&exports).
+		Where("? = ?", bun.Ident("account_export.account_id"), accountID).
+		Order("account_export.id DESC").
+		Scan(ctx); err != nil {
+		return nil, e.conn.ProcessError(err)
+	}
+
+	return exports, nil
+}
+
+func (e *exportDB) PutAccountExport(ctx context.Context, export *gtsmodel.AccountExport) db.Error {
+	if _, err := e.conn.
+		NewInsert().
+		Model(export).
+		Exec(ctx); err != nil {
+		return e.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (e *exportDB) UpdateAccountExport(ctx context.Context, export *gtsmodel.AccountExport, columns ...string) db.Error {
+	export.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	if _, err := e.conn.
+		NewUpdate().
+		Model(export).
+		Column(columns...).
+		Where("? = ?", bun.Ident("account_export.id"), export.ID).
+		Exec(ctx); err != nil {
+		return e.conn.ProcessError(err)
+	}
+
+	return nil
+}
+
+func (e *exportDB) DeleteAccountExportByID(ctx context.Context, id string) db.Error {
+	if _, err := e.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("account_exports"), bun.Ident("account_export")).
+		Where("? = ?", bun.Ident("account_export.id"), id).
+		Exec(ctx); err != nil {
+		return e.conn.ProcessError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ExportTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ExportTestSuite) TestPutGetUpdateDeleteAccountExport() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	exports, err := suite.db.GetAccountExportsByAccountID(ctx, account.ID)
+	suite.NoError(err)
+	suite.Empty(exports)
+
+	for _, id := range []string{"01GTBKJ2X4WKHJ6DF3JCG1H7GY", "01GTBKJJZTF4R8Q6M3WDX1HQ5A"} {
+		suite.NoError(suite.db.PutAccountExport(ctx, &gtsmodel.AccountExport{
+			ID:        id,
+			AccountID: account.ID,
+			State:     gtsmodel.AccountExportStateProcessing,
+		}))
+	}
+
+	exports, err = suite.db.GetAccountExportsByAccountID(ctx, account.ID)
+	suite.NoError(err)
+	suite.Len(exports, 2)
+	suite.Equal("01GTBKJJZTF4R8Q6M3WDX1HQ5A", exports[0].ID) // newest first
+
+	export := exports[0]
+	export.State = gtsmodel.AccountExportStateComplete
+	export.Path = account.ID + "/export/" + export.ID + ".zip"
+	export.Size = 1024
+	export.CompletedAt = time.Now()
+	suite.NoError(suite.db.UpdateAccountExport(ctx, export, "state", "path", "size", "completed_at"))
+
+	dbExport, err := suite.db.GetAccountExportByID(ctx, export.ID)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.AccountExportStateComplete, dbExport.State)
+	suite.Equal(export.Path, dbExport.Path)
+	suite.EqualValues(1024, dbExport.Size)
+	suite.False(dbExport.CompletedAt.IsZero())
+
+	suite.NoError(suite.db.DeleteAccountExportByID(ctx, export.ID))
+
+	_, err = suite.db.GetAccountExportByID(ctx, export.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func TestExportTestSuite(t *testing.T) {
+	suite.Run(t, new(ExportTestSuite))
+}
@@ -0,0 +1,261 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type filterDB struct {
+	conn *DBConn
+}
+
+/*
+	FILTER FUNCTIONS
+*/
+
+func (f *filterDB) GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, db.Error) {
+	filter := &gtsmodel.Filter{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(filter).
+		Where("? = ?", bun.Ident("filter.id"), id).
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	keywords, err := f.GetFilterKeywordsForFilterID(ctx, filter.ID)
+	if err != nil {
+		return nil, err
+	}
+	filter.Keywords = keywords
+
+	return filter, nil
+}
+
+func (f *filterDB) GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, db.Error) {
+	filters := []*gtsmodel.Filter{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(&filters).
+		Where("? = ?", bun.Ident("filter.account_id"), accountID).
+		Order("filter.id DESC").
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	if len(filters) == 0 {
+		return filters, nil
+	}
+
+	// Select all keywords belonging to this account in one
+	// go, and sort them into their corresponding filters.
+	keywords := []*gtsmodel.FilterKeyword{}
+	if err := f.conn.
+		NewSelect().
+		Model(&keywords).
+		Where("? = ?", bun.Ident("filter_keyword.account_id"), accountID).
+		Order("filter_keyword.id ASC").
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	filtersByID := make(map[string]*gtsmodel.Filter, len(filters))
+	for _, filter := range filters {
+		filtersByID[filter.ID] = filter
+	}
+
+	for _, keyword := range keywords {
+		if filter, ok := filtersByID[keyword.FilterID]; ok {
+			keyword.Filter = filter
+			filter.Keywords = append(filter.Keywords, keyword)
+		}
+	}
+
+	return filters, nil
+}
+
+func (f *filterDB) PutFilter(ctx context.Context, filter *gtsmodel.Filter) db.Error {
+	return f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewInsert().
+			Model(filter).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		for _, keyword := range filter.Keywords {
+			if _, err := tx.
+				NewInsert().
+				Model(keyword).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
+func (f *filterDB) UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) db.Error {
+	filter.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := f.conn.
+		NewUpdate().
+		Model(filter).
+		Where("? = ?", bun.Ident("filter.id"), filter.ID).
+		Column(columns...).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) DeleteFilterByID(ctx context.Context, id string) db.Error {
+	return f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Delete all keywords attached to filter.
+		if _, err := tx.NewDelete().
+			Table("filter_keywords").
+			Where("? = ?", bun.Ident("filter_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete the filter itself.
+		_, err := tx.NewDelete().
+			Table("filters").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (f *filterDB) DeleteFiltersByAccountID(ctx context.Context, accountID string) db.Error {
+	return f.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Delete all keywords owned by account.
+		if _, err := tx.NewDelete().
+			Table("filter_keywords").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete all filters owned by account.
+		_, err := tx.NewDelete().
+			Table("filters").
+			Where("? = ?", bun.Ident("account_id"), accountID).
+			Exec(ctx)
+		return err
+	})
+}
+
+/*
+	FILTER KEYWORD FUNCTIONS
+*/
+
+func (f *filterDB) GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, db.Error) {
+	keyword := &gtsmodel.FilterKeyword{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(keyword).
+		Where("? = ?", bun.Ident("filter_keyword.id"), id).
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	filter, err := f.GetFilterByID(ctx, keyword.FilterID)
+	if err != nil {
+		return nil, err
+	}
+	keyword.Filter = filter
+
+	return keyword, nil
+}
+
+func (f *filterDB) GetFilterKeywordsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	filters, err := f.GetFiltersForAccountID(ctx, accountID)
+	if err != nil {
+		return nil, err
+	}
+
+	keywords := []*gtsmodel.FilterKeyword{}
+	for _, filter := range filters {
+		keywords = append(keywords, filter.Keywords...)
+	}
+
+	return keywords, nil
+}
+
+func (f *filterDB) GetFilterKeywordsForFilterID(ctx context.Context, filterID string) ([]*gtsmodel.FilterKeyword, db.Error) {
+	keywords := []*gtsmodel.FilterKeyword{}
+
+	if err := f.conn.
+		NewSelect().
+		Model(&keywords).
+		Where("? = ?", bun.Ident("filter_keyword.filter_id"), filterID).
+		Order("filter_keyword.id ASC").
+		Scan(ctx); err != nil {
+		return nil, f.conn.ProcessError(err)
+	}
+
+	return keywords, nil
+}
+
+func (f *filterDB) PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) db.Error {
+	_, err := f.conn.
+		NewInsert().
+		Model(filterKeyword).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) db.Error {
+	filterKeyword.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := f.conn.
+		NewUpdate().
+		Model(filterKeyword).
+		Where("? = ?", bun.Ident("filter_keyword.id"), filterKeyword.ID).
+		Column(columns...).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
+
+func (f *filterDB) DeleteFilterKeywordByID(ctx context.Context, id string) db.Error {
+	_, err := f.conn.
+		NewDelete().
+		Table("filter_keywords").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return f.conn.ProcessError(err)
+}
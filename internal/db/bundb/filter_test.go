@@ -0,0 +1,138 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type FilterTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *FilterTestSuite) TestGetFilterByID() {
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+
+	filter, err := suite.db.GetFilterByID(context.Background(), testFilter.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testFilter.Title, filter.Title)
+	suite.Equal(testFilter.Action, filter.Action)
+	suite.True(filter.AppliesTo(gtsmodel.FilterContextHome))
+	suite.False(filter.AppliesTo(gtsmodel.FilterContextNotifications))
+	suite.Len(filter.Keywords, 1)
+	suite.Equal("fnord", filter.Keywords[0].Keyword)
+}
+
+func (suite *FilterTestSuite) TestGetFiltersForAccountID() {
+	filters, err := suite.db.GetFiltersForAccountID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(filters, 1)
+	suite.Len(filters[0].Keywords, 1)
+	suite.Equal(filters[0], filters[0].Keywords[0].Filter)
+}
+
+func (suite *FilterTestSuite) TestGetFiltersForAccountIDNone() {
+	filters, err := suite.db.GetFiltersForAccountID(context.Background(), suite.testAccounts["local_account_2"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(filters)
+}
+
+func (suite *FilterTestSuite) TestGetFilterKeywordByID() {
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+
+	keyword, err := suite.db.GetFilterKeywordByID(context.Background(), testKeyword.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testKeyword.Keyword, keyword.Keyword)
+	suite.NotNil(keyword.Filter)
+	suite.Equal(testKeyword.FilterID, keyword.Filter.ID)
+}
+
+func (suite *FilterTestSuite) TestPutFilterKeywordDuplicate() {
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+
+	// Same keyword on the same filter should conflict.
+	wholeWord := false
+	err := suite.db.PutFilterKeyword(context.Background(), &gtsmodel.FilterKeyword{
+		ID:        "01HNEK4RB6GJJKC7MFMWQ2QXT4",
+		AccountID: testKeyword.AccountID,
+		FilterID:  testKeyword.FilterID,
+		Keyword:   testKeyword.Keyword,
+		WholeWord: &wholeWord,
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func (suite *FilterTestSuite) TestDeleteFilterByID() {
+	ctx := context.Background()
+	testFilter := suite.testFilters["local_account_1_filter_1"]
+	testKeyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+
+	if err := suite.db.DeleteFilterByID(ctx, testFilter.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// Filter should be gone.
+	_, err := suite.db.GetFilterByID(ctx, testFilter.ID)
+	if !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow("", "expected ErrNoEntries, got %v", err)
+	}
+
+	// Keyword should be gone too.
+	_, err = suite.db.GetFilterKeywordByID(ctx, testKeyword.ID)
+	if !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow("", "expected ErrNoEntries, got %v", err)
+	}
+}
+
+func (suite *FilterTestSuite) TestDeleteFiltersByAccountID() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	if err := suite.db.DeleteFiltersByAccountID(ctx, testAccount.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	filters, err := suite.db.GetFiltersForAccountID(ctx, testAccount.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(filters)
+}
+
+func TestFilterTestSuite(t *testing.T) {
+	suite.Run(t, new(FilterTestSuite))
+}
@@ -20,11 +20,13 @@ package bundb
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/uptrace/bun"
 )
 
@@ -40,6 +42,9 @@ func (queryHook) AfterQuery(_ context.Context, event *bun.QueryEvent) {
 	// Get the DB query duration
 	dur := time.Since(event.StartTime)
 
+	// Record duration by operation
+	metrics.ObserveDBQuery(strings.ToUpper(event.Operation()), dur)
+
 	switch {
 	// Warn on slow database queries
 	case dur > time.Second:
@@ -20,6 +20,7 @@ package bundb
 
 import (
 	"context"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -117,6 +118,51 @@ func (i *instanceDB) GetInstancePeers(ctx context.Context, includeSuspended bool
 	return instances, nil
 }
 
+func (i *instanceDB) GetInstance(ctx context.Context, domain string) (*gtsmodel.Instance, db.Error) {
+	instance := &gtsmodel.Instance{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(instance).
+		Where("? = ?", bun.Ident("instance.domain"), domain).
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return instance, nil
+}
+
+func (i *instanceDB) GetFailingInstances(ctx context.Context) ([]*gtsmodel.Instance, db.Error) {
+	instances := []*gtsmodel.Instance{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(&instances).
+		Where("? > 0", bun.Ident("instance.failures")).
+		Order("instance.failing_since ASC").
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return instances, nil
+}
+
+func (i *instanceDB) UpdateInstance(ctx context.Context, instance *gtsmodel.Instance, columns ...string) db.Error {
+	instance.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.conn.
+		NewUpdate().
+		Model(instance).
+		Where("? = ?", bun.Ident("instance.id"), instance.ID).
+		Column(columns...).
+		Exec(ctx)
+	return i.conn.ProcessError(err)
+}
+
 func (i *instanceDB) GetInstanceAccounts(ctx context.Context, domain string, maxID string, limit int) ([]*gtsmodel.Account, db.Error) {
 	accounts := []*gtsmodel.Account{}
 
@@ -21,9 +21,11 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 )
 
 type InstanceTestSuite struct {
@@ -72,6 +74,34 @@ func (suite *InstanceTestSuite) TestGetInstancePeersIncludeSuspended() {
 	suite.Len(peers, 2)
 }
 
+func (suite *InstanceTestSuite) TestGetUpdateInstance() {
+	ctx := context.Background()
+
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.Zero(instance.Failures)
+
+	failingInstances, err := suite.db.GetFailingInstances(ctx)
+	suite.NoError(err)
+	suite.Empty(failingInstances)
+
+	instance.Failures = 3
+	instance.FailingSince = time.Now().Add(-time.Hour)
+	instance.LastFailedAt = time.Now()
+	instance.LastFailure = "dial tcp: lookup example.org: no such host"
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "failures", "failing_since", "last_failed_at", "last_failure"))
+
+	failingInstances, err = suite.db.GetFailingInstances(ctx)
+	suite.NoError(err)
+	suite.Len(failingInstances, 1)
+	suite.Equal("example.org", failingInstances[0].Domain)
+	suite.Equal(3, failingInstances[0].Failures)
+	suite.Equal("dial tcp: lookup example.org: no such host", failingInstances[0].LastFailure)
+
+	_, err = suite.db.GetInstance(ctx, "nowhere.example.org")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
 func (suite *InstanceTestSuite) TestGetInstanceAccounts() {
 	accounts, err := suite.db.GetInstanceAccounts(context.Background(), "fossbros-anonymous.io", "", 10)
 	suite.NoError(err)
@@ -0,0 +1,147 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type inviteDB struct {
+	conn *DBConn
+}
+
+func (i *inviteDB) GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, db.Error) {
+	invite := &gtsmodel.Invite{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(invite).
+		Where("? = ?", bun.Ident("invite.id"), id).
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return invite, nil
+}
+
+func (i *inviteDB) GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, db.Error) {
+	invite := &gtsmodel.Invite{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(invite).
+		Where("? = ?", bun.Ident("invite.code"), code).
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return invite, nil
+}
+
+func (i *inviteDB) GetAccountInvites(ctx context.Context, accountID string) ([]*gtsmodel.Invite, db.Error) {
+	invites := []*gtsmodel.Invite{}
+
+	if err := i.conn.
+		NewSelect().
+		Model(&invites).
+		Where("? = ?", bun.Ident("invite.account_id"), accountID).
+		Order("invite.id DESC").
+		Scan(ctx); err != nil {
+		return nil, i.conn.ProcessError(err)
+	}
+
+	return invites, nil
+}
+
+func (i *inviteDB) PutInvite(ctx context.Context, invite *gtsmodel.Invite) db.Error {
+	_, err := i.conn.
+		NewInsert().
+		Model(invite).
+		Exec(ctx)
+	return i.conn.ProcessError(err)
+}
+
+func (i *inviteDB) UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) db.Error {
+	invite.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := i.conn.
+		NewUpdate().
+		Model(invite).
+		Where("? = ?", bun.Ident("invite.id"), invite.ID).
+		Column(columns...).
+		Exec(ctx)
+	return i.conn.ProcessError(err)
+}
+
+func (i *inviteDB) UseInvite(ctx context.Context, invite *gtsmodel.Invite) db.Error {
+	now := time.Now()
+
+	// Check and increment the uses in one statement, so
+	// that two sign-ups racing for the last use of an
+	// invite can't both get it.
+	res, err := i.conn.
+		NewUpdate().
+		TableExpr("? AS ?", bun.Ident("invites"), bun.Ident("invite")).
+		Set("? = ? + 1", bun.Ident("uses"), bun.Ident("uses")).
+		Set("? = ?", bun.Ident("updated_at"), now).
+		Where("? = ?", bun.Ident("invite.id"), invite.ID).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? = 0", bun.Ident("invite.max_uses")).
+				WhereOr("? < ?", bun.Ident("invite.uses"), bun.Ident("invite.max_uses"))
+		}).
+		WhereGroup(" AND ", func(q *bun.UpdateQuery) *bun.UpdateQuery {
+			return q.
+				Where("? IS NULL", bun.Ident("invite.expires_at")).
+				WhereOr("? > ?", bun.Ident("invite.expires_at"), now)
+		}).
+		Exec(ctx)
+	if err != nil {
+		return i.conn.ProcessError(err)
+	}
+
+	rows, err := res.RowsAffected()
+	if err != nil {
+		return i.conn.ProcessError(err)
+	}
+
+	if rows == 0 {
+		return db.ErrNoEntries
+	}
+
+	if err := i.conn.
+		NewSelect().
+		Model(invite).
+		Column("uses", "updated_at").
+		Where("? = ?", bun.Ident("invite.id"), invite.ID).
+		Scan(ctx); err != nil {
+		return i.conn.ProcessError(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type InviteTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *InviteTestSuite) putInvite(id string, code string, maxUses int, expiresAt time.Time) *gtsmodel.Invite {
+	invite := &gtsmodel.Invite{
+		ID:        id,
+		Code:      code,
+		AccountID: suite.testAccounts["admin_account"].ID,
+		MaxUses:   maxUses,
+		ExpiresAt: expiresAt,
+	}
+	suite.NoError(suite.db.PutInvite(context.Background(), invite))
+	return invite
+}
+
+func (suite *InviteTestSuite) TestPutGetInvite() {
+	ctx := context.Background()
+	invite := suite.putInvite("01GTFWQ2TJ9Q0DZ3A5ZFY8V5MZ", "welcome-friends", 2, time.Time{})
+
+	dbInvite, err := suite.db.GetInviteByCode(ctx, "welcome-friends")
+	suite.NoError(err)
+	suite.Equal(invite.ID, dbInvite.ID)
+	suite.Equal(2, dbInvite.MaxUses)
+	suite.Equal(0, dbInvite.Uses)
+	suite.True(dbInvite.ExpiresAt.IsZero())
+
+	invites, err := suite.db.GetAccountInvites(ctx, suite.testAccounts["admin_account"].ID)
+	suite.NoError(err)
+	suite.Len(invites, 1)
+
+	invites, err = suite.db.GetAccountInvites(ctx, suite.testAccounts["local_account_1"].ID)
+	suite.NoError(err)
+	suite.Empty(invites)
+
+	_, err = suite.db.GetInviteByCode(ctx, "not-a-code")
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *InviteTestSuite) TestUseInviteMaxUses() {
+	ctx := context.Background()
+	invite := suite.putInvite("01GTFWQ2TJ9Q0DZ3A5ZFY8V5MZ", "welcome-friends", 2, time.Time{})
+
+	suite.NoError(suite.db.UseInvite(ctx, invite))
+	suite.Equal(1, invite.Uses)
+	suite.NoError(suite.db.UseInvite(ctx, invite))
+	suite.Equal(2, invite.Uses)
+
+	// all used up
+	suite.ErrorIs(suite.db.UseInvite(ctx, invite), db.ErrNoEntries)
+
+	dbInvite, err := suite.db.GetInviteByID(ctx, invite.ID)
+	suite.NoError(err)
+	suite.Equal(2, dbInvite.Uses)
+}
+
+func (suite *InviteTestSuite) TestUseInviteUnlimited() {
+	ctx := context.Background()
+	invite := suite.putInvite("01GTFWQ2TJ9Q0DZ3A5ZFY8V5MZ", "welcome-friends", 0, time.Now().Add(time.Hour))
+
+	for i := 1; i <= 5; i++ {
+		suite.NoError(suite.db.UseInvite(ctx, invite))
+		suite.Equal(i, invite.Uses)
+	}
+}
+
+func (suite *InviteTestSuite) TestUseInviteExpired() {
+	ctx := context.Background()
+	invite := suite.putInvite("01GTFWQ2TJ9Q0DZ3A5ZFY8V5MZ", "welcome-friends", 0, time.Now().Add(time.Hour))
+
+	invite.ExpiresAt = time.Now().Add(-time.Minute)
+	suite.NoError(suite.db.UpdateInvite(ctx, invite, "expires_at"))
+
+	suite.ErrorIs(suite.db.UseInvite(ctx, invite), db.ErrNoEntries)
+	suite.Equal(0, invite.Uses)
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, new(InviteTestSuite))
+}
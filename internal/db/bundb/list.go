@@ -0,0 +1,370 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+)
+
+type listDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+/*
+	LIST FUNCTIONS
+*/
+
+func (l *listDB) GetListByID(ctx context.Context, id string) (*gtsmodel.List, db.Error) {
+	list := &gtsmodel.List{}
+
+	if err := l.conn.
+		NewSelect().
+		Model(list).
+		Where("? = ?", bun.Ident("list.id"), id).
+		Scan(ctx); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if err := l.populateList(ctx, list); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (l *listDB) GetListsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.List, db.Error) {
+	// Fetch IDs of all lists owned by this account.
+	var listIDs []string
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("lists"), bun.Ident("list")).
+		Column("list.id").
+		Where("? = ?", bun.Ident("list.account_id"), accountID).
+		Order("list.id DESC").
+		Scan(ctx, &listIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(listIDs) == 0 {
+		return nil, nil
+	}
+
+	// Select each list using its ID to ensure it's populated.
+	lists := make([]*gtsmodel.List, 0, len(listIDs))
+	for _, id := range listIDs {
+		list, err := l.GetListByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetListsForAccountID: error fetching list %q: %v", id, err)
+			continue
+		}
+
+		lists = append(lists, list)
+	}
+
+	return lists, nil
+}
+
+func (l *listDB) populateList(ctx context.Context, list *gtsmodel.List) db.Error {
+	if list.Account == nil {
+		// List account is not set, fetch from the database.
+		account, err := l.state.DB.GetAccountByID(ctx, list.AccountID)
+		if err != nil {
+			return fmt.Errorf("populateList: error populating list account: %w", err)
+		}
+		list.Account = account
+	}
+
+	return nil
+}
+
+func (l *listDB) PutList(ctx context.Context, list *gtsmodel.List) db.Error {
+	_, err := l.conn.
+		NewInsert().
+		Model(list).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
+
+func (l *listDB) UpdateList(ctx context.Context, list *gtsmodel.List, columns ...string) db.Error {
+	list.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := l.conn.
+		NewUpdate().
+		Model(list).
+		Where("? = ?", bun.Ident("list.id"), list.ID).
+		Column(columns...).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
+
+func (l *listDB) DeleteListByID(ctx context.Context, id string) db.Error {
+	return l.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Delete all entries attached to list.
+		if _, err := tx.NewDelete().
+			Table("list_entries").
+			Where("? = ?", bun.Ident("list_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// Delete the list itself.
+		_, err := tx.NewDelete().
+			Table("lists").
+			Where("? = ?", bun.Ident("id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (l *listDB) DeleteListsByAccountID(ctx context.Context, accountID string) db.Error {
+	var listIDs []string
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("lists"), bun.Ident("list")).
+		Column("list.id").
+		Where("? = ?", bun.Ident("list.account_id"), accountID).
+		Scan(ctx, &listIDs); err != nil {
+		return l.conn.ProcessError(err)
+	}
+
+	for _, id := range listIDs {
+		if err := l.DeleteListByID(ctx, id); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/*
+	LIST ENTRY functions
+*/
+
+func (l *listDB) GetListEntryByID(ctx context.Context, id string) (*gtsmodel.ListEntry, db.Error) {
+	listEntry := &gtsmodel.ListEntry{}
+
+	if err := l.conn.
+		NewSelect().
+		Model(listEntry).
+		Where("? = ?", bun.Ident("list_entry.id"), id).
+		Scan(ctx); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if err := l.populateListEntry(ctx, listEntry); err != nil {
+		return nil, err
+	}
+
+	return listEntry, nil
+}
+
+func (l *listDB) GetListEntries(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ListEntry, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	entryIDs := make([]string, 0, limit)
+
+	q := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("entry")).
+		// Select only IDs from table
+		Column("entry.id").
+		// Select only entries belonging to listID.
+		Where("? = ?", bun.Ident("entry.list_id"), listID)
+
+	if maxID != "" {
+		// return only entries LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("entry.id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only entries HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("entry.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only entries HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("entry.id"), minID)
+
+		// page up
+		q = q.Order("entry.id ASC")
+	} else {
+		// page down
+		q = q.Order("entry.id DESC")
+	}
+
+	if limit > 0 {
+		// limit amount of entries returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &entryIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	// If we're paging up, we still want entries
+	// to be sorted by ID desc, so reverse ids slice.
+	// https://zchee.github.io/golang-wiki/SliceTricks/#reversing
+	if minID != "" {
+		for i, j := 0, len(entryIDs)-1; i < j; i, j = i+1, j-1 {
+			entryIDs[i], entryIDs[j] = entryIDs[j], entryIDs[i]
+		}
+	}
+
+	entries := make([]*gtsmodel.ListEntry, 0, len(entryIDs))
+	for _, id := range entryIDs {
+		// Fetch list entry from db for ID
+		entry, err := l.GetListEntryByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetListEntries: error fetching entry %q: %v", id, err)
+			continue
+		}
+
+		// Append entry to slice
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (l *listDB) GetListEntriesForFollowID(ctx context.Context, followID string) ([]*gtsmodel.ListEntry, db.Error) {
+	var entryIDs []string
+	if err := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("entry")).
+		// Select only IDs from table
+		Column("entry.id").
+		// Select only entries belonging with given followID.
+		Where("? = ?", bun.Ident("entry.follow_id"), followID).
+		Scan(ctx, &entryIDs); err != nil {
+		return nil, l.conn.ProcessError(err)
+	}
+
+	if len(entryIDs) == 0 {
+		return nil, nil
+	}
+
+	entries := make([]*gtsmodel.ListEntry, 0, len(entryIDs))
+	for _, id := range entryIDs {
+		// Fetch list entry from db for ID
+		entry, err := l.GetListEntryByID(ctx, id)
+		if err != nil {
+			log.Errorf("GetListEntriesForFollowID: error fetching entry %q: %v", id, err)
+			continue
+		}
+
+		// Append entry to slice
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (l *listDB) populateListEntry(ctx context.Context, listEntry *gtsmodel.ListEntry) db.Error {
+	if listEntry.Follow == nil {
+		// ListEntry follow is not set, fetch from the database.
+		follow := &gtsmodel.Follow{}
+		if err := l.conn.
+			NewSelect().
+			Model(follow).
+			Where("? = ?", bun.Ident("follow.id"), listEntry.FollowID).
+			Scan(ctx); err != nil {
+			return fmt.Errorf("populateListEntry: error populating listEntry follow: %w", l.conn.ProcessError(err))
+		}
+
+		// Set the follow's target account, so that
+		// callers know which account the entry is for.
+		targetAccount, err := l.state.DB.GetAccountByID(ctx, follow.TargetAccountID)
+		if err != nil {
+			return fmt.Errorf("populateListEntry: error populating listEntry follow target account: %w", err)
+		}
+		follow.TargetAccount = targetAccount
+
+		listEntry.Follow = follow
+	}
+
+	return nil
+}
+
+func (l *listDB) PutListEntries(ctx context.Context, listEntries []*gtsmodel.ListEntry) db.Error {
+	return l.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		for _, listEntry := range listEntries {
+			if _, err := tx.
+				NewInsert().
+				Model(listEntry).
+				Exec(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (l *listDB) DeleteListEntry(ctx context.Context, id string) db.Error {
+	_, err := l.conn.
+		NewDelete().
+		Table("list_entries").
+		Where("? = ?", bun.Ident("id"), id).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
+
+func (l *listDB) DeleteListEntriesForFollowID(ctx context.Context, followID string) db.Error {
+	_, err := l.conn.
+		NewDelete().
+		Table("list_entries").
+		Where("? = ?", bun.Ident("follow_id"), followID).
+		Exec(ctx)
+	return l.conn.ProcessError(err)
+}
+
+func (l *listDB) ListIncludesAccount(ctx context.Context, listID string, accountID string) (bool, db.Error) {
+	q := l.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("entry")).
+		Join(
+			"JOIN ? AS ? ON ? = ?",
+			bun.Ident("follows"), bun.Ident("follow"),
+			bun.Ident("entry.follow_id"), bun.Ident("follow.id"),
+		).
+		Where("? = ?", bun.Ident("entry.list_id"), listID).
+		Where("? = ?", bun.Ident("follow.target_account_id"), accountID)
+
+	return l.conn.Exists(ctx, q)
+}
@@ -0,0 +1,148 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ListTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ListTestSuite) TestGetListByID() {
+	testList := suite.testLists["local_account_1_list_1"]
+
+	list, err := suite.db.GetListByID(context.Background(), testList.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testList.Title, list.Title)
+	suite.Equal(testList.RepliesPolicy, list.RepliesPolicy)
+	suite.NotNil(list.Account)
+}
+
+func (suite *ListTestSuite) TestGetListsForAccountID() {
+	lists, err := suite.db.GetListsForAccountID(context.Background(), suite.testAccounts["local_account_1"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(lists, 1)
+}
+
+func (suite *ListTestSuite) TestGetListsForAccountIDNone() {
+	lists, err := suite.db.GetListsForAccountID(context.Background(), suite.testAccounts["local_account_2"].ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Empty(lists)
+}
+
+func (suite *ListTestSuite) TestGetListEntriesPaged() {
+	testList := suite.testLists["local_account_1_list_1"]
+
+	entries, err := suite.db.GetListEntries(context.Background(), testList.ID, "", "", "", 1)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(entries, 1)
+	suite.Equal("01H0G8FFM1AGQDRNGBGGX8CYJQ", entries[0].ID)
+	suite.NotNil(entries[0].Follow)
+	suite.NotNil(entries[0].Follow.TargetAccount)
+
+	entries, err = suite.db.GetListEntries(context.Background(), testList.ID, entries[0].ID, "", "", 1)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(entries, 1)
+	suite.Equal("01H0G89MWVQE0M58VD2HQYMQWH", entries[0].ID)
+}
+
+func (suite *ListTestSuite) TestListIncludesAccount() {
+	testList := suite.testLists["local_account_1_list_1"]
+
+	included, err := suite.db.ListIncludesAccount(context.Background(), testList.ID, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	suite.True(included)
+
+	included, err = suite.db.ListIncludesAccount(context.Background(), testList.ID, suite.testAccounts["remote_account_1"].ID)
+	suite.NoError(err)
+	suite.False(included)
+}
+
+func (suite *ListTestSuite) TestDeleteListByID() {
+	ctx := context.Background()
+	testList := suite.testLists["local_account_1_list_1"]
+
+	if err := suite.db.DeleteListByID(ctx, testList.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetListByID(ctx, testList.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// entries should be gone too
+	for _, entry := range suite.testListEntries {
+		_, err := suite.db.GetListEntryByID(ctx, entry.ID)
+		suite.ErrorIs(err, db.ErrNoEntries)
+	}
+}
+
+func (suite *ListTestSuite) TestDeleteListEntriesForFollowID() {
+	ctx := context.Background()
+	testEntry := suite.testListEntries["local_account_1_list_1_entry_1"]
+
+	if err := suite.db.DeleteListEntriesForFollowID(ctx, testEntry.FollowID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	entries, err := suite.db.GetListEntriesForFollowID(ctx, testEntry.FollowID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(entries)
+}
+
+func (suite *ListTestSuite) TestPutListEntriesDuplicate() {
+	testEntry := suite.testListEntries["local_account_1_list_1_entry_1"]
+
+	err := suite.db.PutListEntries(context.Background(), []*gtsmodel.ListEntry{
+		{
+			ID:       "01H0MKMQY69HWDSDR2SWGA17R4",
+			ListID:   testEntry.ListID,
+			FollowID: testEntry.FollowID,
+		},
+	})
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func TestListTestSuite(t *testing.T) {
+	suite.Run(t, new(ListTestSuite))
+}
@@ -107,7 +107,8 @@ func (m *mediaDB) GetLocalUnattachedOlderThan(ctx context.Context, olderThan tim
 		Where("? = ?", bun.Ident("media_attachment.header"), false).
 		Where("? < ?", bun.Ident("media_attachment.created_at"), olderThan).
 		Where("? IS NULL", bun.Ident("media_attachment.remote_url")).
-		Where("? IS NULL", bun.Ident("media_attachment.status_id"))
+		Where("? IS NULL", bun.Ident("media_attachment.status_id")).
+		Where("? IS NULL", bun.Ident("media_attachment.scheduled_status_id"))
 
 	if maxID != "" {
 		q = q.Where("? < ?", bun.Ident("media_attachment.id"), maxID)
@@ -21,6 +21,7 @@ package bundb
 import (
 	"context"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -43,7 +44,7 @@ func (m *mentionDB) newMentionQ(i interface{}) *bun.SelectQuery {
 }
 
 func (m *mentionDB) GetMention(ctx context.Context, id string) (*gtsmodel.Mention, db.Error) {
-	return m.state.Caches.GTS.Mention().Load("ID", func() (*gtsmodel.Mention, error) {
+	return cache.Load("mention", m.state.Caches.GTS.Mention(), "ID", func() (*gtsmodel.Mention, error) {
 		var mention gtsmodel.Mention
 
 		q := m.newMentionQ(&mention).
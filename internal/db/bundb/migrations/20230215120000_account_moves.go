@@ -0,0 +1,97 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			// Account aliases used to be (unused) single account IDs,
+			// but are now a list of ActivityPub URIs, so migrate any
+			// existing ID over to a new column as the URI it points to.
+			q := tx.NewAddColumn().Model(&gtsmodel.Account{})
+
+			// NOTE: the copy is done with a subquery rather
+			// than UPDATE ... FROM, which older SQLite lacks.
+			var copyQuery string
+			switch tx.Dialect().Name() {
+			case dialect.PG:
+				q = q.ColumnExpr("? VARCHAR[]", bun.Ident("also_known_as_uris"))
+				copyQuery = "UPDATE ? SET ? = (SELECT ARRAY[?] FROM ? AS ? WHERE ? = ?)"
+			case dialect.SQLite:
+				q = q.ColumnExpr("? VARCHAR", bun.Ident("also_known_as_uris"))
+				copyQuery = "UPDATE ? SET ? = (SELECT json_array(?) FROM ? AS ? WHERE ? = ?)"
+			default:
+				log.Panic("db dialect was neither pg nor sqlite")
+			}
+
+			if _, err := q.Exec(ctx); err != nil {
+				return err
+			}
+
+			if _, err := tx.ExecContext(ctx, copyQuery,
+				bun.Ident("accounts"),
+				bun.Ident("also_known_as_uris"),
+				bun.Ident("aka.uri"),
+				bun.Ident("accounts"),
+				bun.Ident("aka"),
+				bun.Ident("aka.id"),
+				bun.Ident("accounts.also_known_as"),
+			); err != nil {
+				return err
+			}
+
+			// Swap the new column in for the old one. SQLite has
+			// supported DROP COLUMN since 3.35, and the version
+			// built into our SQLite driver is newer than that.
+			if _, err := tx.ExecContext(ctx, "ALTER TABLE ? DROP COLUMN ?", bun.Ident("accounts"), bun.Ident("also_known_as")); err != nil {
+				return err
+			}
+
+			if _, err := tx.ExecContext(ctx, "ALTER TABLE ? RENAME COLUMN ? TO ?", bun.Ident("accounts"), bun.Ident("also_known_as_uris"), bun.Ident("also_known_as")); err != nil {
+				return err
+			}
+
+			// ActivityPub URI of the account an account has moved to.
+			if _, err := tx.ExecContext(ctx, "ALTER TABLE ? ADD COLUMN ? TEXT", bun.Ident("accounts"), bun.Ident("moved_to_uri")); err != nil {
+				return err
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
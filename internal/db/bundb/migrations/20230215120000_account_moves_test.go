@@ -0,0 +1,143 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations_test
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations"
+	oldmodel "github.com/superseriousbusiness/gotosocial/internal/db/bundb/migrations/20211113114307_init"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/sqlitedialect"
+	_ "modernc.org/sqlite"
+)
+
+type AccountMovesTestSuite struct {
+	suite.Suite
+	db *bun.DB
+}
+
+func (suite *AccountMovesTestSuite) SetupTest() {
+	sqldb, err := sql.Open("sqlite", "file::memory:")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// every connection to :memory: gets
+	// its own database, so stick to one
+	sqldb.SetMaxOpenConns(1)
+
+	suite.db = bun.NewDB(sqldb, sqlitedialect.New())
+
+	// many-to-many tables need registering, as in bundb
+	suite.db.RegisterModel(
+		&gtsmodel.AccountToEmoji{},
+		&gtsmodel.StatusToEmoji{},
+		&gtsmodel.StatusToTag{},
+	)
+}
+
+func (suite *AccountMovesTestSuite) TearDownTest() {
+	if err := suite.db.Close(); err != nil {
+		suite.FailNow(err.Error())
+	}
+}
+
+// migrateBefore runs every migration that comes before the one with the given name.
+func (suite *AccountMovesTestSuite) migrateBefore(ctx context.Context, name string) {
+	for _, migration := range migrations.Migrations.Sorted() {
+		if migration.Name >= name {
+			return
+		}
+
+		if err := migration.Up(ctx, suite.db); err != nil {
+			suite.FailNow("error running migration "+migration.Name, err.Error())
+		}
+	}
+}
+
+// migrate runs only the migration with the given name.
+func (suite *AccountMovesTestSuite) migrate(ctx context.Context, name string) {
+	for _, migration := range migrations.Migrations.Sorted() {
+		if migration.Name != name {
+			continue
+		}
+
+		if err := migration.Up(ctx, suite.db); err != nil {
+			suite.FailNow("error running migration "+migration.Name, err.Error())
+		}
+		return
+	}
+
+	suite.FailNow("no migration named " + name)
+}
+
+func (suite *AccountMovesTestSuite) TestAliasCopiedToURIs() {
+	ctx := context.Background()
+	suite.migrateBefore(ctx, "20230215120000")
+
+	// an account with an alias set to the ID of another
+	// account, as the old column used to be defined
+	for _, account := range []*oldmodel.Account{
+		{
+			ID:           "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Username:     "the_mighty_zork",
+			URI:          "http://localhost:8080/users/the_mighty_zork",
+			ActorType:    "Person",
+			PublicKeyURI: "http://localhost:8080/users/the_mighty_zork#main-key",
+			AlsoKnownAs:  "01F8MH5ZK5VRH73AKHQM6Y9VNX",
+		},
+		{
+			ID:           "01F8MH5ZK5VRH73AKHQM6Y9VNX",
+			Username:     "foss_satan",
+			Domain:       "fossbros-anonymous.io",
+			URI:          "http://fossbros-anonymous.io/users/foss_satan",
+			ActorType:    "Person",
+			PublicKeyURI: "http://fossbros-anonymous.io/users/foss_satan#main-key",
+		},
+	} {
+		if _, err := suite.db.NewInsert().Model(account).Exec(ctx); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	suite.migrate(ctx, "20230215120000")
+
+	accounts := []*gtsmodel.Account{}
+	if err := suite.db.
+		NewSelect().
+		Model(&accounts).
+		Column("id", "also_known_as").
+		Order("id").
+		Scan(ctx); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Len(accounts, 2)
+	suite.Equal([]string{"http://fossbros-anonymous.io/users/foss_satan"}, accounts[0].AlsoKnownAs)
+	suite.Empty(accounts[1].AlsoKnownAs)
+}
+
+func TestAccountMovesTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountMovesTestSuite))
+}
@@ -0,0 +1,95 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package migrations
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+func init() {
+	up := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			var queries []string
+
+			switch tx.Dialect().Name() {
+			case dialect.PG:
+				// Postgres can index the text search document of each
+				// local status directly; only local statuses have their
+				// text stored, so there's no point indexing remote ones.
+				queries = []string{
+					`CREATE INDEX IF NOT EXISTS "statuses_text_search_idx" ON "statuses" ` +
+						`USING GIN ((to_tsvector('simple', COALESCE("content_warning", '') || ' ' || COALESCE("text", '')))) ` +
+						`WHERE "local" = TRUE`,
+				}
+			case dialect.SQLite:
+				// SQLite uses an FTS5 table. FTS5 tables need an integer
+				// rowid, but statuses are keyed by ULID, and the implicit
+				// rowid of the statuses table may be changed by a VACUUM,
+				// so status IDs are mapped to stable rowids separately.
+				//
+				// Both tables are kept up to date by triggers on statuses.
+				queries = []string{
+					`CREATE TABLE IF NOT EXISTS "status_fts_ids" ("rowid" INTEGER PRIMARY KEY, "status_id" CHAR(26) NOT NULL UNIQUE)`,
+					`CREATE VIRTUAL TABLE IF NOT EXISTS "status_fts" USING fts5("content_warning", "text", tokenize = 'unicode61 remove_diacritics 2')`,
+					`CREATE TRIGGER IF NOT EXISTS "statuses_fts_insert" AFTER INSERT ON "statuses" WHEN new."local" BEGIN
+						INSERT INTO "status_fts_ids" ("status_id") VALUES (new."id");
+						INSERT INTO "status_fts" ("rowid", "content_warning", "text") VALUES (last_insert_rowid(), new."content_warning", new."text");
+					END`,
+					`CREATE TRIGGER IF NOT EXISTS "statuses_fts_update" AFTER UPDATE OF "content_warning", "text" ON "statuses" WHEN new."local" BEGIN
+						UPDATE "status_fts" SET "content_warning" = new."content_warning", "text" = new."text"
+						WHERE "rowid" = (SELECT "rowid" FROM "status_fts_ids" WHERE "status_id" = new."id");
+					END`,
+					`CREATE TRIGGER IF NOT EXISTS "statuses_fts_delete" AFTER DELETE ON "statuses" WHEN old."local" BEGIN
+						DELETE FROM "status_fts" WHERE "rowid" = (SELECT "rowid" FROM "status_fts_ids" WHERE "status_id" = old."id");
+						DELETE FROM "status_fts_ids" WHERE "status_id" = old."id";
+					END`,
+					// Index existing local statuses.
+					`INSERT INTO "status_fts_ids" ("status_id") SELECT "id" FROM "statuses" WHERE "local"`,
+					`INSERT INTO "status_fts" ("rowid", "content_warning", "text")
+					SELECT "status_fts_ids"."rowid", "statuses"."content_warning", "statuses"."text"
+					FROM "status_fts_ids" JOIN "statuses" ON "statuses"."id" = "status_fts_ids"."status_id"`,
+				}
+			default:
+				log.Panic("db dialect was neither pg nor sqlite")
+			}
+
+			for _, q := range queries {
+				if _, err := tx.ExecContext(ctx, q); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	down := func(ctx context.Context, db *bun.DB) error {
+		return db.RunInTx(ctx, nil, func(ctx context.Context, tx bun.Tx) error {
+			return nil
+		})
+	}
+
+	if err := Migrations.Register(up, down); err != nil {
+		panic(err)
+	}
+}
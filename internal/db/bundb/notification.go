@@ -21,6 +21,7 @@ package bundb
 import (
 	"context"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -34,7 +35,7 @@ type notificationDB struct {
 }
 
 func (n *notificationDB) GetNotification(ctx context.Context, id string) (*gtsmodel.Notification, db.Error) {
-	return n.state.Caches.GTS.Notification().Load("ID", func() (*gtsmodel.Notification, error) {
+	return cache.Load("notification", n.state.Caches.GTS.Notification(), "ID", func() (*gtsmodel.Notification, error) {
 		var notif gtsmodel.Notification
 
 		q := n.conn.NewSelect().
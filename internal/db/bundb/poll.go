@@ -0,0 +1,220 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type pollDB struct {
+	conn *DBConn
+}
+
+/*
+	POLL FUNCTIONS
+*/
+
+func (p *pollDB) GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, db.Error) {
+	poll := &gtsmodel.Poll{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.id"), id).
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return poll, nil
+}
+
+func (p *pollDB) GetPollByStatusID(ctx context.Context, statusID string) (*gtsmodel.Poll, db.Error) {
+	poll := &gtsmodel.Poll{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.status_id"), statusID).
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return poll, nil
+}
+
+func (p *pollDB) GetPollsExpiredBefore(ctx context.Context, t time.Time) ([]*gtsmodel.Poll, db.Error) {
+	polls := []*gtsmodel.Poll{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(&polls).
+		Where("? IS NOT NULL", bun.Ident("poll.expires_at")).
+		Where("? <= ?", bun.Ident("poll.expires_at"), t).
+		Where("? IS NULL", bun.Ident("poll.closed_at")).
+		Order("poll.expires_at ASC").
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return polls, nil
+}
+
+func (p *pollDB) PutPoll(ctx context.Context, poll *gtsmodel.Poll) db.Error {
+	_, err := p.conn.
+		NewInsert().
+		Model(poll).
+		Exec(ctx)
+	return p.conn.ProcessError(err)
+}
+
+func (p *pollDB) UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) db.Error {
+	poll.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := p.conn.
+		NewUpdate().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.id"), poll.ID).
+		Column(columns...).
+		Exec(ctx)
+	return p.conn.ProcessError(err)
+}
+
+func (p *pollDB) DeletePollByID(ctx context.Context, id string) db.Error {
+	return p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+			Where("? = ?", bun.Ident("poll_vote.poll_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("polls"), bun.Ident("poll")).
+			Where("? = ?", bun.Ident("poll.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+/*
+	POLL VOTE FUNCTIONS
+*/
+
+func (p *pollDB) GetPollVotes(ctx context.Context, pollID string) ([]*gtsmodel.PollVote, db.Error) {
+	votes := []*gtsmodel.PollVote{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(&votes).
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+		Order("poll_vote.id ASC").
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return votes, nil
+}
+
+func (p *pollDB) GetPollVoteBy(ctx context.Context, pollID string, accountID string) (*gtsmodel.PollVote, db.Error) {
+	vote := &gtsmodel.PollVote{}
+
+	if err := p.conn.
+		NewSelect().
+		Model(vote).
+		Where("? = ?", bun.Ident("poll_vote.poll_id"), pollID).
+		Where("? = ?", bun.Ident("poll_vote.account_id"), accountID).
+		Scan(ctx); err != nil {
+		return nil, p.conn.ProcessError(err)
+	}
+
+	return vote, nil
+}
+
+func (p *pollDB) PutPollVote(ctx context.Context, vote *gtsmodel.PollVote) db.Error {
+	return p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewInsert().
+			Model(vote).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return incrementPollVotes(ctx, tx, vote.PollID, vote.Choices, 1)
+	})
+}
+
+func (p *pollDB) AddPollVoteChoices(ctx context.Context, vote *gtsmodel.PollVote, choices []int) db.Error {
+	return p.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		vote.Choices = append(vote.Choices, choices...)
+		if _, err := tx.
+			NewUpdate().
+			Model(vote).
+			Where("? = ?", bun.Ident("poll_vote.id"), vote.ID).
+			Column("choices").
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		return incrementPollVotes(ctx, tx, vote.PollID, choices, 0)
+	})
+}
+
+// incrementPollVotes increments the vote counts of the given choices, and the
+// voters count by the given amount, on the poll with the given id, using the
+// given transaction. The poll is selected and updated within the transaction,
+// so that concurrent votes don't clobber each other's counts.
+func incrementPollVotes(ctx context.Context, tx bun.Tx, pollID string, choices []int, voters int) error {
+	poll := &gtsmodel.Poll{}
+	if err := tx.
+		NewSelect().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.id"), pollID).
+		Scan(ctx); err != nil {
+		return err
+	}
+
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(poll.Votes) {
+			return errors.New("incrementPollVotes: vote choice out of range")
+		}
+		poll.Votes[choice]++
+	}
+	poll.Voters += voters
+	poll.UpdatedAt = time.Now()
+
+	_, err := tx.
+		NewUpdate().
+		Model(poll).
+		Where("? = ?", bun.Ident("poll.id"), poll.ID).
+		Column("votes", "voters", "updated_at").
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,181 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *PollTestSuite) TestGetPollByID() {
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	poll, err := suite.db.GetPollByID(context.Background(), testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testPoll.StatusID, poll.StatusID)
+	suite.Equal([]string{"yes", "no"}, poll.Options)
+	suite.Equal([]int{1, 0}, poll.Votes)
+	suite.Equal(1, poll.Voters)
+	suite.False(poll.Closed())
+}
+
+func (suite *PollTestSuite) TestGetStatusWithPoll() {
+	testStatus := suite.testStatuses["local_account_2_status_7"]
+
+	status, err := suite.db.GetStatusByID(context.Background(), testStatus.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.NotNil(status.Poll)
+	suite.Equal(suite.testPolls["local_account_2_status_7_poll"].ID, status.Poll.ID)
+}
+
+func (suite *PollTestSuite) TestGetPollsExpiredBefore() {
+	ctx := context.Background()
+
+	// No fixture polls have expired yet.
+	polls, err := suite.db.GetPollsExpiredBefore(ctx, time.Now())
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(polls)
+
+	// Until the end of the century.
+	polls, err = suite.db.GetPollsExpiredBefore(ctx, testrig.TimeMustParse("2100-01-01T00:00:00Z"))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(polls, 1)
+
+	// Closed polls aren't returned.
+	poll := polls[0]
+	poll.ClosedAt = time.Now()
+	if err := suite.db.UpdatePoll(ctx, poll, "closed_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	polls, err = suite.db.GetPollsExpiredBefore(ctx, testrig.TimeMustParse("2100-01-01T00:00:00Z"))
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(polls)
+}
+
+func (suite *PollTestSuite) TestPutPollVote() {
+	ctx := context.Background()
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	vote := &gtsmodel.PollVote{
+		ID:        "01GV2AF6S8BFDZ3BKRP6QMMX8R",
+		Choices:   []int{1},
+		AccountID: suite.testAccounts["local_account_1"].ID,
+		PollID:    testPoll.ID,
+	}
+
+	if err := suite.db.PutPollVote(ctx, vote); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	poll, err := suite.db.GetPollByID(ctx, testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{1, 1}, poll.Votes)
+	suite.Equal(2, poll.Voters)
+
+	dbVote, err := suite.db.GetPollVoteBy(ctx, testPoll.ID, vote.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{1}, dbVote.Choices)
+
+	votes, err := suite.db.GetPollVotes(ctx, testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(votes, 2)
+
+	// Voting twice isn't allowed.
+	vote.ID = "01GV2AH0A2X0NVGQGDKM0Y6HKG"
+	err = suite.db.PutPollVote(ctx, vote)
+	suite.ErrorIs(err, db.ErrAlreadyExists)
+}
+
+func (suite *PollTestSuite) TestAddPollVoteChoices() {
+	ctx := context.Background()
+	testVote := suite.testPollVotes["admin_account_local_account_2_status_7_poll"]
+
+	vote, err := suite.db.GetPollVoteBy(ctx, testVote.PollID, testVote.AccountID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	if err := suite.db.AddPollVoteChoices(ctx, vote, []int{1}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	poll, err := suite.db.GetPollByID(ctx, testVote.PollID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]int{1, 1}, poll.Votes)
+	suite.Equal(1, poll.Voters)
+
+	// Out of range choices are rejected.
+	err = suite.db.AddPollVoteChoices(ctx, vote, []int{2})
+	suite.Error(err)
+}
+
+func (suite *PollTestSuite) TestDeleteStatusWithPoll() {
+	ctx := context.Background()
+	testStatus := suite.testStatuses["local_account_2_status_7"]
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+
+	if err := suite.db.DeleteStatusByID(ctx, testStatus.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err := suite.db.GetPollByID(ctx, testPoll.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	votes, err := suite.db.GetPollVotes(ctx, testPoll.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Empty(votes)
+}
+
+func TestPollTestSuite(t *testing.T) {
+	suite.Run(t, new(PollTestSuite))
+}
@@ -23,7 +23,9 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
@@ -90,7 +92,7 @@ func (r *relationshipDB) GetBlock(ctx context.Context, account1 string, account2
 }
 
 func (r *relationshipDB) getBlock(ctx context.Context, account1 string, account2 string) (*gtsmodel.Block, db.Error) {
-	return r.state.Caches.GTS.Block().Load("AccountID.TargetAccountID", func() (*gtsmodel.Block, error) {
+	return cache.Load("block", r.state.Caches.GTS.Block(), "AccountID.TargetAccountID", func() (*gtsmodel.Block, error) {
 		var block gtsmodel.Block
 
 		q := r.conn.NewSelect().Model(&block).
@@ -183,6 +185,152 @@ func (r *relationshipDB) DeleteBlocksByTargetAccountID(ctx context.Context, targ
 	return nil
 }
 
+func (r *relationshipDB) DeleteFollowByID(ctx context.Context, id string) db.Error {
+	return r.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// Take the follow out of any lists first, so
+		// that no list entry is left pointing at nothing.
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("list_entries"), bun.Ident("list_entry")).
+			Where("? = ?", bun.Ident("list_entry.follow_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+			Where("? = ?", bun.Ident("follow.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (r *relationshipDB) DeleteFollowByURI(ctx context.Context, uri string) db.Error {
+	return r.deleteFollowsWhere(ctx, "follow.uri", uri)
+}
+
+func (r *relationshipDB) DeleteFollowsByOriginAccountID(ctx context.Context, originAccountID string) db.Error {
+	return r.deleteFollowsWhere(ctx, "follow.account_id", originAccountID)
+}
+
+func (r *relationshipDB) DeleteFollowsByTargetAccountID(ctx context.Context, targetAccountID string) db.Error {
+	return r.deleteFollowsWhere(ctx, "follow.target_account_id", targetAccountID)
+}
+
+// deleteFollowsWhere deletes, by ID, every follow whose given column equals the given value.
+func (r *relationshipDB) deleteFollowsWhere(ctx context.Context, column string, value string) db.Error {
+	followIDs := []string{}
+
+	q := r.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Column("follow.id").
+		Where("? = ?", bun.Ident(column), value)
+
+	if err := q.Scan(ctx, &followIDs); err != nil {
+		return r.conn.ProcessError(err)
+	}
+
+	for _, followID := range followIDs {
+		if err := r.DeleteFollowByID(ctx, followID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *relationshipDB) GetMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, db.Error) {
+	mute := &gtsmodel.UserMute{}
+
+	if err := r.conn.
+		NewSelect().
+		Model(mute).
+		Where("? = ?", bun.Ident("user_mute.account_id"), account1).
+		Where("? = ?", bun.Ident("user_mute.target_account_id"), account2).
+		WhereGroup(" AND ", whereMuteNotExpired).
+		Scan(ctx); err != nil {
+		return nil, r.conn.ProcessError(err)
+	}
+
+	return mute, nil
+}
+
+func (r *relationshipDB) PutMute(ctx context.Context, mute *gtsmodel.UserMute) db.Error {
+	return r.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// remove any existing (possibly expired) mute of the same account
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+			Where("? = ?", bun.Ident("user_mute.account_id"), mute.AccountID).
+			Where("? = ?", bun.Ident("user_mute.target_account_id"), mute.TargetAccountID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewInsert().Model(mute).Exec(ctx)
+		return err
+	})
+}
+
+func (r *relationshipDB) DeleteMuteByID(ctx context.Context, id string) db.Error {
+	_, err := r.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		Where("? = ?", bun.Ident("user_mute.id"), id).
+		Exec(ctx)
+	return r.conn.ProcessError(err)
+}
+
+func (r *relationshipDB) DeleteMutesByAccountID(ctx context.Context, accountID string) db.Error {
+	_, err := r.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("user_mutes"), bun.Ident("user_mute")).
+		WhereOr("? = ?", bun.Ident("user_mute.account_id"), accountID).
+		WhereOr("? = ?", bun.Ident("user_mute.target_account_id"), accountID).
+		Exec(ctx)
+	return r.conn.ProcessError(err)
+}
+
+func (r *relationshipDB) GetAccountMutes(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.UserMute, db.Error) {
+	mutes := []*gtsmodel.UserMute{}
+
+	q := r.conn.
+		NewSelect().
+		Model(&mutes).
+		Relation("TargetAccount").
+		Where("? = ?", bun.Ident("user_mute.account_id"), accountID).
+		WhereGroup(" AND ", whereMuteNotExpired).
+		Order("user_mute.id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("user_mute.id"), maxID)
+	}
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("user_mute.id"), sinceID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, r.conn.ProcessError(err)
+	}
+
+	return mutes, nil
+}
+
+// whereMuteNotExpired selects only mutes
+// that have no expiry, or haven't expired yet.
+func whereMuteNotExpired(q *bun.SelectQuery) *bun.SelectQuery {
+	return q.
+		WhereOr("? IS NULL", bun.Ident("user_mute.expires_at")).
+		WhereOr("? > ?", bun.Ident("user_mute.expires_at"), time.Now())
+}
+
 func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount string, targetAccount string) (*gtsmodel.Relationship, db.Error) {
 	rel := &gtsmodel.Relationship{
 		ID: targetAccount,
@@ -252,6 +400,16 @@ func (r *relationshipDB) GetRelationship(ctx context.Context, requestingAccount
 	}
 	rel.BlockedBy = (blockT2A != nil)
 
+	// check if the requesting account is muting the target account
+	mute, err := r.GetMute(ctx, requestingAccount, targetAccount)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("GetRelationship: error checking muting: %s", err)
+	}
+	if mute != nil {
+		rel.Muting = true
+		rel.MutingNotifications = mute.Notifications != nil && *mute.Notifications
+	}
+
 	return rel, nil
 }
 
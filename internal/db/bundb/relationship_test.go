@@ -21,6 +21,7 @@ package bundb_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -220,6 +221,45 @@ func (suite *RelationshipTestSuite) TestDeleteBlocksByTargetAccountID() {
 	suite.Nil(block)
 }
 
+func (suite *RelationshipTestSuite) TestDeleteFollowByIDRemovesListEntries() {
+	ctx := context.Background()
+	follow := suite.testFollows["local_account_1_local_account_2"]
+
+	// the follow should be in a list to begin with
+	entries, err := suite.db.GetListEntriesForFollowID(ctx, follow.ID)
+	suite.NoError(err)
+	suite.NotEmpty(entries)
+
+	err = suite.db.DeleteFollowByID(ctx, follow.ID)
+	suite.NoError(err)
+
+	// follow should be gone
+	isFollowing, err := suite.db.IsFollowing(ctx, suite.testAccounts["local_account_1"], suite.testAccounts["local_account_2"])
+	suite.NoError(err)
+	suite.False(isFollowing)
+
+	// and so should its list entries
+	entries, err = suite.db.GetListEntriesForFollowID(ctx, follow.ID)
+	suite.NoError(err)
+	suite.Empty(entries)
+}
+
+func (suite *RelationshipTestSuite) TestDeleteFollowsByTargetAccountIDRemovesListEntries() {
+	ctx := context.Background()
+	follow := suite.testFollows["local_account_1_local_account_2"]
+
+	err := suite.db.DeleteFollowsByTargetAccountID(ctx, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+
+	followedBy, err := suite.db.GetAccountFollowedBy(ctx, suite.testAccounts["local_account_2"].ID, false)
+	suite.NoError(err)
+	suite.Empty(followedBy)
+
+	entries, err := suite.db.GetListEntriesForFollowID(ctx, follow.ID)
+	suite.NoError(err)
+	suite.Empty(entries)
+}
+
 func (suite *RelationshipTestSuite) TestGetRelationship() {
 	requestingAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
@@ -242,6 +282,130 @@ func (suite *RelationshipTestSuite) TestGetRelationship() {
 	suite.Empty(relationship.Note)
 }
 
+func (suite *RelationshipTestSuite) TestPutGetDeleteMute() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	account2 := suite.testAccounts["local_account_2"].ID
+	notifications := false
+
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01GSZ5J8F4B2QV7EYQJ0N3C2XW",
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Notifications:   &notifications,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	mute, err := suite.db.GetMute(ctx, account1, account2)
+	suite.NoError(err)
+	suite.Equal("01GSZ5J8F4B2QV7EYQJ0N3C2XW", mute.ID)
+	suite.False(*mute.Notifications)
+
+	// the mute should show up in the relationship
+	relationship, err := suite.db.GetRelationship(ctx, account1, account2)
+	suite.NoError(err)
+	suite.True(relationship.Muting)
+	suite.False(relationship.MutingNotifications)
+
+	// putting another mute of the same account should replace the first one
+	notifications = true
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01GSZ5N3W0QK1TBM3YJ5P9F4RS",
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Notifications:   &notifications,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	mute, err = suite.db.GetMute(ctx, account1, account2)
+	suite.NoError(err)
+	suite.Equal("01GSZ5N3W0QK1TBM3YJ5P9F4RS", mute.ID)
+	suite.True(*mute.Notifications)
+
+	// delete the mute by ID
+	err = suite.db.DeleteMuteByID(ctx, mute.ID)
+	suite.NoError(err)
+
+	// mute should be gone
+	mute, err = suite.db.GetMute(ctx, account1, account2)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(mute)
+}
+
+func (suite *RelationshipTestSuite) TestGetMuteExpired() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	account2 := suite.testAccounts["local_account_2"].ID
+	notifications := true
+
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01GSZ5J8F4B2QV7EYQJ0N3C2XW",
+		ExpiresAt:       time.Now().Add(-1 * time.Hour),
+		AccountID:       account1,
+		TargetAccountID: account2,
+		Notifications:   &notifications,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// an expired mute should not be returned
+	mute, err := suite.db.GetMute(ctx, account1, account2)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Nil(mute)
+
+	mutes, err := suite.db.GetAccountMutes(ctx, account1, "", "", 0)
+	suite.NoError(err)
+	suite.Empty(mutes)
+}
+
+func (suite *RelationshipTestSuite) TestGetAccountMutes() {
+	ctx := context.Background()
+
+	account1 := suite.testAccounts["local_account_1"].ID
+	notifications := true
+
+	for muteID, target := range map[string]string{
+		"01GSZ5J8F4B2QV7EYQJ0N3C2XW": suite.testAccounts["local_account_2"].ID,
+		"01GSZ5N3W0QK1TBM3YJ5P9F4RS": suite.testAccounts["admin_account"].ID,
+	} {
+		if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+			ID:              muteID,
+			ExpiresAt:       time.Now().Add(time.Hour),
+			AccountID:       account1,
+			TargetAccountID: target,
+			Notifications:   &notifications,
+		}); err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	mutes, err := suite.db.GetAccountMutes(ctx, account1, "", "", 0)
+	suite.NoError(err)
+	suite.Len(mutes, 2)
+
+	// newest mute first
+	suite.Equal("01GSZ5N3W0QK1TBM3YJ5P9F4RS", mutes[0].ID)
+	suite.NotNil(mutes[0].TargetAccount)
+
+	// page down
+	mutes, err = suite.db.GetAccountMutes(ctx, account1, "01GSZ5N3W0QK1TBM3YJ5P9F4RS", "", 0)
+	suite.NoError(err)
+	suite.Len(mutes, 1)
+	suite.Equal("01GSZ5J8F4B2QV7EYQJ0N3C2XW", mutes[0].ID)
+
+	// deleting mutes targeting an account removes them
+	err = suite.db.DeleteMutesByAccountID(ctx, suite.testAccounts["admin_account"].ID)
+	suite.NoError(err)
+
+	mutes, err = suite.db.GetAccountMutes(ctx, account1, "", "", 0)
+	suite.NoError(err)
+	suite.Len(mutes, 1)
+}
+
 func (suite *RelationshipTestSuite) TestIsFollowingYes() {
 	requestingAccount := suite.testAccounts["local_account_1"]
 	targetAccount := suite.testAccounts["admin_account"]
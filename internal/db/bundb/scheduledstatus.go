@@ -0,0 +1,244 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/uptrace/bun"
+)
+
+type scheduledStatusDB struct {
+	conn *DBConn
+}
+
+func (s *scheduledStatusDB) populateAttachments(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) db.Error {
+	if len(scheduledStatus.AttachmentIDs) == 0 {
+		return nil
+	}
+
+	attachments := []*gtsmodel.MediaAttachment{}
+	if err := s.conn.
+		NewSelect().
+		Model(&attachments).
+		Where("? IN (?)", bun.Ident("media_attachment.id"), bun.In(scheduledStatus.AttachmentIDs)).
+		Scan(ctx); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	// keep the attachments in the order they were given
+	scheduledStatus.Attachments = make([]*gtsmodel.MediaAttachment, 0, len(attachments))
+	for _, id := range scheduledStatus.AttachmentIDs {
+		for _, a := range attachments {
+			if a.ID == id {
+				scheduledStatus.Attachments = append(scheduledStatus.Attachments, a)
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, db.Error) {
+	scheduledStatus := &gtsmodel.ScheduledStatus{}
+
+	if err := s.conn.
+		NewSelect().
+		Model(scheduledStatus).
+		Where("? = ?", bun.Ident("scheduled_status.id"), id).
+		Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	if err := s.populateAttachments(ctx, scheduledStatus); err != nil {
+		return nil, err
+	}
+
+	return scheduledStatus, nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusesForAccountID(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ScheduledStatus, db.Error) {
+	scheduledStatuses := []*gtsmodel.ScheduledStatus{}
+
+	q := s.conn.
+		NewSelect().
+		Model(&scheduledStatuses).
+		Where("? = ?", bun.Ident("scheduled_status.account_id"), accountID)
+
+	if maxID != "" {
+		// return only scheduled statuses LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("scheduled_status.id"), maxID)
+	}
+
+	if sinceID != "" {
+		// return only scheduled statuses HIGHER (ie., newer) than sinceID
+		q = q.Where("? > ?", bun.Ident("scheduled_status.id"), sinceID)
+	}
+
+	if minID != "" {
+		// return only scheduled statuses HIGHER (ie., newer) than minID,
+		// starting from the oldest, so we page up from minID
+		q = q.
+			Where("? > ?", bun.Ident("scheduled_status.id"), minID).
+			Order("scheduled_status.id ASC")
+	} else {
+		q = q.Order("scheduled_status.id DESC")
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	if minID != "" {
+		// we selected oldest first, so reverse
+		// the slice to return newest first
+		for i, j := 0, len(scheduledStatuses)-1; i < j; i, j = i+1, j-1 {
+			scheduledStatuses[i], scheduledStatuses[j] = scheduledStatuses[j], scheduledStatuses[i]
+		}
+	}
+
+	for _, scheduledStatus := range scheduledStatuses {
+		if err := s.populateAttachments(ctx, scheduledStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	return scheduledStatuses, nil
+}
+
+func (s *scheduledStatusDB) GetScheduledStatusesDueBefore(ctx context.Context, t time.Time) ([]*gtsmodel.ScheduledStatus, db.Error) {
+	scheduledStatuses := []*gtsmodel.ScheduledStatus{}
+
+	if err := s.conn.
+		NewSelect().
+		Model(&scheduledStatuses).
+		Where("? <= ?", bun.Ident("scheduled_status.scheduled_at"), t).
+		Order("scheduled_status.scheduled_at ASC").
+		Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	for _, scheduledStatus := range scheduledStatuses {
+		if err := s.populateAttachments(ctx, scheduledStatus); err != nil {
+			return nil, err
+		}
+	}
+
+	return scheduledStatuses, nil
+}
+
+func (s *scheduledStatusDB) CountScheduledStatusesForAccountID(ctx context.Context, accountID string, from time.Time, to time.Time) (int, db.Error) {
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("scheduled_statuses"), bun.Ident("scheduled_status")).
+		Where("? = ?", bun.Ident("scheduled_status.account_id"), accountID)
+
+	if !from.IsZero() && !to.IsZero() {
+		q = q.
+			Where("? >= ?", bun.Ident("scheduled_status.scheduled_at"), from).
+			Where("? < ?", bun.Ident("scheduled_status.scheduled_at"), to)
+	}
+
+	count, err := q.Count(ctx)
+	if err != nil {
+		return 0, s.conn.ProcessError(err)
+	}
+
+	return count, nil
+}
+
+func (s *scheduledStatusDB) PutScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) db.Error {
+	return s.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		if _, err := tx.
+			NewInsert().
+			Model(scheduledStatus).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if len(scheduledStatus.AttachmentIDs) == 0 {
+			return nil
+		}
+
+		// mark the attachments as belonging to this scheduled status
+		_, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+			Set("? = ?", bun.Ident("scheduled_status_id"), scheduledStatus.ID).
+			Set("? = ?", bun.Ident("updated_at"), time.Now()).
+			Where("? IN (?)", bun.Ident("media_attachment.id"), bun.In(scheduledStatus.AttachmentIDs)).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (s *scheduledStatusDB) UpdateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, columns ...string) db.Error {
+	scheduledStatus.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := s.conn.
+		NewUpdate().
+		Model(scheduledStatus).
+		Where("? = ?", bun.Ident("scheduled_status.id"), scheduledStatus.ID).
+		Column(columns...).
+		Exec(ctx)
+	return s.conn.ProcessError(err)
+}
+
+func (s *scheduledStatusDB) DeleteScheduledStatusByID(ctx context.Context, id string) db.Error {
+	return s.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// release any attachments belonging to this scheduled status
+		if _, err := tx.
+			NewUpdate().
+			TableExpr("? AS ?", bun.Ident("media_attachments"), bun.Ident("media_attachment")).
+			Set("? = NULL", bun.Ident("scheduled_status_id")).
+			Set("? = ?", bun.Ident("updated_at"), time.Now()).
+			Where("? = ?", bun.Ident("media_attachment.scheduled_status_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("scheduled_statuses"), bun.Ident("scheduled_status")).
+			Where("? = ?", bun.Ident("scheduled_status.id"), id).
+			Exec(ctx)
+		return err
+	})
+}
+
+func (s *scheduledStatusDB) DeleteScheduledStatusesByAccountID(ctx context.Context, accountID string) db.Error {
+	_, err := s.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("scheduled_statuses"), bun.Ident("scheduled_status")).
+		Where("? = ?", bun.Ident("scheduled_status.account_id"), accountID).
+		Exec(ctx)
+	return s.conn.ProcessError(err)
+}
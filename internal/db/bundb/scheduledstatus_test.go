@@ -0,0 +1,135 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ScheduledStatusTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatusByID() {
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(context.Background(), testScheduledStatus.ID)
+	suite.NoError(err)
+	suite.Equal(testScheduledStatus.AccountID, dbScheduledStatus.AccountID)
+	suite.Equal("hello from the future!", dbScheduledStatus.Text)
+	suite.Equal(gtsmodel.VisibilityPublic, dbScheduledStatus.Visibility)
+	suite.Empty(dbScheduledStatus.Attachments)
+}
+
+func (suite *ScheduledStatusTestSuite) TestGetScheduledStatusesForAccountID() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	scheduledStatuses, err := suite.db.GetScheduledStatusesForAccountID(ctx, testAccount.ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(scheduledStatuses, 1)
+
+	// nothing older than the only scheduled status
+	scheduledStatuses, err = suite.db.GetScheduledStatusesForAccountID(ctx, testAccount.ID, scheduledStatuses[0].ID, "", "", 0)
+	suite.NoError(err)
+	suite.Empty(scheduledStatuses)
+
+	// other accounts have nothing scheduled
+	scheduledStatuses, err = suite.db.GetScheduledStatusesForAccountID(ctx, suite.testAccounts["local_account_2"].ID, "", "", "", 0)
+	suite.NoError(err)
+	suite.Empty(scheduledStatuses)
+}
+
+func (suite *ScheduledStatusTestSuite) TestPutDeleteScheduledStatusWithAttachment() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	scheduledStatus := &gtsmodel.ScheduledStatus{
+		ID:            "01GSKZ2QW9DK3ZJMDE7HJ1TZQE",
+		AccountID:     testAccount.ID,
+		ScheduledAt:   time.Now().Add(-1 * time.Minute),
+		Text:          "look at this!",
+		Visibility:    gtsmodel.VisibilityUnlocked,
+		AttachmentIDs: []string{testAttachment.ID},
+		ApplicationID: suite.testApplications["application_1"].ID,
+	}
+	suite.NoError(suite.db.PutScheduledStatus(ctx, scheduledStatus))
+
+	// the attachment should now belong to the scheduled status
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Equal(scheduledStatus.ID, dbAttachment.ScheduledStatusID)
+
+	// so it shouldn't be considered unattached any more
+	unattached, err := suite.db.GetLocalUnattachedOlderThan(ctx, time.Now(), "", 0)
+	suite.NoError(err)
+	for _, a := range unattached {
+		suite.NotEqual(testAttachment.ID, a.ID)
+	}
+
+	dbScheduledStatus, err := suite.db.GetScheduledStatusByID(ctx, scheduledStatus.ID)
+	suite.NoError(err)
+	suite.Len(dbScheduledStatus.Attachments, 1)
+	suite.Equal(testAttachment.ID, dbScheduledStatus.Attachments[0].ID)
+
+	// it's due, the fixture one isn't
+	due, err := suite.db.GetScheduledStatusesDueBefore(ctx, time.Now())
+	suite.NoError(err)
+	suite.Len(due, 1)
+	suite.Equal(scheduledStatus.ID, due[0].ID)
+
+	count, err := suite.db.CountScheduledStatusesForAccountID(ctx, testAccount.ID, time.Time{}, time.Time{})
+	suite.NoError(err)
+	suite.Equal(2, count)
+
+	suite.NoError(suite.db.DeleteScheduledStatusByID(ctx, scheduledStatus.ID))
+
+	_, err = suite.db.GetScheduledStatusByID(ctx, scheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// the attachment should have been released
+	dbAttachment, err = suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+}
+
+func (suite *ScheduledStatusTestSuite) TestCountScheduledStatusesBetween() {
+	ctx := context.Background()
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+
+	count, err := suite.db.CountScheduledStatusesForAccountID(ctx, testScheduledStatus.AccountID, testScheduledStatus.ScheduledAt.Add(-1*time.Hour), testScheduledStatus.ScheduledAt.Add(time.Hour))
+	suite.NoError(err)
+	suite.Equal(1, count)
+
+	count, err = suite.db.CountScheduledStatusesForAccountID(ctx, testScheduledStatus.AccountID, testrig.TimeMustParse("2022-01-01T00:00:00Z"), testrig.TimeMustParse("2022-01-02T00:00:00Z"))
+	suite.NoError(err)
+	suite.Zero(count)
+}
+
+func TestScheduledStatusTestSuite(t *testing.T) {
+	suite.Run(t, new(ScheduledStatusTestSuite))
+}
@@ -0,0 +1,294 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"strings"
+	"unicode"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/state"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect"
+)
+
+// statusTSVector is the postgres text search document for a status.
+// It must match the expression of the statuses_text_search_idx index
+// exactly, or the index won't be used by the query planner.
+const statusTSVector = "to_tsvector('simple', COALESCE(?, '') || ' ' || COALESCE(?, ''))"
+
+type searchDB struct {
+	conn  *DBConn
+	state *state.State
+}
+
+func (s *searchDB) SearchForStatuses(ctx context.Context, query string, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Make educated guess for slice size
+	statusIDs := make([]string, 0, limit)
+
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+		// Select only IDs from table
+		Column("status.id").
+		// Only local statuses are indexed
+		Where("? = ?", bun.Ident("status.local"), true).
+		// Boosts carry the text of the boosted status, leave them out
+		WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id")).
+		// Sort by highest ID (newest) to lowest ID (oldest)
+		Order("status.id DESC")
+
+	switch s.conn.Dialect().Name() {
+	case dialect.SQLite:
+		match := ftsMatchExpression(query)
+		if match == "" {
+			// nothing searchable in the query
+			return []*gtsmodel.Status{}, nil
+		}
+
+		q = q.
+			Join("JOIN ? AS ? ON ? = ?",
+				bun.Ident("status_fts_ids"),
+				bun.Ident("status_fts_id"),
+				bun.Ident("status_fts_id.status_id"),
+				bun.Ident("status.id")).
+			Join("JOIN ? ON ? = ?",
+				bun.Ident("status_fts"),
+				bun.Ident("status_fts.rowid"),
+				bun.Ident("status_fts_id.rowid")).
+			Where("? MATCH ?", bun.Ident("status_fts"), match)
+	case dialect.PG:
+		q = q.Where(statusTSVector+" @@ plainto_tsquery('simple', ?)",
+			bun.Ident("status.content_warning"),
+			bun.Ident("status.text"),
+			query)
+	default:
+		panic("db conn was neither pg not sqlite")
+	}
+
+	if accountID != "" {
+		// return only statuses authored by accountID
+		q = q.Where("? = ?", bun.Ident("status.account_id"), accountID)
+	}
+
+	if maxID != "" {
+		// return only statuses LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("status.id"), maxID)
+	}
+
+	if minID != "" {
+		// return only statuses HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("status.id"), minID)
+	}
+
+	if limit > 0 {
+		// limit amount of statuses returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &statusIDs); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	statuses := make([]*gtsmodel.Status, 0, len(statusIDs))
+
+	for _, id := range statusIDs {
+		// Fetch status from db for ID
+		status, err := s.state.DB.GetStatusByID(ctx, id)
+		if err != nil {
+			log.Errorf("SearchForStatuses: error fetching status %q: %v", id, err)
+			continue
+		}
+
+		// Append status to slice
+		statuses = append(statuses, status)
+	}
+
+	return statuses, nil
+}
+
+func (s *searchDB) SearchForAccounts(ctx context.Context, accountID string, query string, following bool, offset int, limit int) ([]*gtsmodel.Account, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	// Split a query like "user@domain" into its parts
+	query = strings.ToLower(strings.TrimPrefix(query, "@"))
+	username, domain, hasDomain := strings.Cut(query, "@")
+
+	// Make educated guess for slice size
+	accountIDs := make([]string, 0, limit)
+
+	// Select IDs of accounts that accountID follows.
+	followedAccounts := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("follows"), bun.Ident("follow")).
+		Column("follow.target_account_id").
+		Where("? = ?", bun.Ident("follow.account_id"), accountID)
+
+	q := s.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("accounts"), bun.Ident("account")).
+		// Select only IDs from table
+		Column("account.id").
+		Where("? IS NULL", bun.Ident("account.suspended_at")).
+		// Leave out the instance account; usernames can't
+		// otherwise contain the characters of a hostname.
+		Where("? != ?", bun.Ident("account.username"), config.GetHost()).
+		// Match username or any word of the display name
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.username"), likePrefix(username), likeEscape).
+				WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.display_name"), likePrefix(username), likeEscape).
+				WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.display_name"), "% "+likePrefix(username), likeEscape)
+		}).
+		// Exact username matches first, then
+		// sort alphabetically by username
+		OrderExpr("CASE WHEN LOWER(?) = ? THEN 0 ELSE 1 END", bun.Ident("account.username"), username).
+		Order("account.username ASC", "account.id ASC")
+
+	if hasDomain {
+		// Local accounts have no domain
+		// stored, but they can still match
+		// on this instance's account domain.
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			q = q.WhereOr("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("account.domain"), likePrefix(domain), likeEscape)
+			if strings.HasPrefix(strings.ToLower(config.GetAccountDomain()), domain) {
+				q = q.WhereOr("? IS NULL", bun.Ident("account.domain"))
+			}
+			return q
+		})
+	}
+
+	if following {
+		// return only accounts that accountID follows
+		q = q.Where("? IN (?)", bun.Ident("account.id"), followedAccounts)
+	} else {
+		// return discoverable accounts, or
+		// accounts that accountID follows
+		q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				WhereOr("? = ?", bun.Ident("account.discoverable"), true).
+				WhereOr("? IN (?)", bun.Ident("account.id"), followedAccounts)
+		})
+	}
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if limit > 0 {
+		// limit amount of accounts returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &accountIDs); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	accounts := make([]*gtsmodel.Account, 0, len(accountIDs))
+
+	for _, id := range accountIDs {
+		// Fetch account from db for ID
+		account, err := s.state.DB.GetAccountByID(ctx, id)
+		if err != nil {
+			log.Errorf("SearchForAccounts: error fetching account %q: %v", id, err)
+			continue
+		}
+
+		// Append account to slice
+		accounts = append(accounts, account)
+	}
+
+	return accounts, nil
+}
+
+func (s *searchDB) SearchForTags(ctx context.Context, query string, offset int, limit int) ([]*gtsmodel.Tag, db.Error) {
+	// Ensure reasonable
+	if limit < 0 {
+		limit = 0
+	}
+
+	query = strings.ToLower(query)
+	tags := make([]*gtsmodel.Tag, 0, limit)
+
+	q := s.conn.
+		NewSelect().
+		Model(&tags).
+		Where("? = ?", bun.Ident("tag.listable"), true).
+		Where("LOWER(?) LIKE ? ESCAPE ?", bun.Ident("tag.name"), likePrefix(query), likeEscape).
+		// Exact match first, then
+		// most recently used tags
+		OrderExpr("CASE WHEN LOWER(?) = ? THEN 0 ELSE 1 END", bun.Ident("tag.name"), query).
+		Order("tag.last_status_at DESC", "tag.id ASC")
+
+	if offset > 0 {
+		q = q.Offset(offset)
+	}
+
+	if limit > 0 {
+		// limit amount of tags returned
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	return tags, nil
+}
+
+// likeEscape is the escape character
+// used in patterns built by likePrefix.
+const likeEscape = `\`
+
+// likePrefix returns a LIKE pattern matching anything starting with
+// the given string, escaping any LIKE wildcards within the string.
+func likePrefix(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+	return s + "%"
+}
+
+// ftsMatchExpression converts a user-provided search query into
+// an sqlite FTS5 match expression, by splitting it into words and
+// quoting each one, so that nothing in the query can be interpreted
+// as FTS5 query syntax. Words are implicitly ANDed together.
+//
+// An empty string is returned if the query contains no words.
+func ftsMatchExpression(query string) string {
+	words := strings.FieldsFunc(query, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	for i, word := range words {
+		words[i] = `"` + word + `"`
+	}
+
+	return strings.Join(words, " ")
+}
@@ -0,0 +1,222 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type SearchTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func statusIDs(statuses []*gtsmodel.Status) []string {
+	ids := make([]string, 0, len(statuses))
+	for _, status := range statuses {
+		ids = append(ids, status.ID)
+	}
+	return ids
+}
+
+func (suite *SearchTestSuite) TestSearchForStatuses() {
+	statuses, err := suite.db.SearchForStatuses(context.Background(), "hello", "", "", "", 10)
+	suite.NoError(err)
+
+	// the admin's boost of "hello everyone!" should not be included
+	suite.Equal([]string{
+		"01F8MHAMCHF6Y650WCRSCP4WMY",
+		"01F8MH75CBF9JFX4ZAD54N0W0R",
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesContentWarning() {
+	statuses, err := suite.db.SearchForStatuses(context.Background(), "Introduction", "", "", "", 10)
+	suite.NoError(err)
+	suite.Equal([]string{
+		"01F8MHBQCBTDKN6X5VHGMMN4MA",
+		"01F8MHAMCHF6Y650WCRSCP4WMY",
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesAllWords() {
+	statuses, err := suite.db.SearchForStatuses(context.Background(), "hi zork", "", "", "", 10)
+	suite.NoError(err)
+	suite.Equal([]string{
+		"01FN3VJGFH10KR7S2PB0GFJZYG",
+		"01FF25D5Q0DH7CHD57CTRS6WK0",
+		"01FCQSQ667XHJ9AV9T27SJJSX5",
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesByAccount() {
+	statuses, err := suite.db.SearchForStatuses(context.Background(), "introduction", suite.testAccounts["local_account_2"].ID, "", "", 10)
+	suite.NoError(err)
+	suite.Equal([]string{"01F8MHBQCBTDKN6X5VHGMMN4MA"}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesPaging() {
+	statuses, err := suite.db.SearchForStatuses(context.Background(), "hi", "", "", "", 2)
+	suite.NoError(err)
+	suite.Equal([]string{
+		"01G20ZM733MGN8J344T4ZDDFY1",
+		"01FN3VJGFH10KR7S2PB0GFJZYG",
+	}, statusIDs(statuses))
+
+	statuses, err = suite.db.SearchForStatuses(context.Background(), "hi", "", "01FN3VJGFH10KR7S2PB0GFJZYG", "", 2)
+	suite.NoError(err)
+	suite.Equal([]string{
+		"01FF25D5Q0DH7CHD57CTRS6WK0",
+		"01FCTA44PW9H1TB328S9AQXKDS",
+	}, statusIDs(statuses))
+
+	statuses, err = suite.db.SearchForStatuses(context.Background(), "hi", "", "", "01FF25D5Q0DH7CHD57CTRS6WK0", 10)
+	suite.NoError(err)
+	suite.Equal([]string{
+		"01G20ZM733MGN8J344T4ZDDFY1",
+		"01FN3VJGFH10KR7S2PB0GFJZYG",
+	}, statusIDs(statuses))
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesQuerySyntax() {
+	// none of this should be interpreted as fts query syntax
+	statuses, err := suite.db.SearchForStatuses(context.Background(), `"hello" OR NEAR( text:* -`, "", "", "", 10)
+	suite.NoError(err)
+	suite.Empty(statuses)
+
+	statuses, err = suite.db.SearchForStatuses(context.Background(), `"#@!`, "", "", "", 10)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesDiacritics() {
+	statuses, err := suite.db.SearchForStatuses(context.Background(), "hëllo", "", "", "", 10)
+	suite.NoError(err)
+	suite.Len(statuses, 2)
+}
+
+func (suite *SearchTestSuite) TestSearchForStatusesUpdateDelete() {
+	ctx := context.Background()
+
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.Text = "goodbye everyone!"
+	suite.NoError(suite.db.UpdateStatus(ctx, status))
+
+	statuses, err := suite.db.SearchForStatuses(ctx, "goodbye", "", "", "", 10)
+	suite.NoError(err)
+	suite.Equal([]string{status.ID}, statusIDs(statuses))
+
+	statuses, err = suite.db.SearchForStatuses(ctx, "hello", "", "", "", 10)
+	suite.NoError(err)
+	suite.Equal([]string{"01F8MH75CBF9JFX4ZAD54N0W0R"}, statusIDs(statuses))
+
+	suite.NoError(suite.db.DeleteStatusByID(ctx, status.ID))
+
+	statuses, err = suite.db.SearchForStatuses(ctx, "goodbye", "", "", "", 10)
+	suite.NoError(err)
+	suite.Empty(statuses)
+}
+
+func accountUsernames(accounts []*gtsmodel.Account) []string {
+	usernames := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		usernames = append(usernames, account.Username)
+	}
+	return usernames
+}
+
+func (suite *SearchTestSuite) TestSearchForAccounts() {
+	// matches both username and display name, ignoring case
+	accounts, err := suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "SOME", false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"Some_User"}, accountUsernames(accounts))
+
+	// matches any word of the display name
+	accounts, err = suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "gera", false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"foss_satan"}, accountUsernames(accounts))
+}
+
+func (suite *SearchTestSuite) TestSearchForAccountsDiscoverable() {
+	// 1happyturtle isn't discoverable, but zork follows them
+	accounts, err := suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "turtle", false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"1happyturtle"}, accountUsernames(accounts))
+
+	// admin doesn't follow them
+	accounts, err = suite.db.SearchForAccounts(context.Background(), suite.testAccounts["admin_account"].ID, "turtle", false, 0, 10)
+	suite.NoError(err)
+	suite.Empty(accounts)
+}
+
+func (suite *SearchTestSuite) TestSearchForAccountsFollowing() {
+	accounts, err := suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "", true, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"1happyturtle", "admin"}, accountUsernames(accounts))
+}
+
+func (suite *SearchTestSuite) TestSearchForAccountsWithDomain() {
+	accounts, err := suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "@foss@fossbros", false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"foss_satan"}, accountUsernames(accounts))
+
+	// local accounts match this instance's domain
+	accounts, err = suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "the_mighty@localhost", false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"the_mighty_zork"}, accountUsernames(accounts))
+
+	accounts, err = suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "the_mighty@example.org", false, 0, 10)
+	suite.NoError(err)
+	suite.Empty(accounts)
+}
+
+func (suite *SearchTestSuite) TestSearchForAccountsEscape() {
+	// wildcards should be matched literally
+	accounts, err := suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "%user", false, 0, 10)
+	suite.NoError(err)
+	suite.Empty(accounts)
+
+	accounts, err = suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "her_", false, 0, 10)
+	suite.NoError(err)
+	suite.Equal([]string{"her_fuckin_maj"}, accountUsernames(accounts))
+
+	accounts, err = suite.db.SearchForAccounts(context.Background(), suite.testAccounts["local_account_1"].ID, "the_mighty_zorkk", false, 0, 10)
+	suite.NoError(err)
+	suite.Empty(accounts)
+}
+
+func (suite *SearchTestSuite) TestSearchForTags() {
+	tags, err := suite.db.SearchForTags(context.Background(), "WEL", 0, 10)
+	suite.NoError(err)
+	if suite.Len(tags, 1) {
+		suite.Equal("welcome", tags[0].Name)
+	}
+
+	tags, err = suite.db.SearchForTags(context.Background(), "%", 0, 10)
+	suite.NoError(err)
+	suite.Empty(tags)
+}
+
+func TestSearchTestSuite(t *testing.T) {
+	suite.Run(t, new(SearchTestSuite))
+}
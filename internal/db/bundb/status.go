@@ -26,6 +26,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
@@ -109,7 +110,7 @@ func (s *statusDB) GetStatusByURL(ctx context.Context, url string) (*gtsmodel.St
 
 func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*gtsmodel.Status) error, keyParts ...any) (*gtsmodel.Status, db.Error) {
 	// Fetch status from database cache with loader callback
-	status, err := s.state.Caches.GTS.Status().Load(lookup, func() (*gtsmodel.Status, error) {
+	status, err := cache.Load("status", s.state.Caches.GTS.Status(), lookup, func() (*gtsmodel.Status, error) {
 		var status gtsmodel.Status
 
 		// Not cached! Perform database query
@@ -176,6 +177,14 @@ func (s *statusDB) getStatus(ctx context.Context, lookup string, dbQuery func(*g
 		}
 	}
 
+	if id := status.PollID; id != "" {
+		// Fetch status poll
+		status.Poll, err = s.state.DB.GetPollByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("error getting status poll: %w", err)
+		}
+	}
+
 	if len(status.MentionIDs) > 0 {
 		// Fetch status mentions
 		status.Mentions, err = s.state.DB.GetMentions(ctx, status.MentionIDs)
@@ -239,6 +248,18 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) db.Er
 				}
 			}
 
+			// insert any poll attached to this status
+			if status.Poll != nil {
+				status.Poll.StatusID = status.ID
+				status.PollID = status.Poll.ID
+				if _, err := tx.
+					NewInsert().
+					Model(status.Poll).
+					Exec(ctx); err != nil {
+					return err
+				}
+			}
+
 			// Finally, insert the status
 			_, err := tx.NewInsert().Model(status).Exec(ctx)
 			return err
@@ -248,66 +269,107 @@ func (s *statusDB) PutStatus(ctx context.Context, status *gtsmodel.Status) db.Er
 
 func (s *statusDB) UpdateStatus(ctx context.Context, status *gtsmodel.Status) db.Error {
 	if err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
-		// create links between this status and any emojis it uses
-		for _, i := range status.EmojiIDs {
-			if _, err := tx.
-				NewInsert().
-				Model(&gtsmodel.StatusToEmoji{
-					StatusID: status.ID,
-					EmojiID:  i,
-				}).Exec(ctx); err != nil {
-				err = s.conn.ProcessError(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
-				}
-			}
+		return s.updateStatus(ctx, tx, status)
+	}); err != nil {
+		return err
+	}
+
+	// Drop any old value from cache by this ID
+	s.state.Caches.GTS.Status().Invalidate("ID", status.ID)
+	return nil
+}
+
+func (s *statusDB) EditStatus(ctx context.Context, status *gtsmodel.Status, edit *gtsmodel.StatusEdit) db.Error {
+	if err := s.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// store the previous revision of the status
+		if _, err := tx.
+			NewInsert().
+			Model(edit).
+			Exec(ctx); err != nil {
+			return err
 		}
 
-		// create links between this status and any tags it uses
-		for _, i := range status.TagIDs {
-			if _, err := tx.
-				NewInsert().
-				Model(&gtsmodel.StatusToTag{
-					StatusID: status.ID,
-					TagID:    i,
-				}).Exec(ctx); err != nil {
-				err = s.conn.ProcessError(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
-				}
+		return s.updateStatus(ctx, tx, status)
+	}); err != nil {
+		return s.conn.ProcessError(err)
+	}
+
+	// Drop any old value from cache by this ID
+	s.state.Caches.GTS.Status().Invalidate("ID", status.ID)
+	return nil
+}
+
+// updateStatus updates the given status in the database using the given
+// transaction, including links between the status and its emojis, tags + media.
+func (s *statusDB) updateStatus(ctx context.Context, tx bun.Tx, status *gtsmodel.Status) error {
+	// create links between this status and any emojis it uses
+	for _, i := range status.EmojiIDs {
+		if _, err := tx.
+			NewInsert().
+			Model(&gtsmodel.StatusToEmoji{
+				StatusID: status.ID,
+				EmojiID:  i,
+			}).Exec(ctx); err != nil {
+			err = s.conn.ProcessError(err)
+			if !errors.Is(err, db.ErrAlreadyExists) {
+				return err
 			}
 		}
+	}
 
-		// change the status ID of the media attachments to the new status
-		for _, a := range status.Attachments {
-			a.StatusID = status.ID
-			a.UpdatedAt = time.Now()
-			if _, err := tx.
-				NewUpdate().
-				Model(a).
-				Where("? = ?", bun.Ident("media_attachment.id"), a.ID).
-				Exec(ctx); err != nil {
-				err = s.conn.ProcessError(err)
-				if !errors.Is(err, db.ErrAlreadyExists) {
-					return err
-				}
+	// create links between this status and any tags it uses
+	for _, i := range status.TagIDs {
+		if _, err := tx.
+			NewInsert().
+			Model(&gtsmodel.StatusToTag{
+				StatusID: status.ID,
+				TagID:    i,
+			}).Exec(ctx); err != nil {
+			err = s.conn.ProcessError(err)
+			if !errors.Is(err, db.ErrAlreadyExists) {
+				return err
 			}
 		}
+	}
 
-		// Finally, insert the status
-		_, err := tx.
+	// change the status ID of the media attachments to the new status
+	for _, a := range status.Attachments {
+		a.StatusID = status.ID
+		a.UpdatedAt = time.Now()
+		if _, err := tx.
 			NewUpdate().
-			Model(status).
-			Where("? = ?", bun.Ident("status.id"), status.ID).
-			Exec(ctx)
-		return err
-	}); err != nil {
-		return err
+			Model(a).
+			Where("? = ?", bun.Ident("media_attachment.id"), a.ID).
+			Exec(ctx); err != nil {
+			err = s.conn.ProcessError(err)
+			if !errors.Is(err, db.ErrAlreadyExists) {
+				return err
+			}
+		}
 	}
 
-	// Drop any old value from cache by this ID
-	s.state.Caches.GTS.Status().Invalidate("ID", status.ID)
-	return nil
+	// Finally, update the status
+	_, err := tx.
+		NewUpdate().
+		Model(status).
+		Where("? = ?", bun.Ident("status.id"), status.ID).
+		Exec(ctx)
+	return err
+}
+
+func (s *statusDB) GetStatusEdits(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, db.Error) {
+	edits := []*gtsmodel.StatusEdit{}
+
+	if err := s.conn.
+		NewSelect().
+		Model(&edits).
+		Where("? = ?", bun.Ident("status_edit.status_id"), statusID).
+		Order("status_edit.created_at ASC", "status_edit.id ASC").
+		Scan(ctx); err != nil {
+		return nil, s.conn.ProcessError(err)
+	}
+
+	return edits, nil
 }
 
 func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) db.Error {
@@ -330,6 +392,36 @@ func (s *statusDB) DeleteStatusByID(ctx context.Context, id string) db.Error {
 			return err
 		}
 
+		// delete any poll attached to this status, and its votes
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("poll_votes"), bun.Ident("poll_vote")).
+			Where("? IN (?)", bun.Ident("poll_vote.poll_id"), tx.
+				NewSelect().
+				TableExpr("? AS ?", bun.Ident("polls"), bun.Ident("poll")).
+				Column("poll.id").
+				Where("? = ?", bun.Ident("poll.status_id"), id)).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("polls"), bun.Ident("poll")).
+			Where("? = ?", bun.Ident("poll.status_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		// delete any previous revisions of this status
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("status_edits"), bun.Ident("status_edit")).
+			Where("? = ?", bun.Ident("status_edit.status_id"), id).
+			Exec(ctx); err != nil {
+			return err
+		}
+
 		// delete the status itself
 		if _, err := tx.
 			NewDelete().
@@ -491,15 +583,71 @@ func (s *statusDB) IsStatusRebloggedBy(ctx context.Context, status *gtsmodel.Sta
 }
 
 func (s *statusDB) IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, db.Error) {
+	statusIDs, err := s.statusAndAncestorIDs(ctx, status)
+	if err != nil {
+		return false, err
+	}
+
 	q := s.conn.
 		NewSelect().
 		TableExpr("? AS ?", bun.Ident("status_mutes"), bun.Ident("status_mute")).
-		Where("? = ?", bun.Ident("status_mute.status_id"), status.ID).
+		Where("? IN (?)", bun.Ident("status_mute.status_id"), bun.In(statusIDs)).
 		Where("? = ?", bun.Ident("status_mute.account_id"), accountID)
 
 	return s.conn.Exists(ctx, q)
 }
 
+func (s *statusDB) DeleteStatusMutes(ctx context.Context, status *gtsmodel.Status, accountID string) db.Error {
+	statusIDs, err := s.statusAndAncestorIDs(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("status_mutes"), bun.Ident("status_mute")).
+		Where("? IN (?)", bun.Ident("status_mute.status_id"), bun.In(statusIDs)).
+		Where("? = ?", bun.Ident("status_mute.account_id"), accountID).
+		Exec(ctx)
+	return s.conn.ProcessError(err)
+}
+
+// statusAndAncestorIDs returns the ID of the given status, followed by the
+// IDs of each status above it in its thread that we have in the database.
+func (s *statusDB) statusAndAncestorIDs(ctx context.Context, status *gtsmodel.Status) ([]string, db.Error) {
+	statusIDs := []string{status.ID}
+
+	for inReplyToID := status.InReplyToID; inReplyToID != ""; {
+		for _, id := range statusIDs {
+			if id == inReplyToID {
+				// we've somehow looped
+				// back on ourselves, bail
+				return statusIDs, nil
+			}
+		}
+		statusIDs = append(statusIDs, inReplyToID)
+
+		parentInReplyToIDs := []string{}
+		if err := s.conn.
+			NewSelect().
+			TableExpr("? AS ?", bun.Ident("statuses"), bun.Ident("status")).
+			Column("status.in_reply_to_id").
+			Where("? = ?", bun.Ident("status.id"), inReplyToID).
+			Scan(ctx, &parentInReplyToIDs); err != nil {
+			return nil, s.conn.ProcessError(err)
+		}
+
+		if len(parentInReplyToIDs) == 0 {
+			// we don't have the parent, so
+			// this is as far up as we can go
+			break
+		}
+		inReplyToID = parentInReplyToIDs[0]
+	}
+
+	return statusIDs, nil
+}
+
 func (s *statusDB) IsStatusBookmarkedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, db.Error) {
 	q := s.conn.
 		NewSelect().
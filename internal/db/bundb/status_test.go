@@ -26,6 +26,7 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
 type StatusTestSuite struct {
@@ -175,6 +176,40 @@ func (suite *StatusTestSuite) TestGetStatusChildren() {
 	}
 }
 
+func (suite *StatusTestSuite) TestIsStatusMutedByThread() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	parent := suite.testStatuses["local_account_1_status_1"]
+	reply := suite.testStatuses["local_account_2_status_5"]
+
+	muted, err := suite.db.IsStatusMutedBy(ctx, reply, account.ID)
+	suite.NoError(err)
+	suite.False(muted)
+
+	// mute the top of the thread
+	if err := suite.db.Put(ctx, &gtsmodel.StatusMute{
+		ID:              "01GSZ6D9N5X3KQ7Y2B8M4W0RTE",
+		AccountID:       account.ID,
+		TargetAccountID: parent.AccountID,
+		StatusID:        parent.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// the reply should now be muted too
+	muted, err = suite.db.IsStatusMutedBy(ctx, reply, account.ID)
+	suite.NoError(err)
+	suite.True(muted)
+
+	// removing mutes from the reply should unmute the whole thread
+	err = suite.db.DeleteStatusMutes(ctx, reply, account.ID)
+	suite.NoError(err)
+
+	muted, err = suite.db.IsStatusMutedBy(ctx, parent, account.ID)
+	suite.NoError(err)
+	suite.False(muted)
+}
+
 func (suite *StatusTestSuite) TestDeleteStatus() {
 	targetStatus := suite.testStatuses["admin_account_status_1"]
 	err := suite.db.DeleteStatusByID(context.Background(), targetStatus.ID)
@@ -184,6 +219,53 @@ func (suite *StatusTestSuite) TestDeleteStatus() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *StatusTestSuite) TestEditStatus() {
+	ctx := context.Background()
+
+	// take a copy of the status so we don't mess with the fixtures
+	targetStatus := &gtsmodel.Status{}
+	*targetStatus = *suite.testStatuses["local_account_1_status_1"]
+
+	edit := &gtsmodel.StatusEdit{
+		ID:             "01GSA0ZV0NZ5S1VQDJXQ8TG1K4",
+		CreatedAt:      targetStatus.CreatedAt,
+		StatusID:       targetStatus.ID,
+		AccountID:      targetStatus.AccountID,
+		Content:        targetStatus.Content,
+		Text:           targetStatus.Text,
+		ContentWarning: targetStatus.ContentWarning,
+		Sensitive:      targetStatus.Sensitive,
+		Language:       targetStatus.Language,
+		AttachmentIDs:  targetStatus.AttachmentIDs,
+		EmojiIDs:       targetStatus.EmojiIDs,
+	}
+
+	editedAt := time.Now()
+	targetStatus.Content = "<p>this status has been edited</p>"
+	targetStatus.Text = "this status has been edited"
+	targetStatus.EditedAt = editedAt
+
+	err := suite.db.EditStatus(ctx, targetStatus, edit)
+	suite.NoError(err)
+
+	dbStatus, err := suite.db.GetStatusByID(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Equal("<p>this status has been edited</p>", dbStatus.Content)
+	suite.WithinDuration(editedAt, dbStatus.EditedAt, time.Millisecond)
+
+	edits, err := suite.db.GetStatusEdits(ctx, targetStatus.ID)
+	suite.NoError(err)
+	suite.Len(edits, 1)
+	suite.Equal(edit.ID, edits[0].ID)
+	suite.Equal(suite.testStatuses["local_account_1_status_1"].Content, edits[0].Content)
+}
+
+func (suite *StatusTestSuite) TestGetStatusEditsNone() {
+	edits, err := suite.db.GetStatusEdits(context.Background(), suite.testStatuses["local_account_1_status_1"].ID)
+	suite.NoError(err)
+	suite.Empty(edits)
+}
+
 func TestStatusTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusTestSuite))
 }
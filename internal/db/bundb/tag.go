@@ -0,0 +1,255 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+type tagDB struct {
+	conn *DBConn
+}
+
+/*
+	TAG FUNCTIONS
+*/
+
+func (t *tagDB) GetTagByID(ctx context.Context, id string) (*gtsmodel.Tag, db.Error) {
+	tag := &gtsmodel.Tag{}
+
+	if err := t.conn.
+		NewSelect().
+		Model(tag).
+		Where("? = ?", bun.Ident("tag.id"), id).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return tag, nil
+}
+
+func (t *tagDB) GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, db.Error) {
+	tag := &gtsmodel.Tag{}
+
+	// Match the way tags are selected when
+	// they're used in a status: ignoring case.
+	if err := t.conn.
+		NewSelect().
+		Model(tag).
+		Where("LOWER(?) = LOWER(?)", bun.Ident("tag.name"), name).
+		Order("tag.id ASC").
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return tag, nil
+}
+
+func (t *tagDB) GetTagHistory(ctx context.Context, tagID string, days int) ([]*gtsmodel.TagHistory, db.Error) {
+	if days < 1 {
+		return []*gtsmodel.TagHistory{}, nil
+	}
+
+	// Start of each day, most recent first
+	history := make([]*gtsmodel.TagHistory, days)
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	for i := range history {
+		history[i] = &gtsmodel.TagHistory{Day: today.AddDate(0, 0, -i)}
+	}
+
+	uses := []struct {
+		CreatedAt time.Time `bun:"created_at"`
+		AccountID string    `bun:"account_id"`
+	}{}
+
+	if err := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("status_to_tags"), bun.Ident("status_to_tag")).
+		Join("JOIN ? AS ? ON ? = ?",
+			bun.Ident("statuses"),
+			bun.Ident("status"),
+			bun.Ident("status.id"),
+			bun.Ident("status_to_tag.status_id")).
+		Column("status.created_at", "status.account_id").
+		Where("? = ?", bun.Ident("status_to_tag.tag_id"), tagID).
+		Where("? >= ?", bun.Ident("status.created_at"), history[days-1].Day).
+		WhereGroup(" AND ", whereEmptyOrNull("status.boost_of_id")).
+		Scan(ctx, &uses); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	// Count uses and distinct accounts per day
+	accounts := make([]map[string]struct{}, days)
+	for _, use := range uses {
+		i := int(today.Sub(use.CreatedAt.UTC().Truncate(24*time.Hour)) / (24 * time.Hour))
+		if i < 0 || i >= days {
+			// from the future?
+			continue
+		}
+
+		history[i].Uses++
+		if accounts[i] == nil {
+			accounts[i] = make(map[string]struct{})
+		}
+		accounts[i][use.AccountID] = struct{}{}
+	}
+
+	for i, a := range accounts {
+		history[i].Accounts = len(a)
+	}
+
+	return history, nil
+}
+
+/*
+	FOLLOWED TAG FUNCTIONS
+*/
+
+func (t *tagDB) GetFollowedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FollowedTag, db.Error) {
+	followedTag := &gtsmodel.FollowedTag{}
+
+	if err := t.conn.
+		NewSelect().
+		Model(followedTag).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? = ?", bun.Ident("followed_tag.tag_id"), tagID).
+		Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	tag, err := t.GetTagByID(ctx, followedTag.TagID)
+	if err != nil {
+		return nil, err
+	}
+	followedTag.Tag = tag
+
+	return followedTag, nil
+}
+
+func (t *tagDB) GetFollowedTagsForAccountID(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.FollowedTag, db.Error) {
+	followedTags := []*gtsmodel.FollowedTag{}
+
+	q := t.conn.
+		NewSelect().
+		Model(&followedTags).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Order("followed_tag.id DESC")
+
+	if maxID != "" {
+		// return only tag follows LOWER (ie., older) than maxID
+		q = q.Where("? < ?", bun.Ident("followed_tag.id"), maxID)
+	}
+
+	if minID != "" {
+		// return only tag follows HIGHER (ie., newer) than minID
+		q = q.Where("? > ?", bun.Ident("followed_tag.id"), minID)
+	}
+
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	// Populate tags, dropping any tag
+	// follows whose tag has disappeared.
+	populated := make([]*gtsmodel.FollowedTag, 0, len(followedTags))
+	for _, followedTag := range followedTags {
+		tag, err := t.GetTagByID(ctx, followedTag.TagID)
+		if err != nil {
+			log.Errorf("GetFollowedTagsForAccountID: error fetching tag %q: %v", followedTag.TagID, err)
+			continue
+		}
+		followedTag.Tag = tag
+		populated = append(populated, followedTag)
+	}
+
+	return populated, nil
+}
+
+func (t *tagDB) GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, db.Error) {
+	accountIDs := []string{}
+
+	if len(tagIDs) == 0 {
+		return accountIDs, nil
+	}
+
+	if err := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Column("followed_tag.account_id").
+		Distinct().
+		Where("? IN (?)", bun.Ident("followed_tag.tag_id"), bun.In(tagIDs)).
+		Scan(ctx, &accountIDs); err != nil {
+		return nil, t.conn.ProcessError(err)
+	}
+
+	return accountIDs, nil
+}
+
+func (t *tagDB) IsFollowingAnyTag(ctx context.Context, accountID string, tagIDs []string) (bool, db.Error) {
+	if len(tagIDs) == 0 {
+		return false, nil
+	}
+
+	q := t.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Column("followed_tag.id").
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? IN (?)", bun.Ident("followed_tag.tag_id"), bun.In(tagIDs))
+
+	return t.conn.Exists(ctx, q)
+}
+
+func (t *tagDB) PutFollowedTag(ctx context.Context, followedTag *gtsmodel.FollowedTag) db.Error {
+	_, err := t.conn.
+		NewInsert().
+		Model(followedTag).
+		Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+func (t *tagDB) DeleteFollowedTag(ctx context.Context, accountID string, tagID string) db.Error {
+	_, err := t.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Where("? = ?", bun.Ident("followed_tag.tag_id"), tagID).
+		Exec(ctx)
+	return t.conn.ProcessError(err)
+}
+
+func (t *tagDB) DeleteFollowedTagsByAccountID(ctx context.Context, accountID string) db.Error {
+	_, err := t.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("followed_tags"), bun.Ident("followed_tag")).
+		Where("? = ?", bun.Ident("followed_tag.account_id"), accountID).
+		Exec(ctx)
+	return t.conn.ProcessError(err)
+}
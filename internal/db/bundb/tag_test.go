@@ -0,0 +1,145 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type TagTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *TagTestSuite) TestGetTagByName() {
+	testTag := suite.testTags["welcome"]
+
+	// names should be matched case-insensitively
+	tag, err := suite.db.GetTagByName(context.Background(), "WelCome")
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.Equal(testTag.ID, tag.ID)
+	suite.Equal(testTag.URL, tag.URL)
+}
+
+func (suite *TagTestSuite) TestGetTagByNameNotFound() {
+	tag, err := suite.db.GetTagByName(context.Background(), "nosuchtag")
+	suite.Nil(tag)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func (suite *TagTestSuite) TestGetTagHistory() {
+	ctx := context.Background()
+	testTag := suite.testTags["welcome"]
+
+	// the fixture status using this tag is too old to count
+	history, err := suite.db.GetTagHistory(ctx, testTag.ID, 7)
+	suite.NoError(err)
+	suite.Len(history, 7)
+	for _, day := range history {
+		suite.Zero(day.Uses)
+		suite.Zero(day.Accounts)
+	}
+
+	// use the tag in a status today
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_1_status_1"]
+	status.ID = "01GTBXFQ7ZJ1TS3CFKS7F6MNY8"
+	status.URI = "http://localhost:8080/users/the_mighty_zork/statuses/01GTBXFQ7ZJ1TS3CFKS7F6MNY8"
+	status.URL = "http://localhost:8080/@the_mighty_zork/statuses/01GTBXFQ7ZJ1TS3CFKS7F6MNY8"
+	status.CreatedAt = time.Now()
+	status.TagIDs = []string{testTag.ID}
+	status.Tags = []*gtsmodel.Tag{testTag}
+	suite.NoError(suite.db.PutStatus(ctx, status))
+
+	history, err = suite.db.GetTagHistory(ctx, testTag.ID, 7)
+	suite.NoError(err)
+	suite.Len(history, 7)
+	suite.Equal(1, history[0].Uses)
+	suite.Equal(1, history[0].Accounts)
+	suite.Equal(time.Now().UTC().Truncate(24*time.Hour), history[0].Day)
+	suite.Equal(history[0].Day.AddDate(0, 0, -6), history[6].Day)
+}
+
+func (suite *TagTestSuite) TestFollowUnfollowTag() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testTag := suite.testTags["welcome"]
+
+	following, err := suite.db.IsFollowingAnyTag(ctx, testAccount.ID, []string{testTag.ID})
+	suite.NoError(err)
+	suite.False(following)
+
+	if err := suite.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        "01GSGVJ5BTBVGNVB9A0MBSNFJ5",
+		AccountID: testAccount.ID,
+		TagID:     testTag.ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// following the same tag twice should fail
+	err = suite.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        "01GSGVM2K8DGHZ3J0CBCZR4WTA",
+		AccountID: testAccount.ID,
+		TagID:     testTag.ID,
+	})
+	suite.True(errors.Is(err, db.ErrAlreadyExists))
+
+	following, err = suite.db.IsFollowingAnyTag(ctx, testAccount.ID, []string{suite.testTags["Hashtag"].ID, testTag.ID})
+	suite.NoError(err)
+	suite.True(following)
+
+	followedTag, err := suite.db.GetFollowedTag(ctx, testAccount.ID, testTag.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal(testTag.Name, followedTag.Tag.Name)
+
+	followedTags, err := suite.db.GetFollowedTagsForAccountID(ctx, testAccount.ID, "", "", 0)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Len(followedTags, 1)
+
+	accountIDs, err := suite.db.GetAccountIDsFollowingTagIDs(ctx, []string{testTag.ID})
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.Equal([]string{testAccount.ID}, accountIDs)
+
+	if err := suite.db.DeleteFollowedTag(ctx, testAccount.ID, testTag.ID); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	_, err = suite.db.GetFollowedTag(ctx, testAccount.ID, testTag.ID)
+	suite.True(errors.Is(err, db.ErrNoEntries))
+}
+
+func TestTagTestSuite(t *testing.T) {
+	suite.Run(t, new(TagTestSuite))
+}
@@ -84,6 +84,59 @@ func (suite *TimelineTestSuite) TestGetHomeTimelineWithFutureStatus() {
 	suite.Len(s, 16)
 }
 
+func (suite *TimelineTestSuite) TestGetHomeTimelineWithFollowedTag() {
+	ctx := context.Background()
+
+	viewingAccount := suite.testAccounts["local_account_2"]
+	taggedStatus := suite.testStatuses["admin_account_status_1"]
+
+	before, err := suite.db.GetHomeTimeline(ctx, viewingAccount.ID, "", "", "", 20, false)
+	suite.NoError(err)
+	for _, s := range before {
+		suite.NotEqual(taggedStatus.ID, s.ID)
+	}
+
+	// follow #welcome, which admin used in a status
+	if err := suite.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        "01GSGVJ5BTBVGNVB9A0MBSNFJ5",
+		AccountID: viewingAccount.ID,
+		TagID:     suite.testTags["welcome"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	after, err := suite.db.GetHomeTimeline(ctx, viewingAccount.ID, "", "", "", 20, false)
+	suite.NoError(err)
+	suite.Len(after, len(before)+1)
+
+	var found bool
+	for _, s := range after {
+		if s.ID == taggedStatus.ID {
+			found = true
+		}
+	}
+	suite.True(found)
+}
+
+func (suite *TimelineTestSuite) TestGetTagTimeline() {
+	ctx := context.Background()
+
+	s, err := suite.db.GetTagTimeline(ctx, suite.testTags["welcome"].ID, "", "", "", 20, false)
+	suite.NoError(err)
+
+	suite.Len(s, 1)
+	suite.Equal(suite.testStatuses["admin_account_status_1"].ID, s[0].ID)
+}
+
+func (suite *TimelineTestSuite) TestGetTagTimelineNoStatuses() {
+	ctx := context.Background()
+
+	s, err := suite.db.GetTagTimeline(ctx, suite.testTags["Hashtag"].ID, "", "", "", 20, false)
+	suite.NoError(err)
+
+	suite.Empty(s)
+}
+
 func getFutureStatus() *gtsmodel.Status {
 	theDistantFuture := time.Now().Add(876600 * time.Hour)
 	id, err := id.NewULIDFromTime(theDistantFuture)
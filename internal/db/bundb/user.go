@@ -22,8 +22,10 @@ import (
 	"context"
 	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/cache"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/state"
 	"github.com/uptrace/bun"
 )
@@ -34,7 +36,7 @@ type userDB struct {
 }
 
 func (u *userDB) GetUserByID(ctx context.Context, id string) (*gtsmodel.User, db.Error) {
-	return u.state.Caches.GTS.User().Load("ID", func() (*gtsmodel.User, error) {
+	return cache.Load("user", u.state.Caches.GTS.User(), "ID", func() (*gtsmodel.User, error) {
 		var user gtsmodel.User
 
 		q := u.conn.
@@ -52,7 +54,7 @@ func (u *userDB) GetUserByID(ctx context.Context, id string) (*gtsmodel.User, db
 }
 
 func (u *userDB) GetUserByAccountID(ctx context.Context, accountID string) (*gtsmodel.User, db.Error) {
-	return u.state.Caches.GTS.User().Load("AccountID", func() (*gtsmodel.User, error) {
+	return cache.Load("user", u.state.Caches.GTS.User(), "AccountID", func() (*gtsmodel.User, error) {
 		var user gtsmodel.User
 
 		q := u.conn.
@@ -70,7 +72,7 @@ func (u *userDB) GetUserByAccountID(ctx context.Context, accountID string) (*gts
 }
 
 func (u *userDB) GetUserByEmailAddress(ctx context.Context, emailAddress string) (*gtsmodel.User, db.Error) {
-	return u.state.Caches.GTS.User().Load("Email", func() (*gtsmodel.User, error) {
+	return cache.Load("user", u.state.Caches.GTS.User(), "Email", func() (*gtsmodel.User, error) {
 		var user gtsmodel.User
 
 		q := u.conn.
@@ -88,7 +90,7 @@ func (u *userDB) GetUserByEmailAddress(ctx context.Context, emailAddress string)
 }
 
 func (u *userDB) GetUserByExternalID(ctx context.Context, id string) (*gtsmodel.User, db.Error) {
-	return u.state.Caches.GTS.User().Load("ExternalID", func() (*gtsmodel.User, error) {
+	return cache.Load("user", u.state.Caches.GTS.User(), "ExternalID", func() (*gtsmodel.User, error) {
 		var user gtsmodel.User
 
 		q := u.conn.
@@ -106,7 +108,7 @@ func (u *userDB) GetUserByExternalID(ctx context.Context, id string) (*gtsmodel.
 }
 
 func (u *userDB) GetUserByConfirmationToken(ctx context.Context, confirmationToken string) (*gtsmodel.User, db.Error) {
-	return u.state.Caches.GTS.User().Load("ConfirmationToken", func() (*gtsmodel.User, error) {
+	return cache.Load("user", u.state.Caches.GTS.User(), "ConfirmationToken", func() (*gtsmodel.User, error) {
 		var user gtsmodel.User
 
 		q := u.conn.
@@ -123,6 +125,82 @@ func (u *userDB) GetUserByConfirmationToken(ctx context.Context, confirmationTok
 	}, confirmationToken)
 }
 
+func (u *userDB) GetPendingUsers(ctx context.Context, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.User, db.Error) {
+	userIDs := []string{}
+
+	q := u.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Column("user.id").
+		Where("? = ?", bun.Ident("user.approved"), false).
+		Order("user.account_id DESC")
+
+	if maxID != "" {
+		q = q.Where("? < ?", bun.Ident("user.account_id"), maxID)
+	}
+
+	if sinceID != "" {
+		q = q.Where("? > ?", bun.Ident("user.account_id"), sinceID)
+	}
+
+	if minID != "" {
+		q = q.Where("? > ?", bun.Ident("user.account_id"), minID)
+	}
+
+	if limit != 0 {
+		q = q.Limit(limit)
+	}
+
+	if err := q.Scan(ctx, &userIDs); err != nil {
+		return nil, u.conn.ProcessError(err)
+	}
+
+	return u.getUsersByIDs(ctx, userIDs)
+}
+
+func (u *userDB) GetModeratorUsers(ctx context.Context) ([]*gtsmodel.User, db.Error) {
+	userIDs := []string{}
+
+	q := u.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("users"), bun.Ident("user")).
+		Column("user.id").
+		WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.
+				Where("? = ?", bun.Ident("user.admin"), true).
+				WhereOr("? = ?", bun.Ident("user.moderator"), true)
+		}).
+		Order("user.id ASC")
+
+	if err := q.Scan(ctx, &userIDs); err != nil {
+		return nil, u.conn.ProcessError(err)
+	}
+
+	return u.getUsersByIDs(ctx, userIDs)
+}
+
+func (u *userDB) getUsersByIDs(ctx context.Context, userIDs []string) ([]*gtsmodel.User, db.Error) {
+	// Catch case of no users early
+	if len(userIDs) == 0 {
+		return nil, db.ErrNoEntries
+	}
+
+	// Allocate return slice (will be at most len userIDs)
+	users := make([]*gtsmodel.User, 0, len(userIDs))
+	for _, id := range userIDs {
+		user, err := u.GetUserByID(ctx, id)
+		if err != nil {
+			log.Errorf("getUsersByIDs: error getting user %q: %v", id, err)
+			continue
+		}
+
+		// Append to return slice
+		users = append(users, user)
+	}
+
+	return users, nil
+}
+
 func (u *userDB) PutUser(ctx context.Context, user *gtsmodel.User) db.Error {
 	return u.state.Caches.GTS.User().Store(user, func() error {
 		_, err := u.conn.
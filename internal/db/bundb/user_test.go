@@ -23,6 +23,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 )
 
@@ -48,6 +49,27 @@ func (suite *UserTestSuite) TestGetUserByAccountID() {
 	suite.NotNil(user)
 }
 
+func (suite *UserTestSuite) TestGetPendingUsers() {
+	users, err := suite.db.GetPendingUsers(context.Background(), "", "", "", 0)
+	suite.NoError(err)
+	suite.Len(users, 1)
+	suite.Equal(suite.testUsers["unconfirmed_account"].ID, users[0].ID)
+	suite.NotNil(users[0].Account)
+}
+
+func (suite *UserTestSuite) TestGetPendingUsersPaged() {
+	users, err := suite.db.GetPendingUsers(context.Background(), suite.testAccounts["unconfirmed_account"].ID, "", "", 0)
+	suite.ErrorIs(err, db.ErrNoEntries)
+	suite.Empty(users)
+}
+
+func (suite *UserTestSuite) TestGetModeratorUsers() {
+	users, err := suite.db.GetModeratorUsers(context.Background())
+	suite.NoError(err)
+	suite.Len(users, 1)
+	suite.Equal(suite.testUsers["admin_account"].ID, users[0].ID)
+}
+
 func (suite *UserTestSuite) TestUpdateUserSelectedColumns() {
 	testUser := suite.testUsers["local_account_1"]
 
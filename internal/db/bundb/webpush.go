@@ -0,0 +1,184 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/uptrace/bun"
+)
+
+type webPushDB struct {
+	conn *DBConn
+}
+
+func (w *webPushDB) CreateInstanceVAPIDKeyPair(ctx context.Context) db.Error {
+	q := w.conn.
+		NewSelect().
+		TableExpr("? AS ?", bun.Ident("vapid_key_pairs"), bun.Ident("vapid_key_pair")).
+		Column("vapid_key_pair.id")
+
+	exists, err := w.conn.Exists(ctx, q)
+	if err != nil {
+		return err
+	}
+	if exists {
+		log.Infof("instance vapid key pair already exists")
+		return nil
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		log.Errorf("error creating new vapid key: %s", err)
+		return err
+	}
+
+	privateDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+
+	pairID, err := id.NewRandomULID()
+	if err != nil {
+		return err
+	}
+
+	pair := &gtsmodel.VAPIDKeyPair{
+		ID:      pairID,
+		Public:  base64.RawURLEncoding.EncodeToString(elliptic.Marshal(key.Curve, key.X, key.Y)),
+		Private: base64.RawURLEncoding.EncodeToString(privateDER),
+	}
+
+	if _, err := w.conn.
+		NewInsert().
+		Model(pair).
+		Exec(ctx); err != nil {
+		return w.conn.ProcessError(err)
+	}
+
+	log.Infof("instance vapid key pair CREATED with id %s", pair.ID)
+	return nil
+}
+
+func (w *webPushDB) GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, db.Error) {
+	pair := &gtsmodel.VAPIDKeyPair{}
+
+	if err := w.conn.
+		NewSelect().
+		Model(pair).
+		Order("vapid_key_pair.id ASC").
+		Limit(1).
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return pair, nil
+}
+
+func (w *webPushDB) GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, db.Error) {
+	subscription := &gtsmodel.WebPushSubscription{}
+
+	if err := w.conn.
+		NewSelect().
+		Model(subscription).
+		Where("? = ?", bun.Ident("web_push_subscription.token_id"), tokenID).
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return subscription, nil
+}
+
+func (w *webPushDB) GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, db.Error) {
+	subscriptions := []*gtsmodel.WebPushSubscription{}
+
+	if err := w.conn.
+		NewSelect().
+		Model(&subscriptions).
+		Where("? = ?", bun.Ident("web_push_subscription.account_id"), accountID).
+		Order("web_push_subscription.id ASC").
+		Scan(ctx); err != nil {
+		return nil, w.conn.ProcessError(err)
+	}
+
+	return subscriptions, nil
+}
+
+func (w *webPushDB) PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) db.Error {
+	return w.conn.RunInTx(ctx, func(tx bun.Tx) error {
+		// remove any existing subscription for this token
+		if _, err := tx.
+			NewDelete().
+			TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+			Where("? = ?", bun.Ident("web_push_subscription.token_id"), subscription.TokenID).
+			Exec(ctx); err != nil {
+			return err
+		}
+
+		_, err := tx.NewInsert().Model(subscription).Exec(ctx)
+		return err
+	})
+}
+
+func (w *webPushDB) UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) db.Error {
+	subscription.UpdatedAt = time.Now()
+	if len(columns) > 0 {
+		// If we're updating by column, ensure "updated_at" is included.
+		columns = append(columns, "updated_at")
+	}
+
+	_, err := w.conn.
+		NewUpdate().
+		Model(subscription).
+		Where("? = ?", bun.Ident("web_push_subscription.id"), subscription.ID).
+		Column(columns...).
+		Exec(ctx)
+	return w.conn.ProcessError(err)
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByID(ctx context.Context, id string) db.Error {
+	return w.deleteWebPushSubscriptionsWhere(ctx, "web_push_subscription.id", id)
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) db.Error {
+	return w.deleteWebPushSubscriptionsWhere(ctx, "web_push_subscription.token_id", tokenID)
+}
+
+func (w *webPushDB) DeleteWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) db.Error {
+	return w.deleteWebPushSubscriptionsWhere(ctx, "web_push_subscription.account_id", accountID)
+}
+
+func (w *webPushDB) deleteWebPushSubscriptionsWhere(ctx context.Context, column string, value string) db.Error {
+	_, err := w.conn.
+		NewDelete().
+		TableExpr("? AS ?", bun.Ident("web_push_subscriptions"), bun.Ident("web_push_subscription")).
+		Where("? = ?", bun.Ident(column), value).
+		Exec(ctx)
+	return w.conn.ProcessError(err)
+}
@@ -0,0 +1,124 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package bundb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type WebPushTestSuite struct {
+	BunDBStandardTestSuite
+}
+
+func (suite *WebPushTestSuite) TestCreateInstanceVAPIDKeyPairIdempotent() {
+	ctx := context.Background()
+
+	pair, err := suite.db.GetVAPIDKeyPair(ctx)
+	suite.NoError(err)
+	suite.NotEmpty(pair.Public)
+	suite.NotEmpty(pair.Private)
+
+	// creating again should leave the existing key pair alone
+	suite.NoError(suite.db.CreateInstanceVAPIDKeyPair(ctx))
+
+	again, err := suite.db.GetVAPIDKeyPair(ctx)
+	suite.NoError(err)
+	suite.Equal(pair.ID, again.ID)
+	suite.Equal(pair.Public, again.Public)
+}
+
+func (suite *WebPushTestSuite) TestGetWebPushSubscriptionByTokenID() {
+	testSubscription := suite.testWebPushSubs["local_account_1_token_1"]
+
+	subscription, err := suite.db.GetWebPushSubscriptionByTokenID(context.Background(), testSubscription.TokenID)
+	suite.NoError(err)
+	suite.Equal(testSubscription.ID, subscription.ID)
+	suite.Equal(testSubscription.Endpoint, subscription.Endpoint)
+	suite.True(subscription.AlertEnabled(gtsmodel.NotificationMention))
+	suite.False(subscription.AlertEnabled(gtsmodel.NotificationReblog))
+}
+
+func (suite *WebPushTestSuite) TestPutWebPushSubscriptionReplacesExisting() {
+	ctx := context.Background()
+	testSubscription := suite.testWebPushSubs["local_account_1_token_1"]
+
+	alertReblog := true
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:          "01GT1AD4JG9Y6X2QH5Z6N7W0R3",
+		AccountID:   testSubscription.AccountID,
+		TokenID:     testSubscription.TokenID,
+		Endpoint:    "https://push.example.org/send/another",
+		Auth:        testSubscription.Auth,
+		P256dh:      testSubscription.P256dh,
+		AlertReblog: &alertReblog,
+	}
+	suite.NoError(suite.db.PutWebPushSubscription(ctx, subscription))
+
+	dbSubscription, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, testSubscription.TokenID)
+	suite.NoError(err)
+	suite.Equal(subscription.ID, dbSubscription.ID)
+	suite.Equal("https://push.example.org/send/another", dbSubscription.Endpoint)
+	suite.True(dbSubscription.AlertEnabled(gtsmodel.NotificationReblog))
+	suite.False(dbSubscription.AlertEnabled(gtsmodel.NotificationMention))
+
+	subscriptions, err := suite.db.GetWebPushSubscriptionsByAccountID(ctx, testSubscription.AccountID)
+	suite.NoError(err)
+	suite.Len(subscriptions, 1)
+}
+
+func (suite *WebPushTestSuite) TestUpdateWebPushSubscription() {
+	ctx := context.Background()
+
+	subscription, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, suite.testWebPushSubs["local_account_1_token_1"].TokenID)
+	suite.NoError(err)
+
+	alertMention := false
+	subscription.AlertMention = &alertMention
+	subscription.Endpoint = "https://should.not.be.updated.example.org"
+	suite.NoError(suite.db.UpdateWebPushSubscription(ctx, subscription, "alert_mention"))
+
+	dbSubscription, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, subscription.TokenID)
+	suite.NoError(err)
+	suite.False(dbSubscription.AlertEnabled(gtsmodel.NotificationMention))
+	suite.Equal(suite.testWebPushSubs["local_account_1_token_1"].Endpoint, dbSubscription.Endpoint)
+	suite.True(dbSubscription.UpdatedAt.After(suite.testWebPushSubs["local_account_1_token_1"].UpdatedAt))
+}
+
+func (suite *WebPushTestSuite) TestDeleteWebPushSubscriptionsByAccountID() {
+	ctx := context.Background()
+	testSubscription := suite.testWebPushSubs["local_account_1_token_1"]
+
+	suite.NoError(suite.db.DeleteWebPushSubscriptionsByAccountID(ctx, testSubscription.AccountID))
+
+	_, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, testSubscription.TokenID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	subscriptions, err := suite.db.GetWebPushSubscriptionsByAccountID(ctx, testSubscription.AccountID)
+	suite.NoError(err)
+	suite.Empty(subscriptions)
+}
+
+func TestWebPushTestSuite(t *testing.T) {
+	suite.Run(t, new(WebPushTestSuite))
+}
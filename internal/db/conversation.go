@@ -0,0 +1,62 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Conversation contains functions for getting, creating, updating, and deleting direct message conversations.
+type Conversation interface {
+	// GetConversationByID gets one conversation with the given id.
+	GetConversationByID(ctx context.Context, id string) (*gtsmodel.Conversation, Error)
+
+	// GetConversationByThreadAndAccountIDs gets the conversation owned by the given accountID, about
+	// the thread with the given threadID, between the owner and the accounts in otherAccountsKey.
+	GetConversationByThreadAndAccountIDs(ctx context.Context, accountID string, threadID string, otherAccountsKey string) (*gtsmodel.Conversation, Error)
+
+	// GetConversationsForAccountID gets conversations owned by the given accountID, in descending order
+	// of their most recent status. The maxID, sinceID and minID parameters refer to last status IDs,
+	// and are all optional, as is limit.
+	GetConversationsForAccountID(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.Conversation, Error)
+
+	// PutConversation puts a new conversation in the database.
+	PutConversation(ctx context.Context, conversation *gtsmodel.Conversation) Error
+
+	// UpdateConversation updates the given conversation.
+	// Columns is optional, if not specified all will be updated.
+	UpdateConversation(ctx context.Context, conversation *gtsmodel.Conversation, columns ...string) Error
+
+	// AddStatusToConversation records that the given statusID is part of the given conversationID.
+	// It's not an error if the status is already part of the conversation.
+	AddStatusToConversation(ctx context.Context, conversationID string, statusID string) Error
+
+	// DeleteConversationByID deletes one conversation with the given id.
+	DeleteConversationByID(ctx context.Context, id string) Error
+
+	// DeleteConversationsByAccountID deletes all conversations owned by the given accountID.
+	DeleteConversationsByAccountID(ctx context.Context, accountID string) Error
+
+	// DeleteStatusFromConversations removes the given statusID from any conversations it's part of. Conversations
+	// whose last status was the given status will be moved back to their previous status, or deleted if they don't
+	// have one. It uses a transaction to ensure no partial updates.
+	DeleteStatusFromConversations(ctx context.Context, statusID string) Error
+}
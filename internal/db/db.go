@@ -34,19 +34,30 @@ type DB interface {
 	Account
 	Admin
 	Basic
+	Conversation
+	Delivery
 	Domain
 	Emoji
+	Export
+	Filter
+	Invite
 	Instance
+	List
 	Media
 	Mention
 	Notification
+	Poll
 	Relationship
 	Report
+	ScheduledStatus
+	Search
 	Session
 	Status
+	Tag
 	Timeline
 	User
 	Tombstone
+	WebPush
 
 	/*
 		USEFUL CONVERSION FUNCTIONS
@@ -0,0 +1,65 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Delivery contains functions for managing the queue of federation deliveries waiting to be retried.
+type Delivery interface {
+	// GetDeliveryByID gets one queued delivery with the given id.
+	GetDeliveryByID(ctx context.Context, id string) (*gtsmodel.Delivery, Error)
+
+	// GetDeliveries gets queued deliveries, newest first. If domain is set,
+	// only deliveries targeting that domain are returned. The maxID, minID
+	// and limit parameters are optional.
+	GetDeliveries(ctx context.Context, domain string, maxID string, minID string, limit int) ([]*gtsmodel.Delivery, Error)
+
+	// GetDeliveriesDueBefore gets up to limit queued deliveries whose next attempt
+	// is at or before the given time, most overdue first.
+	GetDeliveriesDueBefore(ctx context.Context, t time.Time, limit int) ([]*gtsmodel.Delivery, Error)
+
+	// CountDeliveries returns the number of queued deliveries.
+	// If domain is set, only deliveries targeting that domain are counted.
+	CountDeliveries(ctx context.Context, domain string) (int, Error)
+
+	// PutDelivery puts a new delivery in the queue.
+	PutDelivery(ctx context.Context, delivery *gtsmodel.Delivery) Error
+
+	// UpdateDelivery updates the given queued delivery.
+	// Columns is optional, if not specified all will be updated.
+	UpdateDelivery(ctx context.Context, delivery *gtsmodel.Delivery, columns ...string) Error
+
+	// RescheduleDeliveriesForDomain brings the next attempt of all deliveries
+	// queued for the given domain forward to the given time, if they were
+	// scheduled later than that.
+	RescheduleDeliveriesForDomain(ctx context.Context, domain string, t time.Time) Error
+
+	// DeleteDeliveryByID removes one delivery with the given ID from the queue.
+	DeleteDeliveryByID(ctx context.Context, id string) Error
+
+	// DeleteDeliveries removes queued deliveries, returning the number removed.
+	// If domain is set, only deliveries targeting that domain are removed,
+	// otherwise the whole queue is purged.
+	DeleteDeliveries(ctx context.Context, domain string) (int, Error)
+}
@@ -36,7 +36,20 @@ type Domain interface {
 	// DeleteDomainBlock ...
 	DeleteDomainBlock(ctx context.Context, domain string) Error
 
+	// CreateDomainAllow puts the given instance-level domain allow into the database.
+	CreateDomainAllow(ctx context.Context, allow *gtsmodel.DomainAllow) Error
+
+	// GetDomainAllow returns one instance-level domain allow with the given domain, if it exists.
+	GetDomainAllow(ctx context.Context, domain string) (*gtsmodel.DomainAllow, Error)
+
+	// DeleteDomainAllow deletes an instance-level domain allow with the given domain, if it exists.
+	DeleteDomainAllow(ctx context.Context, domain string) Error
+
+	// IsDomainAllowed checks if an instance-level domain allow exists for the given domain string (eg., `example.org`).
+	IsDomainAllowed(ctx context.Context, domain string) (bool, Error)
+
 	// IsDomainBlocked checks if an instance-level domain block exists for the given domain string (eg., `example.org`).
+	// When the instance federation mode is 'allowlist', domains that aren't explicitly allowed are considered blocked too.
 	IsDomainBlocked(ctx context.Context, domain string) (bool, Error)
 
 	// AreDomainsBlocked checks if an instance-level domain block exists for any of the given domains strings, and returns true if even one is found.
@@ -47,4 +60,24 @@ type Domain interface {
 
 	// AreURIsBlocked checks if an instance-level domain block exists for any `host` in the given URI slice, and returns true if even one is found.
 	AreURIsBlocked(ctx context.Context, uris []*url.URL) (bool, Error)
+
+	// GetDomainBlocksBySubscriptionID gets all domain blocks that were created through the given domain block subscription.
+	GetDomainBlocksBySubscriptionID(ctx context.Context, subscriptionID string) ([]*gtsmodel.DomainBlock, Error)
+
+	// GetDomainBlockSubscriptionByID gets one domain block subscription with the given ID.
+	GetDomainBlockSubscriptionByID(ctx context.Context, id string) (*gtsmodel.DomainBlockSubscription, Error)
+
+	// GetDomainBlockSubscriptions gets all domain block subscriptions, oldest first.
+	GetDomainBlockSubscriptions(ctx context.Context) ([]*gtsmodel.DomainBlockSubscription, Error)
+
+	// PutDomainBlockSubscription puts the given domain block subscription in the database.
+	PutDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) Error
+
+	// UpdateDomainBlockSubscription updates the given domain block subscription.
+	// If columns is empty, all columns will be updated.
+	UpdateDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription, columns ...string) Error
+
+	// DeleteDomainBlockSubscriptionByID deletes the domain block subscription with the given ID.
+	// Domain blocks created through the subscription are not deleted, but are no longer owned by it.
+	DeleteDomainBlockSubscriptionByID(ctx context.Context, id string) Error
 }
@@ -0,0 +1,44 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Export contains functions for getting, creating, updating, and deleting account exports.
+type Export interface {
+	// GetAccountExportByID gets the account export with the given ID.
+	GetAccountExportByID(ctx context.Context, id string) (*gtsmodel.AccountExport, Error)
+
+	// GetAccountExportsByAccountID gets all exports of the given accountID, newest first.
+	GetAccountExportsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.AccountExport, Error)
+
+	// PutAccountExport puts the given account export in the database.
+	PutAccountExport(ctx context.Context, export *gtsmodel.AccountExport) Error
+
+	// UpdateAccountExport updates the given account export.
+	// If columns is empty, all columns will be updated.
+	UpdateAccountExport(ctx context.Context, export *gtsmodel.AccountExport, columns ...string) Error
+
+	// DeleteAccountExportByID deletes the account export with the given ID.
+	DeleteAccountExportByID(ctx context.Context, id string) Error
+}
@@ -0,0 +1,67 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Filter contains functions for getting, creating, updating, and deleting keyword filters and their keywords.
+type Filter interface {
+	// GetFilterByID gets one filter with the given id, populated with its keywords.
+	GetFilterByID(ctx context.Context, id string) (*gtsmodel.Filter, Error)
+
+	// GetFiltersForAccountID gets all filters owned by the given accountID, populated with their keywords.
+	GetFiltersForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.Filter, Error)
+
+	// PutFilter puts a new filter in the database, along with any keywords set on it.
+	// It uses a transaction to ensure no partial updates.
+	PutFilter(ctx context.Context, filter *gtsmodel.Filter) Error
+
+	// UpdateFilter updates the given filter. Keywords are not touched.
+	// Columns is optional, if not specified all will be updated.
+	UpdateFilter(ctx context.Context, filter *gtsmodel.Filter, columns ...string) Error
+
+	// DeleteFilterByID deletes one filter with the given ID, and all keywords belonging to it.
+	DeleteFilterByID(ctx context.Context, id string) Error
+
+	// DeleteFiltersByAccountID deletes all filters and filter keywords owned by the given accountID.
+	DeleteFiltersByAccountID(ctx context.Context, accountID string) Error
+
+	// GetFilterKeywordByID gets one filter keyword with the given ID, populated with its filter.
+	GetFilterKeywordByID(ctx context.Context, id string) (*gtsmodel.FilterKeyword, Error)
+
+	// GetFilterKeywordsForAccountID gets all filter keywords owned by the given accountID, populated with their filters.
+	GetFilterKeywordsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.FilterKeyword, Error)
+
+	// GetFilterKeywordsForFilterID gets all keywords belonging to the given filterID.
+	GetFilterKeywordsForFilterID(ctx context.Context, filterID string) ([]*gtsmodel.FilterKeyword, Error)
+
+	// PutFilterKeyword puts a new filter keyword in the database.
+	PutFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword) Error
+
+	// UpdateFilterKeyword updates the given filter keyword.
+	// Columns is optional, if not specified all will be updated.
+	UpdateFilterKeyword(ctx context.Context, filterKeyword *gtsmodel.FilterKeyword, columns ...string) Error
+
+	// DeleteFilterKeywordByID deletes one filter keyword with the given id.
+	DeleteFilterKeywordByID(ctx context.Context, id string) Error
+}
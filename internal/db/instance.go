@@ -40,4 +40,14 @@ type Instance interface {
 
 	// GetInstancePeers returns a slice of instances that the host instance knows about.
 	GetInstancePeers(ctx context.Context, includeSuspended bool) ([]*gtsmodel.Instance, Error)
+
+	// GetInstance returns the instance entry for the given domain.
+	GetInstance(ctx context.Context, domain string) (*gtsmodel.Instance, Error)
+
+	// GetFailingInstances returns instances whose most recent requests have failed, the longest failing first.
+	GetFailingInstances(ctx context.Context) ([]*gtsmodel.Instance, Error)
+
+	// UpdateInstance updates the given instance entry.
+	// Columns is optional, if not specified all will be updated.
+	UpdateInstance(ctx context.Context, instance *gtsmodel.Instance, columns ...string) Error
 }
@@ -0,0 +1,49 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Invite contains functions for getting, creating and using invite codes.
+type Invite interface {
+	// GetInviteByID gets one invite with the given id.
+	GetInviteByID(ctx context.Context, id string) (*gtsmodel.Invite, Error)
+
+	// GetInviteByCode gets one invite with the given code.
+	GetInviteByCode(ctx context.Context, code string) (*gtsmodel.Invite, Error)
+
+	// GetAccountInvites gets all invites created by the given account, newest first.
+	GetAccountInvites(ctx context.Context, accountID string) ([]*gtsmodel.Invite, Error)
+
+	// PutInvite stores one invite.
+	PutInvite(ctx context.Context, invite *gtsmodel.Invite) Error
+
+	// UpdateInvite updates the given invite.
+	// Columns is optional, if not specified all will be updated.
+	UpdateInvite(ctx context.Context, invite *gtsmodel.Invite, columns ...string) Error
+
+	// UseInvite records one use of the given invite, as long as it hasn't expired
+	// or run out of uses in the meantime. If it can't be used, ErrNoEntries is returned.
+	// On success, the Uses of the given invite are updated to match the database.
+	UseInvite(ctx context.Context, invite *gtsmodel.Invite) Error
+}
@@ -0,0 +1,70 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// List contains functions for getting, creating, updating, and deleting lists and list entries.
+type List interface {
+	// GetListByID gets one list with the given id.
+	GetListByID(ctx context.Context, id string) (*gtsmodel.List, Error)
+
+	// GetListsForAccountID gets all lists owned by the given accountID.
+	GetListsForAccountID(ctx context.Context, accountID string) ([]*gtsmodel.List, Error)
+
+	// PutList puts a new list in the database.
+	PutList(ctx context.Context, list *gtsmodel.List) Error
+
+	// UpdateList updates the given list.
+	// Columns is optional, if not specified all will be updated.
+	UpdateList(ctx context.Context, list *gtsmodel.List, columns ...string) Error
+
+	// DeleteListByID deletes one list with the given ID, and all entries belonging to it.
+	DeleteListByID(ctx context.Context, id string) Error
+
+	// DeleteListsByAccountID deletes all lists (and their entries) owned by the given accountID.
+	DeleteListsByAccountID(ctx context.Context, accountID string) Error
+
+	// GetListEntryByID gets one list entry with the given ID.
+	GetListEntryByID(ctx context.Context, id string) (*gtsmodel.ListEntry, Error)
+
+	// GetListEntries gets list entries from the given listID, using the given parameters.
+	// Entries are returned in descending order of their ID.
+	GetListEntries(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ListEntry, Error)
+
+	// GetListEntriesForFollowID returns all listEntries that pertain to the given followID.
+	GetListEntriesForFollowID(ctx context.Context, followID string) ([]*gtsmodel.ListEntry, Error)
+
+	// PutListEntries inserts a slice of listEntries into the database.
+	// It uses a transaction to ensure no partial updates.
+	PutListEntries(ctx context.Context, listEntries []*gtsmodel.ListEntry) Error
+
+	// DeleteListEntry deletes one list entry with the given id.
+	DeleteListEntry(ctx context.Context, id string) Error
+
+	// DeleteListEntriesForFollowID deletes all list entries with the given followID.
+	DeleteListEntriesForFollowID(ctx context.Context, followID string) Error
+
+	// ListIncludesAccount returns true if the given listID includes the given accountID.
+	ListIncludesAccount(ctx context.Context, listID string, accountID string) (bool, Error)
+}
@@ -42,7 +42,7 @@ type Media interface {
 	GetAvatarsAndHeaders(ctx context.Context, maxID string, limit int) ([]*gtsmodel.MediaAttachment, Error)
 
 	// GetLocalUnattachedOlderThan fetches limit n local media attachments (including avatars and headers), older than
-	// the given time, which aren't header or avatars, and aren't attached to a status. In other words, attachments which were
+	// the given time, which aren't header or avatars, and aren't attached to a status or scheduled status. In other words, attachments which were
 	// uploaded but never used for whatever reason, or attachments that were attached to a status which was subsequently deleted.
 	GetLocalUnattachedOlderThan(ctx context.Context, olderThan time.Time, maxID string, limit int) ([]*gtsmodel.MediaAttachment, Error)
 }
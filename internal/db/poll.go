@@ -0,0 +1,64 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Poll contains functions for getting, creating, updating, and deleting polls and poll votes.
+type Poll interface {
+	// GetPollByID gets one poll with the given id.
+	GetPollByID(ctx context.Context, id string) (*gtsmodel.Poll, Error)
+
+	// GetPollByStatusID gets the poll attached to the given statusID.
+	GetPollByStatusID(ctx context.Context, statusID string) (*gtsmodel.Poll, Error)
+
+	// GetPollsExpiredBefore gets all polls which have an expiry time before the given time, and which have not yet been closed.
+	GetPollsExpiredBefore(ctx context.Context, t time.Time) ([]*gtsmodel.Poll, Error)
+
+	// PutPoll puts a new poll in the database.
+	PutPoll(ctx context.Context, poll *gtsmodel.Poll) Error
+
+	// UpdatePoll updates the given poll.
+	// Columns is optional, if not specified all will be updated.
+	UpdatePoll(ctx context.Context, poll *gtsmodel.Poll, columns ...string) Error
+
+	// DeletePollByID deletes one poll with the given ID, and all votes in it.
+	DeletePollByID(ctx context.Context, id string) Error
+
+	// GetPollVotes gets all votes in the given pollID.
+	GetPollVotes(ctx context.Context, pollID string) ([]*gtsmodel.PollVote, Error)
+
+	// GetPollVoteBy gets the vote cast in the given pollID by the given accountID.
+	GetPollVoteBy(ctx context.Context, pollID string, accountID string) (*gtsmodel.PollVote, Error)
+
+	// PutPollVote puts a new poll vote in the database, and increments the vote
+	// and voter counts of the poll it belongs to. It uses a transaction to ensure
+	// no partial updates.
+	PutPollVote(ctx context.Context, vote *gtsmodel.PollVote) Error
+
+	// AddPollVoteChoices adds the given choices to an existing poll vote, and increments
+	// the vote counts of the poll it belongs to. This is used when votes in a multiple
+	// choice poll are received one by one over federation.
+	AddPollVoteChoices(ctx context.Context, vote *gtsmodel.PollVote, choices []int) Error
+}
@@ -51,6 +51,34 @@ type Relationship interface {
 	// DeleteBlocksByTargetAccountID removes any blocks with given targetAccountID.
 	DeleteBlocksByTargetAccountID(ctx context.Context, targetAccountID string) Error
 
+	// DeleteFollowByID removes follow with given ID from the database, along with any list entries for it.
+	DeleteFollowByID(ctx context.Context, id string) Error
+
+	// DeleteFollowByURI removes follow with given AP URI from the database, along with any list entries for it.
+	DeleteFollowByURI(ctx context.Context, uri string) Error
+
+	// DeleteFollowsByOriginAccountID removes any follows with accountID equal to originAccountID, along with any list entries for them.
+	DeleteFollowsByOriginAccountID(ctx context.Context, originAccountID string) Error
+
+	// DeleteFollowsByTargetAccountID removes any follows with given targetAccountID, along with any list entries for them.
+	DeleteFollowsByTargetAccountID(ctx context.Context, targetAccountID string) Error
+
+	// GetMute returns the mute from account1 targeting account2, if it exists and hasn't expired, or an error if it doesn't.
+	GetMute(ctx context.Context, account1 string, account2 string) (*gtsmodel.UserMute, Error)
+
+	// PutMute places the given account mute in the database, replacing any
+	// existing mute from the same account targeting the same account.
+	PutMute(ctx context.Context, mute *gtsmodel.UserMute) Error
+
+	// DeleteMuteByID removes mute with given ID from the database.
+	DeleteMuteByID(ctx context.Context, id string) Error
+
+	// DeleteMutesByAccountID removes any mutes with accountID or targetAccountID equal to the given accountID.
+	DeleteMutesByAccountID(ctx context.Context, accountID string) Error
+
+	// GetAccountMutes returns unexpired mutes owned by the given accountID, newest first, with their target accounts populated.
+	GetAccountMutes(ctx context.Context, accountID string, maxID string, sinceID string, limit int) ([]*gtsmodel.UserMute, Error)
+
 	// GetRelationship retrieves the relationship of the targetAccount to the requestingAccount.
 	GetRelationship(ctx context.Context, requestingAccount string, targetAccount string) (*gtsmodel.Relationship, Error)
 
@@ -0,0 +1,59 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// ScheduledStatus contains functions for getting, creating, updating, and deleting scheduled statuses.
+type ScheduledStatus interface {
+	// GetScheduledStatusByID gets one scheduled status with the given id, with its attachments populated.
+	GetScheduledStatusByID(ctx context.Context, id string) (*gtsmodel.ScheduledStatus, Error)
+
+	// GetScheduledStatusesForAccountID gets scheduled statuses owned by the given accountID, newest first,
+	// with their attachments populated. The maxID, sinceID, minID and limit parameters are all optional.
+	GetScheduledStatusesForAccountID(ctx context.Context, accountID string, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.ScheduledStatus, Error)
+
+	// GetScheduledStatusesDueBefore gets all scheduled statuses whose scheduled time is at or before the given time, oldest first.
+	GetScheduledStatusesDueBefore(ctx context.Context, t time.Time) ([]*gtsmodel.ScheduledStatus, Error)
+
+	// CountScheduledStatusesForAccountID returns the number of statuses scheduled by the given accountID.
+	// If from and to are both set, only statuses scheduled for between those times will be counted.
+	CountScheduledStatusesForAccountID(ctx context.Context, accountID string, from time.Time, to time.Time) (int, Error)
+
+	// PutScheduledStatus puts a new scheduled status in the database, and marks
+	// its attachments as belonging to it. It uses a transaction to ensure no
+	// partial updates.
+	PutScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) Error
+
+	// UpdateScheduledStatus updates the given scheduled status.
+	// Columns is optional, if not specified all will be updated.
+	UpdateScheduledStatus(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus, columns ...string) Error
+
+	// DeleteScheduledStatusByID deletes one scheduled status with the given ID,
+	// and releases any attachments that belonged to it so they can be used again.
+	DeleteScheduledStatusByID(ctx context.Context, id string) Error
+
+	// DeleteScheduledStatusesByAccountID deletes all scheduled statuses owned by the given accountID.
+	DeleteScheduledStatusesByAccountID(ctx context.Context, accountID string) Error
+}
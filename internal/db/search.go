@@ -0,0 +1,47 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Search contains functions for searching through the contents of the database.
+type Search interface {
+	// SearchForStatuses performs a full-text search for local statuses whose content warning or text match
+	// the given query, returning matches newest first. If accountID is set, only statuses authored by that
+	// account will be returned. Statuses are paged using maxID and minID, which are both exclusive.
+	//
+	// The returned statuses are not filtered for visibility, that's up to the caller.
+	SearchForStatuses(ctx context.Context, query string, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.Status, Error)
+
+	// SearchForAccounts searches for known accounts, local or remote, with a username or display name
+	// starting with the given query. A query like "user@domain" matches on both username and domain.
+	// Only discoverable accounts are returned, plus any accounts that accountID follows; if following
+	// is true, only accounts that accountID follows are returned.
+	//
+	// The returned accounts are not filtered for blocks, that's up to the caller.
+	SearchForAccounts(ctx context.Context, accountID string, query string, following bool, offset int, limit int) ([]*gtsmodel.Account, Error)
+
+	// SearchForTags searches for listable tags with a name starting with the given query, ignoring case.
+	// An exact match comes first, then other matches in order of when they were last used, most recent first.
+	SearchForTags(ctx context.Context, query string, offset int, limit int) ([]*gtsmodel.Tag, Error)
+}
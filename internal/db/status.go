@@ -44,6 +44,13 @@ type Status interface {
 	// UpdateStatus updates one status in the database and returns it to the caller.
 	UpdateStatus(ctx context.Context, status *gtsmodel.Status) Error
 
+	// EditStatus stores the given edit, which should be the previous revision of the given status,
+	// and then updates the status in the database to its new revision, all in one transaction.
+	EditStatus(ctx context.Context, status *gtsmodel.Status, edit *gtsmodel.StatusEdit) Error
+
+	// GetStatusEdits returns all previous revisions of the status with the given ID, oldest first.
+	GetStatusEdits(ctx context.Context, statusID string) ([]*gtsmodel.StatusEdit, Error)
+
 	// DeleteStatusByID deletes one status from the database.
 	DeleteStatusByID(ctx context.Context, id string) Error
 
@@ -72,9 +79,14 @@ type Status interface {
 	// IsStatusRebloggedBy checks if a given status has been reblogged/boosted by a given account ID
 	IsStatusRebloggedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
-	// IsStatusMutedBy checks if a given status has been muted by a given account ID
+	// IsStatusMutedBy checks if a given status, or any status it replies to further up
+	// its thread, has been muted by a given account ID
 	IsStatusMutedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
+	// DeleteStatusMutes removes any mutes by the given account ID of the given status,
+	// or of any status it replies to further up its thread, so that it's no longer muted
+	DeleteStatusMutes(ctx context.Context, status *gtsmodel.Status, accountID string) Error
+
 	// IsStatusBookmarkedBy checks if a given status has been bookmarked by a given account ID
 	IsStatusBookmarkedBy(ctx context.Context, status *gtsmodel.Status, accountID string) (bool, Error)
 
@@ -0,0 +1,60 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// Tag contains functions for getting hashtags, and for getting, creating, and deleting tag follows.
+type Tag interface {
+	// GetTagByID gets one tag with the given id.
+	GetTagByID(ctx context.Context, id string) (*gtsmodel.Tag, Error)
+
+	// GetTagByName gets one tag with the given name. Names are compared case-insensitively.
+	GetTagByName(ctx context.Context, name string) (*gtsmodel.Tag, Error)
+
+	// GetTagHistory counts the uses of the tag with the given id on each of the given number of days
+	// up to and including today (in UTC), most recent day first.
+	GetTagHistory(ctx context.Context, tagID string, days int) ([]*gtsmodel.TagHistory, Error)
+
+	// GetFollowedTag gets the tag follow owned by the given accountID for the given tagID, populated with its tag.
+	GetFollowedTag(ctx context.Context, accountID string, tagID string) (*gtsmodel.FollowedTag, Error)
+
+	// GetFollowedTagsForAccountID gets tag follows owned by the given accountID, populated with their tags.
+	// Tag follows are returned in descending order of when they were created (newest first).
+	GetFollowedTagsForAccountID(ctx context.Context, accountID string, maxID string, minID string, limit int) ([]*gtsmodel.FollowedTag, Error)
+
+	// GetAccountIDsFollowingTagIDs returns the deduplicated IDs of accounts that follow any of the given tagIDs.
+	GetAccountIDsFollowingTagIDs(ctx context.Context, tagIDs []string) ([]string, Error)
+
+	// IsFollowingAnyTag returns true if the given accountID follows at least one of the given tagIDs.
+	IsFollowingAnyTag(ctx context.Context, accountID string, tagIDs []string) (bool, Error)
+
+	// PutFollowedTag puts a new tag follow in the database.
+	PutFollowedTag(ctx context.Context, followedTag *gtsmodel.FollowedTag) Error
+
+	// DeleteFollowedTag deletes the tag follow owned by the given accountID for the given tagID, if it exists.
+	DeleteFollowedTag(ctx context.Context, accountID string, tagID string) Error
+
+	// DeleteFollowedTagsByAccountID deletes all tag follows owned by the given accountID.
+	DeleteFollowedTagsByAccountID(ctx context.Context, accountID string) Error
+}
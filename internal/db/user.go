@@ -36,6 +36,11 @@ type User interface {
 	GetUserByExternalID(ctx context.Context, id string) (*gtsmodel.User, Error)
 	// GetUserByConfirmationToken returns one user by its confirmation token, or an error if something goes wrong.
 	GetUserByConfirmationToken(ctx context.Context, confirmationToken string) (*gtsmodel.User, Error)
+	// GetPendingUsers returns users whose sign-up is still awaiting approval by a moderator, newest first.
+	// Paging parameters are compared against the IDs of the users' accounts.
+	GetPendingUsers(ctx context.Context, maxID string, sinceID string, minID string, limit int) ([]*gtsmodel.User, Error)
+	// GetModeratorUsers returns all users with either the admin or the moderator role.
+	GetModeratorUsers(ctx context.Context) ([]*gtsmodel.User, Error)
 	// PutUser will attempt to place user in the database
 	PutUser(ctx context.Context, user *gtsmodel.User) Error
 	// UpdateUser updates one user by its primary key, updating either only the specified columns, or all of them.
@@ -0,0 +1,58 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package db
+
+import (
+	"context"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// WebPush contains functions for getting, creating, updating, and deleting Web Push subscriptions and VAPID keys.
+type WebPush interface {
+	// CreateInstanceVAPIDKeyPair generates a VAPID key pair for this instance, and stores it in the database.
+	// If a key pair already exists, this is a no-op.
+	CreateInstanceVAPIDKeyPair(ctx context.Context) Error
+
+	// GetVAPIDKeyPair gets this instance's VAPID key pair.
+	GetVAPIDKeyPair(ctx context.Context) (*gtsmodel.VAPIDKeyPair, Error)
+
+	// GetWebPushSubscriptionByTokenID gets the Web Push subscription created with the given OAuth token ID.
+	GetWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) (*gtsmodel.WebPushSubscription, Error)
+
+	// GetWebPushSubscriptionsByAccountID gets all Web Push subscriptions owned by the given accountID.
+	GetWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) ([]*gtsmodel.WebPushSubscription, Error)
+
+	// PutWebPushSubscription puts the given Web Push subscription in the database, replacing any
+	// existing subscription for the same token.
+	PutWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) Error
+
+	// UpdateWebPushSubscription updates the given Web Push subscription.
+	// If columns is empty, all columns will be updated.
+	UpdateWebPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription, columns ...string) Error
+
+	// DeleteWebPushSubscriptionByID deletes the Web Push subscription with the given ID.
+	DeleteWebPushSubscriptionByID(ctx context.Context, id string) Error
+
+	// DeleteWebPushSubscriptionByTokenID deletes the Web Push subscription created with the given OAuth token ID.
+	DeleteWebPushSubscriptionByTokenID(ctx context.Context, tokenID string) Error
+
+	// DeleteWebPushSubscriptionsByAccountID deletes all Web Push subscriptions owned by the given accountID.
+	DeleteWebPushSubscriptionsByAccountID(ctx context.Context, accountID string) Error
+}
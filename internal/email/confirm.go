@@ -29,6 +29,7 @@ import (
 const (
 	confirmTemplate = "email_confirm_text.tmpl"
 	confirmSubject  = "GoToSocial Email Confirmation"
+	approvedSubject = "GoToSocial Account Request Approved"
 )
 
 func (s *sender) SendConfirmEmail(toAddress string, data ConfirmData) error {
@@ -38,7 +39,7 @@ func (s *sender) SendConfirmEmail(toAddress string, data ConfirmData) error {
 	}
 	confirmBody := buf.String()
 
-	msg, err := assembleMessage(confirmSubject, confirmBody, toAddress, s.from)
+	msg, err := assembleMessage(data.subject(), confirmBody, toAddress, s.from)
 	if err != nil {
 		return err
 	}
@@ -57,4 +58,16 @@ type ConfirmData struct {
 	// Link to present to the receiver to click on and do the confirmation.
 	// Should be a full link with protocol eg., https://example.org/confirm_email?token=some-long-token
 	ConfirmLink string
+	// Approved should be true if this email is being sent because
+	// a moderator has just approved the receiver's sign-up request.
+	Approved bool
+	// Optional message from the moderator who approved the sign-up.
+	Message string
+}
+
+func (d ConfirmData) subject() string {
+	if d.Approved {
+		return approvedSubject
+	}
+	return confirmSubject
 }
@@ -56,7 +56,7 @@ func (s *noopSender) SendConfirmEmail(toAddress string, data ConfirmData) error
 	}
 	confirmBody := buf.String()
 
-	msg, err := assembleMessage(confirmSubject, confirmBody, toAddress, "test@example.org")
+	msg, err := assembleMessage(data.subject(), confirmBody, toAddress, "test@example.org")
 	if err != nil {
 		return err
 	}
@@ -89,3 +89,45 @@ func (s *noopSender) SendResetEmail(toAddress string, data ResetData) error {
 
 	return nil
 }
+
+func (s *noopSender) SendRejectEmail(toAddress string, data RejectData) error {
+	buf := &bytes.Buffer{}
+	if err := s.template.ExecuteTemplate(buf, rejectTemplate, data); err != nil {
+		return err
+	}
+	rejectBody := buf.String()
+
+	msg, err := assembleMessage(rejectSubject, rejectBody, toAddress, "test@example.org")
+	if err != nil {
+		return err
+	}
+
+	log.Tracef("NOT SENDING reject email to %s with contents: %s", toAddress, msg)
+
+	if s.sendCallback != nil {
+		s.sendCallback(toAddress, string(msg))
+	}
+
+	return nil
+}
+
+func (s *noopSender) SendModerationEmail(toAddress string, data ModerationData) error {
+	buf := &bytes.Buffer{}
+	if err := s.template.ExecuteTemplate(buf, moderationTemplate, data); err != nil {
+		return err
+	}
+	moderationBody := buf.String()
+
+	msg, err := assembleMessage(moderationSubject, moderationBody, toAddress, "test@example.org")
+	if err != nil {
+		return err
+	}
+
+	log.Tracef("NOT SENDING moderation email to %s with contents: %s", toAddress, msg)
+
+	if s.sendCallback != nil {
+		s.sendCallback(toAddress, string(msg))
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package email
+
+import (
+	"bytes"
+	"net/smtp"
+)
+
+const (
+	rejectTemplate = "email_reject_text.tmpl"
+	rejectSubject  = "GoToSocial Account Request Rejected"
+)
+
+func (s *sender) SendRejectEmail(toAddress string, data RejectData) error {
+	buf := &bytes.Buffer{}
+	if err := s.template.ExecuteTemplate(buf, rejectTemplate, data); err != nil {
+		return err
+	}
+	rejectBody := buf.String()
+
+	msg, err := assembleMessage(rejectSubject, rejectBody, toAddress, s.from)
+	if err != nil {
+		return err
+	}
+	return smtp.SendMail(s.hostAddress, s.auth, s.from, []string{toAddress}, msg)
+}
+
+// RejectData represents data passed into the rejected sign-up email template.
+type RejectData struct {
+	// Username to be addressed.
+	Username string
+	// URL of the instance to present to the receiver.
+	InstanceURL string
+	// Name of the instance to present to the receiver.
+	InstanceName string
+	// Optional message from the moderator who rejected the sign-up.
+	Message string
+}
@@ -33,6 +33,12 @@ type Sender interface {
 
 	// SendResetEmail sends a 'reset your password' style email to the given toAddress, with the given data.
 	SendResetEmail(toAddress string, data ResetData) error
+
+	// SendRejectEmail sends a 'your sign-up has been rejected' style email to the given toAddress, with the given data.
+	SendRejectEmail(toAddress string, data RejectData) error
+
+	// SendModerationEmail sends a 'the moderators took action on your account' style email to the given toAddress, with the given data.
+	SendModerationEmail(toAddress string, data ModerationData) error
 }
 
 // NewSender returns a new email Sender interface with the given configuration, or an error if something goes wrong.
@@ -42,6 +42,34 @@ func (suite *UtilTestSuite) TestTemplateConfirm() {
 	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Email Confirmation\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because you've requested an account on https://example.org.\r\n\r\nWe just need to confirm that this is your email address. To confirm your email, paste the following in your browser's address bar:\r\n\r\nhttps://example.org/confirm_email?token=ee24f71d-e615-43f9-afae-385c0799b7fa\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org\r\n\r\n", suite.sentEmails["user@example.org"])
 }
 
+func (suite *UtilTestSuite) TestTemplateConfirmApproved() {
+	confirmData := email.ConfirmData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		ConfirmLink:  "https://example.org/confirm_email?token=ee24f71d-e615-43f9-afae-385c0799b7fa",
+		Approved:     true,
+		Message:      "Welcome aboard!",
+	}
+
+	suite.sender.SendConfirmEmail("user@example.org", confirmData)
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Account Request Approved\r\n\r\nHello test!\r\n\r\nYour request for an account on https://example.org has been approved by the moderators.\r\n\r\nMessage from the moderators:\r\n\r\nWelcome aboard!\r\n\r\nWe just need to confirm that this is your email address. To confirm your email, paste the following in your browser's address bar:\r\n\r\nhttps://example.org/confirm_email?token=ee24f71d-e615-43f9-afae-385c0799b7fa\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *UtilTestSuite) TestTemplateConfirmApprovedAlreadyConfirmed() {
+	confirmData := email.ConfirmData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		Approved:     true,
+	}
+
+	suite.sender.SendConfirmEmail("user@example.org", confirmData)
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Account Request Approved\r\n\r\nHello test!\r\n\r\nYour request for an account on https://example.org has been approved by the moderators.\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
 func (suite *UtilTestSuite) TestTemplateReset() {
 	resetData := email.ResetData{
 		Username:     "test",
@@ -55,6 +83,33 @@ func (suite *UtilTestSuite) TestTemplateReset() {
 	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Password Reset\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because a password reset has been requested for your account on https://example.org.\r\n\r\nTo reset your password, paste the following in your browser's address bar:\r\n\r\nhttps://example.org/reset_email?token=ee24f71d-e615-43f9-afae-385c0799b7fa\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
 }
 
+func (suite *UtilTestSuite) TestTemplateReject() {
+	rejectData := email.RejectData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		Message:      "Sorry, we're only accepting people we know right now.",
+	}
+
+	suite.sender.SendRejectEmail("user@example.org", rejectData)
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Account Request Rejected\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because you've requested an account on https://example.org, but the moderators have rejected your request.\r\n\r\nMessage from the moderators:\r\n\r\nSorry, we're only accepting people we know right now.\r\n\r\nAny information you submitted with your request has been removed from https://example.org.\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
+func (suite *UtilTestSuite) TestTemplateModeration() {
+	moderationData := email.ModerationData{
+		Username:     "test",
+		InstanceURL:  "https://example.org",
+		InstanceName: "Test Instance",
+		Action:       "Your account has been limited.",
+		Message:      "Please stop posting in all caps.",
+	}
+
+	suite.sender.SendModerationEmail("user@example.org", moderationData)
+	suite.Len(suite.sentEmails, 1)
+	suite.Equal("To: user@example.org\r\nSubject: GoToSocial Moderation Notice\r\n\r\nHello test!\r\n\r\nYou are receiving this mail because the moderators of https://example.org have taken action on your account.\r\n\r\nYour account has been limited.\r\n\r\nMessage from the moderators:\r\n\r\nPlease stop posting in all caps.\r\n\r\nIf you have any questions about this, feel free to contact the administrator of https://example.org.\r\n\r\n", suite.sentEmails["user@example.org"])
+}
+
 func TestUtilTestSuite(t *testing.T) {
 	suite.Run(t, &UtilTestSuite{})
 }
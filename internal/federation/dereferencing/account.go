@@ -38,6 +38,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 )
 
@@ -75,6 +76,10 @@ type GetAccountParams struct {
 	// quickly fetch a remote account from the database or fail, and don't want to cause
 	// http requests to go flying around.
 	SkipResolve bool
+	// Whether to refetch the account from the remote instance even if we already have it
+	// in the database. This is useful when a decision depends on the account's fields being
+	// up to date, such as checking the aliases of an account that another has moved to.
+	Refresh bool
 	// PartialAccount can be used if the GetRemoteAccount call results from a federated/ap
 	// account update. In this case, we will already have a partial representation of the account,
 	// derived from converting the AP representation to a gtsmodel representation. If this field
@@ -215,6 +220,36 @@ func (d *deref) GetAccount(ctx context.Context, params GetAccountParams) (foundA
 	// if we reach this point, we have some remote calls to make
 
 	var accountable ap.Accountable
+	if params.Refresh && foundAccount != nil && params.PartialAccount == nil && params.RemoteAccountID != nil {
+		// we already have the account but we've been asked to refresh it, so
+		// dereference it now and treat the result as a partial account update
+		var derefErr error
+		accountable, derefErr = d.dereferenceAccountable(ctx, params.RequestingUsername, params.RemoteAccountID)
+		if derefErr != nil {
+			err = wrapDerefError(derefErr, "GetRemoteAccount: error dereferencing Accountable for refresh")
+			return
+		}
+
+		refreshed, convErr := d.typeConverter.ASRepresentationToAccount(ctx, accountable, foundAccount.Domain, true)
+		if convErr != nil {
+			err = newErrOther(fmt.Errorf("GetRemoteAccount: error converting Accountable to account for refresh: %w", convErr))
+			return
+		}
+
+		// keep the fields that only we know about
+		refreshed.ID = foundAccount.ID
+		refreshed.CreatedAt = foundAccount.CreatedAt
+		refreshed.Language = foundAccount.Language
+		refreshed.LastWebfingeredAt = foundAccount.LastWebfingeredAt
+		relme.KeepVerified(foundAccount.Fields, refreshed.Fields)
+		if refreshed.MovedToURI == foundAccount.MovedToURI {
+			refreshed.MovedToAccountID = foundAccount.MovedToAccountID
+		}
+
+		foundAccount = refreshed
+		params.PartialAccount = refreshed
+	}
+
 	if params.RemoteAccountUsername == "" && params.RemoteAccountHost == "" {
 		// if we're still missing some params, try to populate them now
 		params.RemoteAccountHost = params.RemoteAccountID.Host
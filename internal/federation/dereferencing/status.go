@@ -35,6 +35,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
 // EnrichRemoteStatus takes a remote status that's already been inserted into the database in a minimal form,
@@ -112,7 +113,11 @@ func (d *deref) GetStatus(ctx context.Context, username string, statusURI *url.U
 
 	if status != nil && refetch {
 		// we already had the status in the db, and we've also
-		// now fetched the AP representation as requested
+		// now fetched the AP representation as requested, so
+		// take the opportunity to refresh any poll vote counts
+		if err := d.refreshStatusPoll(ctx, status, statusable); err != nil {
+			log.Errorf("GetRemoteStatus: error refreshing poll for status %s: %s", uriString, err)
+		}
 		return status, statusable, nil
 	}
 
@@ -210,6 +215,12 @@ func (d *deref) dereferenceStatusable(ctx context.Context, username string, remo
 			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsNote")
 		}
 		return p, nil
+	case ap.ActivityQuestion:
+		p, ok := t.(vocab.ActivityStreamsQuestion)
+		if !ok {
+			return nil, errors.New("DereferenceStatusable: error resolving type as ActivityStreamsQuestion")
+		}
+		return p, nil
 	case ap.ObjectPage:
 		p, ok := t.(vocab.ActivityStreamsPage)
 		if !ok {
@@ -239,6 +250,37 @@ func (d *deref) dereferenceStatusable(ctx context.Context, username string, remo
 	return nil, newErrWrongType(fmt.Errorf("DereferenceStatusable: type name %s not supported as Statusable", t.GetTypeName()))
 }
 
+// refreshStatusPoll updates the vote counts, voters count, and expiry
+// of the poll attached to the given status, using the freshly
+// dereferenced statusable. Statuses without polls are left alone.
+func (d *deref) refreshStatusPoll(ctx context.Context, status *gtsmodel.Status, statusable ap.Statusable) error {
+	pollable, ok := statusable.(ap.Pollable)
+	if !ok || status.Poll == nil {
+		return nil
+	}
+
+	updated, err := ap.ExtractPoll(pollable)
+	if err != nil {
+		return err
+	}
+
+	poll := status.Poll
+	if len(updated.Votes) == len(poll.Votes) {
+		poll.Votes = updated.Votes
+		poll.Voters = updated.Voters
+	}
+
+	// if the poll was closed early, bring its expiry forward
+	// so that it gets closed (and voters notified) on our end
+	if !updated.ClosedAt.IsZero() && (poll.ExpiresAt.IsZero() || updated.ClosedAt.Before(poll.ExpiresAt)) {
+		poll.ExpiresAt = updated.ClosedAt
+	} else if !updated.ExpiresAt.IsZero() {
+		poll.ExpiresAt = updated.ExpiresAt
+	}
+
+	return d.db.UpdatePoll(ctx, poll, "votes", "voters", "expires_at")
+}
+
 // populateStatusFields fetches all the information we temporarily pinned to an incoming
 // federated status, back in the federating db's Create function.
 //
@@ -290,7 +332,9 @@ func (d *deref) populateStatusFields(ctx context.Context, status *gtsmodel.Statu
 	}
 
 	// 2. Hashtags
-	// TODO
+	if err := d.populateStatusTags(ctx, status); err != nil {
+		return fmt.Errorf("populateStatusFields: error populating status tags: %s", err)
+	}
 
 	// 3. Emojis
 	if err := d.populateStatusEmojis(ctx, status, requestingUsername); err != nil {
@@ -415,6 +459,13 @@ func (d *deref) populateStatusAttachments(ctx context.Context, status *gtsmodel.
 	attachments := []*gtsmodel.MediaAttachment{}
 
 	for _, a := range status.Attachments {
+		if a.ID != "" {
+			// we've already got this attachment, since it has an ID
+			attachmentIDs = append(attachmentIDs, a.ID)
+			attachments = append(attachments, a)
+			continue
+		}
+
 		a.AccountID = status.AccountID
 		a.StatusID = status.ID
 
@@ -446,6 +497,45 @@ func (d *deref) populateStatusAttachments(ctx context.Context, status *gtsmodel.
 	return nil
 }
 
+func (d *deref) populateStatusTags(ctx context.Context, status *gtsmodel.Status) error {
+	// Deduplicate the names of hashtags pinned to the status during
+	// conversion, and drop any that we wouldn't allow to be used locally.
+	names := make([]string, 0, len(status.Tags))
+	seen := make(map[string]struct{}, len(status.Tags))
+	for _, t := range status.Tags {
+		if err := validate.TagName(t.Name); err != nil {
+			log.Debugf("populateStatusTags: skipping tag: %s", err)
+			continue
+		}
+
+		key := strings.ToLower(t.Name)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		names = append(names, t.Name)
+	}
+
+	// Get existing tags, or create new ones pointing
+	// to this instance, as we do for local statuses.
+	tags, err := d.db.TagStringsToTags(ctx, names, status.AccountID)
+	if err != nil {
+		return err
+	}
+
+	tagIDs := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		if err := d.db.Put(ctx, tag); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+			return fmt.Errorf("populateStatusTags: error putting tag %s in db: %s", tag.Name, err)
+		}
+		tagIDs = append(tagIDs, tag.ID)
+	}
+
+	status.Tags = tags
+	status.TagIDs = tagIDs
+	return nil
+}
+
 func (d *deref) populateStatusEmojis(ctx context.Context, status *gtsmodel.Status, requestingUsername string) error {
 	emojis, err := d.populateEmojis(ctx, status.Emojis, requestingUsername)
 	if err != nil {
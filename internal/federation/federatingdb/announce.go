@@ -27,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStreamsAnnounce) error {
@@ -63,6 +64,7 @@ func (f *federatingDB) Announce(ctx context.Context, announce vocab.ActivityStre
 		APActivityType:   ap.ActivityCreate,
 		GTSModel:         boost,
 		ReceivingAccount: receivingAccount,
+		TraceContext:     trace.SpanContextFromContext(ctx),
 	})
 
 	return nil
@@ -23,6 +23,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
@@ -33,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Create adds a new entry to the database which must be able to be
@@ -115,6 +117,7 @@ func (f *federatingDB) activityBlock(ctx context.Context, asType vocab.Type, rec
 		APActivityType:   ap.ActivityCreate,
 		GTSModel:         block,
 		ReceivingAccount: receiving,
+		TraceContext:     trace.SpanContextFromContext(ctx),
 	})
 	return nil
 }
@@ -150,8 +153,25 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 		asObjectTypeName := asObjectType.GetTypeName()
 		switch asObjectTypeName {
 		case ap.ObjectNote:
+			note := objectIter.GetActivityStreamsNote()
+
+			// CREATE A POLL VOTE
+			isVote, err := f.createPollVote(ctx, note, requestingAccount)
+			if err != nil {
+				errs = append(errs, err.Error())
+				continue
+			}
+			if isVote {
+				continue
+			}
+
 			// CREATE A NOTE
-			if err := f.createNote(ctx, objectIter.GetActivityStreamsNote(), receivingAccount, requestingAccount); err != nil {
+			if err := f.createStatusable(ctx, note, receivingAccount, requestingAccount); err != nil {
+				errs = append(errs, err.Error())
+			}
+		case ap.ActivityQuestion:
+			// CREATE A QUESTION
+			if err := f.createStatusable(ctx, objectIter.GetActivityStreamsQuestion(), receivingAccount, requestingAccount); err != nil {
 				errs = append(errs, err.Error())
 			}
 		default:
@@ -166,8 +186,8 @@ func (f *federatingDB) activityCreate(ctx context.Context, asType vocab.Type, re
 	return nil
 }
 
-// createNote handles a Create activity with a Note type.
-func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStreamsNote, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
+// createStatusable handles a Create activity with a Note or Question type.
+func (f *federatingDB) createStatusable(ctx context.Context, statusable ap.Statusable, receivingAccount *gtsmodel.Account, requestingAccount *gtsmodel.Account) error {
 	l := log.WithFields(kv.Fields{
 		{"receivingAccount", receivingAccount.URI},
 		{"requestingAccount", requestingAccount.URI},
@@ -178,9 +198,9 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	forward := true
 
 	// note should have an attributedTo
-	noteAttributedTo := note.GetActivityStreamsAttributedTo()
+	noteAttributedTo := statusable.GetActivityStreamsAttributedTo()
 	if noteAttributedTo == nil {
-		return errors.New("createNote: note had no attributedTo")
+		return errors.New("createStatusable: note had no attributedTo")
 	}
 
 	// compare the attributedTo(s) with the actor who posted this to our inbox
@@ -198,27 +218,28 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 	// If we do have a forward, we should ignore the content for now and just dereference based on the URL/ID of the note instead, to get the note straight from the horse's mouth
 	if forward {
 		l.Trace("note is a forward")
-		id := note.GetJSONLDId()
+		id := statusable.GetJSONLDId()
 		if !id.IsIRI() {
 			// if the note id isn't an IRI, there's nothing we can do here
 			return nil
 		}
 		// pass the note iri into the processor and have it do the dereferencing instead of doing it here
 		f.fedWorker.Queue(messages.FromFederator{
-			APObjectType:     ap.ObjectNote,
+			APObjectType:     statusable.GetTypeName(),
 			APActivityType:   ap.ActivityCreate,
 			APIri:            id.GetIRI(),
 			GTSModel:         nil,
 			ReceivingAccount: receivingAccount,
+			TraceContext:     trace.SpanContextFromContext(ctx),
 		})
 		return nil
 	}
 
 	// if we reach this point, we know it's not a forwarded status, so proceed with processing it as normal
 
-	status, err := f.typeConverter.ASStatusToStatus(ctx, note)
+	status, err := f.typeConverter.ASStatusToStatus(ctx, statusable)
 	if err != nil {
-		return fmt.Errorf("createNote: error converting note to status: %s", err)
+		return fmt.Errorf("createStatusable: error converting note to status: %s", err)
 	}
 
 	// id the status based on the time it was created
@@ -235,7 +256,7 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 			return nil
 		}
 		// an actual error has happened
-		return fmt.Errorf("createNote: database error inserting status: %s", err)
+		return fmt.Errorf("createStatusable: database error inserting status: %s", err)
 	}
 
 	f.fedWorker.Queue(messages.FromFederator{
@@ -243,11 +264,117 @@ func (f *federatingDB) createNote(ctx context.Context, note vocab.ActivityStream
 		APActivityType:   ap.ActivityCreate,
 		GTSModel:         status,
 		ReceivingAccount: receivingAccount,
+		TraceContext:     trace.SpanContextFromContext(ctx),
 	})
 
 	return nil
 }
 
+// createPollVote handles a Create activity with a Note type, which is a vote in a local poll.
+// Poll votes are Notes with a name (the chosen option) and no content, replying to the Question.
+// If the note isn't a vote in a local poll, false will be returned, and the note should instead
+// be handled as a status.
+func (f *federatingDB) createPollVote(ctx context.Context, note vocab.ActivityStreamsNote, requestingAccount *gtsmodel.Account) (bool, error) {
+	if ap.ExtractContent(note) != "" {
+		return false, nil
+	}
+
+	name, err := ap.ExtractName(note)
+	if err != nil {
+		return false, nil
+	}
+
+	inReplyTo := ap.ExtractInReplyToURI(note)
+	if inReplyTo == nil {
+		return false, nil
+	}
+
+	status, err := f.db.GetStatusByURI(ctx, inReplyTo.String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return false, nil
+		}
+		return false, fmt.Errorf("createPollVote: error getting status %s: %w", inReplyTo, err)
+	}
+
+	if status.Poll == nil || !*status.Local {
+		return false, nil
+	}
+	poll := status.Poll
+
+	// From here on we know the note is a vote in one of our polls.
+	attributedTo, err := ap.ExtractAttributedTo(note)
+	if err != nil || attributedTo.String() != requestingAccount.URI {
+		return true, fmt.Errorf("createPollVote: vote in poll %s was not attributed to requesting account %s", poll.ID, requestingAccount.URI)
+	}
+
+	l := log.WithFields(kv.Fields{
+		{"poll", poll.ID},
+		{"requestingAccount", requestingAccount.URI},
+	}...)
+
+	if poll.Closed() || poll.Expired(time.Now()) {
+		l.Debug("ignoring vote in closed poll")
+		return true, nil
+	}
+
+	choice := -1
+	for i, option := range poll.Options {
+		if option == name {
+			choice = i
+			break
+		}
+	}
+	if choice == -1 {
+		return true, fmt.Errorf("createPollVote: poll %s has no option %q", poll.ID, name)
+	}
+
+	vote, err := f.db.GetPollVoteBy(ctx, poll.ID, requestingAccount.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return true, fmt.Errorf("createPollVote: error checking existing vote: %w", err)
+	}
+
+	if vote == nil {
+		voteID, err := id.NewULID()
+		if err != nil {
+			return true, err
+		}
+
+		vote = &gtsmodel.PollVote{
+			ID:        voteID,
+			Choices:   []int{choice},
+			AccountID: requestingAccount.ID,
+			PollID:    poll.ID,
+		}
+
+		if err := f.db.PutPollVote(ctx, vote); err != nil {
+			return true, fmt.Errorf("createPollVote: database error inserting vote: %w", err)
+		}
+
+		return true, nil
+	}
+
+	// The account has voted already; this is fine for multiple
+	// choice polls, where each choice is federated separately.
+	if poll.Multiple == nil || !*poll.Multiple {
+		l.Debug("ignoring repeat vote in single choice poll")
+		return true, nil
+	}
+
+	for _, c := range vote.Choices {
+		if c == choice {
+			// already got this one
+			return true, nil
+		}
+	}
+
+	if err := f.db.AddPollVoteChoices(ctx, vote, []int{choice}); err != nil {
+		return true, fmt.Errorf("createPollVote: database error adding vote choice: %w", err)
+	}
+
+	return true, nil
+}
+
 /*
 	FOLLOW HANDLERS
 */
@@ -278,6 +405,7 @@ func (f *federatingDB) activityFollow(ctx context.Context, asType vocab.Type, re
 		APActivityType:   ap.ActivityCreate,
 		GTSModel:         followRequest,
 		ReceivingAccount: receivingAccount,
+		TraceContext:     trace.SpanContextFromContext(ctx),
 	})
 
 	return nil
@@ -313,6 +441,7 @@ func (f *federatingDB) activityLike(ctx context.Context, asType vocab.Type, rece
 		APActivityType:   ap.ActivityCreate,
 		GTSModel:         fave,
 		ReceivingAccount: receivingAccount,
+		TraceContext:     trace.SpanContextFromContext(ctx),
 	})
 
 	return nil
@@ -348,6 +477,7 @@ func (f *federatingDB) activityFlag(ctx context.Context, asType vocab.Type, rece
 		APActivityType:   ap.ActivityCreate,
 		GTSModel:         report,
 		ReceivingAccount: receivingAccount,
+		TraceContext:     trace.SpanContextFromContext(ctx),
 	})
 
 	return nil
@@ -26,6 +26,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Delete removes the entry with the given id.
@@ -57,6 +58,7 @@ func (f *federatingDB) Delete(ctx context.Context, id *url.URL) error {
 			APActivityType:   ap.ActivityDelete,
 			GTSModel:         s,
 			ReceivingAccount: receivingAccount,
+			TraceContext:     trace.SpanContextFromContext(ctx),
 		})
 	}
 
@@ -67,6 +69,7 @@ func (f *federatingDB) Delete(ctx context.Context, id *url.URL) error {
 			APActivityType:   ap.ActivityDelete,
 			GTSModel:         a,
 			ReceivingAccount: receivingAccount,
+			TraceContext:     trace.SpanContextFromContext(ctx),
 		})
 	}
 
@@ -0,0 +1,115 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federatingdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"codeberg.org/gruf/go-logger/v2/level"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (f *federatingDB) Move(ctx context.Context, move vocab.ActivityStreamsMove) error {
+	if log.Level() >= level.DEBUG {
+		i, err := marshalItem(move)
+		if err != nil {
+			return err
+		}
+		l := log.WithField("move", i)
+		l.Debug("entering Move")
+	}
+
+	receivingAccount, requestingAccount := extractFromCtx(ctx)
+	if receivingAccount == nil {
+		// If the receiving account wasn't set on the context, that means this request didn't pass
+		// through the API, but came from inside GtS as the result of another activity on this instance. That being so,
+		// we can safely just ignore this activity, since we know we've already processed it elsewhere.
+		return nil
+	}
+
+	if requestingAccount == nil {
+		return errors.New("Move: requesting account wasn't set on context")
+	}
+
+	actorIRI, err := ap.ExtractActor(move)
+	if err != nil {
+		return fmt.Errorf("Move: error extracting actor: %s", err)
+	}
+
+	objectIRI, err := ap.ExtractObject(move)
+	if err != nil {
+		return fmt.Errorf("Move: error extracting object: %s", err)
+	}
+
+	targetIRI, err := ap.ExtractTarget(move)
+	if err != nil {
+		return fmt.Errorf("Move: error extracting target: %s", err)
+	}
+
+	// an account can only move itself
+	if actorIRI.String() != requestingAccount.URI || objectIRI.String() != requestingAccount.URI {
+		return fmt.Errorf("Move: move of %s by %s was requested by account %s, this is not valid", objectIRI, actorIRI, requestingAccount.URI)
+	}
+
+	if targetIRI.String() == requestingAccount.URI {
+		return fmt.Errorf("Move: account %s cannot move to itself", requestingAccount.URI)
+	}
+
+	if requestingAccount.Domain == "" || requestingAccount.Domain == config.GetHost() || requestingAccount.Domain == config.GetAccountDomain() {
+		// local accounts are moved by the processor, not by federated moves
+		return nil
+	}
+
+	// if the target is local then we know its aliases for sure,
+	// so check them now; remote targets are dereferenced and
+	// checked by the processor, so we don't block the inbox
+	if targetIRI.Host == config.GetHost() || targetIRI.Host == config.GetAccountDomain() {
+		targetAccount, err := f.db.GetAccountByURI(ctx, targetIRI.String())
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				return fmt.Errorf("Move: local target account %s not found", targetIRI)
+			}
+			return fmt.Errorf("Move: error getting local target account %s: %s", targetIRI, err)
+		}
+
+		if !targetAccount.IsAliasOf(requestingAccount) {
+			return fmt.Errorf("Move: target account %s does not list %s as an alias", targetIRI, requestingAccount.URI)
+		}
+	}
+
+	// pass to the processor for dereferencing the target and moving followers
+	f.fedWorker.Queue(messages.FromFederator{
+		APObjectType:     ap.ActorPerson,
+		APActivityType:   ap.ActivityMove,
+		APIri:            targetIRI,
+		GTSModel:         requestingAccount,
+		ReceivingAccount: receivingAccount,
+		TraceContext:     trace.SpanContextFromContext(ctx),
+	})
+
+	return nil
+}
@@ -0,0 +1,143 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federatingdb_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type MoveTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func newMove(actor string, object string, target string) vocab.ActivityStreamsMove {
+	move := streams.NewActivityStreamsMove()
+
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(testrig.URLMustParse(actor))
+	move.SetActivityStreamsActor(actorProp)
+
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(testrig.URLMustParse(object))
+	move.SetActivityStreamsObject(objectProp)
+
+	targetProp := streams.NewActivityStreamsTargetProperty()
+	targetProp.AppendIRI(testrig.URLMustParse(target))
+	move.SetActivityStreamsTarget(targetProp)
+
+	return move
+}
+
+func (suite *MoveTestSuite) TestMoveToRemote() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	movingAccount := suite.testAccounts["remote_account_1"]
+	targetAccount := suite.testAccounts["remote_account_2"]
+
+	ctx := createTestContext(receivingAccount, movingAccount)
+	move := newMove(movingAccount.URI, movingAccount.URI, targetAccount.URI)
+
+	err := suite.federatingDB.Move(ctx, move)
+	suite.NoError(err)
+
+	// the target is remote, so the alias
+	// check should be left to the processor
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ActorPerson, msg.APObjectType)
+	suite.Equal(ap.ActivityMove, msg.APActivityType)
+	suite.Equal(targetAccount.URI, msg.APIri.String())
+	suite.Equal(receivingAccount.ID, msg.ReceivingAccount.ID)
+
+	moved, ok := msg.GTSModel.(*gtsmodel.Account)
+	suite.True(ok)
+	suite.Equal(movingAccount.ID, moved.ID)
+}
+
+func (suite *MoveTestSuite) TestMoveToLocalAliased() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	movingAccount := suite.testAccounts["remote_account_1"]
+
+	targetAccount := &gtsmodel.Account{}
+	*targetAccount = *suite.testAccounts["local_account_2"]
+	targetAccount.AlsoKnownAs = []string{movingAccount.URI}
+	if err := suite.db.UpdateAccount(context.Background(), targetAccount); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	ctx := createTestContext(receivingAccount, movingAccount)
+	move := newMove(movingAccount.URI, movingAccount.URI, targetAccount.URI)
+
+	err := suite.federatingDB.Move(ctx, move)
+	suite.NoError(err)
+
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ActivityMove, msg.APActivityType)
+	suite.Equal(targetAccount.URI, msg.APIri.String())
+}
+
+func (suite *MoveTestSuite) TestMoveToLocalNotAliased() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	movingAccount := suite.testAccounts["remote_account_1"]
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	ctx := createTestContext(receivingAccount, movingAccount)
+	move := newMove(movingAccount.URI, movingAccount.URI, targetAccount.URI)
+
+	err := suite.federatingDB.Move(ctx, move)
+	suite.EqualError(err, "Move: target account http://localhost:8080/users/1happyturtle does not list http://fossbros-anonymous.io/users/foss_satan as an alias")
+	suite.Empty(suite.fromFederator)
+}
+
+func (suite *MoveTestSuite) TestMoveSomeoneElse() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_2"]
+	movingAccount := suite.testAccounts["remote_account_1"]
+
+	// remote_account_2 tries to move remote_account_1 to itself
+	ctx := createTestContext(receivingAccount, requestingAccount)
+	move := newMove(requestingAccount.URI, movingAccount.URI, requestingAccount.URI)
+
+	err := suite.federatingDB.Move(ctx, move)
+	suite.ErrorContains(err, "this is not valid")
+	suite.Empty(suite.fromFederator)
+}
+
+func (suite *MoveTestSuite) TestMoveNoTarget() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	movingAccount := suite.testAccounts["remote_account_1"]
+
+	ctx := createTestContext(receivingAccount, movingAccount)
+	move := newMove(movingAccount.URI, movingAccount.URI, movingAccount.URI)
+	move.SetActivityStreamsTarget(nil)
+
+	err := suite.federatingDB.Move(ctx, move)
+	suite.EqualError(err, "Move: error extracting target: target property was nil")
+	suite.Empty(suite.fromFederator)
+}
+
+func TestMoveTestSuite(t *testing.T) {
+	suite.Run(t, &MoveTestSuite{})
+}
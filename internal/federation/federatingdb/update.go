@@ -22,14 +22,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/activity/streams/vocab"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Update sets an existing entry to the database based on the value's
@@ -71,6 +75,24 @@ func (f *federatingDB) Update(ctx context.Context, asType vocab.Type) error {
 	}
 
 	typeName := asType.GetTypeName()
+	if typeName == ap.ActivityQuestion {
+		// it's an UPDATE to a poll, probably with new vote counts
+		question, ok := asType.(vocab.ActivityStreamsQuestion)
+		if !ok {
+			return errors.New("UPDATE: could not convert type to question")
+		}
+		return f.updateQuestion(ctx, question, requestingAcct)
+	}
+
+	if typeName == ap.ObjectNote {
+		// it's an UPDATE to a status, ie., an edit
+		note, ok := asType.(vocab.ActivityStreamsNote)
+		if !ok {
+			return errors.New("UPDATE: could not convert type to note")
+		}
+		return f.updateNote(ctx, note, requestingAcct, receivingAccount)
+	}
+
 	if typeName == ap.ActorApplication ||
 		typeName == ap.ActorGroup ||
 		typeName == ap.ActorOrganization ||
@@ -135,6 +157,11 @@ func (f *federatingDB) Update(ctx context.Context, asType vocab.Type) error {
 		updatedAcct.CreatedAt = requestingAcct.CreatedAt
 		updatedAcct.ID = requestingAcct.ID
 		updatedAcct.Language = requestingAcct.Language
+		relme.KeepVerified(requestingAcct.Fields, updatedAcct.Fields)
+		if updatedAcct.MovedToURI == requestingAcct.MovedToURI {
+			// the account we resolved a move to is still valid
+			updatedAcct.MovedToAccountID = requestingAcct.MovedToAccountID
+		}
 
 		// pass to the processor for further updating of eg., avatar/header, emojis
 		// the actual db insert/update will take place a bit later
@@ -143,8 +170,175 @@ func (f *federatingDB) Update(ctx context.Context, asType vocab.Type) error {
 			APActivityType:   ap.ActivityUpdate,
 			GTSModel:         updatedAcct,
 			ReceivingAccount: receivingAccount,
+			TraceContext:     trace.SpanContextFromContext(ctx),
 		})
 	}
 
 	return nil
 }
+
+// updateNote applies an edit of a remote status that we already have stored,
+// storing the previous revision of the status in its edit history.
+func (f *federatingDB) updateNote(ctx context.Context, note vocab.ActivityStreamsNote, requestingAcct *gtsmodel.Account, receivingAccount *gtsmodel.Account) error {
+	idProp := note.GetJSONLDId()
+	if idProp == nil || !idProp.IsIRI() {
+		return errors.New("UPDATE: note had no id")
+	}
+
+	existing, err := f.db.GetStatusByURI(ctx, idProp.GetIRI().String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// we don't know this status,
+			// so there's nothing to update
+			return nil
+		}
+		return fmt.Errorf("UPDATE: error getting status for note: %s", err)
+	}
+
+	if *existing.Local {
+		// no need to update local statuses
+		return nil
+	}
+
+	if requestingAcct.URI != existing.AccountURI {
+		return fmt.Errorf("UPDATE: update for note %s was requested by account %s, this is not valid", existing.URI, requestingAcct.URI)
+	}
+
+	updated, err := f.typeConverter.ASStatusToStatus(ctx, note)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error converting note to status: %s", err)
+	}
+
+	// ignore stale or repeated updates
+	if !updated.EditedAt.IsZero() && !updated.EditedAt.After(existing.EditedAt) {
+		return nil
+	}
+
+	// snapshot the current revision of the status before we change anything
+	edit, err := f.typeConverter.StatusToStatusEdit(ctx, existing)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error creating edit from status: %s", err)
+	}
+
+	// only content is allowed to change in an edit,
+	// so carry over everything else from the existing status
+	updated.ID = existing.ID
+	updated.CreatedAt = existing.CreatedAt
+	updated.UpdatedAt = time.Now()
+	updated.Local = existing.Local
+	updated.AccountID = existing.AccountID
+	updated.AccountURI = existing.AccountURI
+	updated.Account = existing.Account
+	updated.InReplyToID = existing.InReplyToID
+	updated.InReplyToURI = existing.InReplyToURI
+	updated.InReplyToAccountID = existing.InReplyToAccountID
+	updated.InReplyTo = existing.InReplyTo
+	updated.InReplyToAccount = existing.InReplyToAccount
+	updated.BoostOfID = existing.BoostOfID
+	updated.BoostOfAccountID = existing.BoostOfAccountID
+	updated.Visibility = existing.Visibility
+	updated.Pinned = existing.Pinned
+	updated.Federated = existing.Federated
+	updated.Boostable = existing.Boostable
+	updated.Replyable = existing.Replyable
+	updated.Likeable = existing.Likeable
+	updated.PollID = existing.PollID
+	updated.Poll = existing.Poll
+	updated.ActivityStreamsType = existing.ActivityStreamsType
+	updated.CreatedWithApplicationID = existing.CreatedWithApplicationID
+	if updated.EditedAt.IsZero() {
+		updated.EditedAt = updated.UpdatedAt
+	}
+
+	// reuse mentions + attachments that are unchanged by the edit,
+	// so we don't have to dereference them all over again
+	updated.MentionIDs = []string{}
+	for i, m := range updated.Mentions {
+		for _, em := range existing.Mentions {
+			if em.TargetAccountURI == m.TargetAccountURI {
+				updated.Mentions[i] = em
+				updated.MentionIDs = append(updated.MentionIDs, em.ID)
+				break
+			}
+		}
+	}
+
+	updated.AttachmentIDs = []string{}
+	for i, a := range updated.Attachments {
+		for _, ea := range existing.Attachments {
+			if ea.RemoteURL == a.RemoteURL {
+				updated.Attachments[i] = ea
+				updated.AttachmentIDs = append(updated.AttachmentIDs, ea.ID)
+				break
+			}
+		}
+	}
+
+	if err := f.db.EditStatus(ctx, updated, edit); err != nil {
+		return fmt.Errorf("UPDATE: error editing status: %s", err)
+	}
+
+	// pass to the processor for further dereferencing of
+	// eg., new attachments and mentions, and for timelining
+	f.fedWorker.Queue(messages.FromFederator{
+		APObjectType:     ap.ObjectNote,
+		APActivityType:   ap.ActivityUpdate,
+		GTSModel:         updated,
+		ReceivingAccount: receivingAccount,
+		TraceContext:     trace.SpanContextFromContext(ctx),
+	})
+
+	return nil
+}
+
+// updateQuestion refreshes the vote counts and expiry of a
+// remote poll that we already have stored, from the given question.
+func (f *federatingDB) updateQuestion(ctx context.Context, question vocab.ActivityStreamsQuestion, requestingAcct *gtsmodel.Account) error {
+	idProp := question.GetJSONLDId()
+	if idProp == nil || !idProp.IsIRI() {
+		return errors.New("UPDATE: question had no id")
+	}
+
+	status, err := f.db.GetStatusByURI(ctx, idProp.GetIRI().String())
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// we don't know this status,
+			// so there's nothing to update
+			return nil
+		}
+		return fmt.Errorf("UPDATE: error getting status for question: %s", err)
+	}
+
+	if status.Poll == nil || *status.Local {
+		return nil
+	}
+
+	if requestingAcct.URI != status.AccountURI {
+		return fmt.Errorf("UPDATE: update for question %s was requested by account %s, this is not valid", status.URI, requestingAcct.URI)
+	}
+
+	updated, err := ap.ExtractPoll(question)
+	if err != nil {
+		return fmt.Errorf("UPDATE: error extracting poll from question: %s", err)
+	}
+
+	poll := status.Poll
+	if len(updated.Votes) == len(poll.Votes) {
+		poll.Votes = updated.Votes
+		poll.Voters = updated.Voters
+	}
+
+	// if the poll was closed early, bring its expiry forward
+	// so that it gets closed (and voters notified) on our end
+	if !updated.ClosedAt.IsZero() && (poll.ExpiresAt.IsZero() || updated.ClosedAt.Before(poll.ExpiresAt)) {
+		poll.ExpiresAt = updated.ClosedAt
+	} else if !updated.ExpiresAt.IsZero() {
+		poll.ExpiresAt = updated.ExpiresAt
+	}
+
+	if err := f.db.UpdatePoll(ctx, poll, "votes", "voters", "expires_at"); err != nil {
+		return fmt.Errorf("UPDATE: error updating poll: %s", err)
+	}
+
+	return nil
+}
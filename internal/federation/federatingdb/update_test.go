@@ -0,0 +1,123 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package federatingdb_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type UpdateTestSuite struct {
+	FederatingDBTestSuite
+}
+
+func (suite *UpdateTestSuite) editedNote(status *gtsmodel.Status, content string) vocab.Type {
+	raw := `{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "id": "` + status.URI + `",
+  "url": "` + status.URL + `",
+  "type": "Note",
+  "attributedTo": "` + status.AccountURI + `",
+  "content": "` + content + `",
+  "published": "2021-09-20T10:40:37Z",
+  "updated": "2022-12-01T11:00:00Z",
+  "to": "https://www.w3.org/ns/activitystreams#Public",
+  "attachment": [
+    {
+      "type": "Document",
+      "mediaType": "image/jpeg",
+      "url": "http://fossbros-anonymous.io/attachments/original/13bbc3f8-2b5e-46ea-9531-40b4974d9912.jpg"
+    }
+  ]
+}`
+
+	m := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(raw), &m); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	t, err := streams.ToType(context.Background(), m)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return t
+}
+
+func (suite *UpdateTestSuite) TestUpdateNote() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_1"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	err := suite.federatingDB.Update(ctx, suite.editedNote(targetStatus, "dark souls status bot: thoughts of cat"))
+	suite.NoError(err)
+
+	// should be a message heading to the processor now, which we can intercept here
+	msg := <-suite.fromFederator
+	suite.Equal(ap.ObjectNote, msg.APObjectType)
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+
+	status := msg.GTSModel.(*gtsmodel.Status)
+	suite.Equal(targetStatus.ID, status.ID)
+	suite.Equal(targetStatus.Visibility, status.Visibility)
+	suite.Equal("2022-12-01T11:00:00Z", status.EditedAt.UTC().Format("2006-01-02T15:04:05Z"))
+
+	// the existing attachment should have been reused
+	suite.Equal(targetStatus.AttachmentIDs, status.AttachmentIDs)
+
+	// status should be updated in the database
+	dbStatus, err := suite.db.GetStatusByID(context.Background(), targetStatus.ID)
+	suite.NoError(err)
+	suite.Equal("dark souls status bot: thoughts of cat", dbStatus.Content)
+
+	// and the previous revision should be stored
+	edits, err := suite.db.GetStatusEdits(context.Background(), targetStatus.ID)
+	suite.NoError(err)
+	suite.Len(edits, 1)
+	suite.Equal(targetStatus.Content, edits[0].Content)
+}
+
+func (suite *UpdateTestSuite) TestUpdateNoteWrongAccount() {
+	receivingAccount := suite.testAccounts["local_account_1"]
+	requestingAccount := suite.testAccounts["remote_account_2"]
+	targetStatus := suite.testStatuses["remote_account_1_status_1"]
+
+	ctx := createTestContext(receivingAccount, requestingAccount)
+
+	err := suite.federatingDB.Update(ctx, suite.editedNote(targetStatus, "this isn't my status"))
+	suite.EqualError(err, "UPDATE: update for note "+targetStatus.URI+" was requested by account "+requestingAccount.URI+", this is not valid")
+
+	// status should be unchanged in the database
+	dbStatus, err := suite.db.GetStatusByID(context.Background(), targetStatus.ID)
+	suite.NoError(err)
+	suite.Equal(targetStatus.Content, dbStatus.Content)
+}
+
+func TestUpdateTestSuite(t *testing.T) {
+	suite.Run(t, new(UpdateTestSuite))
+}
@@ -236,7 +236,9 @@ func (f *federator) AuthenticatePostInbox(ctx context.Context, w http.ResponseWr
 func (f *federator) Blocked(ctx context.Context, actorIRIs []*url.URL) (bool, error) {
 	log.Debugf("entering BLOCKED function with IRI list: %+v", actorIRIs)
 
-	// check domain blocks first for the given actor IRIs
+	// check domain blocks first for the given actor IRIs; when
+	// we're in allowlist mode, this also blocks any domain that
+	// hasn't been explicitly allowed
 	blocked, err := f.db.AreURIsBlocked(ctx, actorIRIs)
 	if err != nil {
 		return false, fmt.Errorf("error checking domain blocks of actorIRIs: %s", err)
@@ -363,6 +365,9 @@ func (f *federator) FederatingCallbacks(ctx context.Context) (wrapped pub.Federa
 		func(ctx context.Context, announce vocab.ActivityStreamsAnnounce) error {
 			return f.FederatingDB().Announce(ctx, announce)
 		},
+		func(ctx context.Context, move vocab.ActivityStreamsMove) error {
+			return f.FederatingDB().Move(ctx, move)
+		},
 	}
 
 	return
@@ -47,8 +47,10 @@ type Account struct {
 	Note                    string           `validate:"-" bun:""`                                                                                                   // A note that this account has on their profile (ie., the account's bio/description of themselves)
 	NoteRaw                 string           `validate:"-" bun:""`                                                                                                   // The raw contents of .Note without conversion to HTML, only available when requester = target
 	Memorial                *bool            `validate:"-" bun:",default:false"`                                                                                     // Is this a memorial account, ie., has the user passed away?
-	AlsoKnownAs             string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                // This account is associated with x account id (TODO: migrate to be AlsoKnownAsID)
+	AlsoKnownAs             []string         `validate:"dive,url" bun:",array"`                                                                                      // ActivityPub URIs of other accounts that this account is also known as, ie., aliases it may have moved from or to
 	MovedToAccountID        string           `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                // This account has moved this account id in the database
+	MovedToURI              string           `validate:"omitempty,url" bun:",nullzero"`                                                                              // ActivityPub URI of the account that this account has moved to
+	MovedToAccount          *Account         `validate:"-" bun:"-"`                                                                                                  // Account corresponding to movedToAccountID, if it's known to us
 	Bot                     *bool            `validate:"-" bun:",default:false"`                                                                                     // Does this account identify itself as a bot?
 	Reason                  string           `validate:"-" bun:""`                                                                                                   // What reason was given for signing up when this account was created?
 	Locked                  *bool            `validate:"-" bun:",default:true"`                                                                                      // Does this account need an approval for new followers?
@@ -79,6 +81,17 @@ type Account struct {
 	EnableRSS               *bool            `validate:"-" bun:",default:false"`                                                                                     // enable RSS feed subscription for this account's public posts at [URL]/feed
 }
 
+// IsAliasOf returns whether the given account is listed
+// in this account's aliases, ie., its alsoKnownAs URIs.
+func (a *Account) IsAliasOf(other *Account) bool {
+	for _, uri := range a.AlsoKnownAs {
+		if uri == other.URI {
+			return true
+		}
+	}
+	return false
+}
+
 // AccountToEmoji is an intermediate struct to facilitate the many2many relationship between an account and one or more emojis.
 type AccountToEmoji struct {
 	AccountID string   `validate:"ulid,required" bun:"type:CHAR(26),unique:accountemoji,nullzero,notnull"`
@@ -0,0 +1,46 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// AccountExport represents an archive of all of a local account's data, which the
+// account has requested for download. The archive itself is built asynchronously,
+// and stored in storage at Path once it's complete.
+type AccountExport struct {
+	ID          string             `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt   time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt   time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID   string             `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // which account requested this export?
+	Account     *Account           `validate:"-" bun:"-"`                                                           // account corresponding to accountID
+	State       AccountExportState `validate:"oneof=processing complete failed" bun:",nullzero,notnull"`            // current state of the export
+	Path        string             `validate:"-" bun:",nullzero"`                                                   // path of the complete archive in storage
+	Size        int64              `validate:"-" bun:",nullzero"`                                                   // size of the complete archive in bytes
+	CompletedAt time.Time          `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when was the archive completed?
+}
+
+// AccountExportState describes the state of an account export.
+type AccountExportState string
+
+// AccountExportState values.
+const (
+	AccountExportStateProcessing AccountExportState = "processing" // archive is still being built
+	AccountExportStateComplete   AccountExportState = "complete"   // archive is ready for download
+	AccountExportStateFailed     AccountExportState = "failed"     // something went wrong building the archive
+)
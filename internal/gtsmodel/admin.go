@@ -22,27 +22,43 @@ import "time"
 
 // AdminAccountAction models an action taken by an instance administrator on an account.
 type AdminAccountAction struct {
-	ID              string          `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
-	CreatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
-	UpdatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
-	AccountID       string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // Who performed this admin action.
-	Account         *Account        `validate:"-" bun:"rel:has-one"`                                                 // Account corresponding to accountID
-	TargetAccountID string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // Who is the target of this action
-	TargetAccount   *Account        `validate:"-" bun:"rel:has-one"`                                                 // Account corresponding to targetAccountID
-	Text            string          `validate:"-" bun:""`                                                            // text explaining why this action was taken
-	Type            AdminActionType `validate:"oneof=disable silence suspend" bun:",nullzero,notnull"`               // type of action that was taken
-	SendEmail       bool            `validate:"-" bun:""`                                                            // should an email be sent to the account owner to explain what happened
-	ReportID        string          `validate:",omitempty,ulid" bun:"type:CHAR(26),nullzero"`                        // id of a report connected to this action, if it exists
+	ID              string          `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                                                               // id of this item in the database
+	CreatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                        // when was item created
+	UpdatedAt       time.Time       `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                                                        // when was item last updated
+	AccountID       string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                                                                         // Who performed this admin action.
+	Account         *Account        `validate:"-" bun:"rel:has-one"`                                                                                                        // Account corresponding to accountID
+	TargetAccountID string          `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                                                                         // Who is the target of this action
+	TargetAccount   *Account        `validate:"-" bun:"rel:has-one"`                                                                                                        // Account corresponding to targetAccountID
+	Text            string          `validate:"-" bun:""`                                                                                                                   // text explaining why this action was taken
+	Type            AdminActionType `validate:"oneof=none disable sensitive silence suspend enable unsensitive unsilence unsuspend approve reject" bun:",nullzero,notnull"` // type of action that was taken
+	SendEmail       bool            `validate:"-" bun:""`                                                                                                                   // should an email be sent to the account owner to explain what happened
+	ReportID        string          `validate:",omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                                                               // id of a report connected to this action, if it exists
 }
 
 // AdminActionType describes a type of action taken on an entity by an admin
 type AdminActionType string
 
 const (
+	// AdminActionNone -- no action was taken on the account, but its owner may have been sent a warning.
+	AdminActionNone AdminActionType = "none"
 	// AdminActionDisable -- the account or application etc has been disabled but not deleted.
 	AdminActionDisable AdminActionType = "disable"
+	// AdminActionSensitive -- the account's media has been forced to be marked as sensitive.
+	AdminActionSensitive AdminActionType = "sensitive"
 	// AdminActionSilence -- the account or application etc has been silenced.
 	AdminActionSilence AdminActionType = "silence"
 	// AdminActionSuspend -- the account or application etc has been deleted.
 	AdminActionSuspend AdminActionType = "suspend"
+	// AdminActionEnable -- a previous disable of the account has been undone.
+	AdminActionEnable AdminActionType = "enable"
+	// AdminActionUnsensitive -- a previous sensitive action on the account has been undone.
+	AdminActionUnsensitive AdminActionType = "unsensitive"
+	// AdminActionUnsilence -- a previous silence of the account has been undone.
+	AdminActionUnsilence AdminActionType = "unsilence"
+	// AdminActionUnsuspend -- a previous suspension of the account has been undone.
+	AdminActionUnsuspend AdminActionType = "unsuspend"
+	// AdminActionApprove -- the account's pending sign-up has been approved.
+	AdminActionApprove AdminActionType = "approve"
+	// AdminActionReject -- the account's pending sign-up has been rejected.
+	AdminActionReject AdminActionType = "reject"
 )
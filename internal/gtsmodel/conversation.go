@@ -0,0 +1,47 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Conversation represents one local account's view of a thread of direct
+// messages, between that account and a particular set of other accounts.
+type Conversation struct {
+	ID               string     `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                        // id of this item in the database
+	CreatedAt        time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                 // when was item created
+	UpdatedAt        time.Time  `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`                 // when was item last updated
+	AccountID        string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:conversationaccountthread"` // Local account that owns this view of the conversation.
+	Account          *Account   `validate:"-" bun:"-"`                                                                           // Account corresponding to accountID
+	OtherAccountIDs  []string   `validate:"dive,ulid" bun:"other_account_ids,array"`                                             // Other accounts participating in the conversation, sorted by ID.
+	OtherAccounts    []*Account `validate:"-" bun:"-"`                                                                           // Accounts corresponding to otherAccountIDs
+	OtherAccountsKey string     `validate:"-" bun:",notnull,unique:conversationaccountthread"`                                   // Other account IDs joined into one string, to make the participants unique per thread.
+	ThreadID         string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:conversationaccountthread"` // ID of the status at the top of the thread this conversation is about.
+	LastStatusID     string     `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                                  // ID of the most recent status in the conversation.
+	LastStatus       *Status    `validate:"-" bun:"-"`                                                                           // Status corresponding to lastStatusID
+	Read             *bool      `validate:"-" bun:",nullzero,notnull,default:false"`                                             // Has the owning account read the most recent status in the conversation?
+}
+
+// ConversationToStatus is an intermediate struct to facilitate
+// the many2many relationship between conversations and statuses.
+type ConversationToStatus struct {
+	ConversationID string        `validate:"ulid,required" bun:"type:CHAR(26),unique:conversationstatus,nullzero,notnull"`
+	Conversation   *Conversation `validate:"-" bun:"rel:belongs-to"`
+	StatusID       string        `validate:"ulid,required" bun:"type:CHAR(26),unique:conversationstatus,nullzero,notnull"`
+	Status         *Status       `validate:"-" bun:"rel:belongs-to"`
+}
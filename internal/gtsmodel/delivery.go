@@ -0,0 +1,39 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Delivery represents an outgoing federation delivery which
+// couldn't be completed straight away, and is queued to be
+// retried later on. The payload is stored exactly as it was
+// first attempted, and is re-signed with the key identified
+// by PubKeyID on each new attempt.
+type Delivery struct {
+	ID            string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt     time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	PubKeyID      string    `validate:"required,url" bun:",nullzero,notnull"`                                // URI of the public key of the local account that should sign this delivery
+	TargetURI     string    `validate:"required,url" bun:",nullzero,notnull"`                                // URI of the inbox this delivery should be POSTed to
+	TargetDomain  string    `validate:"required,fqdn" bun:",nullzero,notnull"`                               // domain of the target inbox
+	Payload       []byte    `validate:"required" bun:",nullzero,notnull"`                                    // serialized activity to deliver
+	Attempts      int       `validate:"min=0" bun:",notnull,default:0"`                                      // number of attempts made so far, including the first one
+	NextAttemptAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull"`                           // when should this delivery next be attempted?
+	LastError     string    `validate:"-" bun:",nullzero"`                                                   // error returned by the most recent attempt
+}
@@ -0,0 +1,53 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// DomainBlockSubscription represents a blocklist published at some URI, which
+// this instance periodically fetches, creating or removing domain blocks so
+// that they match the domains in the list.
+type DomainBlockSubscription struct {
+	ID                    string                         `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt             time.Time                      `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt             time.Time                      `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Title                 string                         `validate:"-" bun:",nullzero"`                                                   // Title of this subscription, viewable to admins
+	URI                   string                         `validate:"required,url" bun:",nullzero,notnull,unique"`                         // URI of the blocklist, eg 'https://example.org/blocklist.csv' or 'file:///etc/gotosocial/blocklist.txt'
+	ContentType           DomainBlockSubscriptionContent `validate:"required" bun:",nullzero,notnull"`                                    // Format of the blocklist
+	CreatedByAccountID    string                         `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account ID of the creator of this subscription, who blocks created through it will be attributed to
+	CreatedByAccount      *Account                       `validate:"-" bun:"rel:belongs-to"`                                              // Account corresponding to createdByAccountID
+	Obfuscate             *bool                          `validate:"-" bun:",nullzero,notnull,default:false"`                             // Obfuscate domain blocks created through this subscription, if the list doesn't say whether to?
+	FetchedAt             time.Time                      `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When was the blocklist last fetched (successfully or not)?
+	SuccessfullyFetchedAt time.Time                      `validate:"-" bun:"type:timestamptz,nullzero"`                                   // When was the blocklist last fetched and parsed successfully?
+	Error                 string                         `validate:"-" bun:",nullzero"`                                                   // Error encountered during the last fetch, if any
+	Conflicts             []string                       `validate:"-" bun:",array"`                                                      // Domains in the blocklist that are already blocked by a manual block or another subscription
+}
+
+// DomainBlockSubscriptionContent is the format of the blocklist that a domain block subscription points to.
+type DomainBlockSubscriptionContent string
+
+// DomainBlockSubscriptionContent values.
+const (
+	// DomainBlockSubscriptionContentPlain is a blocklist with one domain per line; empty lines and lines starting with '#' are ignored.
+	DomainBlockSubscriptionContentPlain DomainBlockSubscriptionContent = "text/plain"
+	// DomainBlockSubscriptionContentCSV is a blocklist in the CSV format used by Mastodon domain block exports.
+	DomainBlockSubscriptionContentCSV DomainBlockSubscriptionContent = "text/csv"
+	// DomainBlockSubscriptionContentJSON is a blocklist in the JSON format used by GoToSocial domain block exports.
+	DomainBlockSubscriptionContentJSON DomainBlockSubscriptionContent = "application/json"
+)
@@ -0,0 +1,95 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Filter models a user-defined keyword filter, which is used to hide
+// or warn about statuses containing certain words in certain contexts.
+type Filter struct {
+	ID                   string           `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	ExpiresAt            time.Time        `validate:"-" bun:"type:timestamptz,nullzero"`                                   // Time filter should expire. If null, should not expire.
+	AccountID            string           `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                  // ID of the local account that created the filter.
+	Title                string           `validate:"required" bun:",nullzero,notnull"`                                    // The name of the filter.
+	Action               FilterAction     `validate:"oneof=warn hide" bun:",nullzero,notnull,default:'warn'"`              // The action to take when a status matches this filter.
+	Keywords             []*FilterKeyword `validate:"-" bun:"-"`                                                           // Keywords for this filter.
+	ContextHome          *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to home timeline and lists.
+	ContextNotifications *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to notifications.
+	ContextPublic        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter to public timelines.
+	ContextThread        *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter when viewing a status's associated thread.
+	ContextAccount       *bool            `validate:"-" bun:",nullzero,notnull,default:false"`                             // Apply filter when viewing an account profile.
+}
+
+// Expired returns true if the filter has an expiry
+// time set, and that time has passed at the given now.
+func (f *Filter) Expired(now time.Time) bool {
+	return !f.ExpiresAt.IsZero() && !f.ExpiresAt.After(now)
+}
+
+// AppliesTo returns true if the filter should be applied in the given context.
+func (f *Filter) AppliesTo(context FilterContext) bool {
+	var b *bool
+	switch context {
+	case FilterContextHome:
+		b = f.ContextHome
+	case FilterContextNotifications:
+		b = f.ContextNotifications
+	case FilterContextPublic:
+		b = f.ContextPublic
+	case FilterContextThread:
+		b = f.ContextThread
+	case FilterContextAccount:
+		b = f.ContextAccount
+	}
+	return b != nil && *b
+}
+
+// FilterKeyword models a single keyword or phrase belonging to a filter.
+type FilterKeyword struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item last updated
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero"`                              // ID of the local account that created the filter keyword.
+	FilterID  string    `validate:"required,ulid" bun:"type:CHAR(26),notnull,nullzero,unique:filterkeywordfilterkw"` // ID of the filter that this keyword belongs to.
+	Filter    *Filter   `validate:"-" bun:"-"`                                                                       // Filter corresponding to FilterID
+	Keyword   string    `validate:"required" bun:",nullzero,notnull,unique:filterkeywordfilterkw"`                   // The keyword or phrase to filter against.
+	WholeWord *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                                         // Should the filter consider word boundaries?
+}
+
+// FilterAction denotes what should be done
+// with a status that matches a filter.
+type FilterAction string
+
+const (
+	FilterActionWarn FilterAction = "warn" // Show the status behind a warning naming the filter.
+	FilterActionHide FilterAction = "hide" // Don't show the status at all.
+)
+
+// FilterContext denotes where a filter should be applied.
+type FilterContext string
+
+const (
+	FilterContextHome          FilterContext = "home"          // Home timeline and lists.
+	FilterContextNotifications FilterContext = "notifications" // Notifications timeline.
+	FilterContextPublic        FilterContext = "public"        // Public timelines.
+	FilterContextThread        FilterContext = "thread"        // Expanded thread of a detailed status.
+	FilterContextAccount       FilterContext = "account"       // Account profile statuses.
+)
@@ -40,4 +40,8 @@ type Instance struct {
 	ContactAccount         *Account     `validate:"-" bun:"rel:belongs-to"`                                                           // account corresponding to contactAccountID
 	Reputation             int64        `validate:"-" bun:",notnull,default:0"`                                                       // Reputation score of this instance
 	Version                string       `validate:"-" bun:",nullzero"`                                                                // Version of the software used on this instance
+	Failures               int          `validate:"min=0" bun:",notnull"`                                                             // Number of consecutive failed requests to this instance, 0 if the last request succeeded
+	FailingSince           time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When did the current run of failed requests start, if any?
+	LastFailedAt           time.Time    `validate:"-" bun:"type:timestamptz,nullzero"`                                                // When did a request to this instance last fail?
+	LastFailure            string       `validate:"-" bun:",nullzero"`                                                                // Error returned by the last failed request to this instance
 }
@@ -0,0 +1,45 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Invite models an invite code created by a local account, which
+// lets whoever holds it sign up on this instance even if registration
+// is closed. Users who signed up with an invite point back to it with
+// their InviteID, so we can tell who invited whom.
+type Invite struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Code      string    `validate:"required" bun:",nullzero,notnull,unique"`                             // code used to redeem this invite, as it appears in /invite/:code
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // which account created this invite
+	Account   *Account  `validate:"-" bun:"-"`                                                           // account corresponding to AccountID
+	MaxUses   int       `validate:"min=0" bun:",notnull,default:0"`                                      // how many times can this invite be used? 0 means no limit
+	Uses      int       `validate:"min=0" bun:",notnull,default:0"`                                      // how many times has this invite been used so far?
+	ExpiresAt time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // when does this invite stop being usable? zero means never
+}
+
+// Usable returns whether this invite can still be used to sign up at the given time.
+func (i *Invite) Usable(now time.Time) bool {
+	if !i.ExpiresAt.IsZero() && !now.Before(i.ExpiresAt) {
+		return false
+	}
+	return i.MaxUses == 0 || i.Uses < i.MaxUses
+}
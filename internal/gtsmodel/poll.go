@@ -0,0 +1,70 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// Poll represents an attached (to) Status poll, i.e. a questionaire. Can be remote / local.
+type Poll struct {
+	ID         string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	Multiple   *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Is this a multiple choice poll? i.e. can you vote on multiple options.
+	HideCounts *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Hides vote counts until poll ends.
+	Options    []string  `validate:"min=2" bun:",notnull"`                                                // The available options for this poll.
+	Votes      []int     `validate:"-" bun:",notnull"`                                                    // Vote counts per choice, indices map to the Options slice.
+	Voters     int       `validate:"-" bun:",notnull,default:0"`                                          // Total number of accounts that have voted in this poll.
+	StatusID   string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // Status ID of which this Poll is attached to.
+	Status     *Status   `validate:"-" bun:"-"`                                                           // The related Status for StatusID (not always set).
+	ExpiresAt  time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // The expiry date of this Poll. If null, poll does not expire.
+	ClosedAt   time.Time `validate:"-" bun:"type:timestamptz,nullzero"`                                   // The date at which this poll was closed and voters notified.
+}
+
+// Expired returns true if the poll has an expiry
+// time set, and that time has passed at the given now.
+func (p *Poll) Expired(now time.Time) bool {
+	return !p.ExpiresAt.IsZero() && !p.ExpiresAt.After(now)
+}
+
+// Closed returns whether the poll has been closed.
+func (p *Poll) Closed() bool {
+	return !p.ClosedAt.IsZero()
+}
+
+// TotalVotes returns the sum of all vote counts for the poll.
+func (p *Poll) TotalVotes() int {
+	var total int
+	for _, c := range p.Votes {
+		total += c
+	}
+	return total
+}
+
+// PollVote represents a single instance of vote(s) in a Poll by an account.
+// If the Poll is single-choice, len(.Choices) = 1, if multiple-choice, len(.Choices) >= 1.
+// Can be remote or local.
+type PollVote struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                  // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`           // when was item created
+	Choices   []int     `validate:"-" bun:",notnull"`                                                              // The Poll's option indices of which these are votes for.
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:pollvoteaccountpoll"` // Account ID from which this vote originated.
+	Account   *Account  `validate:"-" bun:"-"`                                                                     // The related Account for AccountID (not always set).
+	PollID    string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique:pollvoteaccountpoll"` // Poll ID of which this is a vote in.
+	Poll      *Poll     `validate:"-" bun:"-"`                                                                     // The related Poll for PollID (not always set).
+}
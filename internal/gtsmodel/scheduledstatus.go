@@ -0,0 +1,53 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// ScheduledStatus represents a status that a local account has asked to be
+// published at some point in the future. The parameters of the status are
+// stored as they were submitted, and are only processed into a Status once
+// ScheduledAt has passed.
+type ScheduledStatus struct {
+	ID             string             `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                     // id of this item in the database
+	CreatedAt      time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item created
+	UpdatedAt      time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`              // when was item last updated
+	AccountID      string             `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                               // which account scheduled this status?
+	Account        *Account           `validate:"-" bun:"-"`                                                                        // account corresponding to accountID
+	ScheduledAt    time.Time          `validate:"-" bun:"type:timestamptz,nullzero,notnull"`                                        // when should this status be published?
+	Text           string             `validate:"-" bun:""`                                                                         // text of the status, as submitted
+	SpoilerText    string             `validate:"-" bun:",nullzero"`                                                                // content warning of the status, as submitted
+	Sensitive      *bool              `validate:"-" bun:",nullzero,notnull,default:false"`                                          // mark the status as sensitive?
+	Visibility     Visibility         `validate:"oneof=public unlocked followers_only mutuals_only direct" bun:",nullzero,notnull"` // visibility of the status
+	Language       string             `validate:"-" bun:",nullzero"`                                                                // language of the status, as submitted
+	Format         string             `validate:"-" bun:",nullzero"`                                                                // format to use when parsing the status text
+	InReplyToID    string             `validate:"omitempty,ulid" bun:"type:CHAR(26),nullzero"`                                      // id of the status this status will reply to
+	AttachmentIDs  []string           `validate:"dive,ulid" bun:"attachments,array"`                                                // Database IDs of any media attachments to attach to the status
+	Attachments    []*MediaAttachment `validate:"-" bun:"-"`                                                                        // Attachments corresponding to attachmentIDs
+	PollOptions    []string           `validate:"-" bun:",nullzero"`                                                                // Options of the poll to attach to the status, if any
+	PollExpiresIn  int                `validate:"-" bun:",nullzero"`                                                                // Duration in seconds the poll should be open for
+	PollMultiple   *bool              `validate:"-" bun:",nullzero,notnull,default:false"`                                          // Is the poll multiple choice?
+	PollHideTotals *bool              `validate:"-" bun:",nullzero,notnull,default:false"`                                          // Should the poll hide vote counts until it ends?
+	Federated      *bool              `validate:"-" bun:",nullzero"`                                                                // Will the status be federated beyond the local timeline(s)? If nil, the default will be used
+	Boostable      *bool              `validate:"-" bun:",nullzero"`                                                                // Will the status be boostable? If nil, the default will be used
+	Replyable      *bool              `validate:"-" bun:",nullzero"`                                                                // Will the status be replyable? If nil, the default will be used
+	Likeable       *bool              `validate:"-" bun:",nullzero"`                                                                // Will the status be likeable? If nil, the default will be used
+	ApplicationID  string             `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                               // Which application was used to schedule this status?
+	Application    *Application       `validate:"-" bun:"-"`                                                                        // application corresponding to applicationID
+}
@@ -0,0 +1,39 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// StatusEdit represents a prior revision of a status that has since been edited.
+// The current revision of a status is always the status itself.
+type StatusEdit struct {
+	ID             string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt      time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when this revision of the status was written (not when it was superseded)
+	StatusID       string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // id of the status that this is a revision of
+	Status         *Status   `validate:"-" bun:"-"`                                                           // status corresponding to statusID
+	AccountID      string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // id of the account that wrote this revision
+	Account        *Account  `validate:"-" bun:"-"`                                                           // account corresponding to accountID
+	Content        string    `validate:"-" bun:""`                                                            // content of this revision; likely html-formatted but not guaranteed
+	Text           string    `validate:"-" bun:""`                                                            // original text of this revision without formatting
+	ContentWarning string    `validate:"-" bun:",nullzero"`                                                   // cw string for this revision
+	Sensitive      *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // was this revision marked as sensitive?
+	Language       string    `validate:"-" bun:",nullzero"`                                                   // what language was this revision written in?
+	AttachmentIDs  []string  `validate:"dive,ulid" bun:"attachments,array"`                                   // database IDs of any media attachments on this revision
+	EmojiIDs       []string  `validate:"dive,ulid" bun:"emojis,array"`                                        // database IDs of any emojis used in this revision
+}
@@ -32,3 +32,23 @@ type Tag struct {
 	Listable               *bool     `validate:"-" bun:",nullzero,notnull,default:true"`                              // can our instance users look up this tag?
 	LastStatusAt           time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was this tag last used?
 }
+
+// TagHistory represents the usage of a tag on one day.
+// It's derived from statuses, and is not stored in the database.
+type TagHistory struct {
+	Day      time.Time // Start of the day (UTC)
+	Uses     int       // Number of statuses using the tag on this day
+	Accounts int       // Number of distinct accounts using the tag on this day
+}
+
+// FollowedTag represents an account following a hashtag, so that
+// public statuses using that hashtag appear in their home timeline.
+type FollowedTag struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`                    // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item created
+	UpdatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"`             // when was item last updated
+	AccountID string    `validate:"required,ulid" bun:"type:CHAR(26),unique:followedtagaccounttag,notnull,nullzero"` // Account that follows the tag.
+	Account   *Account  `validate:"-" bun:"-"`                                                                       // Account corresponding to accountID
+	TagID     string    `validate:"required,ulid" bun:"type:CHAR(26),unique:followedtagaccounttag,notnull,nullzero"` // Tag that is followed.
+	Tag       *Tag      `validate:"-" bun:"-"`                                                                       // Tag corresponding to tagID
+}
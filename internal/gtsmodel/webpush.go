@@ -0,0 +1,77 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package gtsmodel
+
+import "time"
+
+// VAPIDKeyPair is the instance's Voluntary Application Server Identification
+// key pair, used to identify this instance to Web Push services (RFC 8292).
+// There should only ever be one of these in the database.
+type VAPIDKeyPair struct {
+	ID        string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	Public    string    `validate:"required" bun:",nullzero,notnull"`                                    // Uncompressed P-256 public key, base64url encoded without padding. This is given to clients as the server key.
+	Private   string    `validate:"required" bun:",nullzero,notnull"`                                    // DER-encoded (SEC 1) P-256 private key, base64url encoded without padding.
+}
+
+// WebPushSubscription represents a Web Push (RFC 8030) subscription
+// created by an account, using a particular OAuth token. Each token
+// can have at most one subscription.
+type WebPushSubscription struct {
+	ID                 string    `validate:"required,ulid" bun:"type:CHAR(26),pk,nullzero,notnull,unique"`        // id of this item in the database
+	CreatedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item created
+	UpdatedAt          time.Time `validate:"-" bun:"type:timestamptz,nullzero,notnull,default:current_timestamp"` // when was item last updated
+	AccountID          string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull"`                  // Account that owns this subscription.
+	TokenID            string    `validate:"required,ulid" bun:"type:CHAR(26),nullzero,notnull,unique"`           // OAuth token that this subscription was created with.
+	Endpoint           string    `validate:"required,url" bun:",nullzero,notnull"`                                // URL of the push service endpoint to deliver pushes to.
+	Auth               string    `validate:"required" bun:",nullzero,notnull"`                                    // Authentication secret given by the client, base64url encoded.
+	P256dh             string    `validate:"required" bun:",nullzero,notnull"`                                    // P-256 ECDH public key given by the client, base64url encoded.
+	AlertFollow        *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Push follow notifications?
+	AlertFollowRequest *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Push follow request notifications?
+	AlertFavourite     *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Push favourite notifications?
+	AlertMention       *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Push mention notifications?
+	AlertReblog        *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Push reblog notifications?
+	AlertPoll          *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Push poll notifications?
+	AlertStatus        *bool     `validate:"-" bun:",nullzero,notnull,default:false"`                             // Push new status notifications?
+}
+
+// AlertEnabled returns whether this subscription
+// wants pushes for the given type of notification.
+func (s *WebPushSubscription) AlertEnabled(notificationType NotificationType) bool {
+	var alert *bool
+
+	switch notificationType {
+	case NotificationFollow:
+		alert = s.AlertFollow
+	case NotificationFollowRequest:
+		alert = s.AlertFollowRequest
+	case NotificationFave:
+		alert = s.AlertFavourite
+	case NotificationMention:
+		alert = s.AlertMention
+	case NotificationReblog:
+		alert = s.AlertReblog
+	case NotificationPoll:
+		alert = s.AlertPoll
+	case NotificationStatus:
+		alert = s.AlertStatus
+	}
+
+	return alert != nil && *alert
+}
@@ -0,0 +1,248 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"io"
+
+	"github.com/disintegration/imaging"
+	"github.com/superseriousbusiness/gotosocial/internal/iotools"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// pictureTypeFrontCover is the ID3 / flac picture
+	// type of front cover art, which we prefer over
+	// any other embedded pictures.
+	pictureTypeFrontCover = 3
+
+	// waveformWidth and waveformHeight are the
+	// dimensions of generated waveform images.
+	waveformWidth  = 640
+	waveformHeight = 360
+
+	// waveformBars is the number of bars
+	// drawn in generated waveform images.
+	waveformBars = 64
+)
+
+type gtsAudio struct {
+	cover    *gtsImage // embedded cover art, or a generated waveform
+	duration float32   // in seconds
+	bitrate  uint64
+}
+
+// audioInfo is what we learn from probing an audio stream.
+type audioInfo struct {
+	// duration of the audio in seconds.
+	duration float64
+
+	// picture is embedded cover art, if any.
+	picture []byte
+
+	// pictureType is the ID3 / flac picture type of picture.
+	pictureType uint32
+
+	// frameSizes are the sizes in bytes of each
+	// compressed audio frame (or packet), in order.
+	frameSizes []uint32
+}
+
+// setPicture sets the embedded picture, unless
+// a front cover has been found already.
+func (a *audioInfo) setPicture(pictureType uint32, data []byte) {
+	if len(data) == 0 {
+		return
+	}
+
+	if a.picture != nil && (a.pictureType == pictureTypeFrontCover || pictureType != pictureTypeFrontCover) {
+		return
+	}
+
+	a.picture = data
+	a.pictureType = pictureType
+}
+
+// decodeAudio probes the given audio stream to extract its metadata, and returns that
+// along with a cover image: either embedded cover art, or a generated waveform.
+func decodeAudio(r io.Reader, contentType string) (*gtsAudio, error) {
+	// we need a readseeker to probe the audio...
+	tfs, err := iotools.TempFileSeeker(r)
+	if err != nil {
+		return nil, fmt.Errorf("error creating temp file seeker: %w", err)
+	}
+	defer func() {
+		if err := tfs.Close(); err != nil {
+			log.Errorf("error closing temp file seeker: %s", err)
+		}
+	}()
+
+	if _, err := tfs.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+
+	var info *audioInfo
+	switch contentType {
+	case mimeAudioMpeg:
+		info, err = probeMP3(tfs)
+	case mimeAudioOgg:
+		info, err = probeOgg(tfs)
+	case mimeAudioFlac:
+		info, err = probeFLAC(tfs)
+	default:
+		err = fmt.Errorf("unsupported audio type: %s", contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if info.duration <= 0 || len(info.frameSizes) == 0 {
+		return nil, fmt.Errorf("error determining audio metadata: no audio found")
+	}
+
+	// bitrate of the audio itself, so that
+	// large cover art doesn't inflate it
+	var audioSize uint64
+	for _, sz := range info.frameSizes {
+		audioSize += uint64(sz)
+	}
+
+	audio := &gtsAudio{
+		duration: float32(info.duration),
+		bitrate:  uint64(float64(audioSize*8) / info.duration),
+	}
+
+	if info.picture != nil {
+		audio.cover, err = decodeImage(bytes.NewReader(info.picture), imaging.AutoOrientation(true))
+		if err != nil {
+			// not fatal, we can draw a waveform instead
+			log.Debugf("error decoding embedded cover art: %s", err)
+		}
+	}
+
+	if audio.cover == nil {
+		audio.cover = waveformImage(info.frameSizes)
+	}
+
+	return audio, nil
+}
+
+// waveformImage generates a waveform-like image from the sizes of
+// compressed audio frames. Compressed frames are larger where the audio
+// is louder or busier, so this gives an impression of the audio's shape
+// without having to decode it.
+func waveformImage(frameSizes []uint32) *gtsImage {
+	img := image.NewRGBA(image.Rect(0, 0, waveformWidth, waveformHeight))
+
+	// fill the background with the same color as blank video frames.
+	draw.Draw(img, img.Bounds(), &image.Uniform{
+		color.RGBA{42, 43, 47, 255},
+	}, image.Point{}, draw.Src)
+
+	// average frame size within each bar
+	var levels [waveformBars]float64
+	for i := range levels {
+		start := i * len(frameSizes) / waveformBars
+		end := (i + 1) * len(frameSizes) / waveformBars
+		if end <= start {
+			end = start + 1
+		}
+		if end > len(frameSizes) {
+			continue
+		}
+
+		var sum float64
+		for _, sz := range frameSizes[start:end] {
+			sum += float64(sz)
+		}
+		levels[i] = sum / float64(end-start)
+	}
+
+	min, max := levels[0], levels[0]
+	for _, l := range levels {
+		if l < min {
+			min = l
+		}
+		if l > max {
+			max = l
+		}
+	}
+
+	barWidth := waveformWidth / waveformBars
+	bar := &image.Uniform{color.RGBA{223, 137, 88, 255}}
+
+	for i, l := range levels {
+		// scale bars between 10% and 90% of the image height
+		scale := 0.5
+		if max > min {
+			scale = (l - min) / (max - min)
+		}
+		h := int(float64(waveformHeight) * (0.1 + 0.8*scale))
+
+		x := i*barWidth + barWidth/4
+		y := (waveformHeight - h) / 2
+		draw.Draw(img, image.Rect(x, y, x+barWidth/2, y+h), bar, image.Point{}, draw.Src)
+	}
+
+	return &gtsImage{image: img}
+}
+
+// parseFLACPicture parses a flac METADATA_BLOCK_PICTURE, as embedded in
+// flac files and (base64 encoded) in vorbis comments, returning the picture
+// type and data; see: https://xiph.org/flac/format.html#metadata_block_picture
+func parseFLACPicture(b []byte) (uint32, []byte, bool) {
+	r := bytes.NewReader(b)
+
+	var pictureType, length uint32
+	if binary.Read(r, binary.BigEndian, &pictureType) != nil {
+		return 0, nil, false
+	}
+
+	// skip mime type and description
+	for i := 0; i < 2; i++ {
+		if binary.Read(r, binary.BigEndian, &length) != nil {
+			return 0, nil, false
+		}
+		if _, err := r.Seek(int64(length), io.SeekCurrent); err != nil {
+			return 0, nil, false
+		}
+	}
+
+	// skip width, height, depth and colors
+	if _, err := r.Seek(16, io.SeekCurrent); err != nil {
+		return 0, nil, false
+	}
+
+	if binary.Read(r, binary.BigEndian, &length) != nil {
+		return 0, nil, false
+	}
+
+	offset := len(b) - r.Len()
+	if int(length) > r.Len() {
+		return 0, nil, false
+	}
+
+	return pictureType, b[offset : offset+int(length)], true
+}
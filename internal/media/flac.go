@@ -0,0 +1,247 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+const (
+	flacBlockStreamInfo = 0
+	flacBlockPicture    = 6
+
+	// maxFLACPictureSize is the largest picture block, in bytes,
+	// that we're willing to read from a flac file.
+	maxFLACPictureSize = 16 << 20 // 16MiB
+)
+
+// probeFLAC reads the metadata blocks of the given flac stream for stream
+// info and cover art, then scans through its frames to measure them; see:
+// https://xiph.org/flac/format.html
+func probeFLAC(r io.Reader) (*audioInfo, error) {
+	br := bufio.NewReader(r)
+	info := &audioInfo{}
+
+	var magic [4]byte
+	if _, err := io.ReadFull(br, magic[:]); err != nil {
+		return nil, err
+	}
+
+	if !bytes.Equal(magic[:], []byte("fLaC")) {
+		return nil, errors.New("not a flac file")
+	}
+
+	var (
+		sampleRate   uint32
+		totalSamples uint64
+	)
+
+	for last := false; !last; {
+		var hdr [4]byte
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			return nil, err
+		}
+
+		last = hdr[0]&0x80 != 0
+		blockType := hdr[0] & 0x7F
+		length := int(hdr[1])<<16 | int(hdr[2])<<8 | int(hdr[3])
+
+		switch {
+		case blockType == flacBlockStreamInfo && length >= 34:
+			var block [34]byte
+			if _, err := io.ReadFull(br, block[:]); err != nil {
+				return nil, err
+			}
+			if _, err := br.Discard(length - 34); err != nil {
+				return nil, err
+			}
+
+			// 20 bits sample rate, 3 bits channels, 5 bits bits
+			// per sample, then 36 bits of total samples
+			v := binary.BigEndian.Uint64(block[10:18])
+			sampleRate = uint32(v >> 44)
+			totalSamples = v & (1<<36 - 1)
+
+		case blockType == flacBlockPicture && length <= maxFLACPictureSize:
+			block := make([]byte, length)
+			if _, err := io.ReadFull(br, block); err != nil {
+				return nil, err
+			}
+
+			if pictureType, picture, ok := parseFLACPicture(block); ok {
+				info.setPicture(pictureType, picture)
+			}
+
+		default:
+			if _, err := br.Discard(length); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if sampleRate == 0 {
+		return nil, errors.New("no flac stream info found")
+	}
+
+	// measure frames by finding the start of each one;
+	// frames don't record their own length
+	var (
+		offset       int64
+		frameStart   int64 = -1
+		frameSamples uint64
+	)
+
+	for {
+		b, err := br.ReadByte()
+		if err != nil {
+			break
+		}
+		offset++
+
+		if b != 0xFF {
+			continue
+		}
+
+		hdr, err := br.Peek(15)
+		if err != nil && len(hdr) < 4 {
+			break
+		}
+
+		blockSize, ok := parseFLACFrameHeader(append([]byte{b}, hdr...))
+		if !ok {
+			continue
+		}
+
+		if frameStart >= 0 {
+			info.frameSizes = append(info.frameSizes, uint32(offset-1-frameStart))
+		}
+		frameStart = offset - 1
+		frameSamples += uint64(blockSize)
+	}
+
+	if frameStart >= 0 {
+		info.frameSizes = append(info.frameSizes, uint32(offset-frameStart))
+	}
+
+	if totalSamples == 0 {
+		// unknown in stream info, so count them
+		totalSamples = frameSamples
+	}
+
+	info.duration = float64(totalSamples) / float64(sampleRate)
+	return info, nil
+}
+
+// parseFLACFrameHeader checks whether the given bytes start with a valid
+// flac frame header, returning the block size (in samples) of the frame.
+// The header CRC is checked, so that sync-like patterns in audio data
+// aren't mistaken for frames.
+func parseFLACFrameHeader(b []byte) (int, bool) {
+	if len(b) < 5 || b[0] != 0xFF || b[1]&0xFE != 0xF8 {
+		return 0, false
+	}
+
+	blockSizeCode := b[2] >> 4
+	sampleRateCode := b[2] & 0x0F
+	channels := b[3] >> 4
+	sampleSize := (b[3] >> 1) & 0x07
+
+	if blockSizeCode == 0 || sampleRateCode == 0x0F || channels > 10 || sampleSize == 3 || b[3]&0x01 != 0 {
+		// reserved values
+		return 0, false
+	}
+
+	// utf-8 like coded frame or sample number
+	n := 4
+	lead := b[n]
+	extra := 0
+	switch {
+	case lead&0x80 == 0:
+	case lead&0xE0 == 0xC0:
+		extra = 1
+	case lead&0xF0 == 0xE0:
+		extra = 2
+	case lead&0xF8 == 0xF0:
+		extra = 3
+	case lead&0xFC == 0xF8:
+		extra = 4
+	case lead&0xFE == 0xFC:
+		extra = 5
+	case lead == 0xFE:
+		extra = 6
+	default:
+		return 0, false
+	}
+	n += 1 + extra
+
+	var blockSize int
+	switch {
+	case blockSizeCode == 1:
+		blockSize = 192
+	case blockSizeCode <= 5:
+		blockSize = 576 << (blockSizeCode - 2)
+	case blockSizeCode == 6:
+		if len(b) <= n {
+			return 0, false
+		}
+		blockSize = int(b[n]) + 1
+		n++
+	case blockSizeCode == 7:
+		if len(b) <= n+1 {
+			return 0, false
+		}
+		blockSize = int(b[n])<<8 | int(b[n+1]) + 1
+		n += 2
+	default:
+		blockSize = 256 << (blockSizeCode - 8)
+	}
+
+	switch sampleRateCode {
+	case 0x0C:
+		n++
+	case 0x0D, 0x0E:
+		n += 2
+	}
+
+	if len(b) <= n || crc8(b[:n]) != b[n] {
+		return 0, false
+	}
+
+	return blockSize, true
+}
+
+// crc8 calculates the flac frame header CRC-8, with polynomial x^8 + x^2 + x + 1.
+func crc8(b []byte) byte {
+	var crc byte
+	for _, c := range b {
+		crc ^= c
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
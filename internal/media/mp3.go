@@ -0,0 +1,395 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io"
+)
+
+const (
+	// maxID3TagSize is the largest ID3v2 tag, in bytes,
+	// that we're willing to read to look for cover art.
+	maxID3TagSize = 16 << 20 // 16MiB
+
+	// maxMP3Junk is the number of bytes of non-frame
+	// data we'll skip over before the first mp3 frame.
+	maxMP3Junk = 64 << 10 // 64KiB
+)
+
+var (
+	// mp3 Layer III bitrates in kbps, by bitrate index.
+	mp3BitratesV1 = [15]int{0, 32, 40, 48, 56, 64, 80, 96, 112, 128, 160, 192, 224, 256, 320}
+	mp3BitratesV2 = [15]int{0, 8, 16, 24, 32, 40, 48, 56, 64, 80, 96, 112, 128, 144, 160}
+
+	// mp3 sample rates in Hz, by version and sample rate index.
+	mp3SampleRates = map[byte][3]int{
+		3: {44100, 48000, 32000}, // MPEG 1
+		2: {22050, 24000, 16000}, // MPEG 2
+		0: {11025, 12000, 8000},  // MPEG 2.5
+	}
+)
+
+// mp3Frame is the parsed header of an MPEG audio Layer III frame.
+type mp3Frame struct {
+	version    byte // 3 = MPEG 1, 2 = MPEG 2, 0 = MPEG 2.5
+	sampleRate int
+	samples    int // samples per frame
+	length     int // of the whole frame in bytes, including header
+	mono       bool
+}
+
+// parseMP3Frame parses the given 4 byte MPEG audio frame header,
+// returning false if it's not a valid Layer III frame header; see:
+// http://www.mp3-tech.org/programmer/frame_header.html
+func parseMP3Frame(hdr []byte) (mp3Frame, bool) {
+	var f mp3Frame
+
+	if len(hdr) < 4 || hdr[0] != 0xFF || hdr[1]&0xE0 != 0xE0 {
+		return f, false
+	}
+
+	f.version = (hdr[1] >> 3) & 0x03
+	layer := (hdr[1] >> 1) & 0x03
+	bitrateIndex := hdr[2] >> 4
+	sampleRateIndex := (hdr[2] >> 2) & 0x03
+	padding := int((hdr[2] >> 1) & 0x01)
+
+	if f.version == 1 || layer != 1 || bitrateIndex == 0 || bitrateIndex == 15 || sampleRateIndex == 3 {
+		// reserved values, free bitrate, or not Layer III
+		return f, false
+	}
+
+	f.sampleRate = mp3SampleRates[f.version][sampleRateIndex]
+	f.mono = hdr[3]>>6 == 3
+
+	if f.version == 3 {
+		f.samples = 1152
+		f.length = 144*mp3BitratesV1[bitrateIndex]*1000/f.sampleRate + padding
+	} else {
+		f.samples = 576
+		f.length = 72*mp3BitratesV2[bitrateIndex]*1000/f.sampleRate + padding
+	}
+
+	return f, true
+}
+
+// isMP3 returns whether the given file header looks like the start of
+// an mp3 file with no ID3 tag. This catches mp3 files not recognized by
+// filetype, which only matches on the most common frame header.
+func isMP3(hdr []byte) bool {
+	f, ok := parseMP3Frame(hdr)
+	if !ok {
+		return false
+	}
+
+	// check for a second frame right after
+	// this one, if it fits in the header
+	if len(hdr) < f.length+4 {
+		return true
+	}
+
+	next, ok := parseMP3Frame(hdr[f.length:])
+	return ok && next.version == f.version && next.sampleRate == f.sampleRate
+}
+
+// probeMP3 reads cover art from the ID3v2 tag of the given mp3 stream,
+// then scans through its frames to work out its duration.
+func probeMP3(r io.Reader) (*audioInfo, error) {
+	br := bufio.NewReader(r)
+	info := &audioInfo{}
+
+	// there may be several tags, eg.,
+	// when tagged by multiple programs
+	for {
+		hdr, err := br.Peek(10)
+		if err != nil || !bytes.HasPrefix(hdr, []byte("ID3")) {
+			break
+		}
+
+		if err := readID3Tag(br, info); err != nil {
+			return nil, err
+		}
+	}
+
+	var (
+		first   *mp3Frame
+		samples int
+		junk    int
+		xing    = true
+	)
+
+	for {
+		hdr, err := br.Peek(4)
+		if err != nil {
+			break
+		}
+
+		f, ok := parseMP3Frame(hdr)
+		if ok && first != nil && (f.version != first.version || f.sampleRate != first.sampleRate) {
+			// only a sync-like pattern in
+			// the data, not a real frame
+			ok = false
+		}
+
+		if !ok {
+			if bytes.HasPrefix(hdr, []byte("TAG")) || bytes.HasPrefix(hdr, []byte("APET")) || bytes.HasPrefix(hdr, []byte("LYRI")) {
+				// trailing ID3v1, APE or lyrics tag
+				break
+			}
+
+			if first == nil {
+				if junk++; junk > maxMP3Junk {
+					return nil, errors.New("no mp3 frames found")
+				}
+			}
+
+			// skip a byte and try to resync
+			if _, err := br.Discard(1); err != nil {
+				break
+			}
+			continue
+		}
+
+		if first == nil {
+			first = &f
+		}
+
+		frame, err := br.Peek(f.length)
+		if err != nil {
+			// truncated frame at the end
+			break
+		}
+
+		// the first frame may be a xing / info frame with
+		// metadata about the file, rather than audio
+		skip := xing && isXingFrame(f, frame)
+		xing = false
+
+		if _, err := br.Discard(f.length); err != nil {
+			break
+		}
+
+		if skip {
+			continue
+		}
+
+		samples += f.samples
+		info.frameSizes = append(info.frameSizes, uint32(f.length))
+	}
+
+	if first == nil {
+		return nil, errors.New("no mp3 frames found")
+	}
+
+	info.duration = float64(samples) / float64(first.sampleRate)
+	return info, nil
+}
+
+// isXingFrame returns whether the given frame is a xing / info frame.
+func isXingFrame(f mp3Frame, frame []byte) bool {
+	// the xing header comes after the side information
+	offset := 4
+	switch {
+	case f.version == 3 && !f.mono:
+		offset += 32
+	case f.version == 3 || !f.mono:
+		offset += 17
+	default:
+		offset += 9
+	}
+
+	if len(frame) < offset+4 {
+		return false
+	}
+
+	tag := frame[offset : offset+4]
+	return bytes.Equal(tag, []byte("Xing")) || bytes.Equal(tag, []byte("Info"))
+}
+
+// readID3Tag reads an ID3v2 tag, storing any embedded picture in info; see:
+// https://id3.org/id3v2.4.0-structure and https://id3.org/id3v2.3.0
+func readID3Tag(br *bufio.Reader, info *audioInfo) error {
+	var hdr [10]byte
+	if _, err := io.ReadFull(br, hdr[:]); err != nil {
+		return err
+	}
+
+	major := hdr[3]
+	flags := hdr[5]
+	size := syncsafe(hdr[6:10])
+	if flags&0x10 != 0 {
+		// footer present
+		size += 10
+	}
+
+	if size > maxID3TagSize || major < 2 || major > 4 {
+		// too large, or a version we don't know;
+		// skip it without looking for pictures
+		_, err := br.Discard(size)
+		return err
+	}
+
+	tag := make([]byte, size)
+	if _, err := io.ReadFull(br, tag); err != nil {
+		return err
+	}
+
+	if major < 4 && flags&0x80 != 0 {
+		// whole tag is unsynchronised
+		tag = unsynchronise(tag)
+	}
+
+	if major > 2 && flags&0x40 != 0 && len(tag) >= 4 {
+		// skip the extended header
+		var extSize int
+		if major == 3 {
+			extSize = int(tag[0])<<24 | int(tag[1])<<16 | int(tag[2])<<8 | int(tag[3]) + 4
+		} else {
+			extSize = syncsafe(tag[:4])
+		}
+		if extSize > len(tag) {
+			return nil
+		}
+		tag = tag[extSize:]
+	}
+
+	for {
+		var (
+			id         string
+			frameSize  int
+			frameFlags int
+			hdrSize    = 10
+		)
+
+		if major == 2 {
+			hdrSize = 6
+		}
+
+		if len(tag) < hdrSize || tag[0] == 0 {
+			// end of frames, or padding
+			return nil
+		}
+
+		switch major {
+		case 2:
+			id = string(tag[:3])
+			frameSize = int(tag[3])<<16 | int(tag[4])<<8 | int(tag[5])
+		case 3:
+			id = string(tag[:4])
+			frameSize = int(tag[4])<<24 | int(tag[5])<<16 | int(tag[6])<<8 | int(tag[7])
+			frameFlags = int(tag[8])<<8 | int(tag[9])
+		case 4:
+			id = string(tag[:4])
+			frameSize = syncsafe(tag[4:8])
+			frameFlags = int(tag[8])<<8 | int(tag[9])
+		}
+
+		if frameSize < 0 || frameSize > len(tag)-hdrSize {
+			return nil
+		}
+
+		data := tag[hdrSize : hdrSize+frameSize]
+		tag = tag[hdrSize+frameSize:]
+
+		if id != "APIC" && id != "PIC" {
+			continue
+		}
+
+		if major == 4 {
+			if frameFlags&0x0002 != 0 {
+				data = unsynchronise(data)
+			}
+			if frameFlags&0x0001 != 0 && len(data) >= 4 {
+				// skip data length indicator
+				data = data[4:]
+			}
+		}
+
+		if major == 3 && frameFlags&0x00C0 != 0 {
+			// compressed or encrypted
+			continue
+		}
+
+		if pictureType, picture, ok := parseID3Picture(id, data); ok {
+			info.setPicture(pictureType, picture)
+		}
+	}
+}
+
+// parseID3Picture parses the data of an APIC (or v2.2 PIC) frame.
+func parseID3Picture(id string, data []byte) (uint32, []byte, bool) {
+	if len(data) < 2 {
+		return 0, nil, false
+	}
+
+	encoding := data[0]
+	data = data[1:]
+
+	if id == "PIC" {
+		// 3 character image format
+		if len(data) < 3 {
+			return 0, nil, false
+		}
+		data = data[3:]
+	} else {
+		// null terminated mime type
+		i := bytes.IndexByte(data, 0)
+		if i < 0 {
+			return 0, nil, false
+		}
+		data = data[i+1:]
+	}
+
+	if len(data) < 1 {
+		return 0, nil, false
+	}
+	pictureType := uint32(data[0])
+	data = data[1:]
+
+	// skip the description, which is terminated by
+	// a double null in the UTF-16 encodings
+	if encoding == 1 || encoding == 2 {
+		for i := 0; i+1 < len(data); i += 2 {
+			if data[i] == 0 && data[i+1] == 0 {
+				return pictureType, data[i+2:], true
+			}
+		}
+		return 0, nil, false
+	}
+
+	i := bytes.IndexByte(data, 0)
+	if i < 0 {
+		return 0, nil, false
+	}
+	return pictureType, data[i+1:], true
+}
+
+// syncsafe decodes a 4 byte ID3 syncsafe integer.
+func syncsafe(b []byte) int {
+	return int(b[0]&0x7F)<<21 | int(b[1]&0x7F)<<14 | int(b[2]&0x7F)<<7 | int(b[3]&0x7F)
+}
+
+// unsynchronise reverses ID3 unsynchronisation,
+// which inserts a zero byte after each 0xFF.
+func unsynchronise(b []byte) []byte {
+	return bytes.ReplaceAll(b, []byte{0xFF, 0x00}, []byte{0xFF})
+}
@@ -0,0 +1,246 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const (
+	// maxOggHeaderPacketSize is the largest ogg header packet, in
+	// bytes, that we're willing to read to look for cover art.
+	maxOggHeaderPacketSize = 16 << 20 // 16MiB
+
+	// opusSampleRate is the rate at which opus
+	// granule positions are always counted.
+	opusSampleRate = 48000
+)
+
+// oggStream keeps track of the logical stream we're reading from an ogg file.
+type oggStream struct {
+	serial     uint32
+	packets    int // number of complete packets read so far
+	headers    int // number of header packets before audio starts
+	packet     []byte
+	packetSize int
+	sampleRate uint32
+	preSkip    uint64
+	granule    int64
+	info       *audioInfo
+}
+
+// probeOgg reads the headers of the first logical stream in the given ogg
+// stream, which must be opus or vorbis, for metadata and cover art, then
+// reads through its pages to work out its duration; see:
+// https://www.rfc-editor.org/rfc/rfc3533 and https://www.rfc-editor.org/rfc/rfc7845
+func probeOgg(r io.Reader) (*audioInfo, error) {
+	br := bufio.NewReader(r)
+	s := &oggStream{info: &audioInfo{}}
+
+	var (
+		hdr      [27]byte
+		segments [255]byte
+		first    = true
+	)
+
+	for {
+		if _, err := io.ReadFull(br, hdr[:]); err != nil {
+			if first {
+				return nil, fmt.Errorf("error reading ogg page: %w", err)
+			}
+			// end of stream (or truncated)
+			break
+		}
+
+		if !bytes.Equal(hdr[:4], []byte("OggS")) || hdr[4] != 0 {
+			return nil, errors.New("invalid ogg page")
+		}
+
+		granule := int64(binary.LittleEndian.Uint64(hdr[6:14]))
+		serial := binary.LittleEndian.Uint32(hdr[14:18])
+		table := segments[:hdr[26]]
+		if _, err := io.ReadFull(br, table); err != nil {
+			break
+		}
+
+		if first {
+			s.serial = serial
+			first = false
+		}
+
+		if serial != s.serial {
+			// some other multiplexed stream, skip the page
+			var size int
+			for _, lace := range table {
+				size += int(lace)
+			}
+			if _, err := br.Discard(size); err != nil {
+				break
+			}
+			continue
+		}
+
+		if err := s.readPage(br, table); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				break
+			}
+			return nil, err
+		}
+
+		if granule > s.granule {
+			// -1 means no packet ends on this page
+			s.granule = granule
+		}
+	}
+
+	if s.sampleRate == 0 {
+		return nil, errors.New("no ogg identification header found")
+	}
+
+	if samples := uint64(s.granule); samples > s.preSkip {
+		s.info.duration = float64(samples-s.preSkip) / float64(s.sampleRate)
+	}
+
+	return s.info, nil
+}
+
+// readPage reads the packet data of one page. Header packets are
+// collected and handled, audio packets are only counted and measured.
+func (s *oggStream) readPage(br *bufio.Reader, table []byte) error {
+	for _, lace := range table {
+		if s.packets < s.headers || s.packets == 0 {
+			if len(s.packet)+int(lace) > maxOggHeaderPacketSize {
+				return errors.New("ogg header packet too large")
+			}
+
+			start := len(s.packet)
+			s.packet = append(s.packet, make([]byte, lace)...)
+			if _, err := io.ReadFull(br, s.packet[start:]); err != nil {
+				return err
+			}
+		} else if _, err := br.Discard(int(lace)); err != nil {
+			return err
+		}
+		s.packetSize += int(lace)
+
+		if lace == 255 {
+			// packet continues in the next segment
+			continue
+		}
+
+		if err := s.endPacket(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// endPacket handles the packet that was just completed.
+func (s *oggStream) endPacket() error {
+	packet, size := s.packet, s.packetSize
+	s.packet, s.packetSize = nil, 0
+	s.packets++
+
+	if s.packets > s.headers && s.packets > 1 {
+		// audio packet
+		s.info.frameSizes = append(s.info.frameSizes, uint32(size))
+		return nil
+	}
+
+	switch {
+	case s.packets == 1 && bytes.HasPrefix(packet, []byte("OpusHead")) && len(packet) >= 19:
+		s.headers = 2
+		s.sampleRate = opusSampleRate
+		s.preSkip = uint64(binary.LittleEndian.Uint16(packet[10:12]))
+
+	case s.packets == 1 && bytes.HasPrefix(packet, []byte("\x01vorbis")) && len(packet) >= 30:
+		s.headers = 3
+		s.sampleRate = binary.LittleEndian.Uint32(packet[12:16])
+		if s.sampleRate == 0 {
+			return errors.New("invalid vorbis sample rate")
+		}
+
+	case s.packets == 1:
+		return errors.New("unsupported ogg codec, only opus and vorbis are supported")
+
+	case s.packets == 2 && bytes.HasPrefix(packet, []byte("OpusTags")):
+		s.readComments(packet[8:])
+
+	case s.packets == 2 && bytes.HasPrefix(packet, []byte("\x03vorbis")):
+		s.readComments(packet[7:])
+	}
+
+	return nil
+}
+
+// readComments looks for cover art in vorbis comments; see:
+// https://www.xiph.org/vorbis/doc/v-comment.html
+func (s *oggStream) readComments(b []byte) {
+	next := func() ([]byte, bool) {
+		if len(b) < 4 {
+			return nil, false
+		}
+		n := binary.LittleEndian.Uint32(b)
+		if uint64(n) > uint64(len(b)-4) {
+			return nil, false
+		}
+		field := b[4 : 4+n]
+		b = b[4+n:]
+		return field, true
+	}
+
+	// vendor string
+	if _, ok := next(); !ok {
+		return
+	}
+
+	if len(b) < 4 {
+		return
+	}
+	count := binary.LittleEndian.Uint32(b)
+	b = b[4:]
+
+	for i := uint32(0); i < count; i++ {
+		comment, ok := next()
+		if !ok {
+			return
+		}
+
+		key, value, ok := strings.Cut(string(comment), "=")
+		if !ok || !strings.EqualFold(key, "METADATA_BLOCK_PICTURE") {
+			continue
+		}
+
+		block, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			continue
+		}
+
+		if pictureType, picture, ok := parseFLACPicture(block); ok {
+			s.info.setPicture(pictureType, picture)
+		}
+	}
+}
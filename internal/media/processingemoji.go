@@ -34,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -78,6 +79,9 @@ func (p *ProcessingEmoji) LoadEmoji(ctx context.Context) (*gtsmodel.Emoji, error
 				// Store error.
 				p.err = err
 			}
+
+			// Record processing result.
+			metrics.RecordMediaProcessed("emoji", err)
 		}()
 
 		// Attempt to store media and calculate
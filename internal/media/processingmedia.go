@@ -24,15 +24,18 @@ import (
 	"fmt"
 	"image/jpeg"
 	"io"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/disintegration/imaging"
 	"github.com/h2non/filetype"
+	"github.com/h2non/filetype/matchers"
 	terminator "github.com/superseriousbusiness/exif-terminator"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -75,6 +78,9 @@ func (p *ProcessingMedia) LoadAttachment(ctx context.Context) (*gtsmodel.MediaAt
 				// Store error.
 				p.err = err
 			}
+
+			// Record processing result.
+			metrics.RecordMediaProcessed(strings.ToLower(string(p.media.Type)), err)
 		}()
 
 		// Attempt to store media and calculate
@@ -151,13 +157,29 @@ func (p *ProcessingMedia) store(ctx context.Context) error {
 		return fmt.Errorf("error parsing file type: %w", err)
 	}
 
+	switch {
+	case info == filetype.Unknown && isMP3(hdrBuf):
+		// filetype only recognizes the most
+		// common mp3 frame header, check others.
+		info = matchers.TypeMp3
+
+	case info == matchers.TypeMov && matchers.Mp4(hdrBuf):
+		// some mp4 headers also match as quicktime,
+		// depending on which matcher filetype tries
+		// first, so make sure these stay mp4.
+		info = matchers.TypeMp4
+	}
+
 	// Recombine header bytes with remaining stream
 	r := io.MultiReader(bytes.NewReader(hdrBuf), rc)
 
 	switch info.Extension {
-	case "mp4":
+	case "mp4", "mov", "webm":
 		p.media.Type = gtsmodel.FileTypeVideo
 
+	case "mp3", "ogg", "flac":
+		p.media.Type = gtsmodel.FileTypeAudio
+
 	case "gif":
 		p.media.Type = gtsmodel.FileTypeImage
 
@@ -246,9 +268,9 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 			return fmt.Errorf("error decoding image: %w", err)
 		}
 
-	// .mp4 video type
-	case mimeVideoMp4:
-		video, err := decodeVideoFrame(rc)
+	// .mp4, .mov, .webm video type
+	case mimeVideoMp4, mimeVideoQuicktime, mimeVideoWebm:
+		video, err := decodeVideoFrame(rc, p.media.File.ContentType)
 		if err != nil {
 			return fmt.Errorf("error decoding video: %w", err)
 		}
@@ -260,6 +282,20 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		p.media.FileMeta.Original.Duration = &video.duration
 		p.media.FileMeta.Original.Framerate = &video.framerate
 		p.media.FileMeta.Original.Bitrate = &video.bitrate
+
+	// .mp3, .ogg, .flac audio type
+	case mimeAudioMpeg, mimeAudioOgg, mimeAudioFlac:
+		audio, err := decodeAudio(rc, p.media.File.ContentType)
+		if err != nil {
+			return fmt.Errorf("error decoding audio: %w", err)
+		}
+
+		// Set cover art (or waveform) as image.
+		fullImg = audio.cover
+
+		// Set audio metadata in attachment info.
+		p.media.FileMeta.Original.Duration = &audio.duration
+		p.media.FileMeta.Original.Bitrate = &audio.bitrate
 	}
 
 	// The image should be in-memory by now.
@@ -267,11 +303,14 @@ func (p *ProcessingMedia) finish(ctx context.Context) error {
 		return fmt.Errorf("error closing file: %w", err)
 	}
 
-	// Set full-size dimensions in attachment info.
-	p.media.FileMeta.Original.Width = int(fullImg.Width())
-	p.media.FileMeta.Original.Height = int(fullImg.Height())
-	p.media.FileMeta.Original.Size = int(fullImg.Size())
-	p.media.FileMeta.Original.Aspect = fullImg.AspectRatio()
+	// Set full-size dimensions in attachment info; audio
+	// has no dimensions, only its thumbnail does.
+	if p.media.Type != gtsmodel.FileTypeAudio {
+		p.media.FileMeta.Original.Width = int(fullImg.Width())
+		p.media.FileMeta.Original.Height = int(fullImg.Height())
+		p.media.FileMeta.Original.Size = int(fullImg.Size())
+		p.media.FileMeta.Original.Aspect = fullImg.AspectRatio()
+	}
 
 	// Calculate attachment thumbnail file path
 	p.media.Thumbnail.Path = fmt.Sprintf(
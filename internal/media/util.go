@@ -30,6 +30,11 @@ var SupportedMIMETypes = []string{
 	mimeImagePng,
 	mimeImageWebp,
 	mimeVideoMp4,
+	mimeVideoQuicktime,
+	mimeVideoWebm,
+	mimeAudioMpeg,
+	mimeAudioOgg,
+	mimeAudioFlac,
 }
 
 var SupportedEmojiMIMETypes = []string{
@@ -19,14 +19,22 @@
 package media
 
 import (
+	"bytes"
 	"fmt"
+	"image"
+	"image/draw"
 	"io"
 
 	"github.com/abema/go-mp4"
+	"github.com/disintegration/imaging"
 	"github.com/superseriousbusiness/gotosocial/internal/iotools"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 )
 
+// maxMP4CoverSize is the largest cover art, in bytes,
+// that we're willing to read from an mp4 or quicktime file.
+const maxMP4CoverSize = 16 << 20 // 16MiB
+
 type gtsVideo struct {
 	frame     *gtsImage
 	duration  float32 // in seconds
@@ -34,9 +42,9 @@ type gtsVideo struct {
 	framerate float32
 }
 
-// decodeVideoFrame decodes and returns an image from a single frame in the given video stream.
-// (note: currently this only returns a blank image resized to fit video dimensions).
-func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
+// decodeVideoFrame decodes and returns an image from a single frame in the given video stream,
+// along with the video metadata. The content type selects the container format to demux.
+func decodeVideoFrame(r io.Reader, contentType string) (*gtsVideo, error) {
 	// we need a readseeker to decode the video...
 	tfs, err := iotools.TempFileSeeker(r)
 	if err != nil {
@@ -48,9 +56,27 @@ func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
 		}
 	}()
 
+	switch contentType {
+	case mimeVideoMp4, mimeVideoQuicktime:
+		return decodeMP4Frame(tfs)
+	case mimeVideoWebm:
+		return decodeWebMFrame(tfs)
+	default:
+		return nil, fmt.Errorf("unsupported video type: %s", contentType)
+	}
+}
+
+// decodeMP4Frame returns metadata and a frame from the given mp4 or quicktime stream.
+// The frame is the cover art embedded in the container's metadata, if it has any,
+// fitted to the video dimensions.
+//
+// NOTE: this is a known gap. We can't decode h264 keyframes, since there's no pure Go
+// decoder for us to use, so videos without cover art get a blank image of the video
+// dimensions instead of a real frame (see the media section of docs/user_guide/posts.md).
+func decodeMP4Frame(rs io.ReadSeeker) (*gtsVideo, error) {
 	// probe the video file to extract useful metadata from it; for methodology, see:
 	// https://github.com/abema/go-mp4/blob/7d8e5a7c5e644e0394261b0cf72fef79ce246d31/mp4tool/probe/probe.go#L85-L154
-	info, err := mp4.Probe(tfs)
+	info, err := mp4.Probe(rs)
 	if err != nil {
 		return nil, fmt.Errorf("error during mp4 probe: %w", err)
 	}
@@ -125,8 +151,92 @@ func decodeVideoFrame(r io.Reader) (*gtsVideo, error) {
 	}
 
 	// Create new empty "frame" image.
-	// TODO: decode frame from video file.
 	video.frame = blankImage(width, height)
 
+	// Draw the cover art over it, if there is any.
+	if cover := decodeMP4Cover(rs); cover != nil {
+		fitImage(video.frame, cover)
+	}
+
 	return &video, nil
 }
+
+// decodeMP4Cover returns the cover art stored in the iTunes-style metadata
+// of the given mp4 or quicktime stream (moov/udta/meta/ilst/covr), or nil if
+// there's none, or it can't be decoded.
+func decodeMP4Cover(rs io.ReadSeeker) *gtsImage {
+	if _, err := rs.Seek(0, io.SeekStart); err != nil {
+		log.Debugf("error seeking to start of mp4: %s", err)
+		return nil
+	}
+
+	boxes, err := mp4.ExtractBox(rs, nil, mp4.BoxPath{
+		mp4.BoxTypeMoov(),
+		mp4.BoxTypeUdta(),
+		mp4.BoxTypeMeta(),
+		mp4.BoxTypeIlst(),
+		mp4.StrToBoxType("covr"),
+		mp4.BoxTypeData(),
+	})
+	if err != nil {
+		log.Debugf("error finding mp4 cover art: %s", err)
+		return nil
+	}
+
+	for _, box := range boxes {
+		if box.Size-box.HeaderSize > maxMP4CoverSize {
+			continue
+		}
+
+		if _, err := box.SeekToPayload(rs); err != nil {
+			log.Debugf("error seeking to mp4 cover art: %s", err)
+			return nil
+		}
+
+		payload, _, err := mp4.UnmarshalAny(rs, box.Type, box.Size-box.HeaderSize, box.Context)
+		if err != nil {
+			log.Debugf("error reading mp4 cover art: %s", err)
+			continue
+		}
+
+		data, ok := payload.(*mp4.Data)
+		if !ok || len(data.Data) == 0 {
+			continue
+		}
+
+		// Cover art is jpeg or png; leave it to the decoder to tell which.
+		cover, err := decodeImage(bytes.NewReader(data.Data), imaging.AutoOrientation(true))
+		if err != nil {
+			log.Debugf("error decoding mp4 cover art: %s", err)
+			continue
+		}
+
+		return cover
+	}
+
+	return nil
+}
+
+// fitImage draws src centered over dst, scaled up or down
+// to the largest size at which it still fits within dst.
+func fitImage(dst *gtsImage, src *gtsImage) {
+	dstW, dstH := int(dst.Width()), int(dst.Height())
+	srcW, srcH := int(src.Width()), int(src.Height())
+	if dstW == 0 || dstH == 0 || srcW == 0 || srcH == 0 {
+		return
+	}
+
+	// Scale to whichever dimension fills dst first.
+	w, h := dstW, srcH*dstW/srcW
+	if h > dstH {
+		w, h = srcW*dstH/srcH, dstH
+	}
+	if w == 0 || h == 0 {
+		return
+	}
+
+	scaled := imaging.Resize(src.image, w, h, imaging.Linear)
+	x, y := (dstW-w)/2, (dstH-h)/2
+
+	draw.Draw(dst.image.(draw.Image), image.Rect(x, y, x+w, y+h), scaled, image.Point{}, draw.Over)
+}
@@ -0,0 +1,720 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package media
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/bits"
+
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/image/vp8"
+)
+
+// EBML element IDs that we care about when demuxing webm; see:
+// https://www.matroska.org/technical/elements.html
+const (
+	ebmlIDHeader          = 0x1A45DFA3
+	ebmlIDDocType         = 0x4282
+	ebmlIDSegment         = 0x18538067
+	ebmlIDSeekHead        = 0x114D9B74
+	ebmlIDInfo            = 0x1549A966
+	ebmlIDTimestampScale  = 0x2AD7B1
+	ebmlIDDuration        = 0x4489
+	ebmlIDTracks          = 0x1654AE6B
+	ebmlIDTrackEntry      = 0xAE
+	ebmlIDTrackNumber     = 0xD7
+	ebmlIDTrackType       = 0x83
+	ebmlIDCodecID         = 0x86
+	ebmlIDDefaultDuration = 0x23E383
+	ebmlIDVideo           = 0xE0
+	ebmlIDPixelWidth      = 0xB0
+	ebmlIDPixelHeight     = 0xBA
+	ebmlIDCluster         = 0x1F43B675
+	ebmlIDTimestamp       = 0xE7
+	ebmlIDSimpleBlock     = 0xA3
+	ebmlIDBlockGroup      = 0xA0
+	ebmlIDBlock           = 0xA1
+	ebmlIDReferenceBlock  = 0xFB
+	ebmlIDCues            = 0x1C53BB6B
+	ebmlIDChapters        = 0x1043A770
+	ebmlIDTags            = 0x1254C367
+	ebmlIDAttachments     = 0x1941A469
+
+	// webmTrackTypeVideo is the TrackType of video tracks.
+	webmTrackTypeVideo = 1
+
+	// webmCodecVP8 is the CodecID of VP8 video tracks,
+	// the only codec we can decode a thumbnail frame from.
+	webmCodecVP8 = "V_VP8"
+
+	// maxWebMFrameSize is the largest video frame, in
+	// bytes, that we're willing to read into memory to
+	// decode as a thumbnail.
+	maxWebMFrameSize = 16 << 20 // 16MiB
+)
+
+// errEBMLInvalid is returned when a webm file can't be parsed.
+var errEBMLInvalid = errors.New("invalid ebml data")
+
+// ebmlElement is the header of one EBML element.
+type ebmlElement struct {
+	id     uint64
+	size   int64 // -1 when the size is unknown
+	offset int64 // offset of the element header
+	start  int64 // offset of the element data
+}
+
+// end returns the offset just after the element data,
+// or the given parent end if the element size is unknown.
+func (el ebmlElement) end(parentEnd int64) int64 {
+	if el.size < 0 {
+		return parentEnd
+	}
+	return el.start + el.size
+}
+
+// ebmlReader reads EBML elements from a seekable stream,
+// keeping track of the current offset as it goes.
+type ebmlReader struct {
+	rs  io.ReadSeeker
+	pos int64
+	buf [8]byte
+}
+
+// readVint reads one EBML variable size integer, returning its value
+// and length in bytes. Element IDs keep their length marker bits.
+func (e *ebmlReader) readVint(keepMarker bool) (uint64, int, error) {
+	if _, err := io.ReadFull(e.rs, e.buf[:1]); err != nil {
+		return 0, 0, err
+	}
+
+	length := bits.LeadingZeros8(e.buf[0]) + 1
+	if length > 8 {
+		return 0, 0, errEBMLInvalid
+	}
+
+	val := uint64(e.buf[0])
+	if !keepMarker {
+		val &= 0xFF >> length
+	}
+
+	if length > 1 {
+		if _, err := io.ReadFull(e.rs, e.buf[1:length]); err != nil {
+			return 0, 0, err
+		}
+		for _, b := range e.buf[1:length] {
+			val = val<<8 | uint64(b)
+		}
+	}
+
+	e.pos += int64(length)
+	return val, length, nil
+}
+
+// next reads the header of the next element.
+func (e *ebmlReader) next() (ebmlElement, error) {
+	el := ebmlElement{offset: e.pos}
+
+	id, _, err := e.readVint(true)
+	if err != nil {
+		return el, err
+	}
+
+	size, n, err := e.readVint(false)
+	if err != nil {
+		return el, err
+	}
+
+	el.id = id
+	el.start = e.pos
+	if size == 1<<(7*n)-1 {
+		// all data bits set means unknown size
+		el.size = -1
+	} else if size > math.MaxInt64/2 {
+		return el, errEBMLInvalid
+	} else {
+		el.size = int64(size)
+	}
+
+	return el, nil
+}
+
+// seek moves to the given offset.
+func (e *ebmlReader) seek(offset int64) error {
+	if _, err := e.rs.Seek(offset, io.SeekStart); err != nil {
+		return err
+	}
+	e.pos = offset
+	return nil
+}
+
+// skip moves past the data of the given element.
+func (e *ebmlReader) skip(el ebmlElement) error {
+	if el.size < 0 {
+		return fmt.Errorf("%w: can't skip element %x of unknown size", errEBMLInvalid, el.id)
+	}
+	return e.seek(el.start + el.size)
+}
+
+// readData reads the data of the given element, up to max bytes.
+func (e *ebmlReader) readData(el ebmlElement, max int64) ([]byte, error) {
+	if el.size < 0 || el.size > max {
+		return nil, fmt.Errorf("%w: element %x too large", errEBMLInvalid, el.id)
+	}
+
+	b := make([]byte, el.size)
+	if _, err := io.ReadFull(e.rs, b); err != nil {
+		return nil, err
+	}
+
+	e.pos += el.size
+	return b, nil
+}
+
+// readUint reads the data of the given element as an unsigned integer.
+func (e *ebmlReader) readUint(el ebmlElement) (uint64, error) {
+	b, err := e.readData(el, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	var val uint64
+	for _, c := range b {
+		val = val<<8 | uint64(c)
+	}
+	return val, nil
+}
+
+// readFloat reads the data of the given element as a float.
+func (e *ebmlReader) readFloat(el ebmlElement) (float64, error) {
+	b, err := e.readData(el, 8)
+	if err != nil {
+		return 0, err
+	}
+
+	switch len(b) {
+	case 0:
+		return 0, nil
+	case 4:
+		return float64(math.Float32frombits(binary.BigEndian.Uint32(b))), nil
+	case 8:
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), nil
+	default:
+		return 0, fmt.Errorf("%w: bad float size %d", errEBMLInvalid, len(b))
+	}
+}
+
+// readString reads the data of the given element as a string.
+func (e *ebmlReader) readString(el ebmlElement) (string, error) {
+	b, err := e.readData(el, 256)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes.TrimRight(b, "\x00")), nil
+}
+
+// webmDemuxer walks through a webm file, gathering
+// metadata about its video track, and decoding the
+// first keyframe it can find on the way.
+type webmDemuxer struct {
+	ebmlReader
+
+	timestampScale  uint64  // nanoseconds per timestamp tick
+	duration        float64 // in timestamp ticks, 0 if not given
+	lastTimestamp   int64   // of the latest block, in timestamp ticks
+	videoTrack      uint64
+	videoCodec      string
+	defaultDuration uint64 // nanoseconds per frame, 0 if not given
+	width           int
+	height          int
+	frames          int
+	frame           *gtsImage
+}
+
+// decodeWebMFrame decodes and returns video metadata and the first
+// keyframe from the given webm stream. Only VP8 frames can be decoded,
+// so for other codecs the frame is a blank image of the video dimensions.
+func decodeWebMFrame(rs io.ReadSeeker) (*gtsVideo, error) {
+	size, err := rs.Seek(0, io.SeekEnd)
+	if err != nil {
+		return nil, err
+	}
+
+	d := &webmDemuxer{
+		ebmlReader:     ebmlReader{rs: rs},
+		timestampScale: 1000000, // default is 1ms per tick
+	}
+
+	if err := d.seek(0); err != nil {
+		return nil, err
+	}
+
+	if err := d.readHeader(); err != nil {
+		return nil, fmt.Errorf("error reading webm header: %w", err)
+	}
+
+	if err := d.readSegment(size); err != nil {
+		return nil, fmt.Errorf("error reading webm segment: %w", err)
+	}
+
+	if d.videoTrack == 0 {
+		return nil, errors.New("no video track found in webm")
+	}
+
+	var video gtsVideo
+
+	// prefer the duration given in the segment info,
+	// falling back to the time of the last block
+	duration := d.duration
+	if duration == 0 {
+		duration = float64(d.lastTimestamp)
+	}
+	video.duration = float32(duration * float64(d.timestampScale) / 1e9)
+
+	if d.defaultDuration != 0 {
+		video.framerate = float32(1e9 / float64(d.defaultDuration))
+	} else if video.duration != 0 {
+		video.framerate = float32(d.frames) / video.duration
+	}
+
+	if video.duration != 0 {
+		video.bitrate = uint64(float64(size*8) / float64(video.duration))
+	}
+
+	// Check for empty video metadata.
+	var empty []string
+	if d.width == 0 {
+		empty = append(empty, "width")
+	}
+	if d.height == 0 {
+		empty = append(empty, "height")
+	}
+	if video.duration == 0 {
+		empty = append(empty, "duration")
+	}
+	if video.framerate == 0 {
+		empty = append(empty, "framerate")
+	}
+	if video.bitrate == 0 {
+		empty = append(empty, "bitrate")
+	}
+	if len(empty) > 0 {
+		return nil, fmt.Errorf("error determining video metadata: %v", empty)
+	}
+
+	video.frame = d.frame
+	if video.frame == nil {
+		video.frame = blankImage(d.width, d.height)
+	}
+
+	return &video, nil
+}
+
+// readHeader reads the EBML header, and checks this is a webm file.
+func (d *webmDemuxer) readHeader() error {
+	hdr, err := d.next()
+	if err != nil {
+		return err
+	}
+
+	if hdr.id != ebmlIDHeader || hdr.size < 0 {
+		return errors.New("not an ebml file")
+	}
+
+	for d.pos < hdr.end(0) {
+		el, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		if el.id != ebmlIDDocType {
+			if err := d.skip(el); err != nil {
+				return err
+			}
+			continue
+		}
+
+		docType, err := d.readString(el)
+		if err != nil {
+			return err
+		}
+
+		if docType != "webm" {
+			return fmt.Errorf("unsupported ebml doctype %q", docType)
+		}
+	}
+
+	return nil
+}
+
+// readSegment finds the segment, and reads the info,
+// tracks and clusters within it.
+func (d *webmDemuxer) readSegment(fileSize int64) error {
+	var segment ebmlElement
+	for {
+		el, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		if el.id == ebmlIDSegment {
+			segment = el
+			break
+		}
+
+		if err := d.skip(el); err != nil {
+			return err
+		}
+	}
+
+	segmentEnd := segment.end(fileSize)
+	for d.pos < segmentEnd {
+		el, err := d.next()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				// truncated file, use what we have
+				return nil
+			}
+			return err
+		}
+
+		switch el.id {
+		case ebmlIDInfo:
+			err = d.readInfo(el)
+		case ebmlIDTracks:
+			err = d.readTracks(el)
+		case ebmlIDCluster:
+			err = d.readCluster(el, segmentEnd)
+		default:
+			err = d.skip(el)
+		}
+
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				// truncated file, use what we have
+				return nil
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// readInfo reads the timestamp scale and duration of the segment.
+func (d *webmDemuxer) readInfo(info ebmlElement) error {
+	end := info.end(0)
+	for d.pos < end {
+		el, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch el.id {
+		case ebmlIDTimestampScale:
+			d.timestampScale, err = d.readUint(el)
+			if err == nil && d.timestampScale == 0 {
+				err = fmt.Errorf("%w: zero timestamp scale", errEBMLInvalid)
+			}
+		case ebmlIDDuration:
+			d.duration, err = d.readFloat(el)
+		default:
+			err = d.skip(el)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTracks reads track entries, keeping hold of the first video track.
+func (d *webmDemuxer) readTracks(tracks ebmlElement) error {
+	end := tracks.end(0)
+	for d.pos < end {
+		el, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		if el.id != ebmlIDTrackEntry {
+			if err := d.skip(el); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := d.readTrackEntry(el); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readTrackEntry reads one track entry, storing
+// its details if it's the first video track.
+func (d *webmDemuxer) readTrackEntry(entry ebmlElement) error {
+	var (
+		number          uint64
+		trackType       uint64
+		codec           string
+		defaultDuration uint64
+		width           uint64
+		height          uint64
+	)
+
+	end := entry.end(0)
+	for d.pos < end {
+		el, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch el.id {
+		case ebmlIDTrackNumber:
+			number, err = d.readUint(el)
+		case ebmlIDTrackType:
+			trackType, err = d.readUint(el)
+		case ebmlIDCodecID:
+			codec, err = d.readString(el)
+		case ebmlIDDefaultDuration:
+			defaultDuration, err = d.readUint(el)
+		case ebmlIDVideo:
+			// read pixel dimensions from within the video element
+			videoEnd := el.end(0)
+			for err == nil && d.pos < videoEnd {
+				var child ebmlElement
+				child, err = d.next()
+				if err != nil {
+					break
+				}
+
+				switch child.id {
+				case ebmlIDPixelWidth:
+					width, err = d.readUint(child)
+				case ebmlIDPixelHeight:
+					height, err = d.readUint(child)
+				default:
+					err = d.skip(child)
+				}
+			}
+		default:
+			err = d.skip(el)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if trackType != webmTrackTypeVideo || d.videoTrack != 0 || number == 0 {
+		// not a video track, or not the first one
+		return nil
+	}
+
+	if width > math.MaxInt32 || height > math.MaxInt32 {
+		return fmt.Errorf("%w: bad video dimensions", errEBMLInvalid)
+	}
+
+	d.videoTrack = number
+	d.videoCodec = codec
+	d.defaultDuration = defaultDuration
+	d.width = int(width)
+	d.height = int(height)
+	return nil
+}
+
+// isLevel1 returns whether the given ID is of an element that lives
+// directly within the segment, which marks the end of a cluster of
+// unknown size.
+func isLevel1(id uint64) bool {
+	switch id {
+	case ebmlIDSeekHead, ebmlIDInfo, ebmlIDTracks, ebmlIDCluster,
+		ebmlIDCues, ebmlIDChapters, ebmlIDTags, ebmlIDAttachments:
+		return true
+	}
+	return false
+}
+
+// readCluster reads the blocks of one cluster.
+func (d *webmDemuxer) readCluster(cluster ebmlElement, segmentEnd int64) error {
+	var timestamp uint64
+
+	end := cluster.end(segmentEnd)
+	for d.pos < end {
+		el, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		if cluster.size < 0 && isLevel1(el.id) {
+			// end of a cluster of unknown size;
+			// rewind so the segment can read it
+			return d.seek(el.offset)
+		}
+
+		switch el.id {
+		case ebmlIDTimestamp:
+			timestamp, err = d.readUint(el)
+		case ebmlIDSimpleBlock:
+			err = d.readBlock(el, timestamp, true)
+		case ebmlIDBlockGroup:
+			err = d.readBlockGroup(el, timestamp)
+		default:
+			err = d.skip(el)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readBlockGroup reads the block within a block group. Blocks in groups
+// are keyframes unless the group contains a reference to another block.
+func (d *webmDemuxer) readBlockGroup(group ebmlElement, timestamp uint64) error {
+	var (
+		block    ebmlElement
+		hasBlock bool
+		keyframe = true
+	)
+
+	end := group.end(0)
+	for d.pos < end {
+		el, err := d.next()
+		if err != nil {
+			return err
+		}
+
+		switch el.id {
+		case ebmlIDBlock:
+			block, hasBlock = el, true
+		case ebmlIDReferenceBlock:
+			keyframe = false
+		}
+
+		if err := d.skip(el); err != nil {
+			return err
+		}
+	}
+
+	if !hasBlock {
+		return nil
+	}
+
+	if err := d.seek(block.start); err != nil {
+		return err
+	}
+
+	if err := d.readBlock(block, timestamp, keyframe); err != nil {
+		return err
+	}
+
+	return d.seek(end)
+}
+
+// readBlock reads the header of a (simple) block, counting its frames,
+// and decodes the first video keyframe that we're able to.
+func (d *webmDemuxer) readBlock(block ebmlElement, clusterTimestamp uint64, keyframe bool) error {
+	end := block.end(0)
+
+	track, _, err := d.readVint(false)
+	if err != nil {
+		return err
+	}
+
+	// relative timestamp and flags
+	var hdr [3]byte
+	if _, err := io.ReadFull(d.rs, hdr[:]); err != nil {
+		return err
+	}
+	d.pos += 3
+
+	timestamp := int64(clusterTimestamp) + int64(int16(binary.BigEndian.Uint16(hdr[:2])))
+	if timestamp > d.lastTimestamp {
+		d.lastTimestamp = timestamp
+	}
+
+	flags := hdr[2]
+	if flags&0x80 == 0 && block.id == ebmlIDSimpleBlock {
+		// keyframe flag is only set on simple blocks
+		keyframe = false
+	}
+
+	if track != d.videoTrack {
+		return d.seek(end)
+	}
+
+	// count the frames in this block
+	laced := flags&0x06 != 0
+	if laced {
+		if _, err := io.ReadFull(d.rs, hdr[:1]); err != nil {
+			return err
+		}
+		d.pos++
+		d.frames += int(hdr[0]) + 1
+	} else {
+		d.frames++
+	}
+
+	if d.frame == nil && keyframe && !laced && d.videoCodec == webmCodecVP8 {
+		d.frame = d.decodeVP8(end - d.pos)
+	}
+
+	return d.seek(end)
+}
+
+// decodeVP8 decodes the vp8 keyframe of the given size at the current
+// offset, returning nil if it couldn't be decoded.
+func (d *webmDemuxer) decodeVP8(size int64) *gtsImage {
+	if size <= 0 || size > maxWebMFrameSize {
+		return nil
+	}
+
+	b := make([]byte, size)
+	if _, err := io.ReadFull(d.rs, b); err != nil {
+		log.Debugf("error reading vp8 frame: %s", err)
+		return nil
+	}
+	d.pos += size
+
+	dec := vp8.NewDecoder()
+	dec.Init(bytes.NewReader(b), len(b))
+
+	fh, err := dec.DecodeFrameHeader()
+	if err != nil || !fh.KeyFrame {
+		log.Debugf("error decoding vp8 frame header: %v", err)
+		return nil
+	}
+
+	img, err := dec.DecodeFrame()
+	if err != nil {
+		log.Debugf("error decoding vp8 frame: %s", err)
+		return nil
+	}
+
+	return &gtsImage{image: img}
+}
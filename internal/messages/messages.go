@@ -22,6 +22,7 @@ import (
 	"net/url"
 
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // FromClientAPI wraps a message that travels from the client API into the processor.
@@ -31,6 +32,13 @@ type FromClientAPI struct {
 	GTSModel       interface{}
 	OriginAccount  *gtsmodel.Account
 	TargetAccount  *gtsmodel.Account
+	TraceContext   trace.SpanContext // span context of the request that caused this message, if any
+}
+
+// SpanContext returns the span context of the request that caused this message,
+// so that its processing can be traced as part of the same trace.
+func (msg FromClientAPI) SpanContext() trace.SpanContext {
+	return msg.TraceContext
 }
 
 // FromFederator wraps a message that travels from the federator into the processor.
@@ -40,4 +48,11 @@ type FromFederator struct {
 	APIri            *url.URL          // what is the IRI ID of this activity?
 	GTSModel         interface{}       // representation of this object if it's already been converted into our internal gts model
 	ReceivingAccount *gtsmodel.Account // which account owns the inbox that this activity was posted to?
+	TraceContext     trace.SpanContext // span context of the request that caused this message, if any
+}
+
+// SpanContext returns the span context of the request that caused this message,
+// so that its processing can be traced as part of the same trace.
+func (msg FromFederator) SpanContext() trace.SpanContext {
+	return msg.TraceContext
 }
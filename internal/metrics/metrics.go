@@ -0,0 +1,209 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package metrics provides the Prometheus collectors used to instrument
+// GoToSocial, and the handler that exposes them at /metrics.
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "gotosocial"
+
+// Result label values used by counters that
+// distinguish between success and failure.
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+	ResultHit     = "hit"
+	ResultMiss    = "miss"
+)
+
+var (
+	// registry is the dedicated registry that all GoToSocial collectors are
+	// registered to, rather than the prometheus global default registry, so
+	// that vendored libraries can't sneak their own metrics into /metrics.
+	registry = prometheus.NewRegistry()
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "Duration of HTTP requests handled by the router, by method, matched route and response status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	workerProcessDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "worker",
+		Name:      "process_duration_seconds",
+		Help:      "Time taken by worker pools to process a single queued message.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"worker"})
+
+	workerQueueDepth = &queueCollector{
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "worker", "queue_depth"),
+			"Number of messages currently waiting in a worker pool queue.",
+			[]string{"worker"}, nil,
+		),
+		depths: make(map[string]func() int),
+	}
+
+	deliveriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "federation",
+		Name:      "deliveries_total",
+		Help:      "Outgoing ActivityPub deliveries, by remote domain and result.",
+	}, []string{"domain", "result"})
+
+	cacheLookupsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cache",
+		Name:      "lookups_total",
+		Help:      "Database cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+
+	dbQueryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "db",
+		Name:      "query_duration_seconds",
+		Help:      "Duration of database queries, by operation (SELECT, INSERT, etc).",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5},
+	}, []string{"operation"})
+
+	mediaProcessedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "media",
+		Name:      "processed_total",
+		Help:      "Media attachments and emojis processed, by type and result.",
+	}, []string{"type", "result"})
+)
+
+func init() {
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		workerProcessDuration,
+		workerQueueDepth,
+		deliveriesTotal,
+		cacheLookupsTotal,
+		dbQueryDuration,
+		mediaProcessedTotal,
+	)
+}
+
+// Handler returns an http.Handler serving all GoToSocial metrics in the
+// Prometheus exposition format. If token is not empty, then requests must
+// provide it as a bearer token in the Authorization header, else they will
+// be rejected with 401 Unauthorized.
+func Handler(token string) http.Handler {
+	handler := promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+	if token == "" {
+		return handler
+	}
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if !checkToken(r.Header.Get("Authorization"), token) {
+			rw.Header().Set("WWW-Authenticate", `Bearer realm="metrics"`)
+			http.Error(rw, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(rw, r)
+	})
+}
+
+// ObserveHTTPRequest records the duration of a handled HTTP request. Route
+// should be the matched route template (eg., /api/v1/statuses/:id) rather
+// than the request path, to keep the number of label values bounded.
+func ObserveHTTPRequest(method string, route string, status int, dur time.Duration) {
+	httpRequestDuration.WithLabelValues(
+		normalizeMethod(method),
+		route,
+		strconv.Itoa(status),
+	).Observe(dur.Seconds())
+}
+
+// ObserveWorkerProcess records the time taken by the named worker pool to process one message.
+func ObserveWorkerProcess(worker string, dur time.Duration) {
+	workerProcessDuration.WithLabelValues(worker).Observe(dur.Seconds())
+}
+
+// RegisterWorkerQueue registers a function returning the current queue depth of the
+// named worker pool, which will be called at scrape time. Registering the same name
+// again replaces the previously registered function.
+func RegisterWorkerQueue(worker string, depth func() int) {
+	workerQueueDepth.mu.Lock()
+	workerQueueDepth.depths[worker] = depth
+	workerQueueDepth.mu.Unlock()
+}
+
+// RecordDelivery records the result of an outgoing delivery to the given remote domain.
+func RecordDelivery(domain string, err error) {
+	deliveriesTotal.WithLabelValues(domain, result(err)).Inc()
+}
+
+// RecordCacheLookup records a lookup in the named database cache.
+func RecordCacheLookup(cache string, hit bool) {
+	res := ResultMiss
+	if hit {
+		res = ResultHit
+	}
+	cacheLookupsTotal.WithLabelValues(cache, res).Inc()
+}
+
+// ObserveDBQuery records the duration of a database query with the given operation.
+func ObserveDBQuery(operation string, dur time.Duration) {
+	dbQueryDuration.WithLabelValues(operation).Observe(dur.Seconds())
+}
+
+// RecordMediaProcessed records the result of processing a piece of media of the
+// given type, which should be a media attachment type (image, video, etc) or "emoji".
+func RecordMediaProcessed(mediaType string, err error) {
+	mediaProcessedTotal.WithLabelValues(mediaType, result(err)).Inc()
+}
+
+// queueCollector is a prometheus.Collector which reports worker queue depths
+// by calling registered functions at scrape time, so that callers needn't
+// keep a gauge up to date on every enqueue and dequeue.
+type queueCollector struct {
+	desc   *prometheus.Desc
+	depths map[string]func() int
+	mu     sync.Mutex
+}
+
+func (q *queueCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- q.desc
+}
+
+func (q *queueCollector) Collect(ch chan<- prometheus.Metric) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for worker, depth := range q.depths {
+		ch <- prometheus.MustNewConstMetric(q.desc, prometheus.GaugeValue, float64(depth()), worker)
+	}
+}
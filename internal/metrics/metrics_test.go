@@ -0,0 +1,106 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics_test
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+)
+
+type MetricsTestSuite struct {
+	suite.Suite
+}
+
+func (suite *MetricsTestSuite) scrape(handler http.Handler, authHeader string) (int, string) {
+	r := httptest.NewRequest(http.MethodGet, metrics.Path, nil)
+	if authHeader != "" {
+		r.Header.Set("Authorization", authHeader)
+	}
+
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, r)
+
+	result := recorder.Result()
+	defer result.Body.Close()
+
+	b, err := io.ReadAll(result.Body)
+	suite.NoError(err)
+
+	return result.StatusCode, string(b)
+}
+
+func (suite *MetricsTestSuite) TestScrapeNoToken() {
+	metrics.ObserveHTTPRequest(http.MethodGet, "/api/v1/statuses/:id", http.StatusOK, 25*time.Millisecond)
+	metrics.ObserveHTTPRequest("BREW", "unmatched", http.StatusNotFound, time.Millisecond)
+	metrics.RegisterWorkerQueue("messages.FromTest", func() int { return 69 })
+	metrics.ObserveWorkerProcess("messages.FromTest", time.Millisecond)
+	metrics.RecordDelivery("example.org", nil)
+	metrics.RecordDelivery("example.org", errors.New("connection refused"))
+	metrics.RecordCacheLookup("account", true)
+	metrics.RecordCacheLookup("account", false)
+	metrics.ObserveDBQuery("SELECT", time.Millisecond)
+	metrics.RecordMediaProcessed("image", nil)
+
+	code, body := suite.scrape(metrics.Handler(""), "")
+	suite.Equal(http.StatusOK, code)
+
+	for _, expect := range []string{
+		`gotosocial_http_request_duration_seconds_count{method="GET",route="/api/v1/statuses/:id",status="200"} `,
+		`gotosocial_http_request_duration_seconds_count{method="OTHER",route="unmatched",status="404"} `,
+		`gotosocial_worker_queue_depth{worker="messages.FromTest"} 69`,
+		`gotosocial_worker_process_duration_seconds_count{worker="messages.FromTest"} `,
+		`gotosocial_federation_deliveries_total{domain="example.org",result="success"} `,
+		`gotosocial_federation_deliveries_total{domain="example.org",result="failure"} `,
+		`gotosocial_cache_lookups_total{cache="account",result="hit"} `,
+		`gotosocial_cache_lookups_total{cache="account",result="miss"} `,
+		`gotosocial_db_query_duration_seconds_count{operation="SELECT"} `,
+		`gotosocial_media_processed_total{result="success",type="image"} `,
+		`go_goroutines `,
+	} {
+		suite.Contains(body, expect)
+	}
+}
+
+func (suite *MetricsTestSuite) TestScrapeToken() {
+	handler := metrics.Handler("scrapescrapescrape")
+
+	code, _ := suite.scrape(handler, "")
+	suite.Equal(http.StatusUnauthorized, code)
+
+	code, _ = suite.scrape(handler, "Bearer nope")
+	suite.Equal(http.StatusUnauthorized, code)
+
+	code, _ = suite.scrape(handler, "scrapescrapescrape")
+	suite.Equal(http.StatusUnauthorized, code)
+
+	code, body := suite.scrape(handler, "Bearer scrapescrapescrape")
+	suite.Equal(http.StatusOK, code)
+	suite.Contains(body, "go_goroutines ")
+}
+
+func TestMetricsTestSuite(t *testing.T) {
+	suite.Run(t, &MetricsTestSuite{})
+}
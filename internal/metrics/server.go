@@ -0,0 +1,43 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package metrics
+
+import (
+	"net/http"
+	"time"
+)
+
+// Path is the path at which metrics are served.
+const Path = "/metrics"
+
+// NewServer returns an http.Server which serves metrics at Path on the given
+// address, for when metrics should be exposed on a separate listener (eg.,
+// a loopback or internal-only address) rather than the main router. The
+// caller is responsible for starting and shutting down the returned server.
+func NewServer(addr string, token string) *http.Server {
+	mux := http.NewServeMux()
+	mux.Handle(Path, Handler(token))
+
+	return &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 30 * time.Second,
+		WriteTimeout:      30 * time.Second,
+	}
+}
@@ -0,0 +1,134 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Scopes that can be granted to a token, and that client API
+// endpoints require. Top-level scopes like 'read' and 'write'
+// include every more specific scope beneath them, so a token
+// granted 'read' may also use endpoints requiring 'read:statuses'.
+//
+// As in Mastodon, there is no bare 'admin' scope: admin scopes
+// start at 'admin:read' and 'admin:write'.
+const (
+	ScopeRead   = "read"
+	ScopeWrite  = "write"
+	ScopeFollow = "follow"
+	ScopePush   = "push"
+
+	ScopeReadAccounts      = "read:accounts"
+	ScopeReadBlocks        = "read:blocks"
+	ScopeReadBookmarks     = "read:bookmarks"
+	ScopeReadCustomEmojis  = "read:custom_emojis"
+	ScopeReadFavourites    = "read:favourites"
+	ScopeReadFilters       = "read:filters"
+	ScopeReadFollows       = "read:follows"
+	ScopeReadLists         = "read:lists"
+	ScopeReadMedia         = "read:media"
+	ScopeReadMutes         = "read:mutes"
+	ScopeReadNotifications = "read:notifications"
+	ScopeReadReports       = "read:reports"
+	ScopeReadSearch        = "read:search"
+	ScopeReadStatuses      = "read:statuses"
+	ScopeReadStreaming     = "read:streaming"
+
+	ScopeWriteAccounts      = "write:accounts"
+	ScopeWriteBlocks        = "write:blocks"
+	ScopeWriteConversations = "write:conversations"
+	ScopeWriteFilters       = "write:filters"
+	ScopeWriteFollows       = "write:follows"
+	ScopeWriteLists         = "write:lists"
+	ScopeWriteMedia         = "write:media"
+	ScopeWriteMutes         = "write:mutes"
+	ScopeWriteNotifications = "write:notifications"
+	ScopeWriteReports       = "write:reports"
+	ScopeWriteStatuses      = "write:statuses"
+	ScopeWriteUser          = "write:user"
+
+	ScopeAdminRead             = "admin:read"
+	ScopeAdminReadAccounts     = "admin:read:accounts"
+	ScopeAdminReadDomainAllows = "admin:read:domain_allows"
+	ScopeAdminReadDomainBlocks = "admin:read:domain_blocks"
+	ScopeAdminReadReports      = "admin:read:reports"
+
+	ScopeAdminWrite             = "admin:write"
+	ScopeAdminWriteAccounts     = "admin:write:accounts"
+	ScopeAdminWriteDomainAllows = "admin:write:domain_allows"
+	ScopeAdminWriteDomainBlocks = "admin:write:domain_blocks"
+	ScopeAdminWriteReports      = "admin:write:reports"
+)
+
+// followScopes are the scopes included in the
+// deprecated 'follow' scope, for older clients.
+var followScopes = []string{
+	ScopeReadBlocks,
+	ScopeWriteBlocks,
+	ScopeReadFollows,
+	ScopeWriteFollows,
+	ScopeReadMutes,
+	ScopeWriteMutes,
+}
+
+// ScopeGrants returns true if the given space-separated
+// list of granted scopes, as stored on a token, includes
+// the required scope.
+func ScopeGrants(granted string, required string) bool {
+	for _, scope := range strings.Fields(granted) {
+		switch {
+		case scope == required:
+			// exact match
+			return true
+		case scope == "admin":
+			// not a real scope, so it mustn't
+			// count as the parent of admin:read
+			continue
+		case strings.HasPrefix(required, scope+":"):
+			// scope is a parent of the required scope,
+			// eg., 'read' grants 'read:statuses', and
+			// 'admin:read' grants 'admin:read:reports'
+			return true
+		case scope == ScopeFollow:
+			for _, followScope := range followScopes {
+				if followScope == required {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// CheckScope returns an error if the token of this Auth was
+// not granted the required scope. Requests without a token
+// are let through, since it's up to Authed to require one.
+func (a *Auth) CheckScope(required string) error {
+	if a.Token == nil {
+		return nil
+	}
+
+	if granted := a.Token.GetScope(); !ScopeGrants(granted, required) {
+		return fmt.Errorf("token scope %q does not include required scope %q", granted, required)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package oauth_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/oauth2/v4/models"
+)
+
+type ScopeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *ScopeTestSuite) TestScopeGrants() {
+	for _, test := range []struct {
+		granted  string
+		required string
+		expect   bool
+	}{
+		// exact matches
+		{"read", oauth.ScopeRead, true},
+		{"read:statuses", oauth.ScopeReadStatuses, true},
+		{"push", oauth.ScopePush, true},
+		{"admin:read:reports", oauth.ScopeAdminReadReports, true},
+
+		// parent scopes
+		{"read", oauth.ScopeReadStatuses, true},
+		{"read", oauth.ScopeReadAccounts, true},
+		{"write", oauth.ScopeWriteStatuses, true},
+		{"admin:read", oauth.ScopeAdminReadReports, true},
+		{"admin:write", oauth.ScopeAdminWriteAccounts, true},
+
+		// read doesn't grant write and vice versa
+		{"read", oauth.ScopeWriteStatuses, false},
+		{"read", oauth.ScopeWrite, false},
+		{"write", oauth.ScopeReadStatuses, false},
+		{"read:statuses", oauth.ScopeRead, false},
+		{"read:statuses", oauth.ScopeReadAccounts, false},
+		{"admin:read", oauth.ScopeAdminWrite, false},
+		{"admin:read", oauth.ScopeAdminWriteReports, false},
+		{"admin:read:reports", oauth.ScopeAdminReadAccounts, false},
+
+		// normal scopes don't grant admin scopes
+		{"read write follow push", oauth.ScopeAdminRead, false},
+		{"read", oauth.ScopeAdminReadReports, false},
+
+		// prefixes only count up to a colon
+		{"read:status", oauth.ScopeReadStatuses, false},
+		{"adm", oauth.ScopeAdminRead, false},
+
+		// deprecated follow scope
+		{"follow", oauth.ScopeReadFollows, true},
+		{"follow", oauth.ScopeWriteFollows, true},
+		{"follow", oauth.ScopeReadBlocks, true},
+		{"follow", oauth.ScopeWriteMutes, true},
+		{"follow", oauth.ScopeReadStatuses, false},
+		{"follow", oauth.ScopeWriteStatuses, false},
+
+		// multiple granted scopes
+		{"read write follow push", oauth.ScopeWriteStatuses, true},
+		{"read write follow push", oauth.ScopePush, true},
+		{"read:accounts write:statuses", oauth.ScopeWriteStatuses, true},
+		{"read:accounts write:statuses", oauth.ScopeReadStatuses, false},
+		{"  read   admin:read ", oauth.ScopeAdminReadAccounts, true},
+
+		// made up scopes don't grant anything
+		{"user", oauth.ScopeReadAccounts, false},
+		{"admin", oauth.ScopeAdminRead, false},
+		{"admin", oauth.ScopeAdminWriteDomainBlocks, false},
+		{"", oauth.ScopeRead, false},
+	} {
+		suite.Equal(test.expect, oauth.ScopeGrants(test.granted, test.required), "granted %q, required %q", test.granted, test.required)
+	}
+}
+
+func (suite *ScopeTestSuite) TestCheckScope() {
+	auth := &oauth.Auth{}
+	suite.NoError(auth.CheckScope(oauth.ScopeWriteStatuses))
+
+	auth.Token = &models.Token{Scope: "read"}
+	suite.NoError(auth.CheckScope(oauth.ScopeReadStatuses))
+	suite.EqualError(auth.CheckScope(oauth.ScopeWriteStatuses), `token scope "read" does not include required scope "write:statuses"`)
+}
+
+func TestScopeTestSuite(t *testing.T) {
+	suite.Run(t, new(ScopeTestSuite))
+}
@@ -24,6 +24,7 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
@@ -55,6 +56,14 @@ func (p *processor) AccountUpdate(ctx context.Context, authed *oauth.Auth, form
 	return p.accountProcessor.Update(ctx, authed.Account, form)
 }
 
+func (p *processor) AccountAlias(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode) {
+	return p.accountProcessor.Alias(ctx, authed.Account, form)
+}
+
+func (p *processor) AccountMove(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode) {
+	return p.accountProcessor.Move(ctx, authed.Account, form)
+}
+
 func (p *processor) AccountStatusesGet(ctx context.Context, authed *oauth.Auth, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinnedOnly bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode) {
 	return p.accountProcessor.StatusesGet(ctx, authed.Account, targetAccountID, limit, excludeReplies, excludeReblogs, maxID, minID, pinnedOnly, mediaOnly, publicOnly)
 }
@@ -63,6 +72,10 @@ func (p *processor) AccountWebStatusesGet(ctx context.Context, targetAccountID s
 	return p.accountProcessor.WebStatusesGet(ctx, targetAccountID, maxID)
 }
 
+func (p *processor) AccountDirectoryGet(ctx context.Context, authed *oauth.Auth, local bool, newest bool, offset int, limit int) ([]apimodel.Account, gtserror.WithCode) {
+	return p.accountProcessor.DirectoryGet(ctx, authed.Account, local, newest, offset, limit)
+}
+
 func (p *processor) AccountFollowersGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]apimodel.Account, gtserror.WithCode) {
 	return p.accountProcessor.FollowersGet(ctx, authed.Account, targetAccountID)
 }
@@ -90,3 +103,25 @@ func (p *processor) AccountBlockCreate(ctx context.Context, authed *oauth.Auth,
 func (p *processor) AccountBlockRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
 	return p.accountProcessor.BlockRemove(ctx, authed.Account, targetAccountID)
 }
+
+func (p *processor) AccountMuteCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMuteRequest) (*apimodel.Relationship, gtserror.WithCode) {
+	relationship, errWithCode := p.accountProcessor.MuteCreate(ctx, authed.Account, form)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// remove any of the muted account's statuses from the requester's home timeline
+	if err := p.statusTimelines.WipeItemsFromAccountID(ctx, authed.Account.ID, form.ID); err != nil {
+		log.Errorf("AccountMuteCreate: error wiping statuses from timeline: %s", err)
+	}
+
+	return relationship, nil
+}
+
+func (p *processor) AccountMuteRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
+	return p.accountProcessor.MuteRemove(ctx, authed.Account, targetAccountID)
+}
+
+func (p *processor) MutesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.accountProcessor.MutesGet(ctx, authed.Account, maxID, sinceID, limit)
+}
@@ -32,6 +32,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
@@ -58,6 +59,11 @@ type Processor interface {
 	GetRSSFeedForUsername(ctx context.Context, username string) (func() (string, gtserror.WithCode), time.Time, gtserror.WithCode)
 	// Update processes the update of an account with the given form
 	Update(ctx context.Context, account *gtsmodel.Account, form *apimodel.UpdateCredentialsRequest) (*apimodel.Account, gtserror.WithCode)
+	// Alias sets the aliases of an account, ie., the accounts that it is also known as, from the given form.
+	Alias(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode)
+	// Move moves an account to the account given in the form, which must list the moving account as an alias,
+	// and sends a Move out to the account's followers.
+	Move(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode)
 	// StatusesGet fetches a number of statuses (in time descending order) from the given account, filtered by visibility for
 	// the account given in authed.
 	StatusesGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinned bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode)
@@ -71,6 +77,9 @@ type Processor interface {
 	FollowersGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
 	// FollowingGet fetches a list of the accounts that target account is following.
 	FollowingGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
+	// DirectoryGet returns a page of discoverable accounts for the profile directory, leaving out accounts that
+	// have a block with requestingAccount. Accounts are ordered by most recent status, or by newest if newest is true.
+	DirectoryGet(ctx context.Context, requestingAccount *gtsmodel.Account, local bool, newest bool, offset int, limit int) ([]apimodel.Account, gtserror.WithCode)
 	// RelationshipGet returns a relationship model describing the relationship of the targetAccount to the Authed account.
 	RelationshipGet(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// FollowCreate handles a follow request to an account, either remote or local.
@@ -81,6 +90,12 @@ type Processor interface {
 	BlockCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// BlockRemove handles the removal of a block from requestingAccount to targetAccountID, either remote or local.
 	BlockRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// MuteCreate handles the creation or updating of a mute from requestingAccount to the account in the given form.
+	MuteCreate(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.AccountMuteRequest) (*apimodel.Relationship, gtserror.WithCode)
+	// MuteRemove handles the removal of a mute from requestingAccount to targetAccountID.
+	MuteRemove(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// MutesGet returns a page of accounts muted by requestingAccount.
+	MutesGet(ctx context.Context, requestingAccount *gtsmodel.Account, maxID string, sinceID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 	// UpdateAvatar does the dirty work of checking the avatar part of an account update form,
 	// parsing and checking the image, and doing the necessary updates in the database for this to become
 	// the account's new avatar image.
@@ -89,6 +104,15 @@ type Processor interface {
 	// parsing and checking the image, and doing the necessary updates in the database for this to become
 	// the account's new header image.
 	UpdateHeader(ctx context.Context, header *multipart.FileHeader, description *string, accountID string) (*gtsmodel.MediaAttachment, error)
+	// VerifyFields checks rel="me" links for the profile fields of the given account, and
+	// stores the updated verification status of each field in the database if it changed.
+	VerifyFields(ctx context.Context, account *gtsmodel.Account) error
+	// ReverifyRemoteFields re-verifies the profile fields of all remote accounts that have fields.
+	ReverifyRemoteFields(ctx context.Context) error
+	// Start starts re-verifying the profile fields of remote accounts at regular intervals.
+	Start() error
+	// Stop stops re-verifying the profile fields of remote accounts.
+	Stop() error
 }
 
 type processor struct {
@@ -101,11 +125,13 @@ type processor struct {
 	db           db.DB
 	federator    federation.Federator
 	parseMention gtsmodel.ParseMentionFunc
+	verifier     relme.Verifier
+	reverifier   *concurrency.TickerWorker
 }
 
 // New returns a new account processor.
-func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, oauthServer oauth.Server, clientWorker *concurrency.WorkerPool[messages.FromClientAPI], federator federation.Federator, parseMention gtsmodel.ParseMentionFunc) Processor {
-	return &processor{
+func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, oauthServer oauth.Server, clientWorker *concurrency.WorkerPool[messages.FromClientAPI], federator federation.Federator, parseMention gtsmodel.ParseMentionFunc, verifier relme.Verifier) Processor {
+	p := &processor{
 		tc:           tc,
 		mediaManager: mediaManager,
 		clientWorker: clientWorker,
@@ -115,5 +141,8 @@ func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, oauth
 		db:           db,
 		federator:    federator,
 		parseMention: parseMention,
+		verifier:     verifier,
 	}
+	p.reverifier = concurrency.NewTickerWorker("re-verifying remote profile fields", reverifyInterval, p.ReverifyRemoteFields)
+	return p
 }
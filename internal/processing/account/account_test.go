@@ -51,6 +51,7 @@ type AccountStandardTestSuite struct {
 	federator           federation.Federator
 	emailSender         email.Sender
 	sentEmails          map[string]string
+	relMePages          map[string]string
 
 	// standard suite models
 	testTokens       map[string]*gtsmodel.Token
@@ -99,7 +100,8 @@ func (suite *AccountStandardTestSuite) SetupTest() {
 	suite.federator = testrig.NewTestFederator(suite.db, suite.transportController, suite.storage, suite.mediaManager, fedWorker)
 	suite.sentEmails = make(map[string]string)
 	suite.emailSender = testrig.NewEmailSender("../../../web/template/", suite.sentEmails)
-	suite.accountProcessor = account.New(suite.db, suite.tc, suite.mediaManager, suite.oauthServer, clientWorker, suite.federator, processing.GetParseMentionFunc(suite.db, suite.federator))
+	suite.relMePages = make(map[string]string)
+	suite.accountProcessor = account.New(suite.db, suite.tc, suite.mediaManager, suite.oauthServer, clientWorker, suite.federator, processing.GetParseMentionFunc(suite.db, suite.federator), testrig.NewRelMeVerifier(suite.relMePages))
 	testrig.StandardDBSetup(suite.db, nil)
 	testrig.StandardStorageSetup(suite.storage, "../../../testrig/media")
 }
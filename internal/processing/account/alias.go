@@ -0,0 +1,103 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// maxAliases is the maximum number of aliases that an account can have.
+const maxAliases = 20
+
+func (p *processor) Alias(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode) {
+	if len(form.AlsoKnownAsURIs) > maxAliases {
+		err := fmt.Errorf("an account can have at most %d aliases", maxAliases)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	aliases := make([]string, 0, len(form.AlsoKnownAsURIs))
+	seen := make(map[string]struct{}, len(form.AlsoKnownAsURIs))
+	for _, rawURI := range form.AlsoKnownAsURIs {
+		uri, err := url.Parse(rawURI)
+		if err != nil || (uri.Scheme != "http" && uri.Scheme != "https") || uri.Host == "" {
+			err := fmt.Errorf("alias %s was not a valid http(s) uri", rawURI)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if uri.String() == account.URI {
+			err := errors.New("an account cannot be an alias of itself")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		// make sure the alias actually points to an account,
+		// and store the canonical uri of that account
+		aliasAccount, err := p.federator.GetAccount(ctx, dereferencing.GetAccountParams{
+			RequestingUsername: account.Username,
+			RemoteAccountID:    uri,
+			Blocking:           true,
+		})
+		if err != nil {
+			err := fmt.Errorf("alias %s could not be resolved to an account: %s", rawURI, err)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		if aliasAccount.ID == account.ID {
+			err := errors.New("an account cannot be an alias of itself")
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if _, ok := seen[aliasAccount.URI]; ok {
+			continue
+		}
+		seen[aliasAccount.URI] = struct{}{}
+		aliases = append(aliases, aliasAccount.URI)
+	}
+
+	account.AlsoKnownAs = aliases
+	if err := p.db.UpdateAccount(ctx, account); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not update account %s: %s", account.ID, err))
+	}
+
+	// federate the new aliases, so that other
+	// instances can verify moves to this account
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       account,
+		OriginAccount:  account,
+		TraceContext:   trace.SpanContextFromContext(ctx),
+	})
+
+	acctSensitive, err := p.tc.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not convert account into apisensitive account: %s", err))
+	}
+	return acctSensitive, nil
+}
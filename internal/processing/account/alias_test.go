@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AccountAliasTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountAliasTestSuite) TestAliasLocalAndRemote() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	form := &apimodel.AccountAliasRequest{
+		AlsoKnownAsURIs: []string{
+			suite.testAccounts["local_account_2"].URI,
+			suite.testAccounts["remote_account_1"].URI,
+			suite.testAccounts["local_account_2"].URI, // duplicates are dropped
+		},
+	}
+
+	apiAccount, errWithCode := suite.accountProcessor.Alias(context.Background(), testAccount, form)
+	suite.NoError(errWithCode)
+	suite.Equal([]string{
+		"http://localhost:8080/users/1happyturtle",
+		"http://fossbros-anonymous.io/users/foss_satan",
+	}, apiAccount.Source.AlsoKnownAsURIs)
+
+	// the new aliases should be federated out
+	msg := <-suite.fromClientAPIChan
+	suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+	suite.Equal(ap.ObjectProfile, msg.APObjectType)
+	suite.Equal(testAccount.ID, msg.OriginAccount.ID)
+
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.Equal(apiAccount.Source.AlsoKnownAsURIs, dbAccount.AlsoKnownAs)
+}
+
+func (suite *AccountAliasTestSuite) TestAliasClear() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	_, errWithCode := suite.accountProcessor.Alias(context.Background(), testAccount, &apimodel.AccountAliasRequest{
+		AlsoKnownAsURIs: []string{suite.testAccounts["local_account_2"].URI},
+	})
+	suite.NoError(errWithCode)
+
+	apiAccount, errWithCode := suite.accountProcessor.Alias(context.Background(), testAccount, &apimodel.AccountAliasRequest{})
+	suite.NoError(errWithCode)
+	suite.Empty(apiAccount.Source.AlsoKnownAsURIs)
+
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.Empty(dbAccount.AlsoKnownAs)
+}
+
+func (suite *AccountAliasTestSuite) TestAliasSelf() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	apiAccount, errWithCode := suite.accountProcessor.Alias(context.Background(), testAccount, &apimodel.AccountAliasRequest{
+		AlsoKnownAsURIs: []string{testAccount.URI},
+	})
+	suite.EqualError(errWithCode, "an account cannot be an alias of itself")
+	suite.Nil(apiAccount)
+}
+
+func (suite *AccountAliasTestSuite) TestAliasNotAURI() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	apiAccount, errWithCode := suite.accountProcessor.Alias(context.Background(), testAccount, &apimodel.AccountAliasRequest{
+		AlsoKnownAsURIs: []string{"@1happyturtle@localhost:8080"},
+	})
+	suite.EqualError(errWithCode, "alias @1happyturtle@localhost:8080 was not a valid http(s) uri")
+	suite.Nil(apiAccount)
+}
+
+func (suite *AccountAliasTestSuite) TestAliasUnknownLocal() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	apiAccount, errWithCode := suite.accountProcessor.Alias(context.Background(), testAccount, &apimodel.AccountAliasRequest{
+		AlsoKnownAsURIs: []string{"http://localhost:8080/users/nobody_here"},
+	})
+	suite.ErrorContains(errWithCode, "could not be resolved to an account")
+	suite.Nil(apiAccount)
+}
+
+func TestAccountAliasTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountAliasTestSuite))
+}
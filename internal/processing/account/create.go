@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/oauth2/v4"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInfo, application *gtsmodel.Application, form *apimodel.AccountCreateRequest) (*apimodel.Token, gtserror.WithCode) {
@@ -86,6 +87,7 @@ func (p *processor) Create(ctx context.Context, applicationToken oauth2.TokenInf
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       user.Account,
 		OriginAccount:  user.Account,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	return &apimodel.Token{
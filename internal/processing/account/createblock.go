@@ -30,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel.Account, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode) {
@@ -70,11 +71,14 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 	}
 
 	// clear any follows or follow requests from the blocked account to the target account -- this is a simple delete
-	if err := p.db.DeleteWhere(ctx, []db.Where{
+	blockedFollow := &gtsmodel.Follow{}
+	if err := p.db.GetWhere(ctx, []db.Where{
 		{Key: "account_id", Value: targetAccountID},
 		{Key: "target_account_id", Value: requestingAccount.ID},
-	}, &gtsmodel.Follow{}); err != nil {
-		return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing follow in db: %s", err))
+	}, blockedFollow); err == nil {
+		if err := p.db.DeleteFollowByID(ctx, blockedFollow.ID); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing follow in db: %s", err))
+		}
 	}
 	if err := p.db.DeleteWhere(ctx, []db.Where{
 		{Key: "account_id", Value: targetAccountID},
@@ -110,7 +114,7 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 		{Key: "target_account_id", Value: targetAccountID},
 	}, f); err == nil {
 		fURI = f.URI
-		if err := p.db.DeleteByID(ctx, f.ID, f); err != nil {
+		if err := p.db.DeleteFollowByID(ctx, f.ID); err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("BlockCreate: error removing follow from db: %s", err))
 		}
 		fChanged = true
@@ -128,6 +132,7 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 			},
 			OriginAccount: requestingAccount,
 			TargetAccount: targetAccount,
+			TraceContext:  trace.SpanContextFromContext(ctx),
 		})
 	}
 
@@ -143,6 +148,7 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 			},
 			OriginAccount: requestingAccount,
 			TargetAccount: targetAccount,
+			TraceContext:  trace.SpanContextFromContext(ctx),
 		})
 	}
 
@@ -153,6 +159,7 @@ func (p *processor) BlockCreate(ctx context.Context, requestingAccount *gtsmodel
 		GTSModel:       block,
 		OriginAccount:  requestingAccount,
 		TargetAccount:  targetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	return p.RelationshipGet(ctx, requestingAccount, targetAccountID)
@@ -30,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) FollowCreate(ctx context.Context, requestingAccount *gtsmodel.Account, form *apimodel.AccountFollowRequest) (*apimodel.Relationship, gtserror.WithCode) {
@@ -98,8 +99,9 @@ func (p *processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 		return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error creating follow request in db: %s", err))
 	}
 
-	// if it's a local account that's not locked we can just straight up accept the follow request
-	if !*targetAcct.Locked && targetAcct.Domain == "" {
+	// if it's a local account that's not locked we can just straight up accept the follow request,
+	// unless the requester has been silenced, in which case the target has to approve it by hand
+	if !*targetAcct.Locked && targetAcct.Domain == "" && requestingAccount.SilencedAt.IsZero() {
 		if _, err := p.db.AcceptFollowRequest(ctx, requestingAccount.ID, form.ID); err != nil {
 			return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountfollowcreate: error accepting folow request for local unlocked account: %s", err))
 		}
@@ -114,6 +116,7 @@ func (p *processor) FollowCreate(ctx context.Context, requestingAccount *gtsmode
 		GTSModel:       fr,
 		OriginAccount:  requestingAccount,
 		TargetAccount:  targetAcct,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	// return whatever relationship results from this
@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/crypto/bcrypt"
 )
 
@@ -50,7 +51,7 @@ import (
 // 11. Delete account's bookmarks
 // 12. Delete account's faves
 // 13. Delete account's mutes
-// 14. Delete account's streams
+// 14. Delete account's streams and web push subscriptions
 // 15. Delete account's tags
 // 16. Delete account's user
 // 17. Delete account's timeline
@@ -128,15 +129,39 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 	// TODO: federate these if necessary
 	l.Trace("deleting account follows")
 	// first delete any follows that this account created
-	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "account_id", Value: account.ID}}, &[]*gtsmodel.Follow{}); err != nil {
+	if err := p.db.DeleteFollowsByOriginAccountID(ctx, account.ID); err != nil {
 		l.Errorf("error deleting follows created by account: %s", err)
 	}
 
 	// now delete any follows that target this account
-	if err := p.db.DeleteWhere(ctx, []db.Where{{Key: "target_account_id", Value: account.ID}}, &[]*gtsmodel.Follow{}); err != nil {
+	if err := p.db.DeleteFollowsByTargetAccountID(ctx, account.ID); err != nil {
 		l.Errorf("error deleting follows targeting account: %s", err)
 	}
 
+	// 5.1. Delete account's lists
+	l.Trace("deleting account lists")
+	if err := p.db.DeleteListsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting lists created by account: %s", err)
+	}
+
+	// 5.2. Delete account's keyword filters
+	l.Trace("deleting account filters")
+	if err := p.db.DeleteFiltersByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting filters created by account: %s", err)
+	}
+
+	// 5.3. Delete account's scheduled statuses
+	l.Trace("deleting account scheduled statuses")
+	if err := p.db.DeleteScheduledStatusesByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting scheduled statuses created by account: %s", err)
+	}
+
+	// 5.4. Delete account's direct message conversations
+	l.Trace("deleting account conversations")
+	if err := p.db.DeleteConversationsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting conversations owned by account: %s", err)
+	}
+
 	var maxID string
 
 	// 6. Delete account's statuses
@@ -174,6 +199,7 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 				GTSModel:       status,
 				OriginAccount:  account,
 				TargetAccount:  account,
+				TraceContext:   trace.SpanContextFromContext(ctx),
 			})
 
 			// Look for any boosts of this status in DB
@@ -205,6 +231,7 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 					GTSModel:       status,
 					OriginAccount:  boost.Account,
 					TargetAccount:  account,
+					TraceContext:   trace.SpanContextFromContext(ctx),
 				})
 			}
 		}
@@ -244,11 +271,22 @@ func (p *processor) Delete(ctx context.Context, account *gtsmodel.Account, origi
 		l.Errorf("error deleting status mutes created by account: %s", err)
 	}
 
-	// 14. Delete account's streams
-	// TODO
+	l.Trace("deleting account user mutes")
+	if err := p.db.DeleteMutesByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting user mutes created by or targeting account: %s", err)
+	}
+
+	// 14. Delete account's streams (TODO) and web push subscriptions
+	l.Trace("deleting account web push subscriptions")
+	if err := p.db.DeleteWebPushSubscriptionsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting web push subscriptions of account: %s", err)
+	}
 
 	// 15. Delete account's tags
-	// TODO
+	l.Trace("deleting account tag follows")
+	if err := p.db.DeleteFollowedTagsByAccountID(ctx, account.ID); err != nil {
+		l.Errorf("error deleting tags followed by account: %s", err)
+	}
 
 	// 16. Delete account's user
 	if user != nil {
@@ -294,6 +332,7 @@ func (p *processor) DeleteLocal(ctx context.Context, account *gtsmodel.Account,
 		APObjectType:   ap.ActorPerson,
 		APActivityType: ap.ActivityDelete,
 		TargetAccount:  account,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	}
 
 	if form.DeleteOriginID == account.ID {
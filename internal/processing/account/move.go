@@ -0,0 +1,119 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (p *processor) Move(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode) {
+	if form.Password == "" {
+		err := errors.New("password must be provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if user.EncryptedPassword == "" {
+		return nil, gtserror.NewErrorForbidden(errors.New("user password was not set"))
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(user.EncryptedPassword), []byte(form.Password)); err != nil {
+		return nil, gtserror.NewErrorForbidden(errors.New("invalid password"), "invalid password")
+	}
+
+	targetURI, err := url.Parse(form.MovedToURI)
+	if err != nil || (targetURI.Scheme != "http" && targetURI.Scheme != "https") || targetURI.Host == "" {
+		err := fmt.Errorf("moved_to_uri %s was not a valid http(s) uri", form.MovedToURI)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if targetURI.String() == account.URI {
+		err := errors.New("an account cannot move to itself")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// get the target fresh from its instance, since
+	// it needs to have been aliased to this account
+	// very recently in order for the move to be valid
+	targetAccount, err := p.federator.GetAccount(ctx, dereferencing.GetAccountParams{
+		RequestingUsername: account.Username,
+		RemoteAccountID:    targetURI,
+		Blocking:           true,
+		Refresh:            true,
+	})
+	if err != nil {
+		err := fmt.Errorf("moved_to_uri %s could not be resolved to an account: %s", form.MovedToURI, err)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if targetAccount.ID == account.ID {
+		err := errors.New("an account cannot move to itself")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if targetAccount.MovedToURI != "" {
+		err := fmt.Errorf("account %s has itself moved to another account", targetAccount.URI)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if !targetAccount.IsAliasOf(account) {
+		err := fmt.Errorf("account %s does not list %s as an alias; add it there before moving", targetAccount.URI, account.URI)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	account.MovedToURI = targetAccount.URI
+	account.MovedToAccountID = targetAccount.ID
+	account.MovedToAccount = targetAccount
+	if err := p.db.UpdateAccount(ctx, account); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not update account %s: %s", account.ID, err))
+	}
+
+	// send the move out to followers,
+	// and move local followers across
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ActorPerson,
+		APActivityType: ap.ActivityMove,
+		GTSModel:       account,
+		OriginAccount:  account,
+		TargetAccount:  targetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
+	})
+
+	acctSensitive, err := p.tc.AccountToAPIAccountSensitive(ctx, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("could not convert account into apisensitive account: %s", err))
+	}
+	return acctSensitive, nil
+}
@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type AccountMoveTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *AccountMoveTestSuite) aliasAccount(account *gtsmodel.Account, aliasOf *gtsmodel.Account) *gtsmodel.Account {
+	aliased, err := suite.db.GetAccountByID(context.Background(), account.ID)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	aliased.AlsoKnownAs = []string{aliasOf.URI}
+	if err := suite.db.UpdateAccount(context.Background(), aliased); err != nil {
+		suite.FailNow(err.Error())
+	}
+	return aliased
+}
+
+func (suite *AccountMoveTestSuite) TestMove() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	targetAccount := suite.aliasAccount(suite.testAccounts["local_account_2"], testAccount)
+
+	apiAccount, errWithCode := suite.accountProcessor.Move(context.Background(), testAccount, &apimodel.AccountMoveRequest{
+		Password:   "password",
+		MovedToURI: targetAccount.URI,
+	})
+	suite.NoError(errWithCode)
+	suite.NotNil(apiAccount.Moved)
+	suite.Equal(targetAccount.ID, apiAccount.Moved.ID)
+	suite.Nil(apiAccount.Moved.Moved)
+
+	// the move should be on its way to the processor
+	msg := <-suite.fromClientAPIChan
+	suite.Equal(ap.ActivityMove, msg.APActivityType)
+	suite.Equal(ap.ActorPerson, msg.APObjectType)
+	suite.Equal(testAccount.ID, msg.OriginAccount.ID)
+	suite.Equal(targetAccount.ID, msg.TargetAccount.ID)
+
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.Equal(targetAccount.URI, dbAccount.MovedToURI)
+	suite.Equal(targetAccount.ID, dbAccount.MovedToAccountID)
+}
+
+func (suite *AccountMoveTestSuite) TestMoveNotAliased() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	apiAccount, errWithCode := suite.accountProcessor.Move(context.Background(), testAccount, &apimodel.AccountMoveRequest{
+		Password:   "password",
+		MovedToURI: targetAccount.URI,
+	})
+	suite.EqualError(errWithCode, "account http://localhost:8080/users/1happyturtle does not list http://localhost:8080/users/the_mighty_zork as an alias; add it there before moving")
+	suite.Nil(apiAccount)
+
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.Empty(dbAccount.MovedToURI)
+}
+
+func (suite *AccountMoveTestSuite) TestMoveWrongPassword() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+	targetAccount := suite.aliasAccount(suite.testAccounts["local_account_2"], testAccount)
+
+	apiAccount, errWithCode := suite.accountProcessor.Move(context.Background(), testAccount, &apimodel.AccountMoveRequest{
+		Password:   "not the right password",
+		MovedToURI: targetAccount.URI,
+	})
+	suite.EqualError(errWithCode, "invalid password")
+	suite.Nil(apiAccount)
+}
+
+func (suite *AccountMoveTestSuite) TestMoveToSelf() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"]
+
+	apiAccount, errWithCode := suite.accountProcessor.Move(context.Background(), testAccount, &apimodel.AccountMoveRequest{
+		Password:   "password",
+		MovedToURI: testAccount.URI,
+	})
+	suite.EqualError(errWithCode, "an account cannot move to itself")
+	suite.Nil(apiAccount)
+}
+
+func TestAccountMoveTestSuite(t *testing.T) {
+	suite.Run(t, new(AccountMoveTestSuite))
+}
@@ -33,9 +33,11 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, form *apimodel.UpdateCredentialsRequest) (*apimodel.Account, gtserror.WithCode) {
@@ -76,6 +78,37 @@ func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		updateEmojis = true
 	}
 
+	if form.FieldsAttributes != nil {
+		if err := validate.ProfileFields(*form.FieldsAttributes); err != nil {
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		// submitted fields replace all existing fields;
+		// fields with an empty name or value are dropped
+		fields := make([]gtsmodel.Field, 0, len(*form.FieldsAttributes))
+		for _, f := range *form.FieldsAttributes {
+			if f.Name == nil || f.Value == nil {
+				continue
+			}
+
+			name := text.SanitizePlaintext(*f.Name)
+			value := text.SanitizePlaintext(*f.Value)
+			if name == "" || value == "" {
+				continue
+			}
+
+			fields = append(fields, gtsmodel.Field{
+				Name:  name,
+				Value: value,
+			})
+		}
+
+		// fields that didn't change stay verified, the
+		// rest are (re)verified once the update is processed
+		relme.KeepVerified(account.Fields, fields)
+		account.Fields = fields
+	}
+
 	if updateEmojis {
 		// account emojis -- treat the sanitized display name and raw
 		// note like one long text for the purposes of deriving emojis
@@ -174,6 +207,7 @@ func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, form
 		APActivityType: ap.ActivityUpdate,
 		GTSModel:       account,
 		OriginAccount:  account,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	acctSensitive, err := p.tc.AccountToAPIAccountSensitive(ctx, account)
@@ -150,6 +150,54 @@ func (suite *AccountUpdateTestSuite) TestAccountUpdateWithMarkdownNote() {
 
 }
 
+func (suite *AccountUpdateTestSuite) TestAccountUpdateFields() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	var (
+		pronounsName  = "pronouns"
+		pronounsValue = "they/them"
+		websiteName   = "website"
+		websiteValue  = "https://example.org/about"
+		emptyName     = ""
+		emptyValue    = ""
+	)
+
+	form := &apimodel.UpdateCredentialsRequest{
+		FieldsAttributes: &[]apimodel.UpdateField{
+			{Name: &pronounsName, Value: &pronounsValue},
+			{Name: &websiteName, Value: &websiteValue},
+			{Name: &emptyName, Value: &emptyValue},
+		},
+	}
+
+	apiAccount, errWithCode := suite.accountProcessor.Update(context.Background(), testAccount, form)
+	suite.NoError(errWithCode)
+
+	// the empty field is dropped, and nothing is verified yet
+	suite.Len(apiAccount.Fields, 2)
+	suite.Equal("pronouns", apiAccount.Fields[0].Name)
+	suite.Equal("they/them", apiAccount.Fields[0].Value)
+	suite.Equal("website", apiAccount.Fields[1].Name)
+	suite.Equal("https://example.org/about", apiAccount.Fields[1].Value)
+	suite.Empty(apiAccount.Fields[1].VerifiedAt)
+
+	dbAccount, err := suite.db.GetAccountByID(context.Background(), testAccount.ID)
+	suite.NoError(err)
+	suite.Len(dbAccount.Fields, 2)
+
+	// too many fields
+	tooMany := []apimodel.UpdateField{}
+	for i := 0; i < 5; i++ {
+		tooMany = append(tooMany, apimodel.UpdateField{Name: &pronounsName, Value: &pronounsValue})
+	}
+	form = &apimodel.UpdateCredentialsRequest{
+		FieldsAttributes: &tooMany,
+	}
+
+	_, errWithCode = suite.accountProcessor.Update(context.Background(), testAccount, form)
+	suite.EqualError(errWithCode, "profile fields count should be no more than 4, but 5 were provided")
+}
+
 func TestAccountUpdateTestSuite(t *testing.T) {
 	suite.Run(t, new(AccountUpdateTestSuite))
 }
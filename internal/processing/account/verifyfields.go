@@ -0,0 +1,101 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
+)
+
+const (
+	// reverifyInterval is the interval at which
+	// profile fields of remote accounts are re-verified.
+	reverifyInterval = 24 * time.Hour
+
+	// reverifyBatchSize is the number of remote
+	// accounts to fetch from the database at once
+	// while re-verifying profile fields.
+	reverifyBatchSize = 100
+)
+
+func (p *processor) VerifyFields(ctx context.Context, account *gtsmodel.Account) error {
+	if !hasFieldLinks(account) {
+		// nothing to verify
+		return nil
+	}
+
+	if !p.verifier.VerifyFields(ctx, account) {
+		// verification status of fields didn't change
+		return nil
+	}
+
+	if err := p.db.UpdateAccount(ctx, account); err != nil {
+		return fmt.Errorf("VerifyFields: error updating account %s: %w", account.ID, err)
+	}
+
+	return nil
+}
+
+func (p *processor) ReverifyRemoteFields(ctx context.Context) error {
+	var maxID string
+
+	for {
+		accounts, err := p.db.GetRemoteAccountsWithFields(ctx, maxID, reverifyBatchSize)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// we're done
+				return nil
+			}
+			return fmt.Errorf("ReverifyRemoteFields: error getting remote accounts with fields: %w", err)
+		}
+
+		for _, account := range accounts {
+			if err := p.VerifyFields(ctx, account); err != nil {
+				log.Errorf("ReverifyRemoteFields: %s", err)
+			}
+		}
+
+		maxID = accounts[len(accounts)-1].ID
+	}
+}
+
+func (p *processor) Start() error {
+	return p.reverifier.Start()
+}
+
+func (p *processor) Stop() error {
+	return p.reverifier.Stop()
+}
+
+// hasFieldLinks returns true if any profile field of the account contains a link.
+func hasFieldLinks(account *gtsmodel.Account) bool {
+	for _, field := range account.Fields {
+		if relme.FieldLink(field.Value) != nil {
+			return true
+		}
+	}
+	return false
+}
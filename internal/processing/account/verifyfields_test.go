@@ -0,0 +1,78 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package account_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type VerifyFieldsTestSuite struct {
+	AccountStandardTestSuite
+}
+
+func (suite *VerifyFieldsTestSuite) TestVerifyFieldsLocal() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+
+	suite.relMePages["https://example.org/about"] = `<html><body><a rel="me" href="` + testAccount.URL + `">me on gts</a></body></html>`
+	testAccount.Fields = []gtsmodel.Field{
+		{Name: "pronouns", Value: "they/them"},
+		{Name: "website", Value: "https://example.org/about"},
+		{Name: "blog", Value: "https://blog.example.org"},
+	}
+
+	err := suite.accountProcessor.VerifyFields(ctx, testAccount)
+	suite.NoError(err)
+
+	dbAccount, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	suite.NoError(err)
+	suite.Len(dbAccount.Fields, 3)
+	suite.True(dbAccount.Fields[0].VerifiedAt.IsZero())
+	suite.WithinDuration(time.Now(), dbAccount.Fields[1].VerifiedAt, 10*time.Second)
+	suite.True(dbAccount.Fields[2].VerifiedAt.IsZero())
+}
+
+func (suite *VerifyFieldsTestSuite) TestReverifyRemoteFields() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["remote_account_1"]
+
+	// link used to be verified, but the rel="me" link is gone now
+	testAccount.Fields = []gtsmodel.Field{
+		{Name: "website", Value: `<a href="https://example.org/gone" rel="me nofollow noopener noreferrer" target="_blank">https://example.org/gone</a>`, VerifiedAt: time.Now().Add(-48 * time.Hour)},
+	}
+	err := suite.db.UpdateAccount(ctx, testAccount)
+	suite.NoError(err)
+
+	err = suite.accountProcessor.ReverifyRemoteFields(ctx)
+	suite.NoError(err)
+
+	dbAccount, err := suite.db.GetAccountByID(ctx, testAccount.ID)
+	suite.NoError(err)
+	suite.Len(dbAccount.Fields, 1)
+	suite.True(dbAccount.Fields[0].VerifiedAt.IsZero())
+}
+
+func TestVerifyFieldsTestSuite(t *testing.T) {
+	suite.Run(t, &VerifyFieldsTestSuite{})
+}
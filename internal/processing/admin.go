@@ -30,6 +30,22 @@ func (p *processor) AdminAccountAction(ctx context.Context, authed *oauth.Auth,
 	return p.adminProcessor.AccountAction(ctx, authed.Account, form)
 }
 
+func (p *processor) AdminAccountActionUndo(ctx context.Context, authed *oauth.Auth, form *apimodel.AdminAccountActionRequest) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	return p.adminProcessor.AccountActionUndo(ctx, authed.Account, form)
+}
+
+func (p *processor) AdminAccountsGet(ctx context.Context, authed *oauth.Auth, status string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.adminProcessor.AccountsGet(ctx, status, maxID, sinceID, minID, limit)
+}
+
+func (p *processor) AdminAccountApprove(ctx context.Context, authed *oauth.Auth, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	return p.adminProcessor.AccountApprove(ctx, authed.Account, targetAccountID, message)
+}
+
+func (p *processor) AdminAccountReject(ctx context.Context, authed *oauth.Auth, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	return p.adminProcessor.AccountReject(ctx, authed.Account, targetAccountID, message)
+}
+
 func (p *processor) AdminEmojiCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode) {
 	return p.adminProcessor.EmojiCreate(ctx, authed.Account, authed.User, form)
 }
@@ -54,6 +70,26 @@ func (p *processor) AdminEmojiCategoriesGet(ctx context.Context) ([]*apimodel.Em
 	return p.adminProcessor.EmojiCategoriesGet(ctx)
 }
 
+func (p *processor) AdminDomainAllowCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainAllowCreateRequest) (*apimodel.DomainAllow, gtserror.WithCode) {
+	return p.adminProcessor.DomainAllowCreate(ctx, authed.Account, form.Domain, form.Obfuscate, form.PublicComment, form.PrivateComment)
+}
+
+func (p *processor) AdminDomainAllowsImport(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainAllowCreateRequest) ([]*apimodel.DomainAllow, gtserror.WithCode) {
+	return p.adminProcessor.DomainAllowsImport(ctx, authed.Account, form.Domains)
+}
+
+func (p *processor) AdminDomainAllowsGet(ctx context.Context, authed *oauth.Auth, export bool) ([]*apimodel.DomainAllow, gtserror.WithCode) {
+	return p.adminProcessor.DomainAllowsGet(ctx, authed.Account, export)
+}
+
+func (p *processor) AdminDomainAllowGet(ctx context.Context, authed *oauth.Auth, id string, export bool) (*apimodel.DomainAllow, gtserror.WithCode) {
+	return p.adminProcessor.DomainAllowGet(ctx, authed.Account, id, export)
+}
+
+func (p *processor) AdminDomainAllowDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainAllow, gtserror.WithCode) {
+	return p.adminProcessor.DomainAllowDelete(ctx, authed.Account, id)
+}
+
 func (p *processor) AdminDomainBlockCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainBlockCreateRequest) (*apimodel.DomainBlock, gtserror.WithCode) {
 	return p.adminProcessor.DomainBlockCreate(ctx, authed.Account, form.Domain, form.Obfuscate, form.PublicComment, form.PrivateComment, "")
 }
@@ -74,6 +110,46 @@ func (p *processor) AdminDomainBlockDelete(ctx context.Context, authed *oauth.Au
 	return p.adminProcessor.DomainBlockDelete(ctx, authed.Account, id)
 }
 
+func (p *processor) AdminDomainBlockSubscriptionCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainBlockSubscriptionCreateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	return p.adminProcessor.DomainBlockSubscriptionCreate(ctx, authed.Account, form)
+}
+
+func (p *processor) AdminDomainBlockSubscriptionsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	return p.adminProcessor.DomainBlockSubscriptionsGet(ctx)
+}
+
+func (p *processor) AdminDomainBlockSubscriptionGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	return p.adminProcessor.DomainBlockSubscriptionGet(ctx, id)
+}
+
+func (p *processor) AdminDomainBlockSubscriptionUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.DomainBlockSubscriptionUpdateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	return p.adminProcessor.DomainBlockSubscriptionUpdate(ctx, id, form)
+}
+
+func (p *processor) AdminDomainBlockSubscriptionDelete(ctx context.Context, authed *oauth.Auth, id string, removeBlocks bool) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	return p.adminProcessor.DomainBlockSubscriptionDelete(ctx, authed.Account, id, removeBlocks)
+}
+
+func (p *processor) AdminDomainBlockSubscriptionSync(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	return p.adminProcessor.DomainBlockSubscriptionSync(ctx, id)
+}
+
+func (p *processor) AdminDeliveriesGet(ctx context.Context, authed *oauth.Auth, domain string, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.adminProcessor.DeliveriesGet(ctx, domain, maxID, minID, limit)
+}
+
+func (p *processor) AdminDeliveriesPurge(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.AdminDeliveriesPurgeResponse, gtserror.WithCode) {
+	return p.adminProcessor.DeliveriesPurge(ctx, domain)
+}
+
+func (p *processor) AdminInstancesGet(ctx context.Context, authed *oauth.Auth, failing bool) ([]*apimodel.AdminInstance, gtserror.WithCode) {
+	return p.adminProcessor.InstancesGet(ctx, failing)
+}
+
+func (p *processor) AdminInstanceGet(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.AdminInstance, gtserror.WithCode) {
+	return p.adminProcessor.InstanceGet(ctx, domain)
+}
+
 func (p *processor) AdminMediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode {
 	return p.adminProcessor.MediaPrune(ctx, mediaRemoteCacheDays)
 }
@@ -2,25 +2,161 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) AccountAction(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminAccountActionRequest) gtserror.WithCode {
+	switch gtsmodel.AdminActionType(form.Type) {
+	case gtsmodel.AdminActionNone,
+		gtsmodel.AdminActionDisable,
+		gtsmodel.AdminActionSensitive,
+		gtsmodel.AdminActionSilence,
+		gtsmodel.AdminActionSuspend:
+	default:
+		err := fmt.Errorf("admin action type %s is not supported for this endpoint", form.Type)
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	_, errWithCode := p.accountAction(ctx, account, form)
+	return errWithCode
+}
+
+func (p *processor) AccountActionUndo(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminAccountActionRequest) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	switch gtsmodel.AdminActionType(form.Type) {
+	case gtsmodel.AdminActionEnable,
+		gtsmodel.AdminActionUnsensitive,
+		gtsmodel.AdminActionUnsilence,
+		gtsmodel.AdminActionUnsuspend:
+	default:
+		err := fmt.Errorf("admin action type %s is not supported for this endpoint", form.Type)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	targetAccount, errWithCode := p.accountAction(ctx, account, form)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiAccount, err := p.tc.AccountToAdminAPIAccount(ctx, targetAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiAccount, nil
+}
+
+// accountAction takes the admin action described by the form on the target account,
+// records it, and resolves the linked report if there is one. Side effects like
+// federating a suspension or emailing the account owner are queued for the client
+// API worker. It returns the target account as it is after the action.
+func (p *processor) accountAction(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminAccountActionRequest) (*gtsmodel.Account, gtserror.WithCode) {
+	actionType := gtsmodel.AdminActionType(form.Type)
+
 	targetAccount, err := p.db.GetAccountByID(ctx, form.TargetAccountID)
 	if err != nil {
-		return gtserror.NewErrorInternalError(err)
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("account %s not found", form.TargetAccountID)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAccount.ID == account.ID {
+		err := errors.New("you can't take admin actions on your own account")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// only local accounts have a user that can be disabled or emailed
+	var user *gtsmodel.User
+	if targetAccount.Domain == "" {
+		user, err = p.db.GetUserByAccountID(ctx, targetAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	if user == nil && (actionType == gtsmodel.AdminActionDisable || actionType == gtsmodel.AdminActionEnable) {
+		err := fmt.Errorf("account %s is not a local account with a user", targetAccount.ID)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.ReportID != "" {
+		report, err := p.db.GetReportByID(ctx, form.ReportID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				err = fmt.Errorf("report %s not found", form.ReportID)
+				return nil, gtserror.NewErrorNotFound(err, err.Error())
+			}
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		if report.TargetAccountID != targetAccount.ID {
+			err := fmt.Errorf("report %s does not target account %s", form.ReportID, targetAccount.ID)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+	}
+
+	switch actionType {
+	case gtsmodel.AdminActionDisable, gtsmodel.AdminActionEnable:
+		disabled := actionType == gtsmodel.AdminActionDisable
+		user.Disabled = &disabled
+		if err := p.db.UpdateUser(ctx, user, "disabled"); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountAction: error updating user %s: %s", user.ID, err))
+		}
+	case gtsmodel.AdminActionSensitive:
+		targetAccount.SensitizedAt = time.Now()
+	case gtsmodel.AdminActionUnsensitive:
+		targetAccount.SensitizedAt = time.Time{}
+	case gtsmodel.AdminActionSilence:
+		targetAccount.SilencedAt = time.Now()
+	case gtsmodel.AdminActionUnsilence:
+		targetAccount.SilencedAt = time.Time{}
+	case gtsmodel.AdminActionSuspend:
+		if !targetAccount.SuspendedAt.IsZero() {
+			err := fmt.Errorf("account %s is already suspended", targetAccount.ID)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+	case gtsmodel.AdminActionUnsuspend:
+		if targetAccount.SuspendedAt.IsZero() {
+			err := fmt.Errorf("account %s is not suspended", targetAccount.ID)
+			return nil, gtserror.NewErrorConflict(err, err.Error())
+		}
+		if targetAccount.Domain == "" {
+			// the statuses, media and user of a local account are
+			// removed when it's suspended, so there's nothing to restore
+			err := fmt.Errorf("account %s is a local account, which can't be unsuspended", targetAccount.ID)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		// the account will be dereferenced again next time we come across it
+		targetAccount.SuspendedAt = time.Time{}
+		targetAccount.SuspensionOrigin = ""
+	}
+
+	switch actionType {
+	case gtsmodel.AdminActionSensitive,
+		gtsmodel.AdminActionUnsensitive,
+		gtsmodel.AdminActionSilence,
+		gtsmodel.AdminActionUnsilence,
+		gtsmodel.AdminActionUnsuspend:
+		if err := p.db.UpdateAccount(ctx, targetAccount); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("accountAction: error updating account %s: %s", targetAccount.ID, err))
+		}
 	}
 
 	adminActionID, err := id.NewULID()
 	if err != nil {
-		return gtserror.NewErrorInternalError(err)
+		return nil, gtserror.NewErrorInternalError(err)
 	}
 
 	adminAction := &gtsmodel.AdminAccountAction{
@@ -28,25 +164,43 @@ func (p *processor) AccountAction(ctx context.Context, account *gtsmodel.Account
 		AccountID:       account.ID,
 		TargetAccountID: targetAccount.ID,
 		Text:            form.Text,
+		Type:            actionType,
+		SendEmail:       form.SendEmailNotification && user != nil && actionType != gtsmodel.AdminActionUnsuspend,
+		ReportID:        form.ReportID,
 	}
 
-	switch form.Type {
-	case string(gtsmodel.AdminActionSuspend):
-		adminAction.Type = gtsmodel.AdminActionSuspend
+	if err := p.db.Put(ctx, adminAction); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if form.ReportID != "" {
+		if _, errWithCode := p.ReportResolve(ctx, account, form.ReportID, nil); errWithCode != nil {
+			return nil, errWithCode
+		}
+	}
+
+	if actionType == gtsmodel.AdminActionSuspend {
 		// pass the account delete through the client api channel for processing
 		p.clientWorker.Queue(messages.FromClientAPI{
 			APObjectType:   ap.ActorPerson,
 			APActivityType: ap.ActivityDelete,
+			GTSModel:       adminAction,
 			OriginAccount:  account,
 			TargetAccount:  targetAccount,
+			TraceContext:   trace.SpanContextFromContext(ctx),
 		})
-	default:
-		return gtserror.NewErrorBadRequest(fmt.Errorf("admin action type %s is not supported for this endpoint", form.Type))
+		return targetAccount, nil
 	}
 
-	if err := p.db.Put(ctx, adminAction); err != nil {
-		return gtserror.NewErrorInternalError(err)
-	}
+	// let the client api worker tidy up after the action
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ActorPerson,
+		APActivityType: ap.ActivityFlag,
+		GTSModel:       adminAction,
+		OriginAccount:  account,
+		TargetAccount:  targetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
+	})
 
-	return nil
+	return targetAccount, nil
 }
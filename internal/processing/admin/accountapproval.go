@@ -0,0 +1,156 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (p *processor) AccountApprove(ctx context.Context, account *gtsmodel.Account, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	targetAccount, user, errWithCode := p.getPendingAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	approved := true
+	user.Approved = &approved
+	if err := p.db.UpdateUser(ctx, user, "approved"); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountApprove: error updating user %s: %s", user.ID, err))
+	}
+
+	adminAction, errWithCode := p.putApprovalAction(ctx, account, targetAccount, gtsmodel.AdminActionApprove, message)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// let the user know they can start using their account
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityAccept,
+		GTSModel:       adminAction,
+		OriginAccount:  account,
+		TargetAccount:  targetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
+	})
+
+	apiAccount, err := p.tc.AccountToAdminAPIAccount(ctx, targetAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountApprove: error converting account to api: %s", err))
+	}
+
+	return apiAccount, nil
+}
+
+func (p *processor) AccountReject(ctx context.Context, account *gtsmodel.Account, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode) {
+	targetAccount, _, errWithCode := p.getPendingAccount(ctx, targetAccountID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// convert the account now, since it's about to be deleted
+	apiAccount, err := p.tc.AccountToAdminAPIAccount(ctx, targetAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("AccountReject: error converting account to api: %s", err))
+	}
+
+	adminAction, errWithCode := p.putApprovalAction(ctx, account, targetAccount, gtsmodel.AdminActionReject, message)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// email the user and then delete the account asynchronously
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityReject,
+		GTSModel:       adminAction,
+		OriginAccount:  account,
+		TargetAccount:  targetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
+	})
+
+	return apiAccount, nil
+}
+
+// getPendingAccount gets the local account with the given ID and its user,
+// making sure that the account's sign-up is still awaiting approval.
+func (p *processor) getPendingAccount(ctx context.Context, targetAccountID string) (*gtsmodel.Account, *gtsmodel.User, gtserror.WithCode) {
+	targetAccount, err := p.db.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("account %s not found", targetAccountID)
+			return nil, nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if targetAccount.Domain != "" {
+		err := fmt.Errorf("account %s is not a local account", targetAccountID)
+		return nil, nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, targetAccount.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("account %s has no user", targetAccountID)
+			return nil, nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if *user.Approved {
+		err := fmt.Errorf("account %s is not pending approval", targetAccountID)
+		return nil, nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	return targetAccount, user, nil
+}
+
+// putApprovalAction records the approval or rejection of the target account's sign-up.
+func (p *processor) putApprovalAction(ctx context.Context, account *gtsmodel.Account, targetAccount *gtsmodel.Account, actionType gtsmodel.AdminActionType, message string) (*gtsmodel.AdminAccountAction, gtserror.WithCode) {
+	adminActionID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	adminAction := &gtsmodel.AdminAccountAction{
+		ID:              adminActionID,
+		AccountID:       account.ID,
+		TargetAccountID: targetAccount.ID,
+		Text:            message,
+		Type:            actionType,
+		SendEmail:       true,
+	}
+
+	if err := p.db.Put(ctx, adminAction); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return adminAction, nil
+}
@@ -21,6 +21,7 @@ package admin
 import (
 	"context"
 	"mime/multipart"
+	"sync"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
@@ -36,12 +37,41 @@ import (
 
 // Processor wraps a bunch of functions for processing admin actions.
 type Processor interface {
+	DomainAllowCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string) (*apimodel.DomainAllow, gtserror.WithCode)
+	DomainAllowsImport(ctx context.Context, account *gtsmodel.Account, domains *multipart.FileHeader) ([]*apimodel.DomainAllow, gtserror.WithCode)
+	DomainAllowsGet(ctx context.Context, account *gtsmodel.Account, export bool) ([]*apimodel.DomainAllow, gtserror.WithCode)
+	DomainAllowGet(ctx context.Context, account *gtsmodel.Account, id string, export bool) (*apimodel.DomainAllow, gtserror.WithCode)
+	DomainAllowDelete(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.DomainAllow, gtserror.WithCode)
 	DomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string, subscriptionID string) (*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlocksImport(ctx context.Context, account *gtsmodel.Account, domains *multipart.FileHeader) ([]*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlocksGet(ctx context.Context, account *gtsmodel.Account, export bool) ([]*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlockGet(ctx context.Context, account *gtsmodel.Account, id string, export bool) (*apimodel.DomainBlock, gtserror.WithCode)
 	DomainBlockDelete(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.DomainBlock, gtserror.WithCode)
+	DomainBlockSubscriptionCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.DomainBlockSubscriptionCreateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	DomainBlockSubscriptionsGet(ctx context.Context) ([]*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	DomainBlockSubscriptionGet(ctx context.Context, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	DomainBlockSubscriptionUpdate(ctx context.Context, id string, form *apimodel.DomainBlockSubscriptionUpdateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	DomainBlockSubscriptionDelete(ctx context.Context, account *gtsmodel.Account, id string, removeBlocks bool) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	DomainBlockSubscriptionSync(ctx context.Context, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	// DomainBlockSubscriptionsSyncDue syncs all domain block subscriptions that haven't been fetched recently.
+	DomainBlockSubscriptionsSyncDue(ctx context.Context) error
+	// DeliveriesGet returns a page of the queue of deliveries waiting to be retried, optionally only for the given domain.
+	DeliveriesGet(ctx context.Context, domain string, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// DeliveriesPurge removes queued deliveries for the given domain from the delivery queue, or the whole queue if domain is empty.
+	DeliveriesPurge(ctx context.Context, domain string) (*apimodel.AdminDeliveriesPurgeResponse, gtserror.WithCode)
+	// InstancesGet returns the admin view of all known remote instances, or only those whose most recent requests failed.
+	InstancesGet(ctx context.Context, failing bool) ([]*apimodel.AdminInstance, gtserror.WithCode)
+	// InstanceGet returns the admin view of the remote instance with the given domain.
+	InstanceGet(ctx context.Context, domain string) (*apimodel.AdminInstance, gtserror.WithCode)
 	AccountAction(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminAccountActionRequest) gtserror.WithCode
+	// AccountActionUndo reverses a previous disable, sensitive, silence or suspend action on the target account.
+	AccountActionUndo(ctx context.Context, account *gtsmodel.Account, form *apimodel.AdminAccountActionRequest) (*apimodel.AdminAccountInfo, gtserror.WithCode)
+	// AccountsGet returns a page of the admin view of local accounts with the given status. Only AccountStatusPending is supported.
+	AccountsGet(ctx context.Context, status string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// AccountApprove approves the pending sign-up of the target account, and emails the user a confirmation link.
+	AccountApprove(ctx context.Context, account *gtsmodel.Account, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
+	// AccountReject rejects the pending sign-up of the target account, emails the user, and deletes the account.
+	AccountReject(ctx context.Context, account *gtsmodel.Account, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
 	EmojiCreate(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode)
 	EmojisGet(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, domain string, includeDisabled bool, includeEnabled bool, shortcode string, maxShortcodeDomain string, minShortcodeDomain string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 	EmojiGet(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, id string) (*apimodel.AdminEmoji, gtserror.WithCode)
@@ -53,6 +83,10 @@ type Processor interface {
 	ReportsGet(ctx context.Context, account *gtsmodel.Account, resolved *bool, accountID string, targetAccountID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 	ReportGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.AdminReport, gtserror.WithCode)
 	ReportResolve(ctx context.Context, account *gtsmodel.Account, id string, actionTakenComment *string) (*apimodel.AdminReport, gtserror.WithCode)
+	// Start starts syncing domain block subscriptions at regular intervals.
+	Start() error
+	// Stop stops syncing domain block subscriptions.
+	Stop() error
 }
 
 type processor struct {
@@ -62,11 +96,13 @@ type processor struct {
 	storage             *storage.Driver
 	clientWorker        *concurrency.WorkerPool[messages.FromClientAPI]
 	db                  db.DB
+	syncMu              sync.Mutex // serializes domain block subscription syncs
+	syncer              *concurrency.TickerWorker
 }
 
 // New returns a new admin processor.
 func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, transportController transport.Controller, storage *storage.Driver, clientWorker *concurrency.WorkerPool[messages.FromClientAPI]) Processor {
-	return &processor{
+	p := &processor{
 		tc:                  tc,
 		mediaManager:        mediaManager,
 		transportController: transportController,
@@ -74,4 +110,6 @@ func New(db db.DB, tc typeutils.TypeConverter, mediaManager media.Manager, trans
 		clientWorker:        clientWorker,
 		db:                  db,
 	}
+	p.syncer = concurrency.NewTickerWorker("syncing domain block subscriptions", syncCheckInterval, p.DomainBlockSubscriptionsSyncDue)
+	return p
 }
@@ -0,0 +1,77 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+func (p *processor) DomainAllowCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string) (*apimodel.DomainAllow, gtserror.WithCode) {
+	// domain allows will always be lowercase
+	domain = strings.ToLower(domain)
+
+	// first check if we already have an allow -- if err == nil we already had an allow so we can skip creating one
+	allow, err := p.db.GetDomainAllow(ctx, domain)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			// something went wrong in the DB
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error checking for existence of domain allow %s: %s", domain, err))
+		}
+
+		// there's no allow for this domain yet so create one
+		allowID, err := id.NewULID()
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating id for new domain allow %s: %s", domain, err))
+		}
+
+		newAllow := &gtsmodel.DomainAllow{
+			ID:                 allowID,
+			Domain:             domain,
+			CreatedByAccountID: account.ID,
+			PrivateComment:     text.SanitizePlaintext(privateComment),
+			PublicComment:      text.SanitizePlaintext(publicComment),
+			Obfuscate:          &obfuscate,
+		}
+
+		// Insert the new allow into the database
+		if err := p.db.CreateDomainAllow(ctx, newAllow); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting new domain allow %s: %s", domain, err))
+		}
+
+		allow = newAllow
+	}
+
+	// Convert our gts model domain allow into an API model
+	apiDomainAllow, err := p.tc.DomainAllowToAPIDomainAllow(ctx, allow, false)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting domain allow to frontend/api representation %s: %s", domain, err))
+	}
+
+	return apiDomainAllow, nil
+}
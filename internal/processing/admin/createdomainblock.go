@@ -35,6 +35,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) DomainBlockCreate(ctx context.Context, account *gtsmodel.Account, domain string, obfuscate bool, publicComment string, privateComment string, subscriptionID string) (*apimodel.DomainBlock, gtserror.WithCode) {
@@ -170,6 +171,7 @@ selectAccountsLoop:
 				GTSModel:       block,
 				OriginAccount:  account,
 				TargetAccount:  a,
+				TraceContext:   trace.SpanContextFromContext(ctx),
 			})
 
 			// if this is the last account in the slice, set the maxID appropriately for the next query
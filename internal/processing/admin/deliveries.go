@@ -0,0 +1,77 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) DeliveriesGet(ctx context.Context, domain string, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	deliveries, err := p.db.GetDeliveries(ctx, domain, maxID, minID, limit)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return util.EmptyPageableResponse(), nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(deliveries)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, d := range deliveries {
+		item, err := p.tc.DeliveryToAdminAPIDelivery(ctx, d)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting delivery to api: %s", err))
+		}
+		items = append(items, item)
+	}
+
+	extraQueryParams := []string{}
+	if domain != "" {
+		extraQueryParams = append(extraQueryParams, "domain="+domain)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:            items,
+		Path:             "/api/v1/admin/deliveries",
+		NextMaxIDValue:   deliveries[count-1].ID,
+		PrevMinIDValue:   deliveries[0].ID,
+		Limit:            limit,
+		ExtraQueryParams: extraQueryParams,
+	})
+}
+
+func (p *processor) DeliveriesPurge(ctx context.Context, domain string) (*apimodel.AdminDeliveriesPurgeResponse, gtserror.WithCode) {
+	purged, err := p.db.DeleteDeliveries(ctx, domain)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error purging deliveries: %s", err))
+	}
+
+	return &apimodel.AdminDeliveriesPurgeResponse{Purged: purged}, nil
+}
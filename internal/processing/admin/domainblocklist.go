@@ -0,0 +1,251 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"golang.org/x/net/idna"
+)
+
+// blocklistEntry is one domain parsed from a subscribed blocklist.
+type blocklistEntry struct {
+	domain        string
+	publicComment string
+	obfuscate     *bool // nil if the blocklist doesn't say
+}
+
+// jsonBlocklistEntry is one entry in a blocklist in the
+// format of a GoToSocial domain blocks export.
+type jsonBlocklistEntry struct {
+	Domain        string `json:"domain"`
+	PublicComment string `json:"public_comment"`
+	Obfuscate     *bool  `json:"obfuscate"`
+}
+
+// parseBlocklist parses the given blocklist data in the given format, returning
+// one entry per unique, valid domain. Invalid domains are skipped. An error will
+// be returned if the data couldn't be parsed, or contained no valid domains at all,
+// since that most likely indicates a problem with the list rather than an intent to
+// unblock everything.
+//
+// Errors never contain the contents of the blocklist, only positions within it.
+func parseBlocklist(contentType gtsmodel.DomainBlockSubscriptionContent, data []byte) ([]*blocklistEntry, error) {
+	var (
+		entries []*blocklistEntry
+		err     error
+	)
+
+	switch contentType {
+	case gtsmodel.DomainBlockSubscriptionContentPlain:
+		entries, err = parsePlainBlocklist(data)
+	case gtsmodel.DomainBlockSubscriptionContentCSV:
+		entries, err = parseCSVBlocklist(data)
+	case gtsmodel.DomainBlockSubscriptionContentJSON:
+		entries, err = parseJSONBlocklist(data)
+	default:
+		err = fmt.Errorf("blocklist content type %s not recognized", contentType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		seen    = make(map[string]struct{}, len(entries))
+		valid   = make([]*blocklistEntry, 0, len(entries))
+		skipped int
+	)
+
+	for _, entry := range entries {
+		domain, ok := normalizeBlocklistDomain(entry.domain)
+		if !ok {
+			skipped++
+			continue
+		}
+
+		if _, ok := seen[domain]; ok {
+			continue
+		}
+		seen[domain] = struct{}{}
+
+		entry.domain = domain
+		valid = append(valid, entry)
+	}
+
+	if skipped != 0 {
+		log.Debugf("parseBlocklist: skipped %d invalid domain(s)", skipped)
+	}
+
+	if len(valid) == 0 {
+		return nil, errors.New("blocklist contained no valid domains")
+	}
+
+	return valid, nil
+}
+
+// parsePlainBlocklist parses a blocklist with one domain per line.
+// Empty lines are ignored, as is anything following a '#'.
+func parsePlainBlocklist(data []byte) ([]*blocklistEntry, error) {
+	entries := []*blocklistEntry{}
+
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for line := 1; scanner.Scan(); line++ {
+		text := scanner.Text()
+		if i := strings.IndexByte(text, '#'); i != -1 {
+			text = text[:i]
+		}
+
+		text = strings.TrimSpace(text)
+		if text == "" {
+			continue
+		}
+
+		entries = append(entries, &blocklistEntry{domain: text})
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("error reading plaintext blocklist: %w", err)
+	}
+
+	return entries, nil
+}
+
+// parseCSVBlocklist parses a blocklist in the CSV format of a Mastodon domain blocks
+// export, ie., with a header row like `#domain,#severity,...,#public_comment,#obfuscate`.
+// If a severity column is present, only rows with severity 'suspend' are used. If there's
+// no recognizable header row, the first column of every row is taken to be the domain.
+func parseCSVBlocklist(data []byte) ([]*blocklistEntry, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.TrimLeadingSpace = true
+
+	records, err := r.ReadAll()
+	if err != nil {
+		// csv.ParseError only gives line and column
+		return nil, fmt.Errorf("error reading csv blocklist: %w", err)
+	}
+
+	if len(records) == 0 {
+		return []*blocklistEntry{}, nil
+	}
+
+	var (
+		domainCol        = 0
+		severityCol      = -1
+		publicCommentCol = -1
+		obfuscateCol     = -1
+	)
+
+	// look for a header row
+	header := false
+	for i, field := range records[0] {
+		switch strings.TrimPrefix(strings.ToLower(strings.TrimSpace(field)), "#") {
+		case "domain":
+			domainCol = i
+			header = true
+		case "severity":
+			severityCol = i
+		case "public_comment":
+			publicCommentCol = i
+		case "obfuscate":
+			obfuscateCol = i
+		}
+	}
+
+	if header {
+		records = records[1:]
+	} else {
+		// those weren't headers after all
+		severityCol, publicCommentCol, obfuscateCol = -1, -1, -1
+	}
+
+	column := func(record []string, i int) string {
+		if i < 0 || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	entries := make([]*blocklistEntry, 0, len(records))
+	for _, record := range records {
+		if severity := column(record, severityCol); severity != "" && severity != "suspend" {
+			// we only do suspensions
+			continue
+		}
+
+		entry := &blocklistEntry{
+			domain:        column(record, domainCol),
+			publicComment: column(record, publicCommentCol),
+		}
+
+		if obfuscate, err := strconv.ParseBool(column(record, obfuscateCol)); err == nil {
+			entry.obfuscate = &obfuscate
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// parseJSONBlocklist parses a blocklist in the JSON
+// format of a GoToSocial domain blocks export.
+func parseJSONBlocklist(data []byte) ([]*blocklistEntry, error) {
+	jsonEntries := []jsonBlocklistEntry{}
+	if err := json.Unmarshal(data, &jsonEntries); err != nil {
+		return nil, fmt.Errorf("error reading json blocklist: %w", err)
+	}
+
+	entries := make([]*blocklistEntry, 0, len(jsonEntries))
+	for _, e := range jsonEntries {
+		entries = append(entries, &blocklistEntry{
+			domain:        e.Domain,
+			publicComment: e.PublicComment,
+			obfuscate:     e.Obfuscate,
+		})
+	}
+
+	return entries, nil
+}
+
+// normalizeBlocklistDomain returns the given blocklist domain lowercased
+// and in punycode, with any leading wildcard or trailing dot removed. If
+// the domain isn't a valid hostname with at least two labels, it returns
+// false. Obfuscated domains like 'ex*mple.org' are therefore not valid.
+func normalizeBlocklistDomain(domain string) (string, bool) {
+	domain = strings.ToLower(strings.TrimSpace(domain))
+	domain = strings.TrimPrefix(domain, "*.")
+	domain = strings.TrimSuffix(domain, ".")
+
+	domain, err := idna.Lookup.ToASCII(domain)
+	if err != nil || !strings.Contains(domain, ".") {
+		return "", false
+	}
+
+	return domain, true
+}
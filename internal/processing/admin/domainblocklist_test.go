@@ -0,0 +1,112 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type BlocklistTestSuite struct {
+	suite.Suite
+}
+
+func (suite *BlocklistTestSuite) domains(entries []*blocklistEntry) []string {
+	domains := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		domains = append(domains, entry.domain)
+	}
+	return domains
+}
+
+func (suite *BlocklistTestSuite) TestParsePlain() {
+	data := []byte(`# a list of bad apples
+bad.apples
+
+Worse.Apples. # trailing comment
+*.wildcard.apples
+bad.apples
+not-a-domain
+ex*mple.org
+münchen.example
+`)
+
+	entries, err := parseBlocklist(gtsmodel.DomainBlockSubscriptionContentPlain, data)
+	suite.NoError(err)
+	suite.Equal([]string{
+		"bad.apples",
+		"worse.apples",
+		"wildcard.apples",
+		"xn--mnchen-3ya.example",
+	}, suite.domains(entries))
+}
+
+func (suite *BlocklistTestSuite) TestParseCSV() {
+	data := []byte(`#domain,#severity,#reject_media,#reject_reports,#public_comment,#obfuscate
+bad.apples,suspend,false,false,they smell,false
+silenced.apples,silence,false,false,,false
+hidden.apples,suspend,false,false,,true
+`)
+
+	entries, err := parseBlocklist(gtsmodel.DomainBlockSubscriptionContentCSV, data)
+	suite.NoError(err)
+	suite.Equal([]string{"bad.apples", "hidden.apples"}, suite.domains(entries))
+	suite.Equal("they smell", entries[0].publicComment)
+	suite.False(*entries[0].obfuscate)
+	suite.True(*entries[1].obfuscate)
+}
+
+func (suite *BlocklistTestSuite) TestParseCSVNoHeader() {
+	data := []byte("bad.apples,they smell\nworse.apples\n")
+
+	entries, err := parseBlocklist(gtsmodel.DomainBlockSubscriptionContentCSV, data)
+	suite.NoError(err)
+	suite.Equal([]string{"bad.apples", "worse.apples"}, suite.domains(entries))
+	suite.Empty(entries[0].publicComment)
+	suite.Nil(entries[0].obfuscate)
+}
+
+func (suite *BlocklistTestSuite) TestParseJSON() {
+	data := []byte(`[{"domain":"bad.apples","public_comment":"they smell"},{"domain":"worse.apples","obfuscate":true}]`)
+
+	entries, err := parseBlocklist(gtsmodel.DomainBlockSubscriptionContentJSON, data)
+	suite.NoError(err)
+	suite.Equal([]string{"bad.apples", "worse.apples"}, suite.domains(entries))
+	suite.Equal("they smell", entries[0].publicComment)
+	suite.Nil(entries[0].obfuscate)
+	suite.True(*entries[1].obfuscate)
+}
+
+func (suite *BlocklistTestSuite) TestParseErrors() {
+	_, err := parseBlocklist(gtsmodel.DomainBlockSubscriptionContentJSON, []byte(`{"not":"a list"}`))
+	suite.Error(err)
+
+	_, err = parseBlocklist(gtsmodel.DomainBlockSubscriptionContentCSV, []byte("bad.apples,\"unterminated\n"))
+	suite.Error(err)
+
+	// a list with nothing valid in it is an error, not an empty list
+	_, err = parseBlocklist(gtsmodel.DomainBlockSubscriptionContentPlain, []byte("# nothing to see here\n\n"))
+	suite.EqualError(err, "blocklist contained no valid domains")
+}
+
+func TestBlocklistTestSuite(t *testing.T) {
+	suite.Run(t, new(BlocklistTestSuite))
+}
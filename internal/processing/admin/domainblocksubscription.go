@@ -0,0 +1,225 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+func (p *processor) DomainBlockSubscriptionCreate(ctx context.Context, account *gtsmodel.Account, form *apimodel.DomainBlockSubscriptionCreateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	// make sure we don't already have a subscription for this uri
+	if err := p.checkSubscriptionURIFree(ctx, form.URI, ""); err != nil {
+		return nil, err
+	}
+
+	subscriptionID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockSubscriptionCreate: error creating id: %s", err))
+	}
+
+	subscription := &gtsmodel.DomainBlockSubscription{
+		ID:                 subscriptionID,
+		Title:              text.SanitizePlaintext(form.Title),
+		URI:                form.URI,
+		ContentType:        gtsmodel.DomainBlockSubscriptionContent(form.ContentType),
+		CreatedByAccountID: account.ID,
+		CreatedByAccount:   account,
+		Obfuscate:          &form.Obfuscate,
+	}
+
+	if err := p.db.PutDomainBlockSubscription(ctx, subscription); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockSubscriptionCreate: db error putting subscription: %s", err))
+	}
+
+	// fetch the blocklist right away so that the admin gets feedback on it;
+	// a failure here isn't fatal, it's recorded on the subscription instead
+	if err := p.syncDomainBlockSubscription(ctx, subscription); err != nil {
+		log.Debugf("DomainBlockSubscriptionCreate: error syncing new subscription %s: %s", subscription.ID, err)
+	}
+
+	return p.apiDomainBlockSubscription(ctx, subscription)
+}
+
+func (p *processor) DomainBlockSubscriptionsGet(ctx context.Context) ([]*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscriptions, err := p.db.GetDomainBlockSubscriptions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockSubscriptionsGet: db error getting subscriptions: %s", err))
+	}
+
+	apiSubscriptions := make([]*apimodel.DomainBlockSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		apiSubscription, errWithCode := p.apiDomainBlockSubscription(ctx, subscription)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+		apiSubscriptions = append(apiSubscriptions, apiSubscription)
+	}
+
+	return apiSubscriptions, nil
+}
+
+func (p *processor) DomainBlockSubscriptionGet(ctx context.Context, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getDomainBlockSubscription(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiDomainBlockSubscription(ctx, subscription)
+}
+
+func (p *processor) DomainBlockSubscriptionUpdate(ctx context.Context, id string, form *apimodel.DomainBlockSubscriptionUpdateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getDomainBlockSubscription(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := []string{}
+	if form.Title != nil {
+		subscription.Title = text.SanitizePlaintext(*form.Title)
+		columns = append(columns, "title")
+	}
+
+	if form.URI != nil && *form.URI != subscription.URI {
+		if errWithCode := p.checkSubscriptionURIFree(ctx, *form.URI, subscription.ID); errWithCode != nil {
+			return nil, errWithCode
+		}
+		subscription.URI = *form.URI
+		columns = append(columns, "uri")
+	}
+
+	if form.ContentType != nil {
+		subscription.ContentType = gtsmodel.DomainBlockSubscriptionContent(*form.ContentType)
+		columns = append(columns, "content_type")
+	}
+
+	if form.Obfuscate != nil {
+		subscription.Obfuscate = form.Obfuscate
+		columns = append(columns, "obfuscate")
+	}
+
+	if len(columns) == 0 {
+		// nothing to do
+		return p.apiDomainBlockSubscription(ctx, subscription)
+	}
+
+	if err := p.db.UpdateDomainBlockSubscription(ctx, subscription, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockSubscriptionUpdate: db error updating subscription: %s", err))
+	}
+
+	return p.apiDomainBlockSubscription(ctx, subscription)
+}
+
+func (p *processor) DomainBlockSubscriptionDelete(ctx context.Context, account *gtsmodel.Account, id string, removeBlocks bool) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getDomainBlockSubscription(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// prepare the subscription to return before we touch its blocks
+	apiSubscription, errWithCode := p.apiDomainBlockSubscription(ctx, subscription)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// lock so we don't race with a sync of this subscription
+	p.syncMu.Lock()
+	defer p.syncMu.Unlock()
+
+	if removeBlocks {
+		blocks, err := p.db.GetDomainBlocksBySubscriptionID(ctx, subscription.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockSubscriptionDelete: db error getting domain blocks: %s", err))
+		}
+
+		for _, block := range blocks {
+			if _, errWithCode := p.DomainBlockDelete(ctx, account, block.ID); errWithCode != nil {
+				return nil, errWithCode
+			}
+		}
+	}
+
+	// any blocks we didn't remove are kept, but orphaned
+	if err := p.db.DeleteDomainBlockSubscriptionByID(ctx, subscription.ID); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("DomainBlockSubscriptionDelete: db error deleting subscription: %s", err))
+	}
+
+	return apiSubscription, nil
+}
+
+func (p *processor) DomainBlockSubscriptionSync(ctx context.Context, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, errWithCode := p.getDomainBlockSubscription(ctx, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// fetch/parse errors are recorded on the subscription
+	// itself, so we can still return it to the caller
+	if err := p.syncDomainBlockSubscription(ctx, subscription); err != nil {
+		log.Debugf("DomainBlockSubscriptionSync: error syncing subscription %s: %s", subscription.ID, err)
+	}
+
+	return p.apiDomainBlockSubscription(ctx, subscription)
+}
+
+func (p *processor) getDomainBlockSubscription(ctx context.Context, id string) (*gtsmodel.DomainBlockSubscription, gtserror.WithCode) {
+	subscription, err := p.db.GetDomainBlockSubscriptionByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(fmt.Errorf("no domain block subscription with id %s", id))
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting domain block subscription %s: %s", id, err))
+	}
+	return subscription, nil
+}
+
+// checkSubscriptionURIFree returns a conflict error if a subscription
+// other than the one with the given id already exists for uri.
+func (p *processor) checkSubscriptionURIFree(ctx context.Context, uri string, id string) gtserror.WithCode {
+	subscriptions, err := p.db.GetDomainBlockSubscriptions(ctx)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return gtserror.NewErrorInternalError(fmt.Errorf("db error getting domain block subscriptions: %s", err))
+	}
+
+	for _, s := range subscriptions {
+		if s.URI == uri && s.ID != id {
+			err := fmt.Errorf("a domain block subscription for %s already exists", uri)
+			return gtserror.NewErrorConflict(err, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (p *processor) apiDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) (*apimodel.DomainBlockSubscription, gtserror.WithCode) {
+	apiSubscription, err := p.tc.DomainBlockSubscriptionToAPIDomainBlockSubscription(ctx, subscription)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+	return apiSubscription, nil
+}
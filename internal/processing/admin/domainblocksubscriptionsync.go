@@ -0,0 +1,220 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// syncCheckInterval is the interval at which domain
+	// block subscriptions are checked to see if they're due
+	// to be synced.
+	syncCheckInterval = 1 * time.Hour
+
+	// syncInterval is the minimum amount of time
+	// between two syncs of one subscription.
+	syncInterval = 6 * time.Hour
+
+	// maxBlocklistSize is the largest blocklist, in bytes,
+	// that we're willing to read for a subscription.
+	maxBlocklistSize = 10 << 20 // 10MiB
+)
+
+func (p *processor) DomainBlockSubscriptionsSyncDue(ctx context.Context) error {
+	subscriptions, err := p.db.GetDomainBlockSubscriptions(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil
+		}
+		return fmt.Errorf("DomainBlockSubscriptionsSyncDue: db error getting subscriptions: %s", err)
+	}
+
+	for _, subscription := range subscriptions {
+		if !subscription.FetchedAt.IsZero() && time.Since(subscription.FetchedAt) < syncInterval {
+			// not due yet
+			continue
+		}
+
+		if err := p.syncDomainBlockSubscription(ctx, subscription); err != nil {
+			log.Warnf("DomainBlockSubscriptionsSyncDue: error syncing subscription %s: %s", subscription.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *processor) Start() error {
+	return p.syncer.Start()
+}
+
+func (p *processor) Stop() error {
+	return p.syncer.Stop()
+}
+
+// syncDomainBlockSubscription fetches and parses the blocklist of the given subscription,
+// then brings the domain blocks owned by the subscription in line with it:
+//
+// 1. Listed domains which aren't blocked yet get a new block owned by the subscription.
+// 2. Listed domains which are already blocked manually or by another subscription are recorded as conflicts.
+// 3. Blocks owned by the subscription whose domain is no longer listed are removed.
+//
+// If the blocklist can't be fetched or parsed, the error is recorded on the
+// subscription and returned, and existing blocks are left untouched.
+func (p *processor) syncDomainBlockSubscription(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) error {
+	p.syncMu.Lock()
+	defer p.syncMu.Unlock()
+
+	subscription.FetchedAt = time.Now()
+
+	entries, err := p.fetchBlocklist(ctx, subscription)
+	if err != nil {
+		subscription.Error = err.Error()
+		if dbErr := p.db.UpdateDomainBlockSubscription(ctx, subscription, "fetched_at", "error"); dbErr != nil {
+			return fmt.Errorf("db error updating subscription: %s", dbErr)
+		}
+		return err
+	}
+
+	account := subscription.CreatedByAccount
+	if account == nil {
+		account, err = p.db.GetAccountByID(ctx, subscription.CreatedByAccountID)
+		if err != nil {
+			return fmt.Errorf("db error getting subscription creator account %s: %s", subscription.CreatedByAccountID, err)
+		}
+		subscription.CreatedByAccount = account
+	}
+
+	owned, err := p.db.GetDomainBlocksBySubscriptionID(ctx, subscription.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("db error getting domain blocks: %s", err)
+	}
+
+	ownedDomains := make(map[string]struct{}, len(owned))
+	for _, block := range owned {
+		ownedDomains[block.Domain] = struct{}{}
+	}
+
+	privateComment := "Created through domain block subscription " + subscription.URI
+	listed := make(map[string]struct{}, len(entries))
+	conflicts := []string{}
+
+	for _, entry := range entries {
+		if entry.domain == config.GetHost() || entry.domain == config.GetAccountDomain() {
+			// never block ourselves
+			continue
+		}
+		listed[entry.domain] = struct{}{}
+
+		if _, ok := ownedDomains[entry.domain]; ok {
+			// already blocked by us
+			continue
+		}
+
+		_, err := p.db.GetDomainBlock(ctx, entry.domain)
+		if err == nil {
+			// blocked manually or by another subscription
+			conflicts = append(conflicts, entry.domain)
+			continue
+		}
+
+		if !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("db error checking for domain block %s: %s", entry.domain, err)
+		}
+
+		obfuscate := subscription.Obfuscate != nil && *subscription.Obfuscate
+		if entry.obfuscate != nil {
+			obfuscate = *entry.obfuscate
+		}
+
+		if _, errWithCode := p.DomainBlockCreate(ctx, account, entry.domain, obfuscate, entry.publicComment, privateComment, subscription.ID); errWithCode != nil {
+			return fmt.Errorf("error creating domain block %s: %s", entry.domain, errWithCode)
+		}
+	}
+
+	for _, block := range owned {
+		if _, ok := listed[block.Domain]; ok {
+			continue
+		}
+
+		if _, errWithCode := p.DomainBlockDelete(ctx, account, block.ID); errWithCode != nil {
+			return fmt.Errorf("error removing domain block %s: %s", block.Domain, errWithCode)
+		}
+	}
+
+	subscription.SuccessfullyFetchedAt = subscription.FetchedAt
+	subscription.Error = ""
+	subscription.Conflicts = conflicts
+	if err := p.db.UpdateDomainBlockSubscription(ctx, subscription, "fetched_at", "successfully_fetched_at", "error", "conflicts"); err != nil {
+		return fmt.Errorf("db error updating subscription: %s", err)
+	}
+
+	return nil
+}
+
+// fetchBlocklist fetches and parses the blocklist of the given subscription,
+// either over http(s) using the instance account transport, or from disk.
+func (p *processor) fetchBlocklist(ctx context.Context, subscription *gtsmodel.DomainBlockSubscription) ([]*blocklistEntry, error) {
+	uri, err := url.Parse(subscription.URI)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing blocklist uri: %w", err)
+	}
+
+	var rc io.ReadCloser
+	if uri.Scheme == "file" {
+		rc, err = os.Open(uri.Path)
+		if err != nil {
+			return nil, fmt.Errorf("error opening blocklist file: %w", err)
+		}
+	} else {
+		t, err := p.transportController.NewTransportForUsername(ctx, "")
+		if err != nil {
+			return nil, fmt.Errorf("error creating transport: %w", err)
+		}
+
+		rc, _, err = t.DereferenceMedia(ctx, uri)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching blocklist: %w", err)
+		}
+	}
+	defer rc.Close()
+
+	// read one byte more than allowed so we know if we went over
+	data, err := io.ReadAll(io.LimitReader(rc, maxBlocklistSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("error reading blocklist: %w", err)
+	}
+
+	if len(data) > maxBlocklistSize {
+		return nil, fmt.Errorf("blocklist was larger than the maximum of %d bytes", maxBlocklistSize)
+	}
+
+	return parseBlocklist(subscription.ContentType, data)
+}
@@ -0,0 +1,91 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+// AccountStatusPending is used to select local accounts
+// whose sign-up is still awaiting moderator approval.
+const AccountStatusPending = "pending"
+
+func (p *processor) AccountsGet(
+	ctx context.Context,
+	status string,
+	maxID string,
+	sinceID string,
+	minID string,
+	limit int,
+) (*apimodel.PageableResponse, gtserror.WithCode) {
+	if status != AccountStatusPending {
+		err := fmt.Errorf("account status %q is not supported, only %q is", status, AccountStatusPending)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	users, err := p.db.GetPendingUsers(ctx, maxID, sinceID, minID, limit)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return util.EmptyPageableResponse(), nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(users)
+	items := make([]interface{}, 0, count)
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+	for i, u := range users {
+		account, err := p.db.GetAccountByID(ctx, u.AccountID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting account %s: %s", u.AccountID, err))
+		}
+
+		item, err := p.tc.AccountToAdminAPIAccount(ctx, account)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting account to api: %s", err))
+		}
+
+		if i == count-1 {
+			nextMaxIDValue = item.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = item.ID
+		}
+
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:            items,
+		Path:             "/api/v1/admin/accounts",
+		NextMaxIDValue:   nextMaxIDValue,
+		PrevMinIDValue:   prevMinIDValue,
+		Limit:            limit,
+		ExtraQueryParams: []string{"status=" + status},
+	})
+}
@@ -47,6 +47,12 @@ func (p *processor) DomainBlocksImport(ctx context.Context, account *gtsmodel.Ac
 		return nil, gtserror.NewErrorBadRequest(errors.New("DomainBlocksImport: could not read provided attachment: size 0 bytes"))
 	}
 
+	if data := bytes.TrimSpace(buf.Bytes()); len(data) == 0 || data[0] != '[' {
+		// not a json array, so this is
+		// probably a csv export from mastodon
+		return p.domainBlocksImportCSV(ctx, account, data)
+	}
+
 	d := []apimodel.DomainBlock{}
 	if err := json.Unmarshal(buf.Bytes(), &d); err != nil {
 		return nil, gtserror.NewErrorBadRequest(fmt.Errorf("DomainBlocksImport: could not read provided attachment: %s", err))
@@ -64,3 +70,27 @@ func (p *processor) DomainBlocksImport(ctx context.Context, account *gtsmodel.Ac
 
 	return blocks, nil
 }
+
+// domainBlocksImportCSV imports domain blocks from either kind of Mastodon CSV
+// export: the admin format with a `#domain,#severity,...` header row, or the
+// one domain per line format of the blocked domains of an individual user.
+func (p *processor) domainBlocksImportCSV(ctx context.Context, account *gtsmodel.Account, data []byte) ([]*apimodel.DomainBlock, gtserror.WithCode) {
+	entries, err := parseBlocklist(gtsmodel.DomainBlockSubscriptionContentCSV, data)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(fmt.Errorf("DomainBlocksImport: could not read provided attachment: %s", err))
+	}
+
+	blocks := []*apimodel.DomainBlock{}
+	for _, entry := range entries {
+		obfuscate := entry.obfuscate != nil && *entry.obfuscate
+
+		block, err := p.DomainBlockCreate(ctx, account, entry.domain, obfuscate, entry.publicComment, "", "")
+		if err != nil {
+			return nil, err
+		}
+
+		blocks = append(blocks, block)
+	}
+
+	return blocks, nil
+}
@@ -0,0 +1,92 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+)
+
+func (p *processor) InstancesGet(ctx context.Context, failing bool) ([]*apimodel.AdminInstance, gtserror.WithCode) {
+	var (
+		instances []*gtsmodel.Instance
+		err       error
+	)
+
+	if failing {
+		instances, err = p.db.GetFailingInstances(ctx)
+	} else {
+		instances, err = p.db.GetInstancePeers(ctx, true)
+	}
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting instances: %s", err))
+	}
+
+	apiInstances := make([]*apimodel.AdminInstance, 0, len(instances))
+	for _, i := range instances {
+		apiInstance, err := p.instanceToAdminAPIInstance(ctx, i)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+		apiInstances = append(apiInstances, apiInstance)
+	}
+
+	return apiInstances, nil
+}
+
+func (p *processor) InstanceGet(ctx context.Context, domain string) (*apimodel.AdminInstance, gtserror.WithCode) {
+	if domain == config.GetHost() {
+		err := fmt.Errorf("instance %s is this instance", domain)
+		return nil, gtserror.NewErrorNotFound(err, err.Error())
+	}
+
+	instance, err := p.db.GetInstance(ctx, domain)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("instance %s not found", domain)
+			return nil, gtserror.NewErrorNotFound(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting instance %s: %s", domain, err))
+	}
+
+	apiInstance, err := p.instanceToAdminAPIInstance(ctx, instance)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiInstance, nil
+}
+
+func (p *processor) instanceToAdminAPIInstance(ctx context.Context, instance *gtsmodel.Instance) (*apimodel.AdminInstance, error) {
+	apiInstance, err := p.tc.InstanceToAdminAPIInstance(ctx, instance)
+	if err != nil {
+		return nil, fmt.Errorf("error converting instance %s to api: %s", instance.Domain, err)
+	}
+
+	apiInstance.Unreachable = transport.Unreachable(instance)
+	return apiInstance, nil
+}
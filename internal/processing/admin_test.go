@@ -0,0 +1,325 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type AdminTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *AdminTestSuite) TestDomainBlockSubscriptionSync() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+
+	blocklist := filepath.Join(suite.T().TempDir(), "blocklist.txt")
+	err := os.WriteFile(blocklist, []byte("bad.apples\nworse.apples\nreplyguys.com\n"), 0o600)
+	suite.NoError(err)
+
+	// creating the subscription syncs it right away
+	subscription, errWithCode := suite.processor.AdminDomainBlockSubscriptionCreate(ctx, authed, &apimodel.DomainBlockSubscriptionCreateRequest{
+		Title:       "some apples",
+		URI:         "file://" + blocklist,
+		ContentType: string(gtsmodel.DomainBlockSubscriptionContentPlain),
+	})
+	suite.NoError(errWithCode)
+	suite.Empty(subscription.Error)
+	suite.NotEmpty(subscription.SuccessfullyFetchedAt)
+	suite.Equal(2, subscription.Count)
+	// replyguys.com was already blocked manually
+	suite.Equal([]string{"replyguys.com"}, subscription.Conflicts)
+
+	block, err := suite.db.GetDomainBlock(ctx, "worse.apples")
+	suite.NoError(err)
+	suite.Equal(subscription.ID, block.SubscriptionID)
+
+	// worse.apples is taken off the list
+	err = os.WriteFile(blocklist, []byte("bad.apples\n"), 0o600)
+	suite.NoError(err)
+
+	subscription, errWithCode = suite.processor.AdminDomainBlockSubscriptionSync(ctx, authed, subscription.ID)
+	suite.NoError(errWithCode)
+	suite.Equal(1, subscription.Count)
+	suite.Empty(subscription.Conflicts)
+
+	_, err = suite.db.GetDomainBlock(ctx, "worse.apples")
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	// a broken list is reported, and doesn't remove anything
+	err = os.WriteFile(blocklist, []byte{}, 0o600)
+	suite.NoError(err)
+
+	subscription, errWithCode = suite.processor.AdminDomainBlockSubscriptionSync(ctx, authed, subscription.ID)
+	suite.NoError(errWithCode)
+	suite.Equal("blocklist contained no valid domains", subscription.Error)
+	suite.Equal(1, subscription.Count)
+
+	// removing the subscription with its blocks leaves the manual block alone
+	_, errWithCode = suite.processor.AdminDomainBlockSubscriptionDelete(ctx, authed, subscription.ID, true)
+	suite.NoError(errWithCode)
+
+	_, err = suite.db.GetDomainBlock(ctx, "bad.apples")
+	suite.True(errors.Is(err, db.ErrNoEntries))
+
+	_, err = suite.db.GetDomainBlock(ctx, "replyguys.com")
+	suite.NoError(err)
+}
+
+func (suite *AdminTestSuite) TestDomainBlockSubscriptionCreateDuplicate() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+
+	_, errWithCode := suite.processor.AdminDomainBlockSubscriptionCreate(ctx, authed, &apimodel.DomainBlockSubscriptionCreateRequest{
+		URI:         "https://blocklists.example.org/blocklist.txt",
+		ContentType: string(gtsmodel.DomainBlockSubscriptionContentPlain),
+	})
+	suite.EqualError(errWithCode, "a domain block subscription for https://blocklists.example.org/blocklist.txt already exists")
+}
+
+func (suite *AdminTestSuite) TestAccountApprove() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+	targetAccount := suite.testAccounts["unconfirmed_account"]
+
+	pending, errWithCode := suite.processor.AdminAccountsGet(ctx, authed, "pending", "", "", "", 20)
+	suite.NoError(errWithCode)
+	suite.Len(pending.Items, 1)
+
+	apiAccount, errWithCode := suite.processor.AdminAccountApprove(ctx, authed, targetAccount.ID, "welcome!")
+	suite.NoError(errWithCode)
+	suite.True(apiAccount.Approved)
+
+	user, err := suite.db.GetUserByAccountID(ctx, targetAccount.ID)
+	suite.NoError(err)
+	suite.True(*user.Approved)
+
+	// the approval should be recorded
+	adminAction := &gtsmodel.AdminAccountAction{}
+	err = suite.db.GetWhere(ctx, []db.Where{{Key: "target_account_id", Value: targetAccount.ID}}, adminAction)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.AdminActionApprove, adminAction.Type)
+	suite.Equal("welcome!", adminAction.Text)
+
+	// the user should be emailed a confirmation link
+	suite.Eventually(func() bool {
+		_, ok := suite.sentEmails["weed_lord420@example.org"]
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+	suite.Contains(suite.sentEmails["weed_lord420@example.org"], "has been approved by the moderators")
+	suite.Contains(suite.sentEmails["weed_lord420@example.org"], "welcome!")
+
+	// the account is no longer pending
+	pending, errWithCode = suite.processor.AdminAccountsGet(ctx, authed, "pending", "", "", "", 20)
+	suite.NoError(errWithCode)
+	suite.Empty(pending.Items)
+
+	_, errWithCode = suite.processor.AdminAccountApprove(ctx, authed, targetAccount.ID, "")
+	suite.EqualError(errWithCode, "account "+targetAccount.ID+" is not pending approval")
+}
+
+func (suite *AdminTestSuite) TestAccountReject() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+	targetAccount := suite.testAccounts["unconfirmed_account"]
+
+	apiAccount, errWithCode := suite.processor.AdminAccountReject(ctx, authed, targetAccount.ID, "no bots please")
+	suite.NoError(errWithCode)
+	suite.Equal(targetAccount.ID, apiAccount.ID)
+
+	// the user should be emailed, and then deleted
+	suite.Eventually(func() bool {
+		_, err := suite.db.GetUserByAccountID(ctx, targetAccount.ID)
+		return errors.Is(err, db.ErrNoEntries)
+	}, 10*time.Second, 10*time.Millisecond)
+	suite.Contains(suite.sentEmails["weed_lord420@example.org"], "the moderators have rejected your request")
+	suite.Contains(suite.sentEmails["weed_lord420@example.org"], "no bots please")
+
+	dbAccount, err := suite.db.GetAccountByID(ctx, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(dbAccount.SuspendedAt.IsZero())
+}
+
+func (suite *AdminTestSuite) TestAccountApproveNotPending() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+
+	_, errWithCode := suite.processor.AdminAccountReject(ctx, authed, suite.testAccounts["local_account_1"].ID, "")
+	suite.EqualError(errWithCode, "account "+suite.testAccounts["local_account_1"].ID+" is not pending approval")
+
+	_, errWithCode = suite.processor.AdminAccountApprove(ctx, authed, suite.testAccounts["remote_account_1"].ID, "")
+	suite.EqualError(errWithCode, "account "+suite.testAccounts["remote_account_1"].ID+" is not a local account")
+}
+
+func (suite *AdminTestSuite) TestAccountActionSilence() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+	targetAccount := suite.testAccounts["remote_account_1"]
+	report := suite.testReports["local_account_2_report_remote_account_1"]
+
+	errWithCode := suite.processor.AdminAccountAction(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionSilence),
+		Text:            "too much shouting",
+		ReportID:        report.ID,
+		TargetAccountID: targetAccount.ID,
+	})
+	suite.NoError(errWithCode)
+
+	dbAccount, err := suite.db.GetAccountByID(ctx, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(dbAccount.SilencedAt.IsZero())
+
+	// the action should be recorded, and the linked report resolved
+	adminAction := &gtsmodel.AdminAccountAction{}
+	err = suite.db.GetWhere(ctx, []db.Where{{Key: "target_account_id", Value: targetAccount.ID}}, adminAction)
+	suite.NoError(err)
+	suite.Equal(gtsmodel.AdminActionSilence, adminAction.Type)
+	suite.Equal(report.ID, adminAction.ReportID)
+	suite.False(adminAction.SendEmail)
+
+	dbReport, err := suite.db.GetReportByID(ctx, report.ID)
+	suite.NoError(err)
+	suite.False(dbReport.ActionTakenAt.IsZero())
+	suite.Equal(authed.Account.ID, dbReport.ActionTakenByAccountID)
+
+	// now lift the silence again
+	apiAccount, errWithCode := suite.processor.AdminAccountActionUndo(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionUnsilence),
+		TargetAccountID: targetAccount.ID,
+	})
+	suite.NoError(errWithCode)
+	suite.False(apiAccount.Silenced)
+
+	dbAccount, err = suite.db.GetAccountByID(ctx, targetAccount.ID)
+	suite.NoError(err)
+	suite.True(dbAccount.SilencedAt.IsZero())
+}
+
+func (suite *AdminTestSuite) TestAccountActionDisable() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	errWithCode := suite.processor.AdminAccountAction(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:                  string(gtsmodel.AdminActionDisable),
+		Text:                  "please take a break",
+		SendEmailNotification: true,
+		TargetAccountID:       targetAccount.ID,
+	})
+	suite.NoError(errWithCode)
+
+	user, err := suite.db.GetUserByAccountID(ctx, targetAccount.ID)
+	suite.NoError(err)
+	suite.True(*user.Disabled)
+
+	// the user should be emailed about the action
+	suite.Eventually(func() bool {
+		_, ok := suite.sentEmails["tortle.dude@example.org"]
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+	suite.Contains(suite.sentEmails["tortle.dude@example.org"], "Your login has been disabled.")
+	suite.Contains(suite.sentEmails["tortle.dude@example.org"], "please take a break")
+
+	apiAccount, errWithCode := suite.processor.AdminAccountActionUndo(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionEnable),
+		TargetAccountID: targetAccount.ID,
+	})
+	suite.NoError(errWithCode)
+	suite.False(apiAccount.Disabled)
+
+	user, err = suite.db.GetUserByAccountID(ctx, targetAccount.ID)
+	suite.NoError(err)
+	suite.False(*user.Disabled)
+}
+
+func (suite *AdminTestSuite) TestAccountActionInvalid() {
+	ctx := context.Background()
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+
+	errWithCode := suite.processor.AdminAccountAction(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionEnable),
+		TargetAccountID: suite.testAccounts["local_account_1"].ID,
+	})
+	suite.EqualError(errWithCode, "admin action type enable is not supported for this endpoint")
+
+	errWithCode = suite.processor.AdminAccountAction(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionSilence),
+		TargetAccountID: authed.Account.ID,
+	})
+	suite.EqualError(errWithCode, "you can't take admin actions on your own account")
+
+	errWithCode = suite.processor.AdminAccountAction(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionDisable),
+		TargetAccountID: suite.testAccounts["remote_account_1"].ID,
+	})
+	suite.EqualError(errWithCode, "account "+suite.testAccounts["remote_account_1"].ID+" is not a local account with a user")
+
+	errWithCode = suite.processor.AdminAccountAction(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionSilence),
+		ReportID:        suite.testReports["local_account_2_report_remote_account_1"].ID,
+		TargetAccountID: suite.testAccounts["local_account_1"].ID,
+	})
+	suite.EqualError(errWithCode, "report "+suite.testReports["local_account_2_report_remote_account_1"].ID+" does not target account "+suite.testAccounts["local_account_1"].ID)
+
+	_, errWithCode = suite.processor.AdminAccountActionUndo(ctx, authed, &apimodel.AdminAccountActionRequest{
+		Type:            string(gtsmodel.AdminActionUnsuspend),
+		TargetAccountID: suite.testAccounts["local_account_1"].ID,
+	})
+	suite.EqualError(errWithCode, "account "+suite.testAccounts["local_account_1"].ID+" is not suspended")
+}
+
+func TestAdminTestSuite(t *testing.T) {
+	suite.Run(t, &AdminTestSuite{})
+}
@@ -0,0 +1,52 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversation
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Processor wraps a bunch of functions for processing direct message conversations.
+type Processor interface {
+	// GetAll returns a page of conversations owned by the given account, most recently active first.
+	GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// Read marks the conversation with the given ID as read, if it's owned by the given account.
+	Read(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Conversation, gtserror.WithCode)
+	// Delete removes the conversation with the given ID, if it's owned by the given account.
+	Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+}
+
+type processor struct {
+	db db.DB
+	tc typeutils.TypeConverter
+}
+
+// New returns a new conversation processor.
+func New(db db.DB, tc typeutils.TypeConverter) Processor {
+	return &processor{
+		db: db,
+		tc: tc,
+	}
+}
@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package conversation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	conversations, err := p.db.GetConversationsForAccountID(ctx, account.ID, maxID, sinceID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetAll: error getting conversations: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(conversations)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, conversation := range conversations {
+		apiConversation, errWithCode := p.apiConversation(ctx, account, conversation)
+		if errWithCode != nil {
+			log.Debugf("GetAll: skipping conversation %s: %v", conversation.ID, errWithCode)
+			continue
+		}
+
+		items = append(items, apiConversation)
+	}
+
+	// conversations are paged by their last status
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/conversations",
+		NextMaxIDValue: conversations[count-1].LastStatusID,
+		PrevMinIDValue: conversations[0].LastStatusID,
+		Limit:          limit,
+	})
+}
+
+// getOwnConversation gets the conversation with the given ID,
+// returning a 404 if it doesn't exist or isn't owned by the given account.
+func (p *processor) getOwnConversation(ctx context.Context, account *gtsmodel.Account, id string) (*gtsmodel.Conversation, gtserror.WithCode) {
+	conversation, err := p.db.GetConversationByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting conversation %s: %w", id, err))
+	}
+
+	if conversation.AccountID != account.ID {
+		err := fmt.Errorf("conversation %s does not belong to account %s", id, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return conversation, nil
+}
+
+func (p *processor) apiConversation(ctx context.Context, account *gtsmodel.Account, conversation *gtsmodel.Conversation) (*apimodel.Conversation, gtserror.WithCode) {
+	apiConversation, err := p.tc.ConversationToAPIConversation(ctx, conversation, account)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting conversation %s to api: %w", conversation.ID, err))
+	}
+
+	return apiConversation, nil
+}
@@ -0,0 +1,43 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) AccountExportCreate(ctx context.Context, authed *oauth.Auth) (*apimodel.AccountExport, gtserror.WithCode) {
+	return p.exportProcessor.Create(ctx, authed.Account)
+}
+
+func (p *processor) AccountExportsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AccountExport, gtserror.WithCode) {
+	return p.exportProcessor.GetAll(ctx, authed.Account)
+}
+
+func (p *processor) AccountExportArchiveGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Content, gtserror.WithCode) {
+	return p.exportProcessor.GetArchive(ctx, authed.Account, id)
+}
+
+func (p *processor) AccountImport(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountImportRequest) gtserror.WithCode {
+	return p.exportProcessor.Import(ctx, authed.Account, form)
+}
@@ -0,0 +1,387 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// pageSize is the amount of entries to
+// get from the db at once when exporting.
+const pageSize = 100
+
+// Names of the files in an export archive. These match the files
+// in a Mastodon archive or data export where there's an equivalent,
+// so that the CSV files can be imported into Mastodon too.
+const (
+	actorFile     = "actor.json"
+	outboxFile    = "outbox.json"
+	followingFile = "following_accounts.csv"
+	blocksFile    = "blocked_accounts.csv"
+	mutesFile     = "muted_accounts.csv"
+	bookmarksFile = "bookmarks.csv"
+	mediaDir      = "media_attachments/"
+)
+
+// writeArchive writes a zip archive of all the data of the given account to w.
+func (p *processor) writeArchive(ctx context.Context, account *gtsmodel.Account, w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	if err := p.writeActor(ctx, zw, account); err != nil {
+		return fmt.Errorf("error writing %s: %w", actorFile, err)
+	}
+
+	attachmentIDs, err := p.writeOutbox(ctx, zw, account)
+	if err != nil {
+		return fmt.Errorf("error writing %s: %w", outboxFile, err)
+	}
+
+	if err := p.writeFollowing(ctx, zw, account); err != nil {
+		return fmt.Errorf("error writing %s: %w", followingFile, err)
+	}
+
+	if err := p.writeBlocks(ctx, zw, account); err != nil {
+		return fmt.Errorf("error writing %s: %w", blocksFile, err)
+	}
+
+	if err := p.writeMutes(ctx, zw, account); err != nil {
+		return fmt.Errorf("error writing %s: %w", mutesFile, err)
+	}
+
+	if err := p.writeBookmarks(ctx, zw, account); err != nil {
+		return fmt.Errorf("error writing %s: %w", bookmarksFile, err)
+	}
+
+	attachmentIDs = append(attachmentIDs, account.AvatarMediaAttachmentID, account.HeaderMediaAttachmentID)
+	for _, attachmentID := range attachmentIDs {
+		if attachmentID == "" {
+			continue
+		}
+
+		if err := p.writeMedia(ctx, zw, attachmentID); err != nil {
+			return fmt.Errorf("error writing media attachment %s: %w", attachmentID, err)
+		}
+	}
+
+	return zw.Close()
+}
+
+func (p *processor) writeActor(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	person, err := p.tc.AccountToAS(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	return writeJSON(zw, actorFile, person)
+}
+
+// writeOutbox writes all statuses of the given account as an OrderedCollection
+// of Create and Announce activities, and returns the IDs of their attachments.
+func (p *processor) writeOutbox(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) ([]string, error) {
+	var (
+		attachmentIDs []string
+		itemsProp     = streams.NewActivityStreamsOrderedItemsProperty()
+		maxID         string
+	)
+
+	for {
+		statuses, err := p.db.GetAccountStatuses(ctx, account.ID, pageSize, false, false, maxID, "", false, false, false)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		if len(statuses) == 0 {
+			break
+		}
+
+		for _, s := range statuses {
+			if err := p.appendOutboxItem(ctx, itemsProp, account, s); err != nil {
+				// one broken status shouldn't make the whole export fail
+				log.Errorf("writeOutbox: error converting status %s: %s", s.ID, err)
+				continue
+			}
+
+			attachmentIDs = append(attachmentIDs, s.AttachmentIDs...)
+		}
+
+		maxID = statuses[len(statuses)-1].ID
+	}
+
+	outboxIRI, err := url.Parse(account.OutboxURI)
+	if err != nil {
+		return nil, err
+	}
+
+	outbox := streams.NewActivityStreamsOrderedCollection()
+
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.SetIRI(outboxIRI)
+	outbox.SetJSONLDId(idProp)
+
+	totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+	totalItemsProp.Set(itemsProp.Len())
+	outbox.SetActivityStreamsTotalItems(totalItemsProp)
+
+	outbox.SetActivityStreamsOrderedItems(itemsProp)
+
+	if err := writeJSON(zw, outboxFile, outbox); err != nil {
+		return nil, err
+	}
+
+	return attachmentIDs, nil
+}
+
+func (p *processor) appendOutboxItem(ctx context.Context, itemsProp vocab.ActivityStreamsOrderedItemsProperty, account *gtsmodel.Account, status *gtsmodel.Status) error {
+	if status.BoostOfID != "" {
+		announce, err := p.tc.BoostToAS(ctx, status, account, status.BoostOfAccount)
+		if err != nil {
+			return err
+		}
+
+		itemsProp.AppendActivityStreamsAnnounce(announce)
+		return nil
+	}
+
+	statusable, err := p.tc.StatusToAS(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	create, err := p.tc.WrapStatusableInCreate(statusable, false)
+	if err != nil {
+		return err
+	}
+
+	itemsProp.AppendActivityStreamsCreate(create)
+	return nil
+}
+
+func (p *processor) writeFollowing(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	follows, err := p.db.GetAccountFollows(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return err
+	}
+
+	records := [][]string{{"Account address", "Show boosts", "Notify on new posts", "Languages"}}
+	for _, f := range follows {
+		if f.TargetAccount == nil {
+			continue
+		}
+
+		records = append(records, []string{
+			accountAddress(f.TargetAccount),
+			strconv.FormatBool(f.ShowReblogs == nil || *f.ShowReblogs),
+			strconv.FormatBool(f.Notify != nil && *f.Notify),
+			"", // we don't do per-follow language filtering
+		})
+	}
+
+	return writeCSV(zw, followingFile, records)
+}
+
+func (p *processor) writeBlocks(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	var (
+		records [][]string
+		maxID   string
+	)
+
+	for {
+		blocked, nextMaxID, _, err := p.db.GetAccountBlocks(ctx, account.ID, maxID, "", pageSize)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		if len(blocked) == 0 {
+			break
+		}
+
+		for _, a := range blocked {
+			if a != nil {
+				records = append(records, []string{accountAddress(a)})
+			}
+		}
+
+		maxID = nextMaxID
+	}
+
+	return writeCSV(zw, blocksFile, records)
+}
+
+func (p *processor) writeMutes(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	var (
+		records = [][]string{{"Account address", "Hide notifications"}}
+		maxID   string
+	)
+
+	for {
+		mutes, err := p.db.GetAccountMutes(ctx, account.ID, maxID, "", pageSize)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		if len(mutes) == 0 {
+			break
+		}
+
+		for _, m := range mutes {
+			if m.TargetAccount != nil {
+				records = append(records, []string{
+					accountAddress(m.TargetAccount),
+					strconv.FormatBool(m.Notifications != nil && *m.Notifications),
+				})
+			}
+		}
+
+		maxID = mutes[len(mutes)-1].ID
+	}
+
+	return writeCSV(zw, mutesFile, records)
+}
+
+func (p *processor) writeBookmarks(ctx context.Context, zw *zip.Writer, account *gtsmodel.Account) error {
+	var (
+		records [][]string
+		maxID   string
+	)
+
+	for {
+		bookmarks, err := p.db.GetBookmarks(ctx, account.ID, pageSize, maxID, "")
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		if len(bookmarks) == 0 {
+			break
+		}
+
+		for _, b := range bookmarks {
+			status, err := p.db.GetStatusByID(ctx, b.StatusID)
+			if err != nil {
+				log.Debugf("writeBookmarks: error getting bookmarked status %s: %s", b.StatusID, err)
+				continue
+			}
+
+			records = append(records, []string{status.URI})
+		}
+
+		maxID = bookmarks[len(bookmarks)-1].ID
+	}
+
+	return writeCSV(zw, bookmarksFile, records)
+}
+
+// writeMedia copies the original file of the given
+// attachment from storage into the media directory.
+func (p *processor) writeMedia(ctx context.Context, zw *zip.Writer, attachmentID string) error {
+	attachment, err := p.db.GetAttachmentByID(ctx, attachmentID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// probably deleted in the meantime
+			return nil
+		}
+		return err
+	}
+
+	if attachment.Cached == nil || !*attachment.Cached || attachment.File.Path == "" {
+		return nil
+	}
+
+	rc, err := p.storage.GetStream(ctx, attachment.File.Path)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+
+	// media is compressed already, so just store it
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     mediaDir + attachment.File.Path,
+		Method:   zip.Store,
+		Modified: attachment.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(f, rc)
+	return err
+}
+
+func writeJSON(zw *zip.Writer, name string, t vocab.Type) error {
+	m, err := streams.Serialize(t)
+	if err != nil {
+		return err
+	}
+
+	b, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(b)
+	return err
+}
+
+func writeCSV(zw *zip.Writer, name string, records [][]string) error {
+	f, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return csv.NewWriter(f).WriteAll(records)
+}
+
+// accountAddress returns the address of the given account
+// in the form used by Mastodon exports, eg 'someone@example.org'.
+func accountAddress(account *gtsmodel.Account) string {
+	domain := account.Domain
+	if domain == "" {
+		domain = config.GetAccountDomain()
+	}
+
+	return account.Username + "@" + domain
+}
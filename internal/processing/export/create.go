@@ -0,0 +1,140 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// exportInterval is how long an account has to wait
+// after requesting an export before requesting another.
+const exportInterval = 24 * time.Hour
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account) (*apimodel.AccountExport, gtserror.WithCode) {
+	previous, err := p.db.GetAccountExportsByAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("Create: db error getting exports: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	for _, e := range previous {
+		if e.State != gtsmodel.AccountExportStateFailed && time.Since(e.CreatedAt) < exportInterval {
+			err := fmt.Errorf("export %s was requested less than %s ago", e.ID, exportInterval)
+			return nil, gtserror.NewErrorUnprocessableEntity(err, "an export can only be requested once every 24 hours")
+		}
+	}
+
+	exportID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	export := &gtsmodel.AccountExport{
+		ID:        exportID,
+		AccountID: account.ID,
+		State:     gtsmodel.AccountExportStateProcessing,
+	}
+
+	if err := p.db.PutAccountExport(ctx, export); err != nil {
+		err = fmt.Errorf("Create: db error putting export: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// Only the newest export is kept.
+	for _, e := range previous {
+		if err := p.deleteExport(ctx, e); err != nil {
+			log.Errorf("Create: error deleting previous export %s: %s", e.ID, err)
+		}
+	}
+
+	go p.buildArchive(context.Background(), account, export)
+
+	apiExport, err := p.tc.AccountExportToAPIAccountExport(ctx, export)
+	if err != nil {
+		err = fmt.Errorf("Create: error converting export to api: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	return apiExport, nil
+}
+
+// buildArchive writes the archive of the given export to storage,
+// and marks the export as complete, or as failed if that didn't work.
+func (p *processor) buildArchive(ctx context.Context, account *gtsmodel.Account, export *gtsmodel.AccountExport) {
+	path := fmt.Sprintf("%s/export/%s.zip", account.ID, export.ID)
+
+	size, err := p.putArchive(ctx, account, path)
+	if err != nil {
+		log.Errorf("buildArchive: error building archive for export %s: %s", export.ID, err)
+
+		export.State = gtsmodel.AccountExportStateFailed
+		if err := p.db.UpdateAccountExport(ctx, export, "state"); err != nil {
+			log.Errorf("buildArchive: db error updating export %s: %s", export.ID, err)
+		}
+
+		return
+	}
+
+	export.State = gtsmodel.AccountExportStateComplete
+	export.Path = path
+	export.Size = size
+	export.CompletedAt = time.Now()
+
+	if err := p.db.UpdateAccountExport(ctx, export, "state", "path", "size", "completed_at"); err != nil {
+		log.Errorf("buildArchive: db error updating export %s: %s", export.ID, err)
+	}
+}
+
+// putArchive streams a zip archive of all the data of
+// the given account into storage at the given path.
+func (p *processor) putArchive(ctx context.Context, account *gtsmodel.Account, path string) (int64, error) {
+	pr, pw := io.Pipe()
+
+	go func() {
+		pw.CloseWithError(p.writeArchive(ctx, account, pw))
+	}()
+
+	size, err := p.storage.PutStream(ctx, path, pr)
+
+	// Make sure the writer isn't left
+	// blocking if storage gave up early.
+	pr.CloseWithError(err)
+
+	if err != nil {
+		// Don't leave a partial archive behind.
+		if err := p.storage.Delete(ctx, path); err != nil {
+			log.Debugf("putArchive: error deleting partial archive %s: %s", path, err)
+		}
+
+		return 0, err
+	}
+
+	return size, nil
+}
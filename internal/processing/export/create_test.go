@@ -0,0 +1,161 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type CreateTestSuite struct {
+	ExportStandardTestSuite
+}
+
+// waitComplete waits for the export with the given ID to finish
+// building, and returns the files in its archive, keyed by name.
+func (suite *CreateTestSuite) waitComplete(exportID string) map[string][]byte {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	if !suite.Eventually(func() bool {
+		exports, errWithCode := suite.export.GetAll(ctx, account)
+		return errWithCode == nil && len(exports) == 1 && exports[0].ID == exportID && exports[0].State == "complete"
+	}, 10*time.Second, 10*time.Millisecond) {
+		suite.FailNow("timed out waiting for export to complete")
+	}
+
+	content, errWithCode := suite.export.GetArchive(ctx, account, exportID)
+	suite.NoError(errWithCode)
+	suite.Equal("application/zip", content.ContentType)
+	defer content.Content.Close()
+
+	b, err := io.ReadAll(content.Content)
+	suite.NoError(err)
+	suite.EqualValues(content.ContentLength, len(b))
+
+	zr, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	suite.NoError(err)
+
+	files := make(map[string][]byte, len(zr.File))
+	for _, f := range zr.File {
+		rc, err := f.Open()
+		suite.NoError(err)
+		data, err := io.ReadAll(rc)
+		suite.NoError(err)
+		rc.Close()
+		files[f.Name] = data
+	}
+
+	return files
+}
+
+func (suite *CreateTestSuite) TestCreate() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	export, errWithCode := suite.export.Create(ctx, account)
+	suite.NoError(errWithCode)
+	suite.Equal("processing", export.State)
+	suite.Empty(export.URL)
+
+	files := suite.waitComplete(export.ID)
+
+	actor := map[string]interface{}{}
+	suite.NoError(json.Unmarshal(files["actor.json"], &actor))
+	suite.Equal("Person", actor["type"])
+	suite.Equal("http://localhost:8080/users/the_mighty_zork", actor["id"])
+
+	outbox := map[string]interface{}{}
+	suite.NoError(json.Unmarshal(files["outbox.json"], &outbox))
+	suite.Equal("OrderedCollection", outbox["type"])
+	suite.Equal("http://localhost:8080/users/the_mighty_zork/outbox", outbox["id"])
+	items, ok := outbox["orderedItems"].([]interface{})
+	suite.True(ok)
+	suite.Len(items, int(outbox["totalItems"].(float64)))
+	suite.NotEmpty(items)
+	suite.Equal("Create", items[0].(map[string]interface{})["type"])
+
+	suite.Equal("Account address,Show boosts,Notify on new posts,Languages\n"+
+		"admin@localhost:8080,true,false,\n"+
+		"1happyturtle@localhost:8080,true,false,\n", string(files["following_accounts.csv"]))
+	suite.Equal("Account address,Hide notifications\n", string(files["muted_accounts.csv"]))
+	suite.Contains(files, "blocked_accounts.csv")
+	suite.Contains(files, "bookmarks.csv")
+
+	// avatar, header and status attachments should all be in there
+	suite.Contains(files, "media_attachments/01F8MH1H7YV1Z7D2C8K2730QBF/avatar/original/01F8MH58A357CV5K7R7TJMSH6S.jpg")
+	suite.Contains(files, "media_attachments/01F8MH1H7YV1Z7D2C8K2730QBF/header/original/01PFPMWK2FF0D9WMHEJHR07C3Q.jpg")
+	suite.Contains(files, "media_attachments/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01F8MH7TDVANYKWVE8VVKFPJTJ.gif")
+}
+
+func (suite *CreateTestSuite) TestCreateTooSoon() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	export, errWithCode := suite.export.Create(ctx, account)
+	suite.NoError(errWithCode)
+	suite.waitComplete(export.ID)
+
+	_, errWithCode = suite.export.Create(ctx, account)
+	suite.Error(errWithCode)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *CreateTestSuite) TestGetArchiveOtherAccount() {
+	ctx := context.Background()
+
+	export, errWithCode := suite.export.Create(ctx, suite.testAccounts["local_account_1"])
+	suite.NoError(errWithCode)
+	suite.waitComplete(export.ID)
+
+	_, errWithCode = suite.export.GetArchive(ctx, suite.testAccounts["local_account_2"], export.ID)
+	suite.Error(errWithCode)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *CreateTestSuite) TestDeleteAll() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	export, errWithCode := suite.export.Create(ctx, account)
+	suite.NoError(errWithCode)
+	suite.waitComplete(export.ID)
+
+	suite.NoError(suite.export.DeleteAll(ctx, account))
+
+	exports, errWithCode := suite.export.GetAll(ctx, account)
+	suite.NoError(errWithCode)
+	suite.Empty(exports)
+
+	has, err := suite.storage.Has(ctx, account.ID+"/export/"+export.ID+".zip")
+	suite.NoError(err)
+	suite.False(has)
+}
+
+func TestCreateTestSuite(t *testing.T) {
+	suite.Run(t, new(CreateTestSuite))
+}
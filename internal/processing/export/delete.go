@@ -0,0 +1,60 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) DeleteAll(ctx context.Context, account *gtsmodel.Account) error {
+	exports, err := p.db.GetAccountExportsByAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return fmt.Errorf("DeleteAll: db error getting exports: %w", err)
+	}
+
+	for _, e := range exports {
+		if err := p.deleteExport(ctx, e); err != nil {
+			return fmt.Errorf("DeleteAll: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deleteExport removes the archive of the given export from storage, and then the export itself.
+func (p *processor) deleteExport(ctx context.Context, export *gtsmodel.AccountExport) error {
+	if export.Path != "" {
+		if err := p.storage.Delete(ctx, export.Path); err != nil {
+			// the archive might be gone already, so carry on
+			log.Debugf("deleteExport: error deleting archive %s: %s", export.Path, err)
+		}
+	}
+
+	if err := p.db.DeleteAccountExportByID(ctx, export.ID); err != nil {
+		return fmt.Errorf("db error deleting export %s: %w", export.ID, err)
+	}
+
+	return nil
+}
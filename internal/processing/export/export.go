@@ -0,0 +1,72 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Processor wraps a bunch of functions for processing exports of
+// account data, and imports of data exported from other instances.
+//
+// Both exports and imports are processed asynchronously, since they
+// can involve a lot of database calls and (for imports) remote lookups.
+type Processor interface {
+	// Create requests a new export of all the data of the given account.
+	// The archive is built asynchronously; the returned export will be in
+	// the 'processing' state until the archive is ready for download.
+	Create(ctx context.Context, account *gtsmodel.Account) (*apimodel.AccountExport, gtserror.WithCode)
+	// GetAll returns all exports of the given account, newest first.
+	GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.AccountExport, gtserror.WithCode)
+	// GetArchive returns the zip archive of the given export, if it's owned by the given account and complete.
+	GetArchive(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Content, gtserror.WithCode)
+	// DeleteAll removes all exports of the given account, including archives.
+	DeleteAll(ctx context.Context, account *gtsmodel.Account) error
+	// Import parses the given CSV file, and asynchronously follows or blocks
+	// the accounts in it on behalf of the given account, depending on the type.
+	Import(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountImportRequest) gtserror.WithCode
+}
+
+type processor struct {
+	db               db.DB
+	tc               typeutils.TypeConverter
+	storage          *storage.Driver
+	federator        federation.Federator
+	accountProcessor account.Processor
+}
+
+// New returns a new export processor.
+func New(db db.DB, tc typeutils.TypeConverter, storage *storage.Driver, federator federation.Federator, accountProcessor account.Processor) Processor {
+	return &processor{
+		db:               db,
+		tc:               tc,
+		storage:          storage,
+		federator:        federator,
+		accountProcessor: accountProcessor,
+	}
+}
@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.AccountExport, gtserror.WithCode) {
+	exports, err := p.db.GetAccountExportsByAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetAll: db error getting exports: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	apiExports := make([]*apimodel.AccountExport, 0, len(exports))
+	for _, e := range exports {
+		apiExport, err := p.tc.AccountExportToAPIAccountExport(ctx, e)
+		if err != nil {
+			err = fmt.Errorf("GetAll: error converting export to api: %w", err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiExports = append(apiExports, apiExport)
+	}
+
+	return apiExports, nil
+}
+
+func (p *processor) GetArchive(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Content, gtserror.WithCode) {
+	export, err := p.db.GetAccountExportByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		err = fmt.Errorf("GetArchive: db error getting export: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if export.AccountID != account.ID {
+		err := fmt.Errorf("export %s does not belong to account %s", export.ID, account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	if export.State != gtsmodel.AccountExportStateComplete {
+		err := fmt.Errorf("export %s is not complete", export.ID)
+		return nil, gtserror.NewErrorNotFound(err, "export archive is not ready")
+	}
+
+	content := &apimodel.Content{
+		ContentType:    "application/zip",
+		ContentLength:  export.Size,
+		ContentUpdated: export.CompletedAt,
+	}
+
+	// Serve a presigned url if
+	// we're on s3 without proxying.
+	if url := p.storage.URL(ctx, export.Path); url != nil {
+		content.URL = url
+		return content, nil
+	}
+
+	content.Content, err = p.storage.GetStream(ctx, export.Path)
+	if err != nil {
+		err = fmt.Errorf("GetArchive: error getting archive from storage: %w", err)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return content, nil
+}
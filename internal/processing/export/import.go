@@ -0,0 +1,277 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// maxImportEntries is the maximum amount of
+// accounts that can be imported from one file.
+const maxImportEntries = 5000
+
+// importEntry is one account parsed from an import file.
+type importEntry struct {
+	username    string
+	domain      string
+	showReblogs bool // only used for follows
+	notify      bool // only used for follows
+}
+
+func (p *processor) Import(ctx context.Context, account *gtsmodel.Account, form *apimodel.AccountImportRequest) gtserror.WithCode {
+	switch form.Type {
+	case apimodel.AccountImportTypeFollowing, apimodel.AccountImportTypeBlocks:
+	default:
+		err := fmt.Errorf("import type %q not recognized", form.Type)
+		return gtserror.NewErrorBadRequest(err, "type must be one of: following, blocks")
+	}
+
+	switch form.Mode {
+	case "":
+		form.Mode = apimodel.AccountImportModeMerge
+	case apimodel.AccountImportModeMerge, apimodel.AccountImportModeOverwrite:
+	default:
+		err := fmt.Errorf("import mode %q not recognized", form.Mode)
+		return gtserror.NewErrorBadRequest(err, "mode must be one of: merge, overwrite")
+	}
+
+	if form.Data == nil {
+		err := errors.New("no data provided")
+		return gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	f, err := form.Data.Open()
+	if err != nil {
+		err = fmt.Errorf("Import: error opening file: %w", err)
+		return gtserror.NewErrorBadRequest(err)
+	}
+	defer f.Close()
+
+	entries, err := parseImport(f)
+	if err != nil {
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	go p.importEntries(context.Background(), account, form.Type, form.Mode, entries)
+
+	return nil
+}
+
+// parseImport parses a CSV file in the format of a Mastodon export of followed,
+// blocked or muted accounts, ie., with the account address in the first column,
+// optionally followed by 'Show boosts' and 'Notify on new posts' columns.
+// The header row is optional, since Mastodon only writes one for some types.
+func parseImport(r io.Reader) ([]*importEntry, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	var (
+		entries []*importEntry
+		seen    = make(map[string]struct{})
+	)
+
+	for i := 1; ; i++ {
+		record, err := cr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading csv: %w", err)
+		}
+
+		address := strings.TrimSpace(record[0])
+		if address == "" || (i == 1 && strings.EqualFold(address, "Account address")) {
+			continue
+		}
+
+		username, domain, ok := splitAddress(address)
+		if !ok {
+			return nil, fmt.Errorf("row %d: %q is not an account address like 'someone@example.org'", i, address)
+		}
+
+		key := username + "@" + domain
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		entry := &importEntry{
+			username:    username,
+			domain:      domain,
+			showReblogs: true,
+		}
+
+		if len(record) > 1 {
+			if showReblogs, err := strconv.ParseBool(strings.TrimSpace(record[1])); err == nil {
+				entry.showReblogs = showReblogs
+			}
+		}
+
+		if len(record) > 2 {
+			if notify, err := strconv.ParseBool(strings.TrimSpace(record[2])); err == nil {
+				entry.notify = notify
+			}
+		}
+
+		entries = append(entries, entry)
+		if len(entries) > maxImportEntries {
+			return nil, fmt.Errorf("file contains more than %d accounts", maxImportEntries)
+		}
+	}
+
+	if len(entries) == 0 {
+		return nil, errors.New("file contains no accounts")
+	}
+
+	return entries, nil
+}
+
+// splitAddress splits an account address like 'someone@example.org'
+// or '@someone@example.org' into its username and domain parts.
+func splitAddress(address string) (string, string, bool) {
+	username, domain, ok := strings.Cut(strings.TrimPrefix(address, "@"), "@")
+	if !ok || username == "" || domain == "" || strings.Contains(domain, "@") {
+		return "", "", false
+	}
+
+	return username, strings.ToLower(domain), true
+}
+
+// importEntries follows or blocks each of the given entries on behalf
+// of the given account. In overwrite mode, any existing follows or blocks
+// of accounts that weren't in the entries are removed afterwards.
+func (p *processor) importEntries(ctx context.Context, account *gtsmodel.Account, importType apimodel.AccountImportType, mode apimodel.AccountImportMode, entries []*importEntry) {
+	imported := make(map[string]struct{}, len(entries))
+
+	for _, e := range entries {
+		target, err := p.federator.GetAccount(ctx, dereferencing.GetAccountParams{
+			RequestingUsername:    account.Username,
+			RemoteAccountUsername: e.username,
+			RemoteAccountHost:     e.domain,
+			Blocking:              true,
+		})
+		if err != nil {
+			log.Debugf("importEntries: error getting account %s@%s: %s", e.username, e.domain, err)
+			continue
+		}
+
+		if target.ID == account.ID {
+			continue
+		}
+
+		var errWithCode gtserror.WithCode
+		switch importType {
+		case apimodel.AccountImportTypeFollowing:
+			_, errWithCode = p.accountProcessor.FollowCreate(ctx, account, &apimodel.AccountFollowRequest{
+				ID:      target.ID,
+				Reblogs: &e.showReblogs,
+				Notify:  &e.notify,
+			})
+		case apimodel.AccountImportTypeBlocks:
+			_, errWithCode = p.accountProcessor.BlockCreate(ctx, account, target.ID)
+		}
+
+		if errWithCode != nil {
+			log.Debugf("importEntries: error importing %s@%s: %s", e.username, e.domain, errWithCode)
+			continue
+		}
+
+		imported[target.ID] = struct{}{}
+	}
+
+	if mode == apimodel.AccountImportModeOverwrite {
+		if err := p.removeNotImported(ctx, account, importType, imported); err != nil {
+			log.Errorf("importEntries: error removing existing %s of account %s: %s", importType, account.ID, err)
+		}
+	}
+
+	log.Infof("importEntries: imported %d of %d %s for account %s", len(imported), len(entries), importType, account.ID)
+}
+
+// removeNotImported removes existing follows or blocks of the given
+// account, targeting accounts whose IDs aren't in the imported set.
+func (p *processor) removeNotImported(ctx context.Context, account *gtsmodel.Account, importType apimodel.AccountImportType, imported map[string]struct{}) error {
+	var targetIDs []string
+
+	switch importType {
+	case apimodel.AccountImportTypeFollowing:
+		follows, err := p.db.GetAccountFollows(ctx, account.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return err
+		}
+
+		for _, f := range follows {
+			targetIDs = append(targetIDs, f.TargetAccountID)
+		}
+	case apimodel.AccountImportTypeBlocks:
+		var maxID string
+		for {
+			blocked, nextMaxID, _, err := p.db.GetAccountBlocks(ctx, account.ID, maxID, "", pageSize)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return err
+			}
+
+			if len(blocked) == 0 {
+				break
+			}
+
+			for _, a := range blocked {
+				if a != nil {
+					targetIDs = append(targetIDs, a.ID)
+				}
+			}
+
+			maxID = nextMaxID
+		}
+	}
+
+	for _, targetID := range targetIDs {
+		if _, ok := imported[targetID]; ok {
+			continue
+		}
+
+		var errWithCode gtserror.WithCode
+		switch importType {
+		case apimodel.AccountImportTypeFollowing:
+			_, errWithCode = p.accountProcessor.FollowRemove(ctx, account, targetID)
+		case apimodel.AccountImportTypeBlocks:
+			_, errWithCode = p.accountProcessor.BlockRemove(ctx, account, targetID)
+		}
+
+		if errWithCode != nil {
+			log.Debugf("removeNotImported: error removing %s of %s: %s", importType, targetID, errWithCode)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package export_test
+
+import (
+	"bytes"
+	"context"
+	"mime/multipart"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ImportTestSuite struct {
+	ExportStandardTestSuite
+}
+
+// csvFile returns a multipart file header for a file with the given contents.
+func (suite *ImportTestSuite) csvFile(contents string) *multipart.FileHeader {
+	b := &bytes.Buffer{}
+	w := multipart.NewWriter(b)
+	fw, err := w.CreateFormFile("data", "import.csv")
+	suite.NoError(err)
+	_, err = fw.Write([]byte(contents))
+	suite.NoError(err)
+	suite.NoError(w.Close())
+
+	form, err := multipart.NewReader(b, w.Boundary()).ReadForm(1024)
+	suite.NoError(err)
+	return form.File["data"][0]
+}
+
+func (suite *ImportTestSuite) isFollowing(account *gtsmodel.Account, target *gtsmodel.Account) bool {
+	following, err := suite.db.IsFollowing(context.Background(), account, target)
+	suite.NoError(err)
+	return following
+}
+
+func (suite *ImportTestSuite) TestImportBlocksMastodonFormat() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	target := suite.testAccounts["remote_account_1"]
+
+	// mastodon doesn't write a header for blocks
+	errWithCode := suite.export.Import(ctx, account, &apimodel.AccountImportRequest{
+		Data: suite.csvFile("foss_satan@fossbros-anonymous.io\n"),
+		Type: apimodel.AccountImportTypeBlocks,
+	})
+	suite.NoError(errWithCode)
+
+	suite.Eventually(func() bool {
+		blocked, err := suite.db.IsBlocked(ctx, account.ID, target.ID, false)
+		return err == nil && blocked
+	}, 10*time.Second, 10*time.Millisecond)
+}
+
+func (suite *ImportTestSuite) TestImportFollowingOverwrite() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+	admin := suite.testAccounts["admin_account"]
+	turtle := suite.testAccounts["local_account_2"]
+	satan := suite.testAccounts["remote_account_1"]
+
+	suite.True(suite.isFollowing(account, admin))
+	suite.True(suite.isFollowing(account, turtle))
+
+	errWithCode := suite.export.Import(ctx, account, &apimodel.AccountImportRequest{
+		Data: suite.csvFile("Account address,Show boosts,Notify on new posts,Languages\n" +
+			"1happyturtle@localhost:8080,false,true,\n" +
+			"@foss_satan@fossbros-anonymous.io,true,false,\n"),
+		Type: apimodel.AccountImportTypeFollowing,
+		Mode: apimodel.AccountImportModeOverwrite,
+	})
+	suite.NoError(errWithCode)
+
+	// admin wasn't in the file so should be unfollowed, and
+	// satan is remote so we should now have a follow request
+	suite.Eventually(func() bool {
+		if suite.isFollowing(account, admin) {
+			return false
+		}
+
+		requested, err := suite.db.IsFollowRequested(ctx, account, satan)
+		return err == nil && requested
+	}, 10*time.Second, 10*time.Millisecond)
+
+	suite.True(suite.isFollowing(account, turtle))
+}
+
+func (suite *ImportTestSuite) TestImportBadFile() {
+	ctx := context.Background()
+	account := suite.testAccounts["local_account_1"]
+
+	for _, contents := range []string{
+		"Account address,Show boosts\n",
+		"this isn't an account address\n",
+		"\"unterminated,quote\n",
+	} {
+		errWithCode := suite.export.Import(ctx, account, &apimodel.AccountImportRequest{
+			Data: suite.csvFile(contents),
+			Type: apimodel.AccountImportTypeFollowing,
+		})
+		suite.Error(errWithCode)
+		suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	}
+}
+
+func (suite *ImportTestSuite) TestImportBadType() {
+	errWithCode := suite.export.Import(context.Background(), suite.testAccounts["local_account_1"], &apimodel.AccountImportRequest{
+		Data: suite.csvFile("foss_satan@fossbros-anonymous.io\n"),
+		Type: "bookmarks",
+	})
+	suite.Error(errWithCode)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func TestImportTestSuite(t *testing.T) {
+	suite.Run(t, new(ImportTestSuite))
+}
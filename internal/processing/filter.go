@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) FiltersV1Get(ctx context.Context, authed *oauth.Auth) ([]*apimodel.Filter, gtserror.WithCode) {
+	return p.filterProcessor.V1GetAll(ctx, authed.Account)
+}
+
+func (p *processor) FilterV1Get(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Filter, gtserror.WithCode) {
+	return p.filterProcessor.V1Get(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterV1Create(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	return p.filterProcessor.V1Create(ctx, authed.Account, form)
+}
+
+func (p *processor) FilterV1Update(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	return p.filterProcessor.V1Update(ctx, authed.Account, id, form)
+}
+
+func (p *processor) FilterV1Delete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.filterProcessor.V1Delete(ctx, authed.Account, id)
+}
+
+func (p *processor) FiltersV2Get(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filterProcessor.V2GetAll(ctx, authed.Account)
+}
+
+func (p *processor) FilterV2Get(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filterProcessor.V2Get(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterV2Create(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filterProcessor.V2Create(ctx, authed.Account, form)
+}
+
+func (p *processor) FilterV2Update(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	return p.filterProcessor.V2Update(ctx, authed.Account, id, form)
+}
+
+func (p *processor) FilterV2Delete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.filterProcessor.V2Delete(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterKeywordsGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filterProcessor.KeywordsGetAll(ctx, authed.Account, filterID)
+}
+
+func (p *processor) FilterKeywordGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filterProcessor.KeywordGet(ctx, authed.Account, id)
+}
+
+func (p *processor) FilterKeywordCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filterProcessor.KeywordCreate(ctx, authed.Account, filterID, form)
+}
+
+func (p *processor) FilterKeywordUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	return p.filterProcessor.KeywordUpdate(ctx, authed.Account, id, form)
+}
+
+func (p *processor) FilterKeywordDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.filterProcessor.KeywordDelete(ctx, authed.Account, id)
+}
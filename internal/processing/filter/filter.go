@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Processor wraps a bunch of functions for processing keyword filters and their keywords.
+//
+// Filters are exposed via two APIs: v1 filters, where each filter has exactly one keyword,
+// and v2 filters, where each filter groups any number of keywords. Both APIs are backed by
+// the same models; a v1 filter is represented by its single keyword, and uses its keyword ID.
+type Processor interface {
+	// V1GetAll returns all keywords owned by the given account, as v1 filters.
+	V1GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Filter, gtserror.WithCode)
+	// V1Get returns one keyword with the given ID, as a v1 filter.
+	V1Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Filter, gtserror.WithCode)
+	// V1Create creates a new filter containing a single keyword for the given account, and returns it as a v1 filter.
+	// The form should have already been validated by the time it reaches this function.
+	V1Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// V1Update updates one keyword and its parent filter, and returns it as a v1 filter.
+	// The form should have already been validated by the time it reaches this function.
+	V1Update(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// V1Delete deletes one keyword, and its parent filter if no other keywords remain in it.
+	V1Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+
+	// V2GetAll returns all filters owned by the given account.
+	V2GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FilterV2, gtserror.WithCode)
+	// V2Get returns one filter with the given ID.
+	V2Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterV2, gtserror.WithCode)
+	// V2Create creates a new filter for the given account, along with any keywords given in the form.
+	// The form should have already been validated by the time it reaches this function.
+	V2Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// V2Update updates one filter for the given account. Keywords are not touched.
+	// The form should have already been validated by the time it reaches this function.
+	V2Update(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// V2Delete deletes one filter for the given account, along with all of its keywords.
+	V2Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+
+	// KeywordsGetAll returns all keywords belonging to the given filter.
+	KeywordsGetAll(ctx context.Context, account *gtsmodel.Account, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordGet returns one filter keyword with the given ID.
+	KeywordGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordCreate adds a new keyword to the given filter.
+	// The form should have already been validated by the time it reaches this function.
+	KeywordCreate(ctx context.Context, account *gtsmodel.Account, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordUpdate updates one filter keyword.
+	// The form should have already been validated by the time it reaches this function.
+	KeywordUpdate(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// KeywordDelete deletes one filter keyword.
+	KeywordDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+}
+
+type processor struct {
+	db              db.DB
+	tc              typeutils.TypeConverter
+	statusTimelines timeline.Manager
+	listTimelines   timeline.Manager
+}
+
+// New returns a new filter processor.
+func New(db db.DB, tc typeutils.TypeConverter, statusTimelines timeline.Manager, listTimelines timeline.Manager) Processor {
+	return &processor{
+		db:              db,
+		tc:              tc,
+		statusTimelines: statusTimelines,
+		listTimelines:   listTimelines,
+	}
+}
@@ -0,0 +1,135 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter
+
+import (
+	"context"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) KeywordsGetAll(ctx context.Context, account *gtsmodel.Account, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiKeywords := make([]*apimodel.FilterKeyword, 0, len(filter.Keywords))
+	for _, keyword := range filter.Keywords {
+		apiKeyword, errWithCode := p.apiFilterKeyword(ctx, keyword)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiKeywords = append(apiKeywords, apiKeyword)
+	}
+
+	return apiKeywords, nil
+}
+
+func (p *processor) KeywordGet(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	keyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterKeyword(ctx, keyword)
+}
+
+func (p *processor) KeywordCreate(ctx context.Context, account *gtsmodel.Account, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	// Ensure filter exists + is owned by requesting account.
+	filter, errWithCode := p.getFilter(ctx, account.ID, filterID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	keywordID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	wholeWord := form.WholeWord != nil && *form.WholeWord
+	keyword := &gtsmodel.FilterKeyword{
+		ID:        keywordID,
+		AccountID: account.ID,
+		FilterID:  filter.ID,
+		Filter:    filter,
+		Keyword:   form.Keyword,
+		WholeWord: &wholeWord,
+	}
+
+	if err := p.db.PutFilterKeyword(ctx, keyword); err != nil {
+		return nil, putKeywordError(err)
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return p.apiFilterKeyword(ctx, keyword)
+}
+
+func (p *processor) KeywordUpdate(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	keyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	columns := []string{"keyword"}
+	keyword.Keyword = form.Keyword
+
+	if form.WholeWord != nil {
+		keyword.WholeWord = form.WholeWord
+		columns = append(columns, "whole_word")
+	}
+
+	if err := p.db.UpdateFilterKeyword(ctx, keyword, columns...); err != nil {
+		return nil, putKeywordError(err)
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return p.apiFilterKeyword(ctx, keyword)
+}
+
+func (p *processor) KeywordDelete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	// Ensure keyword exists + is owned by requesting account.
+	if _, errWithCode := p.getFilterKeyword(ctx, account.ID, id); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteFilterKeywordByID(ctx, id); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("error deleting filter keyword from db: %w", err))
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return nil
+}
+
+func (p *processor) apiFilterKeyword(ctx context.Context, keyword *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, gtserror.WithCode) {
+	apiKeyword, err := p.tc.FilterKeywordToAPIFilterKeyword(ctx, keyword)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter keyword to api filter keyword: %w", err))
+	}
+
+	return apiKeyword, nil
+}
@@ -0,0 +1,153 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// getFilter is a shortcut to get one filter from the database and
+// check that it's owned by the given accountID. Will return
+// appropriate errors so caller doesn't need to bother.
+func (p *processor) getFilter(ctx context.Context, accountID string, filterID string) (*gtsmodel.Filter, gtserror.WithCode) {
+	filter, err := p.db.GetFilterByID(ctx, filterID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Filter doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if filter.AccountID != accountID {
+		err = fmt.Errorf("filter with id %s does not belong to account %s", filter.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	// Make sure each keyword
+	// points back to its filter.
+	for _, keyword := range filter.Keywords {
+		keyword.Filter = filter
+	}
+
+	return filter, nil
+}
+
+// getFilterKeyword is a shortcut to get one filter keyword from the
+// database and check that it's owned by the given accountID. Will
+// return appropriate errors so caller doesn't need to bother.
+func (p *processor) getFilterKeyword(ctx context.Context, accountID string, keywordID string) (*gtsmodel.FilterKeyword, gtserror.WithCode) {
+	keyword, err := p.db.GetFilterKeywordByID(ctx, keywordID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Keyword doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if keyword.AccountID != accountID {
+		err = fmt.Errorf("filter keyword with id %s does not belong to account %s", keyword.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return keyword, nil
+}
+
+// putKeywordError wraps an error from inserting
+// or updating a keyword in an appropriate code.
+func putKeywordError(err error) gtserror.WithCode {
+	if errors.Is(err, db.ErrAlreadyExists) {
+		err = errors.New("duplicate keyword: this filter already contains the given keyword")
+		return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+	return gtserror.NewErrorInternalError(fmt.Errorf("error putting filter keyword in db: %w", err))
+}
+
+// setContexts sets the context flags on the given filter to
+// match the given contexts, which should already be validated.
+func setContexts(filter *gtsmodel.Filter, contexts []string) {
+	home, notifications, public, thread, account := false, false, false, false, false
+
+	for _, context := range contexts {
+		switch gtsmodel.FilterContext(context) {
+		case gtsmodel.FilterContextHome:
+			home = true
+		case gtsmodel.FilterContextNotifications:
+			notifications = true
+		case gtsmodel.FilterContextPublic:
+			public = true
+		case gtsmodel.FilterContextThread:
+			thread = true
+		case gtsmodel.FilterContextAccount:
+			account = true
+		}
+	}
+
+	filter.ContextHome = &home
+	filter.ContextNotifications = &notifications
+	filter.ContextPublic = &public
+	filter.ContextThread = &thread
+	filter.ContextAccount = &account
+}
+
+// contextColumns are the db columns changed by setContexts.
+var contextColumns = []string{
+	"context_home",
+	"context_notifications",
+	"context_public",
+	"context_thread",
+	"context_account",
+}
+
+// expiresAt returns the time that a filter should expire, given
+// an amount of seconds from now. Zero time means no expiry.
+func expiresAt(expiresIn *int) time.Time {
+	if expiresIn == nil || *expiresIn <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(time.Duration(*expiresIn) * time.Second)
+}
+
+// resetTimelines drops the home timeline and list timelines of the given
+// account, so that changes to filters are applied to items that have
+// already been prepared. The timelines will be rebuilt on next request.
+func (p *processor) resetTimelines(ctx context.Context, accountID string) {
+	p.statusTimelines.RemoveTimeline(ctx, accountID)
+
+	lists, err := p.db.GetListsForAccountID(ctx, accountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		log.Errorf("resetTimelines: error getting lists for account %s: %v", accountID, err)
+		return
+	}
+
+	for _, list := range lists {
+		p.listTimelines.RemoveTimeline(ctx, list.ID)
+	}
+}
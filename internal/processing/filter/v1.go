@@ -0,0 +1,190 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) V1GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Filter, gtserror.WithCode) {
+	keywords, err := p.db.GetFilterKeywordsForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting filter keywords: %w", err))
+	}
+
+	apiFilters := make([]*apimodel.Filter, 0, len(keywords))
+	for _, keyword := range keywords {
+		apiFilter, errWithCode := p.apiFilterV1(ctx, keyword)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiFilters = append(apiFilters, apiFilter)
+	}
+
+	return apiFilters, nil
+}
+
+func (p *processor) V1Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Filter, gtserror.WithCode) {
+	keyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV1(ctx, keyword)
+}
+
+func (p *processor) V1Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	filterID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	keywordID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filter := &gtsmodel.Filter{
+		ID:        filterID,
+		ExpiresAt: expiresAt(form.ExpiresIn),
+		AccountID: account.ID,
+		Title:     form.Phrase,
+		Action:    gtsmodel.FilterActionWarn,
+	}
+	setContexts(filter, form.Context)
+
+	if form.Irreversible != nil && *form.Irreversible {
+		filter.Action = gtsmodel.FilterActionHide
+	}
+
+	wholeWord := form.WholeWord != nil && *form.WholeWord
+	keyword := &gtsmodel.FilterKeyword{
+		ID:        keywordID,
+		AccountID: account.ID,
+		FilterID:  filterID,
+		Filter:    filter,
+		Keyword:   form.Phrase,
+		WholeWord: &wholeWord,
+	}
+	filter.Keywords = []*gtsmodel.FilterKeyword{keyword}
+
+	if err := p.db.PutFilter(ctx, filter); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error inserting filter in db: %w", err))
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return p.apiFilterV1(ctx, keyword)
+}
+
+func (p *processor) V1Update(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode) {
+	keyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Update the keyword itself.
+	keywordColumns := []string{"keyword"}
+	keyword.Keyword = form.Phrase
+
+	if form.WholeWord != nil {
+		keyword.WholeWord = form.WholeWord
+		keywordColumns = append(keywordColumns, "whole_word")
+	}
+
+	if err := p.db.UpdateFilterKeyword(ctx, keyword, keywordColumns...); err != nil {
+		return nil, putKeywordError(err)
+	}
+
+	// Update the parent filter, which
+	// holds the context, action and expiry.
+	filter := keyword.Filter
+	filterColumns := make([]string, 0, 8)
+
+	if len(filter.Keywords) <= 1 {
+		// Only retitle the filter if this
+		// keyword is the only one in it, as
+		// it would have been created via v1.
+		filter.Title = form.Phrase
+		filterColumns = append(filterColumns, "title")
+	}
+
+	setContexts(filter, form.Context)
+	filterColumns = append(filterColumns, contextColumns...)
+
+	if form.Irreversible != nil {
+		filter.Action = gtsmodel.FilterActionWarn
+		if *form.Irreversible {
+			filter.Action = gtsmodel.FilterActionHide
+		}
+		filterColumns = append(filterColumns, "action")
+	}
+
+	if form.ExpiresIn != nil {
+		filter.ExpiresAt = expiresAt(form.ExpiresIn)
+		filterColumns = append(filterColumns, "expires_at")
+	}
+
+	if err := p.db.UpdateFilter(ctx, filter, filterColumns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error updating filter in db: %w", err))
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return p.apiFilterV1(ctx, keyword)
+}
+
+func (p *processor) V1Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	keyword, errWithCode := p.getFilterKeyword(ctx, account.ID, id)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	if len(keyword.Filter.Keywords) <= 1 {
+		// This is the last keyword in the filter,
+		// so just delete the filter along with it.
+		if err := p.db.DeleteFilterByID(ctx, keyword.FilterID); err != nil {
+			return gtserror.NewErrorInternalError(fmt.Errorf("error deleting filter from db: %w", err))
+		}
+	} else if err := p.db.DeleteFilterKeywordByID(ctx, keyword.ID); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("error deleting filter keyword from db: %w", err))
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return nil
+}
+
+func (p *processor) apiFilterV1(ctx context.Context, keyword *gtsmodel.FilterKeyword) (*apimodel.Filter, gtserror.WithCode) {
+	apiFilter, err := p.tc.FilterKeywordToAPIFilterV1(ctx, keyword)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter keyword to v1 api filter: %w", err))
+	}
+
+	return apiFilter, nil
+}
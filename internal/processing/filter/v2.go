@@ -0,0 +1,177 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package filter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) V2GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.FilterV2, gtserror.WithCode) {
+	filters, err := p.db.GetFiltersForAccountID(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting filters: %w", err))
+	}
+
+	apiFilters := make([]*apimodel.FilterV2, 0, len(filters))
+	for _, filter := range filters {
+		apiFilter, errWithCode := p.apiFilterV2(ctx, filter)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiFilters = append(apiFilters, apiFilter)
+	}
+
+	return apiFilters, nil
+}
+
+func (p *processor) V2Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	return p.apiFilterV2(ctx, filter)
+}
+
+func (p *processor) V2Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	filterID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filter := &gtsmodel.Filter{
+		ID:        filterID,
+		ExpiresAt: expiresAt(form.ExpiresIn),
+		AccountID: account.ID,
+		Title:     form.Title,
+		Action:    gtsmodel.FilterAction(form.FilterAction),
+	}
+	setContexts(filter, form.Context)
+
+	if filter.Action == "" {
+		// use mastodon default
+		filter.Action = gtsmodel.FilterActionWarn
+	}
+
+	filter.Keywords = make([]*gtsmodel.FilterKeyword, 0, len(form.Keywords))
+	for _, k := range form.Keywords {
+		keywordID, err := id.NewULID()
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		wholeWord := k.WholeWord != nil && *k.WholeWord
+		filter.Keywords = append(filter.Keywords, &gtsmodel.FilterKeyword{
+			ID:        keywordID,
+			AccountID: account.ID,
+			FilterID:  filterID,
+			Filter:    filter,
+			Keyword:   k.Keyword,
+			WholeWord: &wholeWord,
+		})
+	}
+
+	if err := p.db.PutFilter(ctx, filter); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			// Only keywords can conflict.
+			return nil, putKeywordError(err)
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error inserting filter in db: %w", err))
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return p.apiFilterV2(ctx, filter)
+}
+
+func (p *processor) V2Update(ctx context.Context, account *gtsmodel.Account, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode) {
+	filter, errWithCode := p.getFilter(ctx, account.ID, id)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Only update columns we're told to update.
+	columns := make([]string, 0, 8)
+
+	if form.Title != nil {
+		filter.Title = *form.Title
+		columns = append(columns, "title")
+	}
+
+	if len(form.Context) != 0 {
+		setContexts(filter, form.Context)
+		columns = append(columns, contextColumns...)
+	}
+
+	if form.FilterAction != nil {
+		filter.Action = gtsmodel.FilterAction(*form.FilterAction)
+		columns = append(columns, "action")
+	}
+
+	if form.ExpiresIn != nil {
+		filter.ExpiresAt = expiresAt(form.ExpiresIn)
+		columns = append(columns, "expires_at")
+	}
+
+	if len(columns) == 0 {
+		// Nothing to do.
+		return p.apiFilterV2(ctx, filter)
+	}
+
+	if err := p.db.UpdateFilter(ctx, filter, columns...); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error updating filter in db: %w", err))
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return p.apiFilterV2(ctx, filter)
+}
+
+func (p *processor) V2Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode {
+	// Ensure filter exists + is owned by requesting account.
+	if _, errWithCode := p.getFilter(ctx, account.ID, id); errWithCode != nil {
+		return errWithCode
+	}
+
+	if err := p.db.DeleteFilterByID(ctx, id); err != nil {
+		return gtserror.NewErrorInternalError(fmt.Errorf("error deleting filter from db: %w", err))
+	}
+
+	p.resetTimelines(ctx, account.ID)
+
+	return nil
+}
+
+func (p *processor) apiFilterV2(ctx context.Context, filter *gtsmodel.Filter) (*apimodel.FilterV2, gtserror.WithCode) {
+	apiFilter, err := p.tc.FilterToAPIFilterV2(ctx, filter)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting filter to v2 api filter: %w", err))
+	}
+
+	return apiFilter, nil
+}
@@ -27,6 +27,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) FollowRequestsGet(ctx context.Context, auth *oauth.Auth) ([]apimodel.Account, gtserror.WithCode) {
@@ -84,6 +85,7 @@ func (p *processor) FollowRequestAccept(ctx context.Context, auth *oauth.Auth, a
 		GTSModel:       follow,
 		OriginAccount:  follow.Account,
 		TargetAccount:  follow.TargetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	gtsR, err := p.db.GetRelationship(ctx, auth.Account.ID, accountID)
@@ -127,6 +129,7 @@ func (p *processor) FollowRequestReject(ctx context.Context, auth *oauth.Auth, a
 		GTSModel:       followRequest,
 		OriginAccount:  followRequest.Account,
 		TargetAccount:  followRequest.TargetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	gtsR, err := p.db.GetRelationship(ctx, auth.Account.ID, accountID)
@@ -75,6 +75,9 @@ func (p *processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ActivityBlock:
 			// CREATE BLOCK
 			return p.processCreateBlockFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// CREATE POLL VOTE
+			return p.processCreatePollVoteFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityUpdate:
 		// UPDATE
@@ -82,18 +85,32 @@ func (p *processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		case ap.ObjectProfile, ap.ActorPerson:
 			// UPDATE ACCOUNT/PROFILE
 			return p.processUpdateAccountFromClientAPI(ctx, clientMsg)
+		case ap.ObjectNote:
+			// UPDATE (EDIT) NOTE
+			return p.processUpdateStatusFromClientAPI(ctx, clientMsg)
+		case ap.ActivityQuestion:
+			// UPDATE (CLOSE) POLL
+			return p.processUpdatePollFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityAccept:
 		// ACCEPT
-		if clientMsg.APObjectType == ap.ActivityFollow {
+		switch clientMsg.APObjectType {
+		case ap.ActivityFollow:
 			// ACCEPT FOLLOW
 			return p.processAcceptFollowFromClientAPI(ctx, clientMsg)
+		case ap.ObjectProfile:
+			// ACCEPT ACCOUNT/PROFILE (sign-up)
+			return p.processAcceptAccountFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityReject:
 		// REJECT
-		if clientMsg.APObjectType == ap.ActivityFollow {
+		switch clientMsg.APObjectType {
+		case ap.ActivityFollow:
 			// REJECT FOLLOW (request)
 			return p.processRejectFollowFromClientAPI(ctx, clientMsg)
+		case ap.ObjectProfile:
+			// REJECT ACCOUNT/PROFILE (sign-up)
+			return p.processRejectAccountFromClientAPI(ctx, clientMsg)
 		}
 	case ap.ActivityUndo:
 		// UNDO
@@ -123,9 +140,19 @@ func (p *processor) ProcessFromClientAPI(ctx context.Context, clientMsg messages
 		}
 	case ap.ActivityFlag:
 		// FLAG
-		if clientMsg.APObjectType == ap.ObjectProfile {
+		switch clientMsg.APObjectType {
+		case ap.ObjectProfile:
 			// FLAG/REPORT A PROFILE
 			return p.processReportAccountFromClientAPI(ctx, clientMsg)
+		case ap.ActorPerson:
+			// FLAG ACCOUNT (admin action)
+			return p.processAdminActionAccountFromClientAPI(ctx, clientMsg)
+		}
+	case ap.ActivityMove:
+		// MOVE
+		if clientMsg.APObjectType == ap.ActorPerson {
+			// MOVE ACCOUNT/PROFILE
+			return p.processMoveAccountFromClientAPI(ctx, clientMsg)
 		}
 	}
 	return nil
@@ -148,10 +175,56 @@ func (p *processor) processCreateAccountFromClientAPI(ctx context.Context, clien
 		return err
 	}
 
+	// let the admins and moderators know someone signed up
+	if err := p.notifySignup(ctx, account); err != nil {
+		log.Errorf("processCreateAccountFromClientAPI: error notifying moderators of sign-up: %s", err)
+	}
+
+	if !*user.Approved {
+		// the confirmation will be emailed once a moderator approves the sign-up
+		return nil
+	}
+
 	// email a confirmation to this user
 	return p.userProcessor.SendConfirmEmail(ctx, user, account.Username)
 }
 
+func (p *processor) processAcceptAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	adminAction, ok := clientMsg.GTSModel.(*gtsmodel.AdminAccountAction)
+	if !ok {
+		return errors.New("accept was not parseable as *gtsmodel.AdminAccountAction")
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, clientMsg.TargetAccount.ID)
+	if err != nil {
+		return err
+	}
+
+	// email a confirmation to this user, along with the good news
+	return p.userProcessor.SendApprovedEmail(ctx, user, clientMsg.TargetAccount.Username, adminAction.Text)
+}
+
+func (p *processor) processRejectAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	adminAction, ok := clientMsg.GTSModel.(*gtsmodel.AdminAccountAction)
+	if !ok {
+		return errors.New("reject was not parseable as *gtsmodel.AdminAccountAction")
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, clientMsg.TargetAccount.ID)
+	if err != nil {
+		return err
+	}
+
+	// email the user before deleting them, since we won't know their address afterwards
+	if err := p.userProcessor.SendRejectedEmail(ctx, user, clientMsg.TargetAccount.Username, adminAction.Text); err != nil {
+		log.Errorf("processRejectAccountFromClientAPI: error emailing rejected user: %s", err)
+	}
+
+	// the account was never approved so it can't
+	// have federated anything; just delete it locally
+	return p.accountProcessor.Delete(ctx, clientMsg.TargetAccount, clientMsg.OriginAccount.ID)
+}
+
 func (p *processor) processCreateStatusFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
 	if !ok {
@@ -162,6 +235,10 @@ func (p *processor) processCreateStatusFromClientAPI(ctx context.Context, client
 		return err
 	}
 
+	if err := p.conversationStatus(ctx, status); err != nil {
+		return err
+	}
+
 	if err := p.notifyStatus(ctx, status); err != nil {
 		return err
 	}
@@ -232,15 +309,80 @@ func (p *processor) processCreateBlockFromClientAPI(ctx context.Context, clientM
 	return p.federateBlock(ctx, block)
 }
 
+func (p *processor) processCreatePollVoteFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	vote, ok := clientMsg.GTSModel.(*gtsmodel.PollVote)
+	if !ok {
+		return errors.New("vote was not parseable as *gtsmodel.PollVote")
+	}
+
+	return p.federatePollVote(ctx, vote)
+}
+
+func (p *processor) processUpdateStatusFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("note was not parseable as *gtsmodel.Status")
+	}
+
+	if err := p.timelineStatusUpdate(ctx, status); err != nil {
+		return err
+	}
+
+	// notify any accounts newly mentioned by the edit
+	if err := p.notifyStatus(ctx, status); err != nil {
+		return err
+	}
+
+	return p.federateStatusUpdate(ctx, status)
+}
+
+func (p *processor) processUpdatePollFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	status, ok := clientMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("poll status was not parseable as *gtsmodel.Status")
+	}
+
+	if err := p.notifyPollClosed(ctx, status); err != nil {
+		return err
+	}
+
+	return p.federateStatusUpdate(ctx, status)
+}
+
 func (p *processor) processUpdateAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	account, ok := clientMsg.GTSModel.(*gtsmodel.Account)
 	if !ok {
 		return errors.New("account was not parseable as *gtsmodel.Account")
 	}
 
+	// (re)verify links in profile fields; failure
+	// to do so shouldn't stop the update federating
+	if err := p.accountProcessor.VerifyFields(ctx, account); err != nil {
+		log.Errorf("processUpdateAccountFromClientAPI: error verifying profile fields: %s", err)
+	}
+
 	return p.federateAccountUpdate(ctx, account, clientMsg.OriginAccount)
 }
 
+func (p *processor) processMoveAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	account, ok := clientMsg.GTSModel.(*gtsmodel.Account)
+	if !ok {
+		return errors.New("account was not parseable as *gtsmodel.Account")
+	}
+
+	// federate the profile first so that movedTo
+	// is already set when remotes receive the move
+	if err := p.federateAccountUpdate(ctx, account, clientMsg.OriginAccount); err != nil {
+		return err
+	}
+
+	if err := p.federateAccountMove(ctx, account); err != nil {
+		return err
+	}
+
+	return p.moveFollowers(ctx, account, clientMsg.TargetAccount)
+}
+
 func (p *processor) processAcceptFollowFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	follow, ok := clientMsg.GTSModel.(*gtsmodel.Follow)
 	if !ok {
@@ -337,13 +479,49 @@ func (p *processor) processDeleteAccountFromClientAPI(ctx context.Context, clien
 		origin = clientMsg.OriginAccount.ID
 	}
 
+	if adminAction, ok := clientMsg.GTSModel.(*gtsmodel.AdminAccountAction); ok {
+		// email the user before deleting them, since we won't know their address afterwards
+		if err := p.emailAdminAction(ctx, adminAction, clientMsg.TargetAccount); err != nil {
+			log.Errorf("processDeleteAccountFromClientAPI: error emailing suspended user: %s", err)
+		}
+	}
+
 	if err := p.federateAccountDelete(ctx, clientMsg.TargetAccount); err != nil {
 		return err
 	}
 
+	// the account processor doesn't know about exports, so remove them here
+	if err := p.exportProcessor.DeleteAll(ctx, clientMsg.TargetAccount); err != nil {
+		log.Errorf("processDeleteAccountFromClientAPI: error deleting account exports: %s", err)
+	}
+
 	return p.accountProcessor.Delete(ctx, clientMsg.TargetAccount, origin)
 }
 
+func (p *processor) processAdminActionAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
+	adminAction, ok := clientMsg.GTSModel.(*gtsmodel.AdminAccountAction)
+	if !ok {
+		return errors.New("flag was not parseable as *gtsmodel.AdminAccountAction")
+	}
+
+	if adminAction.Type == gtsmodel.AdminActionDisable {
+		// statuses of disabled users aren't visible anymore,
+		// so take them out of the timelines of local followers
+		follows, err := p.db.GetAccountFollowedBy(ctx, clientMsg.TargetAccount.ID, true)
+		if err != nil {
+			return err
+		}
+
+		for _, follow := range follows {
+			if err := p.statusTimelines.WipeItemsFromAccountID(ctx, follow.AccountID, clientMsg.TargetAccount.ID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return p.emailAdminAction(ctx, adminAction, clientMsg.TargetAccount)
+}
+
 func (p *processor) processReportAccountFromClientAPI(ctx context.Context, clientMsg messages.FromClientAPI) error {
 	report, ok := clientMsg.GTSModel.(*gtsmodel.Report)
 	if !ok {
@@ -439,7 +617,7 @@ func (p *processor) federateStatus(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("federateStatus: error converting status to as format: %s", err)
 	}
 
-	create, err := p.tc.WrapNoteInCreate(asStatus, false)
+	create, err := p.tc.WrapStatusableInCreate(asStatus, false)
 	if err != nil {
 		return fmt.Errorf("federateStatus: error wrapping status in create: %s", err)
 	}
@@ -492,7 +670,9 @@ func (p *processor) federateStatusDelete(ctx context.Context, status *gtsmodel.S
 
 	// Set the status as the 'object' property.
 	deleteObject := streams.NewActivityStreamsObjectProperty()
-	deleteObject.AppendActivityStreamsNote(asStatus)
+	if err := deleteObject.AppendType(asStatus); err != nil {
+		return fmt.Errorf("federateStatusDelete: error setting status as delete object: %s", err)
+	}
 	delete.SetActivityStreamsObject(deleteObject)
 
 	// set the to and cc as the original to/cc of the original status
@@ -503,6 +683,87 @@ func (p *processor) federateStatusDelete(ctx context.Context, status *gtsmodel.S
 	return err
 }
 
+func (p *processor) federatePollVote(ctx context.Context, vote *gtsmodel.PollVote) error {
+	creates, err := p.tc.PollVoteToASCreates(ctx, vote)
+	if err != nil {
+		return fmt.Errorf("federatePollVote: error converting vote to as format: %s", err)
+	}
+
+	// do nothing if the poll is local, the vote has already been counted
+	if vote.Poll.Status.Account.Domain == "" {
+		return nil
+	}
+
+	outboxIRI, err := url.Parse(vote.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federatePollVote: error parsing outboxURI %s: %s", vote.Account.OutboxURI, err)
+	}
+
+	for _, create := range creates {
+		if _, err := p.federator.FederatingActor().Send(ctx, outboxIRI, create); err != nil {
+			return fmt.Errorf("federatePollVote: error sending vote: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func (p *processor) federateStatusUpdate(ctx context.Context, status *gtsmodel.Status) error {
+	// do nothing if the status shouldn't be federated
+	if !*status.Federated {
+		return nil
+	}
+
+	if status.Account == nil {
+		statusAccount, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("federateStatusUpdate: error fetching status author account: %s", err)
+		}
+		status.Account = statusAccount
+	}
+
+	// do nothing if this isn't our status
+	if status.Account.Domain != "" {
+		return nil
+	}
+
+	asStatus, err := p.tc.StatusToAS(ctx, status)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error converting status to as format: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(status.Account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error parsing outboxURI %s: %s", status.Account.OutboxURI, err)
+	}
+
+	actorIRI, err := url.Parse(status.Account.URI)
+	if err != nil {
+		return fmt.Errorf("federateStatusUpdate: error parsing actorIRI %s: %s", status.Account.URI, err)
+	}
+
+	// create an update and set the appropriate actor on it
+	update := streams.NewActivityStreamsUpdate()
+
+	updateActor := streams.NewActivityStreamsActorProperty()
+	updateActor.AppendIRI(actorIRI)
+	update.SetActivityStreamsActor(updateActor)
+
+	// Set the updated status (or question with its final results) as the 'object' property.
+	updateObject := streams.NewActivityStreamsObjectProperty()
+	if err := updateObject.AppendType(asStatus); err != nil {
+		return fmt.Errorf("federateStatusUpdate: error setting status as update object: %s", err)
+	}
+	update.SetActivityStreamsObject(updateObject)
+
+	// set the to and cc as the original to/cc of the original status
+	update.SetActivityStreamsTo(asStatus.GetActivityStreamsTo())
+	update.SetActivityStreamsCc(asStatus.GetActivityStreamsCc())
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, update)
+	return err
+}
+
 func (p *processor) federateFollow(ctx context.Context, followRequest *gtsmodel.FollowRequest, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) error {
 	// if both accounts are local there's nothing to do here
 	if originAccount.Domain == "" && targetAccount.Domain == "" {
@@ -842,6 +1103,21 @@ func (p *processor) federateAccountUpdate(ctx context.Context, updatedAccount *g
 	return err
 }
 
+func (p *processor) federateAccountMove(ctx context.Context, account *gtsmodel.Account) error {
+	move, err := p.tc.AccountToASMove(ctx, account)
+	if err != nil {
+		return fmt.Errorf("federateAccountMove: error converting account to move: %s", err)
+	}
+
+	outboxIRI, err := url.Parse(account.OutboxURI)
+	if err != nil {
+		return fmt.Errorf("federateAccountMove: error parsing outboxURI %s: %s", account.OutboxURI, err)
+	}
+
+	_, err = p.federator.FederatingActor().Send(ctx, outboxIRI, move)
+	return err
+}
+
 func (p *processor) federateBlock(ctx context.Context, block *gtsmodel.Block) error {
 	if block.Account == nil {
 		blockAccount, err := p.db.GetAccountByID(ctx, block.AccountID)
@@ -22,6 +22,7 @@ import (
 	"context"
 	"encoding/json"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
@@ -29,6 +30,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
@@ -115,6 +117,125 @@ func (suite *FromClientAPITestSuite) TestProcessStreamNewStatus() {
 	suite.Empty(irrelevantStream.Messages)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessStreamNewStatusWithFollowedTag() {
+	ctx := context.Background()
+
+	// turtle doesn't follow admin, but follows #welcome,
+	// so a new public status by admin using #welcome
+	// should end up in turtle's home timeline anyway
+	postingAccount := suite.testAccounts["admin_account"]
+	receivingAccount := suite.testAccounts["local_account_2"]
+	followedTag := suite.testTags["welcome"]
+
+	err := suite.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        "01GSGVJ5BTBVGNVB9A0MBSNFJ5",
+		AccountID: receivingAccount.ID,
+		TagID:     followedTag.ID,
+	})
+	suite.NoError(err)
+
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, receivingAccount, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	newStatus := &gtsmodel.Status{
+		ID:                       "01GSGX0ZG9QAZ8ZKYWRRAVDJ0M",
+		URI:                      "http://localhost:8080/users/admin/statuses/01GSGX0ZG9QAZ8ZKYWRRAVDJ0M",
+		URL:                      "http://localhost:8080/@admin/statuses/01GSGX0ZG9QAZ8ZKYWRRAVDJ0M",
+		Content:                  "#welcome to everyone who just joined!",
+		AttachmentIDs:            []string{},
+		TagIDs:                   []string{followedTag.ID},
+		MentionIDs:               []string{},
+		EmojiIDs:                 []string{},
+		CreatedAt:                testrig.TimeMustParse("2023-02-17T11:36:45Z"),
+		UpdatedAt:                testrig.TimeMustParse("2023-02-17T11:36:45Z"),
+		Local:                    testrig.TrueBool(),
+		AccountURI:               "http://localhost:8080/users/admin",
+		AccountID:                postingAccount.ID,
+		Visibility:               gtsmodel.VisibilityPublic,
+		Sensitive:                testrig.FalseBool(),
+		Language:                 "en",
+		CreatedWithApplicationID: "01F8MGXQRHYF5QPMTMXP78QC2F",
+		Pinned:                   testrig.FalseBool(),
+		Federated:                testrig.TrueBool(),
+		Boostable:                testrig.TrueBool(),
+		Replyable:                testrig.TrueBool(),
+		Likeable:                 testrig.TrueBool(),
+		ActivityStreamsType:      ap.ObjectNote,
+	}
+
+	err = suite.db.PutStatus(ctx, newStatus)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+
+	// turtle's stream should have the newly created status in it now
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeUpdate, msg.Event)
+	statusStreamed := &apimodel.Status{}
+	err = json.Unmarshal([]byte(msg.Payload), statusStreamed)
+	suite.NoError(err)
+	suite.Equal(newStatus.ID, statusStreamed.ID)
+
+	// and it should be in turtle's home timeline
+	resp, errWithCode := suite.processor.HomeTimelineGet(ctx, &oauth.Auth{Account: receivingAccount}, "", "", "", 20, false)
+	suite.NoError(errWithCode)
+	suite.Equal(newStatus.ID, resp.Items[0].(*apimodel.Status).ID)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessStreamStatusEdit() {
+	ctx := context.Background()
+
+	// admin edits one of their statuses: the edit should be streamed
+	// to the home timeline of any account that follows admin
+	editingAccount := suite.testAccounts["admin_account"]
+	receivingAccount := suite.testAccounts["local_account_1"]
+
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, receivingAccount, stream.TimelineHome)
+	suite.NoError(errWithCode)
+
+	editedStatus := &gtsmodel.Status{}
+	*editedStatus = *suite.testStatuses["admin_account_status_1"]
+
+	edit, err := suite.typeconverter.StatusToStatusEdit(ctx, editedStatus)
+	suite.NoError(err)
+
+	editedStatus.Content = "this edit should stream :)"
+	editedStatus.Text = "this edit should stream :)"
+	editedStatus.EditedAt = time.Now()
+
+	// store the edit first, to mimic what would have already happened earlier up the flow
+	err = suite.db.EditStatus(ctx, editedStatus, edit)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       editedStatus,
+		OriginAccount:  editingAccount,
+	})
+	suite.NoError(err)
+
+	// zork's stream should have the edited status in it now
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeStatusUpdate, msg.Event)
+	suite.EqualValues([]string{stream.TimelineHome}, msg.Stream)
+	statusStreamed := &apimodel.Status{}
+	err = json.Unmarshal([]byte(msg.Payload), statusStreamed)
+	suite.NoError(err)
+	suite.Equal(editedStatus.ID, statusStreamed.ID)
+	suite.Equal("this edit should stream :)", statusStreamed.Content)
+	suite.NotNil(statusStreamed.EditedAt)
+
+	// and stream should now be empty
+	suite.Empty(wssStream.Messages)
+}
+
 func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	ctx := context.Background()
 
@@ -161,6 +282,303 @@ func (suite *FromClientAPITestSuite) TestProcessStatusDelete() {
 	suite.ErrorIs(err, db.ErrNoEntries)
 }
 
+func (suite *FromClientAPITestSuite) TestProcessDirectReplyConversation() {
+	ctx := context.Background()
+
+	// zork replies directly to turtle's direct message
+	postingAccount := suite.testAccounts["local_account_1"]
+	receivingAccount := suite.testAccounts["local_account_2"]
+	dmStatus := suite.testStatuses["local_account_2_status_6"]
+
+	// open direct streams for both of them
+	postingStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, postingAccount, stream.TimelineDirect)
+	suite.NoError(errWithCode)
+	receivingStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, receivingAccount, stream.TimelineDirect)
+	suite.NoError(errWithCode)
+
+	mention := &gtsmodel.Mention{
+		ID:               "01GSN8BBJ6A1TDYS5YQY5R9KFF",
+		StatusID:         "01GSN8AYDEVCB10QWRSZQFXD6E",
+		OriginAccountID:  postingAccount.ID,
+		OriginAccountURI: postingAccount.URI,
+		TargetAccountID:  receivingAccount.ID,
+		NameString:       "@1happyturtle",
+		TargetAccountURI: receivingAccount.URI,
+		TargetAccountURL: receivingAccount.URL,
+	}
+	suite.NoError(suite.db.Put(ctx, mention))
+
+	newStatus := &gtsmodel.Status{
+		ID:                       "01GSN8AYDEVCB10QWRSZQFXD6E",
+		URI:                      "http://localhost:8080/users/the_mighty_zork/statuses/01GSN8AYDEVCB10QWRSZQFXD6E",
+		URL:                      "http://localhost:8080/@the_mighty_zork/statuses/01GSN8AYDEVCB10QWRSZQFXD6E",
+		Content:                  "@1happyturtle hi turtle, shhhhhh yourself!",
+		AttachmentIDs:            []string{},
+		TagIDs:                   []string{},
+		MentionIDs:               []string{mention.ID},
+		EmojiIDs:                 []string{},
+		CreatedAt:                testrig.TimeMustParse("2023-02-20T11:36:45Z"),
+		UpdatedAt:                testrig.TimeMustParse("2023-02-20T11:36:45Z"),
+		Local:                    testrig.TrueBool(),
+		AccountURI:               postingAccount.URI,
+		AccountID:                postingAccount.ID,
+		InReplyToID:              dmStatus.ID,
+		InReplyToAccountID:       receivingAccount.ID,
+		InReplyToURI:             dmStatus.URI,
+		Visibility:               gtsmodel.VisibilityDirect,
+		Sensitive:                testrig.FalseBool(),
+		Language:                 "en",
+		CreatedWithApplicationID: "01F8MGY43H3N2C8EWPR2FPYEXG",
+		Pinned:                   testrig.FalseBool(),
+		Federated:                testrig.FalseBool(),
+		Boostable:                testrig.FalseBool(),
+		Replyable:                testrig.TrueBool(),
+		Likeable:                 testrig.TrueBool(),
+		ActivityStreamsType:      ap.ObjectNote,
+	}
+	suite.NoError(suite.db.PutStatus(ctx, newStatus))
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectNote,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newStatus,
+		OriginAccount:  postingAccount,
+	})
+	suite.NoError(err)
+
+	// turtle should have the updated conversation streamed, unread
+	msg := <-receivingStream.Messages
+	suite.Equal(stream.EventTypeConversation, msg.Event)
+	suite.EqualValues([]string{stream.TimelineDirect}, msg.Stream)
+	conversation := &apimodel.Conversation{}
+	suite.NoError(json.Unmarshal([]byte(msg.Payload), conversation))
+	suite.Equal(suite.testConversations["local_account_2_conversation_1"].ID, conversation.ID)
+	suite.True(conversation.Unread)
+	suite.Equal(newStatus.ID, conversation.LastStatus.ID)
+	suite.Len(conversation.Accounts, 1)
+	suite.Equal(postingAccount.ID, conversation.Accounts[0].ID)
+
+	// zork too, but zork has obviously read their own reply
+	msg = <-postingStream.Messages
+	suite.Equal(stream.EventTypeConversation, msg.Event)
+	conversation = &apimodel.Conversation{}
+	suite.NoError(json.Unmarshal([]byte(msg.Payload), conversation))
+	suite.Equal(suite.testConversations["local_account_1_conversation_1"].ID, conversation.ID)
+	suite.False(conversation.Unread)
+	suite.Equal(newStatus.ID, conversation.LastStatus.ID)
+
+	suite.Empty(receivingStream.Messages)
+	suite.Empty(postingStream.Messages)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessPollClosed() {
+	ctx := context.Background()
+
+	pollingAccount := suite.testAccounts["local_account_2"]
+	votingAccount := suite.testAccounts["admin_account"]
+
+	status, err := suite.db.GetStatusByID(ctx, suite.testStatuses["local_account_2_status_7"].ID)
+	suite.NoError(err)
+	suite.NotNil(status.Poll)
+
+	// close the poll in the db first, to mimic what would have already happened earlier up the flow
+	status.Poll.ClosedAt = testrig.TimeMustParse("2022-10-20T11:36:45Z")
+	err = suite.db.UpdatePoll(ctx, status.Poll, "closed_at")
+	suite.NoError(err)
+
+	// open a notifications stream for the voter
+	wssStream, errWithCode := suite.processor.OpenStreamForAccount(ctx, votingAccount, stream.TimelineNotifications)
+	suite.NoError(errWithCode)
+
+	// process the poll closing
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityQuestion,
+		APActivityType: ap.ActivityUpdate,
+		GTSModel:       status,
+		OriginAccount:  pollingAccount,
+	})
+	suite.NoError(err)
+
+	// the voter's stream should have the poll notification in it now
+	msg := <-wssStream.Messages
+	suite.Equal(stream.EventTypeNotification, msg.Event)
+	notifStreamed := &apimodel.Notification{}
+	err = json.Unmarshal([]byte(msg.Payload), notifStreamed)
+	suite.NoError(err)
+	suite.Equal("poll", notifStreamed.Type)
+	suite.Equal(pollingAccount.ID, notifStreamed.Account.ID)
+	suite.Equal(status.ID, notifStreamed.Status.ID)
+	suite.True(notifStreamed.Status.Poll.Expired)
+
+	// the author of the poll should have been notified too
+	notifs, err := suite.db.GetNotifications(ctx, pollingAccount.ID, nil, 0, "", "")
+	suite.NoError(err)
+
+	var pollNotifs int
+	for _, notif := range notifs {
+		if notif.NotificationType == gtsmodel.NotificationPoll {
+			pollNotifs++
+		}
+	}
+	suite.Equal(1, pollNotifs)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFaveMutedNotifications() {
+	ctx := context.Background()
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	favingAccount := suite.testAccounts["local_account_2"]
+	favedStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// zork mutes turtle, hiding notifications too
+	_, errWithCode := suite.processor.AccountMuteCreate(ctx, &oauth.Auth{Account: receivingAccount}, &apimodel.AccountMuteRequest{
+		ID: favingAccount.ID,
+	})
+	suite.NoError(errWithCode)
+
+	// turtle faves one of zork's statuses
+	fave := &gtsmodel.StatusFave{
+		ID:              "01GSZ8K6XHT3Q0V9JB2R5N7MWC",
+		URI:             "http://localhost:8080/users/1happyturtle/liked/01GSZ8K6XHT3Q0V9JB2R5N7MWC",
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: receivingAccount.ID,
+		TargetAccount:   receivingAccount,
+		StatusID:        favedStatus.ID,
+	}
+	err := suite.db.Put(ctx, fave)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		OriginAccount:  favingAccount,
+		TargetAccount:  receivingAccount,
+	})
+	suite.NoError(err)
+
+	// zork shouldn't have been notified
+	notifs, err := suite.db.GetNotifications(ctx, receivingAccount.ID, nil, 0, "", "")
+	suite.NoError(err)
+	for _, notif := range notifs {
+		suite.False(notif.NotificationType == gtsmodel.NotificationFave && notif.OriginAccountID == favingAccount.ID && notif.StatusID == favedStatus.ID)
+	}
+	// or pushed to
+	suite.Empty(suite.sentPushes)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFaveMutedThread() {
+	ctx := context.Background()
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	favingAccount := suite.testAccounts["local_account_2"]
+	favedStatus := suite.testStatuses["local_account_1_status_1"]
+
+	// zork mutes the thread
+	_, errWithCode := suite.processor.StatusMute(ctx, &oauth.Auth{Account: receivingAccount}, favedStatus.ID)
+	suite.NoError(errWithCode)
+
+	// turtle faves the muted status
+	fave := &gtsmodel.StatusFave{
+		ID:              "01GSZ8K6XHT3Q0V9JB2R5N7MWC",
+		URI:             "http://localhost:8080/users/1happyturtle/liked/01GSZ8K6XHT3Q0V9JB2R5N7MWC",
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: receivingAccount.ID,
+		TargetAccount:   receivingAccount,
+		StatusID:        favedStatus.ID,
+	}
+	err := suite.db.Put(ctx, fave)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		OriginAccount:  favingAccount,
+		TargetAccount:  receivingAccount,
+	})
+	suite.NoError(err)
+
+	// zork shouldn't have been notified
+	notifs, err := suite.db.GetNotifications(ctx, receivingAccount.ID, nil, 0, "", "")
+	suite.NoError(err)
+	for _, notif := range notifs {
+		suite.False(notif.NotificationType == gtsmodel.NotificationFave && notif.OriginAccountID == favingAccount.ID && notif.StatusID == favedStatus.ID)
+	}
+}
+
+func (suite *FromClientAPITestSuite) TestProcessFaveWebPush() {
+	ctx := context.Background()
+
+	receivingAccount := suite.testAccounts["local_account_1"]
+	favingAccount := suite.testAccounts["local_account_2"]
+	favedStatus := suite.testStatuses["local_account_1_status_1"]
+	subscription := testrig.NewTestWebPushSubscriptions()["local_account_1_token_1"]
+
+	// turtle faves one of zork's statuses
+	fave := &gtsmodel.StatusFave{
+		ID:              "01GSZ8K6XHT3Q0V9JB2R5N7MWC",
+		URI:             "http://localhost:8080/users/1happyturtle/liked/01GSZ8K6XHT3Q0V9JB2R5N7MWC",
+		AccountID:       favingAccount.ID,
+		Account:         favingAccount,
+		TargetAccountID: receivingAccount.ID,
+		TargetAccount:   receivingAccount,
+		StatusID:        favedStatus.ID,
+	}
+	err := suite.db.Put(ctx, fave)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ActivityLike,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       fave,
+		OriginAccount:  favingAccount,
+		TargetAccount:  receivingAccount,
+	})
+	suite.NoError(err)
+
+	// zork's web push subscription wants fave alerts, so should have got a push
+	suite.Len(suite.sentPushes, 1)
+	payload, ok := suite.sentPushes[subscription.ID]
+	suite.True(ok)
+
+	push := &apimodel.WebPushNotification{}
+	suite.NoError(json.Unmarshal([]byte(payload), push))
+	suite.Equal(suite.testTokens["local_account_1"].Access, push.AccessToken)
+	suite.Equal("favourite", push.NotificationType)
+	suite.Equal("happy little turtle :3 favourited your post", push.Title)
+	suite.NotEmpty(push.NotificationID)
+}
+
+func (suite *FromClientAPITestSuite) TestProcessCreatePendingAccount() {
+	ctx := context.Background()
+
+	newAccount := suite.testAccounts["unconfirmed_account"]
+	adminAccount := suite.testAccounts["admin_account"]
+
+	err := suite.processor.ProcessFromClientAPI(ctx, messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       newAccount,
+		OriginAccount:  newAccount,
+	})
+	suite.NoError(err)
+
+	// the admin should have been notified of the sign-up
+	notif := &gtsmodel.Notification{}
+	err = suite.db.GetWhere(ctx, []db.Where{
+		{Key: "notification_type", Value: gtsmodel.NotificationSignup},
+		{Key: "target_account_id", Value: adminAccount.ID},
+		{Key: "origin_account_id", Value: newAccount.ID},
+	}, notif)
+	suite.NoError(err)
+
+	// the sign-up is awaiting approval, so no confirmation email yet
+	suite.Empty(suite.sentEmails)
+}
+
 func TestFromClientAPITestSuite(t *testing.T) {
 	suite.Run(t, &FromClientAPITestSuite{})
 }
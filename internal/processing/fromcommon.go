@@ -20,13 +20,17 @@ package processing
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
 
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 )
 
@@ -75,6 +79,15 @@ func (p *processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			return fmt.Errorf("notifyStatus: error checking existence of notification for mention with id %s : %s", m.ID, err)
 		}
 
+		// make sure the mentioned account hasn't muted the author or the thread
+		muted, err := p.notificationFiltered(ctx, m.TargetAccountID, status.AccountID, status)
+		if err != nil {
+			return fmt.Errorf("notifyStatus: error checking mutes for mention with id %s: %s", m.ID, err)
+		}
+		if muted {
+			continue
+		}
+
 		// if we've reached this point we know the mention is for a local account, and the notification doesn't exist, so create it
 		notifID, err := id.NewULID()
 		if err != nil {
@@ -102,9 +115,28 @@ func (p *processor) notifyStatus(ctx context.Context, status *gtsmodel.Status) e
 			return fmt.Errorf("notifyStatus: error converting notification to api representation: %s", err)
 		}
 
+		// check whether the mentioned account has
+		// filtered this status out of notifications
+		filterResults, hide, err := p.statusFilter.StatusFilterResults(ctx, status, m.TargetAccount, gtsmodel.FilterContextNotifications)
+		if err != nil {
+			return fmt.Errorf("notifyStatus: error filtering status: %s", err)
+		}
+
+		if hide {
+			// notification is stored but not streamed
+			continue
+		}
+
+		if apiNotif.Status != nil {
+			apiNotif.Status.Filtered = filterResults
+		}
+
 		if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, m.TargetAccount); err != nil {
 			return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 		}
+
+		// and push it to any web push subscriptions
+		p.pushNotification(ctx, notif, apiNotif)
 	}
 
 	return nil
@@ -127,6 +159,12 @@ func (p *processor) notifyFollowRequest(ctx context.Context, followRequest *gtsm
 		return nil
 	}
 
+	if muted, err := p.notificationMuted(ctx, followRequest.TargetAccountID, followRequest.AccountID, nil); err != nil {
+		return fmt.Errorf("notifyFollowRequest: error checking mutes: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	notifID, err := id.NewULID()
 	if err != nil {
 		return err
@@ -153,9 +191,80 @@ func (p *processor) notifyFollowRequest(ctx context.Context, followRequest *gtsm
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	// and push it to any web push subscriptions
+	p.pushNotification(ctx, notif, apiNotif)
+
+	return nil
+}
+
+// notifySignup notifies all admins and moderators of
+// the instance that the given local account has signed up.
+func (p *processor) notifySignup(ctx context.Context, account *gtsmodel.Account) error {
+	moderators, err := p.db.GetModeratorUsers(ctx)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// nobody to notify
+			return nil
+		}
+		return fmt.Errorf("notifySignup: error getting moderators: %w", err)
+	}
+
+	for _, moderator := range moderators {
+		if moderator.AccountID == account.ID {
+			// don't notify an account of its own sign-up
+			continue
+		}
+
+		targetAccount, err := p.db.GetAccountByID(ctx, moderator.AccountID)
+		if err != nil {
+			return fmt.Errorf("notifySignup: error getting account of moderator %s: %w", moderator.ID, err)
+		}
+
+		notifID, err := id.NewULID()
+		if err != nil {
+			return err
+		}
+
+		notif := &gtsmodel.Notification{
+			ID:               notifID,
+			NotificationType: gtsmodel.NotificationSignup,
+			TargetAccountID:  targetAccount.ID,
+			OriginAccountID:  account.ID,
+		}
+
+		if err := p.db.Put(ctx, notif); err != nil {
+			return fmt.Errorf("notifySignup: error putting notification in database: %w", err)
+		}
+
+		// now stream the notification to the moderator
+		apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
+		if err != nil {
+			return fmt.Errorf("notifySignup: error converting notification to api representation: %w", err)
+		}
+
+		if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
+			return fmt.Errorf("notifySignup: error streaming notification to account: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// emailAdminAction emails the owner of the given local account
+// about the given admin action, if the admin asked for that.
+func (p *processor) emailAdminAction(ctx context.Context, adminAction *gtsmodel.AdminAccountAction, account *gtsmodel.Account) error {
+	if !adminAction.SendEmail || account.Domain != "" {
+		return nil
+	}
+
+	user, err := p.db.GetUserByAccountID(ctx, account.ID)
+	if err != nil {
+		return err
+	}
+
+	return p.userProcessor.SendModerationEmail(ctx, user, account.Username, adminAction.Type, adminAction.Text)
+}
+
 func (p *processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, targetAccount *gtsmodel.Account) error {
 	// return if this isn't a local account
 	if targetAccount.Domain != "" {
@@ -171,6 +280,12 @@ func (p *processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, t
 		return fmt.Errorf("notifyFollow: error removing old follow request notification from database: %s", err)
 	}
 
+	if muted, err := p.notificationFiltered(ctx, follow.TargetAccountID, follow.AccountID, nil); err != nil {
+		return fmt.Errorf("notifyFollow: error checking mutes: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	// now create the new follow notification
 	notifID, err := id.NewULID()
 	if err != nil {
@@ -199,6 +314,91 @@ func (p *processor) notifyFollow(ctx context.Context, follow *gtsmodel.Follow, t
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	// and push it to any web push subscriptions
+	p.pushNotification(ctx, notif, apiNotif)
+
+	return nil
+}
+
+// notifyPollClosed notifies all local voters in the given poll, plus the
+// poll author if they're local, that the poll has closed.
+func (p *processor) notifyPollClosed(ctx context.Context, status *gtsmodel.Status) error {
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return err
+		}
+		status.Account = a
+	}
+
+	votes, err := p.db.GetPollVotes(ctx, status.PollID)
+	if err != nil {
+		return fmt.Errorf("notifyPollClosed: error getting poll votes from database: %s", err)
+	}
+
+	targetAccounts := make([]*gtsmodel.Account, 0, len(votes)+1)
+	if status.Account.Domain == "" {
+		targetAccounts = append(targetAccounts, status.Account)
+	}
+
+	for _, vote := range votes {
+		if vote.Account == nil {
+			a, err := p.db.GetAccountByID(ctx, vote.AccountID)
+			if err != nil {
+				return err
+			}
+			vote.Account = a
+		}
+
+		// only local voters can be notified
+		if vote.Account.Domain == "" {
+			targetAccounts = append(targetAccounts, vote.Account)
+		}
+	}
+
+	for _, targetAccount := range targetAccounts {
+		muted, err := p.notificationFiltered(ctx, targetAccount.ID, status.AccountID, status)
+		if err != nil {
+			return fmt.Errorf("notifyPollClosed: error checking mutes: %s", err)
+		}
+		if muted {
+			continue
+		}
+
+		notifID, err := id.NewULID()
+		if err != nil {
+			return err
+		}
+
+		notif := &gtsmodel.Notification{
+			ID:               notifID,
+			NotificationType: gtsmodel.NotificationPoll,
+			TargetAccountID:  targetAccount.ID,
+			TargetAccount:    targetAccount,
+			OriginAccountID:  status.AccountID,
+			OriginAccount:    status.Account,
+			StatusID:         status.ID,
+			Status:           status,
+		}
+
+		if err := p.db.Put(ctx, notif); err != nil {
+			return fmt.Errorf("notifyPollClosed: error putting notification in database: %s", err)
+		}
+
+		// now stream the notification to the user
+		apiNotif, err := p.tc.NotificationToAPINotification(ctx, notif)
+		if err != nil {
+			return fmt.Errorf("notifyPollClosed: error converting notification to api representation: %s", err)
+		}
+
+		if err := p.streamingProcessor.StreamNotificationToAccount(apiNotif, targetAccount); err != nil {
+			return fmt.Errorf("notifyPollClosed: error streaming notification to account: %s", err)
+		}
+
+		// and push it to any web push subscriptions
+		p.pushNotification(ctx, notif, apiNotif)
+	}
+
 	return nil
 }
 
@@ -222,6 +422,20 @@ func (p *processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return nil
 	}
 
+	if fave.Status == nil {
+		s, err := p.db.GetStatusByID(ctx, fave.StatusID)
+		if err != nil {
+			return err
+		}
+		fave.Status = s
+	}
+
+	if muted, err := p.notificationFiltered(ctx, fave.TargetAccountID, fave.AccountID, fave.Status); err != nil {
+		return fmt.Errorf("notifyFave: error checking mutes: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	notifID, err := id.NewULID()
 	if err != nil {
 		return err
@@ -252,6 +466,9 @@ func (p *processor) notifyFave(ctx context.Context, fave *gtsmodel.StatusFave) e
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	// and push it to any web push subscriptions
+	p.pushNotification(ctx, notif, apiNotif)
+
 	return nil
 }
 
@@ -300,6 +517,12 @@ func (p *processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return nil
 	}
 
+	if muted, err := p.notificationFiltered(ctx, status.BoostOfAccountID, status.AccountID, status.BoostOf); err != nil {
+		return fmt.Errorf("notifyAnnounce: error checking mutes: %s", err)
+	} else if muted {
+		return nil
+	}
+
 	// now create the new reblog notification
 	notifID, err := id.NewULID()
 	if err != nil {
@@ -331,11 +554,86 @@ func (p *processor) notifyAnnounce(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("notifyStatus: error streaming notification to account: %s", err)
 	}
 
+	// and push it to any web push subscriptions
+	p.pushNotification(ctx, notif, apiNotif)
+
 	return nil
 }
 
+// notificationMuted returns true if the target account shouldn't be notified
+// about something done by the origin account, because the target has muted
+// notifications from the origin account, or muted the thread of the given
+// status. The status is optional, and can be nil.
+func (p *processor) notificationMuted(ctx context.Context, targetAccountID string, originAccountID string, status *gtsmodel.Status) (bool, error) {
+	if targetAccountID != originAccountID {
+		mute, err := p.db.GetMute(ctx, targetAccountID, originAccountID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return false, err
+		}
+
+		if mute != nil && mute.Notifications != nil && *mute.Notifications {
+			return true, nil
+		}
+	}
+
+	if status == nil {
+		return false, nil
+	}
+
+	return p.db.IsStatusMutedBy(ctx, status, targetAccountID)
+}
+
+// notificationFiltered returns true if the target account shouldn't be notified
+// about something done by the origin account, either because the target has
+// muted it (see notificationMuted), or because an admin has silenced the origin
+// account and the target doesn't follow it. The status is optional, and can be nil.
+//
+// Follow requests aren't filtered for silences, since those are how
+// silenced accounts get to be followed by anyone at all.
+func (p *processor) notificationFiltered(ctx context.Context, targetAccountID string, originAccountID string, status *gtsmodel.Status) (bool, error) {
+	if muted, err := p.notificationMuted(ctx, targetAccountID, originAccountID, status); err != nil || muted {
+		return muted, err
+	}
+
+	if targetAccountID == originAccountID {
+		return false, nil
+	}
+
+	originAccount, err := p.db.GetAccountByID(ctx, originAccountID)
+	if err != nil {
+		return false, err
+	}
+
+	if originAccount.SilencedAt.IsZero() {
+		return false, nil
+	}
+
+	targetAccount, err := p.db.GetAccountByID(ctx, targetAccountID)
+	if err != nil {
+		return false, err
+	}
+
+	following, err := p.db.IsFollowing(ctx, targetAccount, originAccount)
+	if err != nil {
+		return false, err
+	}
+
+	return !following, nil
+}
+
+// pushNotification sends the given notification to the Web Push
+// subscriptions of its target account. Failing to deliver a push
+// shouldn't stop the notification from being processed, so errors
+// are just logged.
+func (p *processor) pushNotification(ctx context.Context, notif *gtsmodel.Notification, apiNotif *apimodel.Notification) {
+	if err := p.webPushSender.Send(ctx, notif, apiNotif); err != nil {
+		log.Errorf("pushNotification: error sending web push for notification %s: %s", notif.ID, err)
+	}
+}
+
 // timelineStatus processes the given new status and inserts it into
-// the HOME timelines of accounts that follow the status author.
+// the HOME timelines of accounts that follow the status author, or
+// that follow one of the tags used in the status.
 func (p *processor) timelineStatus(ctx context.Context, status *gtsmodel.Status) error {
 	// make sure the author account is pinned onto the status
 	if status.Account == nil {
@@ -360,12 +658,40 @@ func (p *processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 		})
 	}
 
+	// deduplicate the accounts whose home timelines we'll try to put the status in
+	timelineAccountIDs := make([]string, 0, len(follows))
+	seen := make(map[string]struct{}, len(follows))
+	for _, f := range follows {
+		if _, ok := seen[f.AccountID]; ok {
+			continue
+		}
+		seen[f.AccountID] = struct{}{}
+		timelineAccountIDs = append(timelineAccountIDs, f.AccountID)
+	}
+
+	// local accounts that follow a tag used in a public status
+	// should see it too, even if they don't follow the author
+	if status.Visibility == gtsmodel.VisibilityPublic && status.BoostOfID == "" && len(status.TagIDs) != 0 {
+		tagFollowerIDs, err := p.db.GetAccountIDsFollowingTagIDs(ctx, status.TagIDs)
+		if err != nil {
+			return fmt.Errorf("timelineStatus: error getting tag followers for status %s: %s", status.ID, err)
+		}
+
+		for _, accountID := range tagFollowerIDs {
+			if _, ok := seen[accountID]; ok {
+				continue
+			}
+			seen[accountID] = struct{}{}
+			timelineAccountIDs = append(timelineAccountIDs, accountID)
+		}
+	}
+
 	wg := sync.WaitGroup{}
-	wg.Add(len(follows))
-	errors := make(chan error, len(follows))
+	wg.Add(len(timelineAccountIDs))
+	errors := make(chan error, len(timelineAccountIDs))
 
-	for _, f := range follows {
-		go p.timelineStatusForAccount(ctx, status, f.AccountID, errors, &wg)
+	for _, accountID := range timelineAccountIDs {
+		go p.timelineStatusForAccount(ctx, status, accountID, errors, &wg)
 	}
 
 	// read any errors that come in from the async functions
@@ -387,6 +713,50 @@ func (p *processor) timelineStatus(ctx context.Context, status *gtsmodel.Status)
 		return fmt.Errorf("timelineStatus: one or more errors timelining statuses: %s", strings.Join(errs, ";"))
 	}
 
+	return p.timelineStatusForLists(ctx, status, follows)
+}
+
+// timelineStatusForLists puts the given status in the LIST timelines
+// of any lists that contain one of the given follows, if it's listable.
+func (p *processor) timelineStatusForLists(ctx context.Context, status *gtsmodel.Status, follows []*gtsmodel.Follow) error {
+	listFilter := ListFilterFunction(p.db, p.filter)
+	errs := []string{}
+
+	for _, f := range follows {
+		if f.ID == "" {
+			// fake entry for the status author, can't be in a list
+			continue
+		}
+
+		listEntries, err := p.db.GetListEntriesForFollowID(ctx, f.ID)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error getting list entries for follow %s: %s", f.ID, err))
+			continue
+		}
+
+		for _, listEntry := range listEntries {
+			// make sure the status is listable; this covers
+			// visibility as well as the list's replies policy
+			listable, err := listFilter(ctx, listEntry.ListID, status)
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("error getting listability for status %s for list %s: %s", status.ID, listEntry.ListID, err))
+				continue
+			}
+
+			if !listable {
+				continue
+			}
+
+			if _, err := p.listTimelines.IngestAndPrepare(ctx, status, listEntry.ListID); err != nil {
+				errs = append(errs, fmt.Sprintf("error ingesting status %s into list %s: %s", status.ID, listEntry.ListID, err))
+			}
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("timelineStatusForLists: one or more errors timelining statuses: %s", strings.Join(errs, ";"))
+	}
+
 	return nil
 }
 
@@ -425,11 +795,20 @@ func (p *processor) timelineStatusForAccount(ctx context.Context, status *gtsmod
 
 	// the status was inserted so stream it to the user
 	if inserted {
+		// statuses which should be hidden by the user's filters won't
+		// have been inserted, but we still need to mark warned ones
+		filterResults, _, err := p.statusFilter.StatusFilterResults(ctx, status, timelineAccount, gtsmodel.FilterContextHome)
+		if err != nil {
+			errors <- fmt.Errorf("timelineStatusForAccount: error filtering status %s: %s", status.ID, err)
+			return
+		}
+
 		apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, timelineAccount)
 		if err != nil {
 			errors <- fmt.Errorf("timelineStatusForAccount: error converting status %s to frontend representation: %s", status.ID, err)
 			return
 		}
+		apiStatus.Filtered = filterResults
 
 		if err := p.streamingProcessor.StreamUpdateToAccount(apiStatus, timelineAccount, stream.TimelineHome); err != nil {
 			errors <- fmt.Errorf("timelineStatusForAccount: error streaming status %s: %s", status.ID, err)
@@ -437,6 +816,276 @@ func (p *processor) timelineStatusForAccount(ctx context.Context, status *gtsmod
 	}
 }
 
+// timelineStatusUpdate refreshes the given (edited) status in all timelines
+// it's already prepared in, and streams the new version of the status to
+// the home timeline streams of any local followers who can see it.
+func (p *processor) timelineStatusUpdate(ctx context.Context, status *gtsmodel.Status) error {
+	if err := p.statusTimelines.RefreshItemInAllTimelines(ctx, status.ID); err != nil {
+		return fmt.Errorf("timelineStatusUpdate: error refreshing status %s in status timelines: %s", status.ID, err)
+	}
+
+	if err := p.listTimelines.RefreshItemInAllTimelines(ctx, status.ID); err != nil {
+		return fmt.Errorf("timelineStatusUpdate: error refreshing status %s in list timelines: %s", status.ID, err)
+	}
+
+	// make sure the author account is pinned onto the status
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("timelineStatusUpdate: error getting author account with id %s: %s", status.AccountID, err)
+		}
+		status.Account = a
+	}
+
+	// get local followers of the account that posted the status
+	follows, err := p.db.GetAccountFollowedBy(ctx, status.AccountID, true)
+	if err != nil {
+		return fmt.Errorf("timelineStatusUpdate: error getting followers for account id %s: %s", status.AccountID, err)
+	}
+
+	// if the poster is local, add a fake entry for them so they see their own edit
+	if status.Account.Domain == "" {
+		follows = append(follows, &gtsmodel.Follow{
+			AccountID: status.AccountID,
+			Account:   status.Account,
+		})
+	}
+
+	errs := []string{}
+	for _, f := range follows {
+		timelineAccount, err := p.db.GetAccountByID(ctx, f.AccountID)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error getting account for timeline with id %s: %s", f.AccountID, err))
+			continue
+		}
+
+		timelineable, err := p.filter.StatusHometimelineable(ctx, status, timelineAccount)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error getting timelineability for status for timeline with id %s: %s", f.AccountID, err))
+			continue
+		}
+
+		if !timelineable {
+			continue
+		}
+
+		filterResults, hide, err := p.statusFilter.StatusFilterResults(ctx, status, timelineAccount, gtsmodel.FilterContextHome)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error filtering status %s: %s", status.ID, err))
+			continue
+		}
+
+		if hide {
+			continue
+		}
+
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, status, timelineAccount)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("error converting status %s to frontend representation: %s", status.ID, err))
+			continue
+		}
+		apiStatus.Filtered = filterResults
+
+		if err := p.streamingProcessor.StreamStatusUpdateToAccount(apiStatus, timelineAccount, stream.TimelineHome); err != nil {
+			errs = append(errs, fmt.Sprintf("error streaming status %s: %s", status.ID, err))
+		}
+	}
+
+	if len(errs) != 0 {
+		return fmt.Errorf("timelineStatusUpdate: one or more errors streaming status update: %s", strings.Join(errs, ";"))
+	}
+
+	return nil
+}
+
+// conversationStatus adds the given direct status to the conversations of any
+// local accounts participating in it, creating conversations where necessary,
+// and streams the updated conversations to those accounts.
+func (p *processor) conversationStatus(ctx context.Context, status *gtsmodel.Status) error {
+	if status.Visibility != gtsmodel.VisibilityDirect {
+		// only direct messages are part of conversations
+		return nil
+	}
+
+	// make sure the author account is pinned onto the status
+	if status.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, status.AccountID)
+		if err != nil {
+			return fmt.Errorf("conversationStatus: error getting author account with id %s: %s", status.AccountID, err)
+		}
+		status.Account = a
+	}
+
+	if status.Mentions == nil && len(status.MentionIDs) != 0 {
+		menchies, err := p.db.GetMentions(ctx, status.MentionIDs)
+		if err != nil {
+			return fmt.Errorf("conversationStatus: error getting mentions for status %s from the db: %s", status.ID, err)
+		}
+		status.Mentions = menchies
+	}
+
+	// the participants are the author plus anyone mentioned
+	participants := []*gtsmodel.Account{status.Account}
+	for _, m := range status.Mentions {
+		if m.TargetAccount == nil {
+			a, err := p.db.GetAccountByID(ctx, m.TargetAccountID)
+			if err != nil {
+				return fmt.Errorf("conversationStatus: error getting account with id %s from the db: %s", m.TargetAccountID, err)
+			}
+			m.TargetAccount = a
+		}
+
+		duplicate := false
+		for _, participant := range participants {
+			if participant.ID == m.TargetAccount.ID {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			participants = append(participants, m.TargetAccount)
+		}
+	}
+
+	threadID, err := p.conversationThreadID(ctx, status)
+	if err != nil {
+		return err
+	}
+
+	for _, owner := range participants {
+		if owner.Domain != "" {
+			// not a local account so skip it
+			continue
+		}
+
+		visible, err := p.filter.StatusVisible(ctx, status, owner)
+		if err != nil {
+			return fmt.Errorf("conversationStatus: error checking visibility of status %s for account %s: %s", status.ID, owner.ID, err)
+		}
+		if !visible {
+			continue
+		}
+
+		conversation, updated, err := p.conversationStatusForAccount(ctx, status, owner, participants, threadID)
+		if err != nil {
+			return err
+		}
+
+		if !updated {
+			continue
+		}
+
+		apiConversation, err := p.tc.ConversationToAPIConversation(ctx, conversation, owner)
+		if err != nil {
+			return fmt.Errorf("conversationStatus: error converting conversation %s to frontend representation: %s", conversation.ID, err)
+		}
+
+		if err := p.streamingProcessor.StreamConversationToAccount(apiConversation, owner); err != nil {
+			return fmt.Errorf("conversationStatus: error streaming conversation %s: %s", conversation.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// conversationStatusForAccount adds the given status to owner's conversation
+// with the other participants about the given thread, creating it if necessary.
+// The returned bool indicates whether the status became the conversation's last
+// status, ie., whether the conversation should be streamed to the owner.
+func (p *processor) conversationStatusForAccount(ctx context.Context, status *gtsmodel.Status, owner *gtsmodel.Account, participants []*gtsmodel.Account, threadID string) (*gtsmodel.Conversation, bool, error) {
+	otherAccounts := make([]*gtsmodel.Account, 0, len(participants)-1)
+	for _, participant := range participants {
+		if participant.ID != owner.ID {
+			otherAccounts = append(otherAccounts, participant)
+		}
+	}
+	sort.Slice(otherAccounts, func(i, j int) bool {
+		return otherAccounts[i].ID < otherAccounts[j].ID
+	})
+
+	otherAccountIDs := make([]string, 0, len(otherAccounts))
+	for _, a := range otherAccounts {
+		otherAccountIDs = append(otherAccountIDs, a.ID)
+	}
+	otherAccountsKey := strings.Join(otherAccountIDs, ",")
+
+	// the owner has already read anything they wrote themself
+	read := status.AccountID == owner.ID
+
+	conversation, err := p.db.GetConversationByThreadAndAccountIDs(ctx, owner.ID, threadID, otherAccountsKey)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, false, fmt.Errorf("conversationStatusForAccount: error getting conversation for account %s: %s", owner.ID, err)
+	}
+
+	updated := false
+	if conversation == nil {
+		conversationID, err := id.NewULID()
+		if err != nil {
+			return nil, false, err
+		}
+
+		conversation = &gtsmodel.Conversation{
+			ID:               conversationID,
+			AccountID:        owner.ID,
+			OtherAccountIDs:  otherAccountIDs,
+			OtherAccountsKey: otherAccountsKey,
+			ThreadID:         threadID,
+			LastStatusID:     status.ID,
+			Read:             &read,
+		}
+
+		if err := p.db.PutConversation(ctx, conversation); err != nil {
+			return nil, false, fmt.Errorf("conversationStatusForAccount: error putting conversation for account %s: %s", owner.ID, err)
+		}
+		updated = true
+	} else if status.ID > conversation.LastStatusID {
+		conversation.LastStatusID = status.ID
+		conversation.Read = &read
+		if err := p.db.UpdateConversation(ctx, conversation, "last_status_id", "read"); err != nil {
+			return nil, false, fmt.Errorf("conversationStatusForAccount: error updating conversation %s: %s", conversation.ID, err)
+		}
+		updated = true
+	}
+
+	if err := p.db.AddStatusToConversation(ctx, conversation.ID, status.ID); err != nil {
+		return nil, false, fmt.Errorf("conversationStatusForAccount: error adding status %s to conversation %s: %s", status.ID, conversation.ID, err)
+	}
+
+	conversation.Account = owner
+	conversation.OtherAccounts = otherAccounts
+	if conversation.LastStatusID == status.ID {
+		conversation.LastStatus = status
+	}
+
+	return conversation, updated, nil
+}
+
+// conversationThreadID returns the ID of the status at the top of the
+// thread that the given status belongs to, as far as we know about it.
+func (p *processor) conversationThreadID(ctx context.Context, status *gtsmodel.Status) (string, error) {
+	threadID := status.ID
+	inReplyToID := status.InReplyToID
+	seen := map[string]bool{threadID: true}
+
+	for inReplyToID != "" && !seen[inReplyToID] {
+		parent, err := p.db.GetStatusByID(ctx, inReplyToID)
+		if err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// we don't have the parent, so the
+				// top of the thread is as far as we got
+				break
+			}
+			return "", fmt.Errorf("conversationThreadID: error getting status %s: %s", inReplyToID, err)
+		}
+
+		seen[parent.ID] = true
+		threadID = parent.ID
+		inReplyToID = parent.InReplyToID
+	}
+
+	return threadID, nil
+}
+
 // deleteStatusFromTimelines completely removes the given status from all timelines.
 // It will also stream deletion of the status to all open streams.
 func (p *processor) deleteStatusFromTimelines(ctx context.Context, status *gtsmodel.Status) error {
@@ -444,6 +1093,10 @@ func (p *processor) deleteStatusFromTimelines(ctx context.Context, status *gtsmo
 		return err
 	}
 
+	if err := p.listTimelines.WipeItemFromAllTimelines(ctx, status.ID); err != nil {
+		return err
+	}
+
 	return p.streamingProcessor.StreamDelete(status.ID)
 }
 
@@ -498,6 +1151,11 @@ func (p *processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 		return err
 	}
 
+	// delete this status from any direct message conversations
+	if err := p.db.DeleteStatusFromConversations(ctx, statusToDelete.ID); err != nil {
+		return err
+	}
+
 	// delete the status itself
 	if err := p.db.DeleteStatusByID(ctx, statusToDelete.ID); err != nil {
 		return err
@@ -505,3 +1163,43 @@ func (p *processor) wipeStatus(ctx context.Context, statusToDelete *gtsmodel.Sta
 
 	return nil
 }
+
+// moveFollowers moves the local followers of an account that has moved
+// over to the account that it moved to, by following the target account
+// on their behalf and then unfollowing the account that moved.
+func (p *processor) moveFollowers(ctx context.Context, origin *gtsmodel.Account, target *gtsmodel.Account) error {
+	follows, err := p.db.GetAccountFollowedBy(ctx, origin.ID, true)
+	if err != nil {
+		return fmt.Errorf("moveFollowers: error getting followers of account %s: %s", origin.ID, err)
+	}
+
+	for _, follow := range follows {
+		if follow.AccountID == target.ID {
+			// the target can't follow itself
+			continue
+		}
+
+		follower, err := p.db.GetAccountByID(ctx, follow.AccountID)
+		if err != nil {
+			log.Errorf("moveFollowers: error getting follower account %s: %s", follow.AccountID, err)
+			continue
+		}
+
+		if _, errWithCode := p.accountProcessor.FollowCreate(ctx, follower, &apimodel.AccountFollowRequest{
+			ID:      target.ID,
+			Reblogs: follow.ShowReblogs,
+			Notify:  follow.Notify,
+		}); errWithCode != nil {
+			// probably a block between the follower and the
+			// target, so leave the follower where they are
+			log.Debugf("moveFollowers: couldn't follow %s on behalf of %s: %s", target.ID, follower.ID, errWithCode)
+			continue
+		}
+
+		if _, errWithCode := p.accountProcessor.FollowRemove(ctx, follower, origin.ID); errWithCode != nil {
+			log.Errorf("moveFollowers: couldn't unfollow %s on behalf of %s: %s", origin.ID, follower.ID, errWithCode)
+		}
+	}
+
+	return nil
+}
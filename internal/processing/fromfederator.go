@@ -27,6 +27,7 @@ import (
 	"codeberg.org/gruf/go-kv"
 	"codeberg.org/gruf/go-logger/v2/level"
 	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/id"
@@ -67,7 +68,7 @@ func (p *processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 	case ap.ActivityCreate:
 		// CREATE SOMETHING
 		switch federatorMsg.APObjectType {
-		case ap.ObjectNote:
+		case ap.ObjectNote, ap.ActivityQuestion:
 			// CREATE A STATUS
 			return p.processCreateStatusFromFederator(ctx, federatorMsg)
 		case ap.ActivityLike:
@@ -88,9 +89,13 @@ func (p *processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 		}
 	case ap.ActivityUpdate:
 		// UPDATE SOMETHING
-		if federatorMsg.APObjectType == ap.ObjectProfile {
+		switch federatorMsg.APObjectType {
+		case ap.ObjectProfile:
 			// UPDATE AN ACCOUNT
 			return p.processUpdateAccountFromFederator(ctx, federatorMsg)
+		case ap.ObjectNote:
+			// UPDATE (EDIT) A STATUS
+			return p.processUpdateStatusFromFederator(ctx, federatorMsg)
 		}
 	case ap.ActivityDelete:
 		// DELETE SOMETHING
@@ -102,13 +107,19 @@ func (p *processor) ProcessFromFederator(ctx context.Context, federatorMsg messa
 			// DELETE A PROFILE/ACCOUNT
 			return p.processDeleteAccountFromFederator(ctx, federatorMsg)
 		}
+	case ap.ActivityMove:
+		// MOVE SOMETHING
+		if federatorMsg.APObjectType == ap.ActorPerson {
+			// MOVE A PROFILE/ACCOUNT
+			return p.processMoveAccountFromFederator(ctx, federatorMsg)
+		}
 	}
 
 	// not a combination we can/need to process
 	return nil
 }
 
-// processCreateStatusFromFederator handles Activity Create and Object Note
+// processCreateStatusFromFederator handles Activity Create and Object Note or Question
 func (p *processor) processCreateStatusFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
 	// check for either an IRI that we still need to dereference, OR an already dereferenced
 	// and converted status pinned to the message.
@@ -170,6 +181,10 @@ func (p *processor) processCreateStatusFromFederator(ctx context.Context, federa
 		return err
 	}
 
+	if err := p.conversationStatus(ctx, status); err != nil {
+		return err
+	}
+
 	if err := p.notifyStatus(ctx, status); err != nil {
 		return err
 	}
@@ -262,8 +277,9 @@ func (p *processor) processCreateFollowRequestFromFederator(ctx context.Context,
 		followRequest.TargetAccount = a
 	}
 
-	if *followRequest.TargetAccount.Locked {
-		// if the account is locked just notify the follow request and nothing else
+	if *followRequest.TargetAccount.Locked || !followRequest.Account.SilencedAt.IsZero() {
+		// if the account is locked, or the requester has been silenced,
+		// just notify the follow request and nothing else
 		return p.notifyFollowRequest(ctx, followRequest)
 	}
 
@@ -347,6 +363,31 @@ func (p *processor) processCreateBlockFromFederator(ctx context.Context, federat
 		return errors.New("block was not parseable as *gtsmodel.Block")
 	}
 
+	// remove any follows or follow requests between the two accounts, in either direction;
+	// deleting a follow this way also takes it out of any lists it had been put in
+	for _, pair := range [][2]string{
+		{block.AccountID, block.TargetAccountID},
+		{block.TargetAccountID, block.AccountID},
+	} {
+		where := []db.Where{
+			{Key: "account_id", Value: pair[0]},
+			{Key: "target_account_id", Value: pair[1]},
+		}
+
+		follow := &gtsmodel.Follow{}
+		if err := p.db.GetWhere(ctx, where, follow); err == nil {
+			if err := p.db.DeleteFollowByID(ctx, follow.ID); err != nil {
+				return fmt.Errorf("error removing follow in db: %s", err)
+			}
+		} else if !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("error getting follow from db: %s", err)
+		}
+
+		if err := p.db.DeleteWhere(ctx, where, &gtsmodel.FollowRequest{}); err != nil {
+			return fmt.Errorf("error removing follow request in db: %s", err)
+		}
+	}
+
 	// remove any of the blocking account's statuses from the blocked account's timeline, and vice versa
 	if err := p.statusTimelines.WipeItemsFromAccountID(ctx, block.AccountID, block.TargetAccountID); err != nil {
 		return err
@@ -367,6 +408,27 @@ func (p *processor) processCreateFlagFromFederator(ctx context.Context, federato
 	return nil
 }
 
+// processUpdateStatusFromFederator handles Activity Update and Object Note
+func (p *processor) processUpdateStatusFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	status, ok := federatorMsg.GTSModel.(*gtsmodel.Status)
+	if !ok {
+		return errors.New("note was not parseable as *gtsmodel.Status")
+	}
+
+	// dereference any new attachments, mentions etc
+	status, err := p.federator.EnrichRemoteStatus(ctx, federatorMsg.ReceivingAccount.Username, status, false)
+	if err != nil {
+		return fmt.Errorf("error enriching updated status from federator: %s", err)
+	}
+
+	if err := p.timelineStatusUpdate(ctx, status); err != nil {
+		return err
+	}
+
+	// notify any accounts newly mentioned by the edit
+	return p.notifyStatus(ctx, status)
+}
+
 // processUpdateAccountFromFederator handles Activity Update and Object Profile
 func (p *processor) processUpdateAccountFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
 	incomingAccount, ok := federatorMsg.GTSModel.(*gtsmodel.Account)
@@ -380,20 +442,74 @@ func (p *processor) processUpdateAccountFromFederator(ctx context.Context, feder
 	}
 
 	// further database updates occur inside getremoteaccount
-	if _, err := p.federator.GetAccount(ctx, dereferencing.GetAccountParams{
+	account, err := p.federator.GetAccount(ctx, dereferencing.GetAccountParams{
 		RequestingUsername:    federatorMsg.ReceivingAccount.Username,
 		RemoteAccountID:       incomingAccountURL,
 		RemoteAccountHost:     incomingAccount.Domain,
 		RemoteAccountUsername: incomingAccount.Username,
 		PartialAccount:        incomingAccount,
 		Blocking:              true,
-	}); err != nil {
+	})
+	if err != nil {
 		return fmt.Errorf("error enriching updated account from federator: %s", err)
 	}
 
+	// (re)verify links in the updated profile fields
+	if err := p.accountProcessor.VerifyFields(ctx, account); err != nil {
+		log.Errorf("processUpdateAccountFromFederator: error verifying profile fields: %s", err)
+	}
+
 	return nil
 }
 
+// processMoveAccountFromFederator handles Activity Move and Object Person
+func (p *processor) processMoveAccountFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
+	movingAccount, ok := federatorMsg.GTSModel.(*gtsmodel.Account)
+	if !ok {
+		return errors.New("moving account was not parseable as *gtsmodel.Account")
+	}
+
+	if federatorMsg.APIri == nil {
+		return errors.New("move target iri was not set on federatorMsg")
+	}
+
+	// get the latest version of the moving account
+	movingAccount, err := p.db.GetAccountByID(ctx, movingAccount.ID)
+	if err != nil {
+		return fmt.Errorf("error getting moving account from the db: %s", err)
+	}
+
+	// get the target fresh from its instance, since we
+	// need to check that it's recently aliased the mover
+	targetAccount, err := p.federator.GetAccount(ctx, dereferencing.GetAccountParams{
+		RequestingUsername: federatorMsg.ReceivingAccount.Username,
+		RemoteAccountID:    federatorMsg.APIri,
+		Blocking:           true,
+		Refresh:            true,
+	})
+	if err != nil {
+		return fmt.Errorf("error dereferencing move target %s: %s", federatorMsg.APIri, err)
+	}
+
+	if targetAccount.ID == movingAccount.ID {
+		return fmt.Errorf("account %s cannot move to itself", movingAccount.URI)
+	}
+
+	if !targetAccount.IsAliasOf(movingAccount) {
+		return fmt.Errorf("move target %s does not list %s as an alias", targetAccount.URI, movingAccount.URI)
+	}
+
+	if movingAccount.MovedToAccountID != targetAccount.ID {
+		movingAccount.MovedToURI = targetAccount.URI
+		movingAccount.MovedToAccountID = targetAccount.ID
+		if err := p.db.UpdateAccount(ctx, movingAccount); err != nil {
+			return fmt.Errorf("error updating moving account: %s", err)
+		}
+	}
+
+	return p.moveFollowers(ctx, movingAccount, targetAccount)
+}
+
 // processDeleteStatusFromFederator handles Activity Delete and Object Note
 func (p *processor) processDeleteStatusFromFederator(ctx context.Context, federatorMsg messages.FromFederator) error {
 	statusToDelete, ok := federatorMsg.GTSModel.(*gtsmodel.Status)
@@ -392,6 +392,92 @@ func (suite *FromFederatorTestSuite) TestProcessAccountDelete() {
 	suite.Equal(dbAccount.ID, dbAccount.SuspensionOrigin)
 }
 
+func (suite *FromFederatorTestSuite) TestProcessAccountMove() {
+	ctx := context.Background()
+
+	movingAccount := suite.testAccounts["remote_account_1"]
+	receivingAccount := suite.testAccounts["admin_account"]
+
+	// admin follows foss_satan, who is moving to turtle
+	adminFollowSatan := &gtsmodel.Follow{
+		ID:              "01GSGBJHP0RJ6FXVQ1WQ43VQ1Z",
+		CreatedAt:       time.Now().Add(-1 * time.Hour),
+		UpdatedAt:       time.Now().Add(-1 * time.Hour),
+		AccountID:       receivingAccount.ID,
+		TargetAccountID: movingAccount.ID,
+		ShowReblogs:     testrig.FalseBool(),
+		URI:             fmt.Sprintf("%s/follow/01GSGBJHP0RJ6FXVQ1WQ43VQ1Z", receivingAccount.URI),
+		Notify:          testrig.TrueBool(),
+	}
+	err := suite.db.Put(ctx, adminFollowSatan)
+	suite.NoError(err)
+
+	// turtle lists foss_satan as an alias
+	targetAccount, err := suite.db.GetAccountByID(ctx, suite.testAccounts["local_account_2"].ID)
+	suite.NoError(err)
+	targetAccount.AlsoKnownAs = []string{movingAccount.URI}
+	err = suite.db.UpdateAccount(ctx, targetAccount)
+	suite.NoError(err)
+
+	err = suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActorPerson,
+		APActivityType:   ap.ActivityMove,
+		APIri:            testrig.URLMustParse(targetAccount.URI),
+		GTSModel:         movingAccount,
+		ReceivingAccount: receivingAccount,
+	})
+	suite.NoError(err)
+
+	// foss_satan should be marked as moved
+	dbMovingAccount, err := suite.db.GetAccountByID(ctx, movingAccount.ID)
+	suite.NoError(err)
+	suite.Equal(targetAccount.URI, dbMovingAccount.MovedToURI)
+	suite.Equal(targetAccount.ID, dbMovingAccount.MovedToAccountID)
+
+	// turtle is locked, so admin should now have requested
+	// to follow turtle instead, with the same settings
+	requested, err := suite.db.IsFollowRequested(ctx, receivingAccount, targetAccount)
+	suite.NoError(err)
+	suite.True(requested)
+
+	followRequest := &gtsmodel.FollowRequest{}
+	err = suite.db.GetWhere(ctx, []db.Where{
+		{Key: "account_id", Value: receivingAccount.ID},
+		{Key: "target_account_id", Value: targetAccount.ID},
+	}, followRequest)
+	suite.NoError(err)
+	suite.False(*followRequest.ShowReblogs)
+	suite.True(*followRequest.Notify)
+
+	// and admin should no longer follow foss_satan
+	following, err := suite.db.IsFollowing(ctx, receivingAccount, movingAccount)
+	suite.NoError(err)
+	suite.False(following)
+}
+
+func (suite *FromFederatorTestSuite) TestProcessAccountMoveNotAliased() {
+	ctx := context.Background()
+
+	movingAccount := suite.testAccounts["remote_account_1"]
+	receivingAccount := suite.testAccounts["admin_account"]
+	targetAccount := suite.testAccounts["local_account_2"]
+
+	err := suite.processor.ProcessFromFederator(ctx, messages.FromFederator{
+		APObjectType:     ap.ActorPerson,
+		APActivityType:   ap.ActivityMove,
+		APIri:            testrig.URLMustParse(targetAccount.URI),
+		GTSModel:         movingAccount,
+		ReceivingAccount: receivingAccount,
+	})
+	suite.EqualError(err, "move target http://localhost:8080/users/1happyturtle does not list http://fossbros-anonymous.io/users/foss_satan as an alias")
+
+	// foss_satan should not be marked as moved
+	dbMovingAccount, err := suite.db.GetAccountByID(ctx, movingAccount.ID)
+	suite.NoError(err)
+	suite.Empty(dbMovingAccount.MovedToURI)
+	suite.Empty(dbMovingAccount.MovedToAccountID)
+}
+
 func (suite *FromFederatorTestSuite) TestProcessFollowRequestLocked() {
 	ctx := context.Background()
 
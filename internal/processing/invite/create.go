@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+// maxExpiresIn is the longest that an invite
+// can be made to last for, in seconds: one year.
+const maxExpiresIn = 365 * 24 * 60 * 60
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode) {
+	if !config.GetAccountsInvitesEnabled() {
+		err := errors.New("invites are not enabled on this instance")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	if config.GetAccountsInvitesAdminOnly() && !*user.Admin {
+		err := errors.New("only admins can create invites on this instance")
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
+	if form.MaxUses < 0 {
+		err := errors.New("max_uses must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.ExpiresIn < 0 {
+		err := errors.New("expires_in must not be negative")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if form.ExpiresIn > maxExpiresIn {
+		err := fmt.Errorf("expires_in must not be more than %d", maxExpiresIn)
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	inviteID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	invite := &gtsmodel.Invite{
+		ID:        inviteID,
+		Code:      uuid.NewString(),
+		AccountID: account.ID,
+		Account:   account,
+		MaxUses:   form.MaxUses,
+	}
+
+	if form.ExpiresIn > 0 {
+		invite.ExpiresAt = time.Now().Add(time.Duration(form.ExpiresIn) * time.Second)
+	}
+
+	if err := p.db.PutInvite(ctx, invite); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error inserting invite in db: %w", err))
+	}
+
+	return p.apiInvite(ctx, invite)
+}
@@ -0,0 +1,90 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Invite, gtserror.WithCode) {
+	invites, err := p.db.GetAccountInvites(ctx, account.ID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting invites: %w", err))
+	}
+
+	apiInvites := make([]*apimodel.Invite, 0, len(invites))
+	for _, invite := range invites {
+		apiInvite, errWithCode := p.apiInvite(ctx, invite)
+		if errWithCode != nil {
+			return nil, errWithCode
+		}
+
+		apiInvites = append(apiInvites, apiInvite)
+	}
+
+	return apiInvites, nil
+}
+
+func (p *processor) GetUsable(ctx context.Context, code string) (*gtsmodel.Invite, gtserror.WithCode) {
+	if !config.GetAccountsInvitesEnabled() {
+		err := errors.New("invites are not enabled on this instance")
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	invite, err := p.db.GetInviteByCode(ctx, code)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("invite with code %s not found", code)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if !invite.Usable(time.Now()) {
+		// don't let on that the code ever existed
+		err = fmt.Errorf("invite with code %s has expired or been used up", code)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	invite.Account, err = p.db.GetAccountByID(ctx, invite.AccountID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// invites die with the account that created them
+			err = fmt.Errorf("account %s that created invite %s no longer exists", invite.AccountID, invite.ID)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting account %s that created invite %s: %w", invite.AccountID, invite.ID, err))
+	}
+
+	if !invite.Account.SuspendedAt.IsZero() {
+		err = fmt.Errorf("account %s that created invite %s is suspended", invite.AccountID, invite.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return invite, nil
+}
@@ -0,0 +1,64 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Processor wraps a bunch of functions for processing invite codes and sign-ups made with them.
+type Processor interface {
+	// Create creates a new invite code for the given account, as long as invites are enabled
+	// and the account is allowed to create them.
+	Create(ctx context.Context, account *gtsmodel.Account, user *gtsmodel.User, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode)
+	// GetAll returns all invites created by the given account, newest first.
+	GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.Invite, gtserror.WithCode)
+	// Expire makes the invite with the given ID, created by the given account, unusable from now on.
+	// The invite itself is kept, so that we still know who invited the accounts that used it.
+	Expire(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.Invite, gtserror.WithCode)
+	// GetUsable returns the invite with the given code, with its account populated,
+	// as long as invites are enabled and it can still be used to sign up.
+	GetUsable(ctx context.Context, code string) (*gtsmodel.Invite, gtserror.WithCode)
+	// Signup creates a new user and account using the invite with the given code, bypassing
+	// closed registrations and sign-up approval. The form should have its IP set.
+	Signup(ctx context.Context, code string, form *apimodel.AccountCreateRequest) (*gtsmodel.User, gtserror.WithCode)
+}
+
+type processor struct {
+	db           db.DB
+	tc           typeutils.TypeConverter
+	clientWorker *concurrency.WorkerPool[messages.FromClientAPI]
+}
+
+// New returns a new invite processor.
+func New(db db.DB, tc typeutils.TypeConverter, clientWorker *concurrency.WorkerPool[messages.FromClientAPI]) Processor {
+	return &processor{
+		db:           db,
+		tc:           tc,
+		clientWorker: clientWorker,
+	}
+}
@@ -0,0 +1,137 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (p *processor) Signup(ctx context.Context, code string, form *apimodel.AccountCreateRequest) (*gtsmodel.User, gtserror.WithCode) {
+	invite, errWithCode := p.GetUsable(ctx, code)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := validateSignup(form); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	emailAvailable, err := p.db.IsEmailAvailable(ctx, form.Email)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	if !emailAvailable {
+		err := fmt.Errorf("email address %s is not available", form.Email)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	usernameAvailable, err := p.db.IsUsernameAvailable(ctx, form.Username)
+	if err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	if !usernameAvailable {
+		err := fmt.Errorf("username %s in use", form.Username)
+		return nil, gtserror.NewErrorConflict(err, err.Error())
+	}
+
+	// The invite vouches for the new account, so it doesn't need approval
+	// or a reason, but it still has to confirm its email address.
+	log.Tracef("creating new username and account with invite %s", invite.ID)
+	user, err := p.db.NewSignup(ctx, form.Username, "", false, form.Email, form.Password, form.IP, form.Locale, "", false, "", false)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error creating new signup in the database: %w", err))
+	}
+
+	// Only take one use of the invite once the sign-up has gone through, so a
+	// failed sign-up doesn't waste it. If someone else took the last use in
+	// the meantime, we're out of luck, and the new user + account go again.
+	if err := p.db.UseInvite(ctx, invite); err != nil {
+		if delErr := p.db.DeleteUserByID(ctx, user.ID); delErr != nil {
+			log.Errorf("error deleting user %s after failing to use invite: %s", user.ID, delErr)
+		}
+		if delErr := p.db.DeleteAccount(ctx, user.AccountID); delErr != nil {
+			log.Errorf("error deleting account %s after failing to use invite: %s", user.AccountID, delErr)
+		}
+
+		if errors.Is(err, db.ErrNoEntries) {
+			err = fmt.Errorf("invite with code %s has expired or been used up", code)
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	user.InviteID = invite.ID
+	if err := p.db.UpdateUser(ctx, user, "invite_id"); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error setting invite of user %s: %w", user.ID, err))
+	}
+
+	if user.Account == nil {
+		a, err := p.db.GetAccountByID(ctx, user.AccountID)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting new account from the database: %w", err))
+		}
+		user.Account = a
+	}
+
+	// there are side effects for creating a new account (sending confirmation emails etc)
+	// so pass a message to the processor so that it can do it asynchronously
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ObjectProfile,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       user.Account,
+		OriginAccount:  user.Account,
+		TraceContext:   trace.SpanContextFromContext(ctx),
+	})
+
+	return user, nil
+}
+
+// validateSignup checks the parts of the sign-up form that still matter when
+// signing up with an invite; registrations being closed and the sign-up reason don't.
+func validateSignup(form *apimodel.AccountCreateRequest) error {
+	if err := validate.Username(form.Username); err != nil {
+		return err
+	}
+
+	if err := validate.Email(form.Email); err != nil {
+		return err
+	}
+
+	if err := validate.NewPassword(form.Password); err != nil {
+		return err
+	}
+
+	if !form.Agreement {
+		return errors.New("agreement to terms and conditions not given")
+	}
+
+	return validate.Language(form.Locale)
+}
@@ -0,0 +1,156 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite_test
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/invite"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+// failingDB wraps a db.DB, and can be told to
+// fail the calls that a sign-up is made of.
+type failingDB struct {
+	db.DB
+	failNewSignup bool
+	failUseInvite bool
+}
+
+func (f *failingDB) NewSignup(ctx context.Context, username string, reason string, requireApproval bool, email string, password string, signUpIP net.IP, locale string, appID string, emailVerified bool, externalID string, admin bool) (*gtsmodel.User, db.Error) {
+	if f.failNewSignup {
+		return nil, errors.New("sign-up failed")
+	}
+	return f.DB.NewSignup(ctx, username, reason, requireApproval, email, password, signUpIP, locale, appID, emailVerified, externalID, admin)
+}
+
+func (f *failingDB) UseInvite(ctx context.Context, invite *gtsmodel.Invite) db.Error {
+	if f.failUseInvite {
+		// as if someone else took the last use
+		return db.ErrNoEntries
+	}
+	return f.DB.UseInvite(ctx, invite)
+}
+
+type SignupTestSuite struct {
+	suite.Suite
+	db        *failingDB
+	processor invite.Processor
+
+	testAccounts map[string]*gtsmodel.Account
+	testUsers    map[string]*gtsmodel.User
+}
+
+func (suite *SignupTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testUsers = testrig.NewTestUsers()
+}
+
+func (suite *SignupTestSuite) SetupTest() {
+	testrig.InitTestLog()
+	testrig.InitTestConfig()
+
+	clientWorker := concurrency.NewWorkerPool[messages.FromClientAPI](-1, -1)
+	clientWorker.SetProcessor(func(context.Context, messages.FromClientAPI) error { return nil })
+	_ = clientWorker.Start()
+
+	suite.db = &failingDB{DB: testrig.NewTestDB()}
+	suite.processor = invite.New(suite.db, testrig.NewTestTypeConverter(suite.db), clientWorker)
+	testrig.StandardDBSetup(suite.db, nil)
+}
+
+func (suite *SignupTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+// createInvite returns the ID and code of a new single-use invite.
+func (suite *SignupTestSuite) createInvite() (string, string) {
+	apiInvite, errWithCode := suite.processor.Create(context.Background(), suite.testAccounts["local_account_1"], suite.testUsers["local_account_1"], &apimodel.InviteCreateRequest{MaxUses: 1})
+	if errWithCode != nil {
+		suite.FailNow(errWithCode.Error())
+	}
+	return apiInvite.ID, apiInvite.Code
+}
+
+func (suite *SignupTestSuite) signupForm(username string) *apimodel.AccountCreateRequest {
+	return &apimodel.AccountCreateRequest{
+		Username:  username,
+		Email:     username + "@example.org",
+		Password:  "this is a good password 1234!",
+		Agreement: true,
+		Locale:    "en",
+		IP:        net.ParseIP("192.0.2.1"),
+	}
+}
+
+func (suite *SignupTestSuite) TestSignupFailedNewSignup() {
+	ctx := context.Background()
+	inviteID, code := suite.createInvite()
+
+	suite.db.failNewSignup = true
+	_, errWithCode := suite.processor.Signup(ctx, code, suite.signupForm("invited_friend"))
+	suite.Equal(http.StatusInternalServerError, errWithCode.Code())
+
+	// the failed sign-up shouldn't have used up the invite
+	dbInvite, err := suite.db.GetInviteByID(ctx, inviteID)
+	suite.NoError(err)
+	suite.Equal(0, dbInvite.Uses)
+
+	// so it can still be used
+	suite.db.failNewSignup = false
+	user, errWithCode := suite.processor.Signup(ctx, code, suite.signupForm("invited_friend"))
+	suite.NoError(errWithCode)
+	suite.Equal(inviteID, user.InviteID)
+}
+
+func (suite *SignupTestSuite) TestSignupInviteUsedUpMeanwhile() {
+	ctx := context.Background()
+	inviteID, code := suite.createInvite()
+
+	suite.db.failUseInvite = true
+	_, errWithCode := suite.processor.Signup(ctx, code, suite.signupForm("invited_friend"))
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	// the new user and account should be gone again
+	usernameAvailable, err := suite.db.IsUsernameAvailable(ctx, "invited_friend")
+	suite.NoError(err)
+	suite.True(usernameAvailable)
+
+	emailAvailable, err := suite.db.IsEmailAvailable(ctx, "invited_friend@example.org")
+	suite.NoError(err)
+	suite.True(emailAvailable)
+
+	dbInvite, err := suite.db.GetInviteByID(ctx, inviteID)
+	suite.NoError(err)
+	suite.Equal(0, dbInvite.Uses)
+}
+
+func TestSignupTestSuite(t *testing.T) {
+	suite.Run(t, new(SignupTestSuite))
+}
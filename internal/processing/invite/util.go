@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package invite
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// getInvite is a shortcut to get one invite from the database and
+// check that it's owned by the given accountID. Will return
+// appropriate errors so caller doesn't need to bother.
+func (p *processor) getInvite(ctx context.Context, accountID string, inviteID string) (*gtsmodel.Invite, gtserror.WithCode) {
+	invite, err := p.db.GetInviteByID(ctx, inviteID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// Invite doesn't seem to exist.
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		// Real database error.
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if invite.AccountID != accountID {
+		err = fmt.Errorf("invite with id %s does not belong to account %s", invite.ID, accountID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	return invite, nil
+}
+
+// apiInvite is a shortcut to return the API version of the given
+// invite, or return an appropriate error if conversion fails.
+func (p *processor) apiInvite(ctx context.Context, invite *gtsmodel.Invite) (*apimodel.Invite, gtserror.WithCode) {
+	apiInvite, err := p.tc.InviteToAPIInvite(ctx, invite)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting invite to api: %w", err))
+	}
+
+	return apiInvite, nil
+}
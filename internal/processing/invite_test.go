@@ -0,0 +1,167 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing_test
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+type InviteTestSuite struct {
+	ProcessingStandardTestSuite
+}
+
+func (suite *InviteTestSuite) signupForm(username string) *apimodel.AccountCreateRequest {
+	return &apimodel.AccountCreateRequest{
+		Username:  username,
+		Email:     username + "@example.org",
+		Password:  "this is a good password 1234!",
+		Agreement: true,
+		Locale:    "en",
+		IP:        net.ParseIP("192.0.2.1"),
+	}
+}
+
+func (suite *InviteTestSuite) TestInviteSignup() {
+	ctx := context.Background()
+	config.SetAccountsRegistrationOpen(false)
+	authed := &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}
+
+	invite, errWithCode := suite.processor.InviteCreate(ctx, authed, &apimodel.InviteCreateRequest{MaxUses: 1, ExpiresIn: 3600})
+	suite.NoError(errWithCode)
+	suite.Equal(1, invite.MaxUses)
+	suite.Equal(0, invite.Uses)
+	suite.NotNil(invite.ExpiresAt)
+	suite.True(invite.Usable)
+	suite.Equal("http://localhost:8080/invite/"+invite.Code, invite.URL)
+
+	user, errWithCode := suite.processor.InviteSignup(ctx, invite.Code, suite.signupForm("invited_friend"))
+	suite.NoError(errWithCode)
+	suite.Equal(invite.ID, user.InviteID)
+
+	// invitees don't need approval, but do need to confirm their email
+	suite.True(*user.Approved)
+	suite.True(user.ConfirmedAt.IsZero())
+	suite.Eventually(func() bool {
+		_, ok := suite.sentEmails["invited_friend@example.org"]
+		return ok
+	}, 10*time.Second, 10*time.Millisecond)
+
+	// the invite is used up now
+	_, errWithCode = suite.processor.InviteSignup(ctx, invite.Code, suite.signupForm("another_friend"))
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	invites, errWithCode := suite.processor.InvitesGet(ctx, authed)
+	suite.NoError(errWithCode)
+	suite.Len(invites, 1)
+	suite.Equal(1, invites[0].Uses)
+	suite.False(invites[0].Usable)
+
+	// admins can see who invited the new account
+	account, err := suite.db.GetAccountByID(ctx, user.AccountID)
+	suite.NoError(err)
+	adminAccount, err := suite.typeconverter.AccountToAdminAPIAccount(ctx, account)
+	suite.NoError(err)
+	suite.Equal(suite.testAccounts["admin_account"].ID, adminAccount.InvitedByAccountID)
+}
+
+func (suite *InviteTestSuite) TestInviteSignupBadForm() {
+	ctx := context.Background()
+
+	invite, errWithCode := suite.processor.InviteCreate(ctx, suite.testAutheds["local_account_1"], &apimodel.InviteCreateRequest{})
+	suite.NoError(errWithCode)
+
+	form := suite.signupForm("the_mighty_zork")
+	_, errWithCode = suite.processor.InviteSignup(ctx, invite.Code, form)
+	suite.Equal(http.StatusConflict, errWithCode.Code())
+
+	form = suite.signupForm("new_friend")
+	form.Agreement = false
+	_, errWithCode = suite.processor.InviteSignup(ctx, invite.Code, form)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+
+	// failed sign-ups don't use up the invite
+	invites, errWithCode := suite.processor.InvitesGet(ctx, suite.testAutheds["local_account_1"])
+	suite.NoError(errWithCode)
+	suite.Equal(0, invites[0].Uses)
+}
+
+func (suite *InviteTestSuite) TestInviteExpire() {
+	ctx := context.Background()
+	authed := suite.testAutheds["local_account_1"]
+
+	invite, errWithCode := suite.processor.InviteCreate(ctx, authed, &apimodel.InviteCreateRequest{})
+	suite.NoError(errWithCode)
+	suite.Nil(invite.ExpiresAt)
+
+	// only the creator can expire an invite
+	_, errWithCode = suite.processor.InviteExpire(ctx, &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}, invite.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	expired, errWithCode := suite.processor.InviteExpire(ctx, authed, invite.ID)
+	suite.NoError(errWithCode)
+	suite.NotNil(expired.ExpiresAt)
+	suite.False(expired.Usable)
+
+	_, errWithCode = suite.processor.InviteGetUsable(ctx, invite.Code)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func (suite *InviteTestSuite) TestInviteCreateAdminOnly() {
+	ctx := context.Background()
+	config.SetAccountsInvitesAdminOnly(true)
+
+	_, errWithCode := suite.processor.InviteCreate(ctx, suite.testAutheds["local_account_1"], &apimodel.InviteCreateRequest{})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+
+	_, errWithCode = suite.processor.InviteCreate(ctx, &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}, &apimodel.InviteCreateRequest{})
+	suite.NoError(errWithCode)
+}
+
+func (suite *InviteTestSuite) TestInviteCreateDisabled() {
+	ctx := context.Background()
+	config.SetAccountsInvitesEnabled(false)
+
+	_, errWithCode := suite.processor.InviteCreate(ctx, &oauth.Auth{
+		User:    suite.testUsers["admin_account"],
+		Account: suite.testAccounts["admin_account"],
+	}, &apimodel.InviteCreateRequest{})
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+}
+
+func TestInviteTestSuite(t *testing.T) {
+	suite.Run(t, &InviteTestSuite{})
+}
@@ -0,0 +1,60 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) ListCreate(ctx context.Context, authed *oauth.Auth, title string, repliesPolicy gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode) {
+	return p.listProcessor.Create(ctx, authed.Account, title, repliesPolicy)
+}
+
+func (p *processor) ListGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.List, gtserror.WithCode) {
+	return p.listProcessor.Get(ctx, authed.Account, id)
+}
+
+func (p *processor) ListsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.List, gtserror.WithCode) {
+	return p.listProcessor.GetAll(ctx, authed.Account)
+}
+
+func (p *processor) ListUpdate(ctx context.Context, authed *oauth.Auth, id string, title *string, repliesPolicy *gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode) {
+	return p.listProcessor.Update(ctx, authed.Account, id, title, repliesPolicy)
+}
+
+func (p *processor) ListDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode {
+	return p.listProcessor.Delete(ctx, authed.Account, id)
+}
+
+func (p *processor) ListAccountsGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.listProcessor.GetListAccounts(ctx, authed.Account, listID, maxID, sinceID, minID, limit)
+}
+
+func (p *processor) ListAccountsAdd(ctx context.Context, authed *oauth.Auth, listID string, targetAccountIDs []string) gtserror.WithCode {
+	return p.listProcessor.AddToList(ctx, authed.Account, listID, targetAccountIDs)
+}
+
+func (p *processor) ListAccountsRemove(ctx context.Context, authed *oauth.Auth, listID string, targetAccountIDs []string) gtserror.WithCode {
+	return p.listProcessor.RemoveFromList(ctx, authed.Account, listID, targetAccountIDs)
+}
@@ -0,0 +1,68 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package list
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Processor wraps a bunch of functions for processing lists and list entries.
+type Processor interface {
+	// Create creates one a new list for the given account, using the provided parameters.
+	// These params should have already been validated by the time they reach this function.
+	Create(ctx context.Context, account *gtsmodel.Account, title string, repliesPolicy gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode)
+	// Get returns the api model of one list with the given ID.
+	Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.List, gtserror.WithCode)
+	// GetAll returns multiple lists created by the given account, sorted by list ID DESC (newest first).
+	GetAll(ctx context.Context, account *gtsmodel.Account) ([]*apimodel.List, gtserror.WithCode)
+	// Update updates one list for the given account, using the provided parameters.
+	// These params should have already been validated by the time they reach this function.
+	Update(ctx context.Context, account *gtsmodel.Account, id string, title *string, repliesPolicy *gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode)
+	// Delete deletes one list for the given account.
+	Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+	// GetListAccounts returns accounts that are in the given list, owned by the given account.
+	// The additional parameters can be used for paging.
+	GetListAccounts(ctx context.Context, account *gtsmodel.Account, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// AddToList adds targetAccountIDs to the given list, if valid.
+	AddToList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode
+	// RemoveFromList removes targetAccountIDs from the given list, if valid.
+	RemoveFromList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode
+}
+
+type processor struct {
+	db            db.DB
+	tc            typeutils.TypeConverter
+	listTimelines timeline.Manager
+}
+
+// New returns a new list processor.
+func New(db db.DB, tc typeutils.TypeConverter, listTimelines timeline.Manager) Processor {
+	return &processor{
+		db:            db,
+		tc:            tc,
+		listTimelines: listTimelines,
+	}
+}
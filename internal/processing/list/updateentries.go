@@ -0,0 +1,157 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package list
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) AddToList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode {
+	// Ensure this list exists + account owns it.
+	list, errWithCode := p.getList(ctx, account.ID, listID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// Pre-assemble list of entries to add. We *could* add these
+	// one by one as we iterate through accountIDs, but according
+	// to the Mastodon API we should only add them all once we know
+	// they're all valid, no partial updates.
+	listEntries := make([]*gtsmodel.ListEntry, 0, len(targetAccountIDs))
+
+	// Check each targetAccountID is valid.
+	//   - Follow must exist.
+	//   - Follow must not already be in the given list.
+	for _, targetAccountID := range targetAccountIDs {
+		// Ensure follow exists.
+		follow := &gtsmodel.Follow{}
+		if err := p.db.GetWhere(ctx, []db.Where{
+			{Key: "account_id", Value: account.ID},
+			{Key: "target_account_id", Value: targetAccountID},
+		}, follow); err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				err = fmt.Errorf("you do not follow account %s", targetAccountID)
+				return gtserror.NewErrorNotFound(err, err.Error())
+			}
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		// Ensure followID not already in list.
+		// This particular call to ListIncludesAccount
+		// won't error with NoEntries.
+		targetAccountIsInList, err := p.db.ListIncludesAccount(ctx, listID, targetAccountID)
+		if err != nil {
+			err = fmt.Errorf("error checking if account %s is in list %s: %w", targetAccountID, listID, err)
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		if targetAccountIsInList {
+			err = fmt.Errorf("account %s is already in list %s", targetAccountID, listID)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+
+		// Account is eligible to be added to this list, generate entry.
+		entryID, err := id.NewULID()
+		if err != nil {
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		listEntries = append(listEntries, &gtsmodel.ListEntry{
+			ID:       entryID,
+			ListID:   listID,
+			FollowID: follow.ID,
+		})
+	}
+
+	// If we get to here we can assume all
+	// entries are valid, so try to add them.
+	if err := p.db.PutListEntries(ctx, listEntries); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err = fmt.Errorf("one or more errors inserting list entries: %w", err)
+			return gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	// Statuses from the newly added accounts won't be in the
+	// indexed list timeline yet, so have it rebuilt on next get.
+	p.listTimelines.RemoveTimeline(ctx, list.ID)
+
+	return nil
+}
+
+func (p *processor) RemoveFromList(ctx context.Context, account *gtsmodel.Account, listID string, targetAccountIDs []string) gtserror.WithCode {
+	// Ensure this list exists + account owns it.
+	list, errWithCode := p.getList(ctx, account.ID, listID)
+	if errWithCode != nil {
+		return errWithCode
+	}
+
+	// For each targetAccountID, we want to check if
+	// a follow with that targetAccountID is in the
+	// given list. If it is in there, we want to remove
+	// it from the list.
+	for _, targetAccountID := range targetAccountIDs {
+		// Check if targetAccount is followed.
+		follow := &gtsmodel.Follow{}
+		if err := p.db.GetWhere(ctx, []db.Where{
+			{Key: "account_id", Value: account.ID},
+			{Key: "target_account_id", Value: targetAccountID},
+		}, follow); err != nil {
+			if errors.Is(err, db.ErrNoEntries) {
+				// Not followed so can't
+				// be in list, so skip.
+				continue
+			}
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		// Get list entries for this follow, and
+		// remove the one pointing to this list.
+		listEntries, err := p.db.GetListEntriesForFollowID(ctx, follow.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return gtserror.NewErrorInternalError(err)
+		}
+
+		for _, listEntry := range listEntries {
+			if listEntry.ListID != list.ID {
+				continue
+			}
+
+			if err := p.db.DeleteListEntry(ctx, listEntry.ID); err != nil {
+				return gtserror.NewErrorInternalError(err)
+			}
+		}
+
+		// Account is no longer in the list, so
+		// remove its posts from the list timeline.
+		if err := p.listTimelines.WipeItemsFromAccountID(ctx, list.ID, targetAccountID); err != nil {
+			return gtserror.NewErrorInternalError(err)
+		}
+	}
+
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
@@ -44,18 +45,44 @@ func (p *processor) NotificationsGet(ctx context.Context, authed *oauth.Auth, ex
 	nextMaxIDValue := ""
 	prevMinIDValue := ""
 	for i, n := range notifs {
+		// page on notification IDs, regardless of
+		// whether the notification ends up being shown
+		if i == count-1 {
+			nextMaxIDValue = n.ID
+		}
+
+		if i == 0 {
+			prevMinIDValue = n.ID
+		}
+
+		var filterResults []apimodel.FilterResult
+		if n.StatusID != "" {
+			status, err := p.db.GetStatusByID(ctx, n.StatusID)
+			if err != nil {
+				log.Debugf("got an error getting status of notification, will skip it: %s", err)
+				continue
+			}
+
+			var hide bool
+			filterResults, hide, err = p.statusFilter.StatusFilterResults(ctx, status, authed.Account, gtsmodel.FilterContextNotifications)
+			if err != nil {
+				log.Debugf("got an error filtering status of notification, will skip it: %s", err)
+				continue
+			}
+
+			if hide {
+				continue
+			}
+		}
+
 		item, err := p.tc.NotificationToAPINotification(ctx, n)
 		if err != nil {
 			log.Debugf("got an error converting a notification to api, will skip it: %s", err)
 			continue
 		}
 
-		if i == count-1 {
-			nextMaxIDValue = item.GetID()
-		}
-
-		if i == 0 {
-			prevMinIDValue = item.GetID()
+		if item.Status != nil {
+			item.Status.Filtered = filterResults
 		}
 
 		items = append(items, item)
@@ -0,0 +1,64 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (p *processor) CloseExpired(ctx context.Context) error {
+	now := time.Now()
+
+	polls, err := p.db.GetPollsExpiredBefore(ctx, now)
+	if err != nil {
+		return fmt.Errorf("CloseExpired: db error getting expired polls: %s", err)
+	}
+
+	for _, poll := range polls {
+		status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+		if err != nil {
+			return fmt.Errorf("CloseExpired: db error getting status for poll %s: %s", poll.ID, err)
+		}
+
+		poll.ClosedAt = now
+		if err := p.db.UpdatePoll(ctx, poll, "closed_at"); err != nil {
+			return fmt.Errorf("CloseExpired: db error closing poll %s: %s", poll.ID, err)
+		}
+		poll.Status = status
+		status.Poll = poll
+
+		// send it back to the processor for async processing,
+		// ie., notifying voters, and federating the final results
+		p.clientWorker.Queue(messages.FromClientAPI{
+			APObjectType:   ap.ActivityQuestion,
+			APActivityType: ap.ActivityUpdate,
+			GTSModel:       status,
+			OriginAccount:  status.Account,
+			TraceContext:   trace.SpanContextFromContext(ctx),
+		})
+	}
+
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type PollCloseTestSuite struct {
+	PollStandardTestSuite
+}
+
+func (suite *PollCloseTestSuite) TestCloseExpired() {
+	ctx := context.Background()
+
+	// expire the test poll
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+	dbPoll, err := suite.db.GetPollByID(ctx, testPoll.ID)
+	suite.NoError(err)
+	dbPoll.ExpiresAt = time.Now().Add(-1 * time.Minute)
+	suite.NoError(suite.db.UpdatePoll(ctx, dbPoll, "expires_at"))
+
+	suite.NoError(suite.poll.CloseExpired(ctx))
+
+	// the poll should now be closed
+	dbPoll, err = suite.db.GetPollByID(ctx, testPoll.ID)
+	suite.NoError(err)
+	suite.True(dbPoll.Closed())
+
+	// and should have been sent on for notifying + federating
+	select {
+	case msg := <-suite.clientMsgs:
+		suite.Equal(ap.ActivityQuestion, msg.APObjectType)
+		suite.Equal(ap.ActivityUpdate, msg.APActivityType)
+		status, ok := msg.GTSModel.(*gtsmodel.Status)
+		suite.True(ok)
+		suite.Equal(testPoll.StatusID, status.ID)
+		suite.Equal(suite.testAccounts["local_account_2"].ID, msg.OriginAccount.ID)
+	case <-time.After(5 * time.Second):
+		suite.FailNow("timed out waiting for poll close message")
+	}
+
+	// closing again should be a no-op
+	suite.NoError(suite.poll.CloseExpired(ctx))
+	select {
+	case msg := <-suite.clientMsgs:
+		suite.FailNow("unexpected message", "%+v", msg)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func (suite *PollCloseTestSuite) TestCloseExpiredNothingExpired() {
+	ctx := context.Background()
+
+	suite.NoError(suite.poll.CloseExpired(ctx))
+
+	testPoll := suite.testPolls["local_account_2_status_7_poll"]
+	dbPoll, err := suite.db.GetPollByID(ctx, testPoll.ID)
+	suite.NoError(err)
+	suite.False(dbPoll.Closed())
+}
+
+func TestPollCloseTestSuite(t *testing.T) {
+	suite.Run(t, &PollCloseTestSuite{})
+}
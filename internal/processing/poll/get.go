@@ -0,0 +1,72 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Get(ctx context.Context, requestingAccount *gtsmodel.Account, pollID string) (*apimodel.Poll, gtserror.WithCode) {
+	poll, errWithCode := p.getVisiblePoll(ctx, requestingAccount, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiPoll, err := p.tc.PollToAPIPoll(ctx, requestingAccount, poll)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting poll %s to frontend representation: %s", poll.ID, err))
+	}
+
+	return apiPoll, nil
+}
+
+// getVisiblePoll fetches the poll with the given ID, populated with its
+// status, and checks that the status is visible to the requesting account.
+func (p *processor) getVisiblePoll(ctx context.Context, requestingAccount *gtsmodel.Account, pollID string) (*gtsmodel.Poll, gtserror.WithCode) {
+	poll, err := p.db.GetPollByID(ctx, pollID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting poll %s: %s", pollID, err))
+	}
+
+	status, err := p.db.GetStatusByID(ctx, poll.StatusID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting status for poll %s: %s", pollID, err))
+	}
+
+	visible, err := p.filter.StatusVisible(ctx, status, requestingAccount)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error seeing if status %s is visible: %s", status.ID, err))
+	}
+	if !visible {
+		return nil, gtserror.NewErrorNotFound(errors.New("poll status is not visible"))
+	}
+
+	poll.Status = status
+	return poll, nil
+}
@@ -0,0 +1,79 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+)
+
+// closeInterval is the interval at which
+// expired polls are checked for and closed.
+const closeInterval = 1 * time.Minute
+
+// Processor wraps a bunch of functions for processing polls and poll votes.
+type Processor interface {
+	// Get returns the poll with the given ID, if it's visible to the requesting account.
+	Get(ctx context.Context, requestingAccount *gtsmodel.Account, pollID string) (*apimodel.Poll, gtserror.WithCode)
+	// Vote casts a vote by the requesting account for the given choices in the poll with the given ID, returning the updated poll.
+	Vote(ctx context.Context, requestingAccount *gtsmodel.Account, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode)
+	// CloseExpired closes all polls which have expired, and queues them for notification of their voters.
+	CloseExpired(ctx context.Context) error
+	// Start starts closing expired polls at regular intervals.
+	Start() error
+	// Stop stops closing expired polls.
+	Stop() error
+}
+
+type processor struct {
+	db           db.DB
+	tc           typeutils.TypeConverter
+	filter       visibility.Filter
+	clientWorker *concurrency.WorkerPool[messages.FromClientAPI]
+	closer       *concurrency.TickerWorker
+}
+
+// New returns a new poll processor.
+func New(db db.DB, tc typeutils.TypeConverter, clientWorker *concurrency.WorkerPool[messages.FromClientAPI]) Processor {
+	p := &processor{
+		db:           db,
+		tc:           tc,
+		filter:       visibility.NewFilter(db),
+		clientWorker: clientWorker,
+	}
+	p.closer = concurrency.NewTickerWorker("closing expired polls", closeInterval, p.CloseExpired)
+	return p
+}
+
+func (p *processor) Start() error {
+	return p.closer.Start()
+}
+
+func (p *processor) Stop() error {
+	return p.closer.Stop()
+}
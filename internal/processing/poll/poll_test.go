@@ -0,0 +1,77 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/poll"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type PollStandardTestSuite struct {
+	suite.Suite
+	db            db.DB
+	typeConverter typeutils.TypeConverter
+	clientWorker  *concurrency.WorkerPool[messages.FromClientAPI]
+	clientMsgs    chan messages.FromClientAPI
+
+	// standard suite models
+	testAccounts map[string]*gtsmodel.Account
+	testStatuses map[string]*gtsmodel.Status
+	testPolls    map[string]*gtsmodel.Poll
+
+	// module being tested
+	poll poll.Processor
+}
+
+func (suite *PollStandardTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
+	suite.testPolls = testrig.NewTestPolls()
+}
+
+func (suite *PollStandardTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB()
+	suite.typeConverter = testrig.NewTestTypeConverter(suite.db)
+	suite.clientWorker = concurrency.NewWorkerPool[messages.FromClientAPI](-1, -1)
+	suite.clientMsgs = make(chan messages.FromClientAPI, 16)
+	suite.poll = poll.New(suite.db, suite.typeConverter, suite.clientWorker)
+	suite.clientWorker.SetProcessor(func(ctx context.Context, msg messages.FromClientAPI) error {
+		suite.clientMsgs <- msg
+		return nil
+	})
+	suite.NoError(suite.clientWorker.Start())
+
+	testrig.StandardDBSetup(suite.db, suite.testAccounts)
+}
+
+func (suite *PollStandardTestSuite) TearDownTest() {
+	suite.NoError(suite.clientWorker.Stop())
+	testrig.StandardDBTeardown(suite.db)
+}
@@ -0,0 +1,123 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package poll
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func (p *processor) Vote(ctx context.Context, requestingAccount *gtsmodel.Account, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode) {
+	poll, errWithCode := p.getVisiblePoll(ctx, requestingAccount, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if poll.Status.AccountID == requestingAccount.ID {
+		err := errors.New("you can't vote in your own poll")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if poll.Closed() || poll.Expired(time.Now()) {
+		err := errors.New("poll has already ended")
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	if len(choices) == 0 {
+		err := errors.New("no choices provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if len(choices) > 1 && (poll.Multiple == nil || !*poll.Multiple) {
+		err := errors.New("poll only allows one choice")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	seen := make(map[int]struct{}, len(choices))
+	for _, choice := range choices {
+		if choice < 0 || choice >= len(poll.Options) {
+			err := fmt.Errorf("choice %d is not a valid poll option", choice)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+
+		if _, ok := seen[choice]; ok {
+			err := fmt.Errorf("choice %d was provided more than once", choice)
+			return nil, gtserror.NewErrorBadRequest(err, err.Error())
+		}
+		seen[choice] = struct{}{}
+	}
+
+	voteID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	vote := &gtsmodel.PollVote{
+		ID:        voteID,
+		CreatedAt: time.Now(),
+		Choices:   choices,
+		AccountID: requestingAccount.ID,
+		Account:   requestingAccount,
+		PollID:    poll.ID,
+		Poll:      poll,
+	}
+
+	if err := p.db.PutPollVote(ctx, vote); err != nil {
+		if errors.Is(err, db.ErrAlreadyExists) {
+			err := errors.New("you have already voted in this poll")
+			return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting poll vote: %s", err))
+	}
+
+	// send it back to the processor for async processing,
+	// ie., federating the vote to the author of a remote poll
+	p.clientWorker.Queue(messages.FromClientAPI{
+		APObjectType:   ap.ActivityQuestion,
+		APActivityType: ap.ActivityCreate,
+		GTSModel:       vote,
+		OriginAccount:  requestingAccount,
+		TargetAccount:  poll.Status.Account,
+		TraceContext:   trace.SpanContextFromContext(ctx),
+	})
+
+	// refetch the poll to get the updated vote counts
+	poll, errWithCode = p.getVisiblePoll(ctx, requestingAccount, pollID)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	apiPoll, err := p.tc.PollToAPIPoll(ctx, requestingAccount, poll)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting poll %s to frontend representation: %s", poll.ID, err))
+	}
+
+	return apiPoll, nil
+}
@@ -36,17 +36,29 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/account"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/admin"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/conversation"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/export"
 	federationProcessor "github.com/superseriousbusiness/gotosocial/internal/processing/federation"
+	filterProcessor "github.com/superseriousbusiness/gotosocial/internal/processing/filter"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/invite"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/list"
 	mediaProcessor "github.com/superseriousbusiness/gotosocial/internal/processing/media"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/poll"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/push"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/report"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/scheduledstatus"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/streaming"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/tag"
 	"github.com/superseriousbusiness/gotosocial/internal/processing/user"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/stream"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/oauth2/v4"
 )
 
@@ -89,6 +101,10 @@ type Processor interface {
 	AccountGetRSSFeedForUsername(ctx context.Context, username string) (func() (string, gtserror.WithCode), time.Time, gtserror.WithCode)
 	// AccountUpdate processes the update of an account with the given form
 	AccountUpdate(ctx context.Context, authed *oauth.Auth, form *apimodel.UpdateCredentialsRequest) (*apimodel.Account, gtserror.WithCode)
+	// AccountAlias processes setting the aliases of the authed account with the given form.
+	AccountAlias(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountAliasRequest) (*apimodel.Account, gtserror.WithCode)
+	// AccountMove processes moving the authed account to another account with the given form.
+	AccountMove(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMoveRequest) (*apimodel.Account, gtserror.WithCode)
 	// AccountStatusesGet fetches a number of statuses (in time descending order) from the given account, filtered by visibility for
 	// the account given in authed.
 	AccountStatusesGet(ctx context.Context, authed *oauth.Auth, targetAccountID string, limit int, excludeReplies bool, excludeReblogs bool, maxID string, minID string, pinned bool, mediaOnly bool, publicOnly bool) (*apimodel.PageableResponse, gtserror.WithCode)
@@ -99,6 +115,8 @@ type Processor interface {
 	AccountFollowersGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
 	// AccountFollowingGet fetches a list of the accounts that target account is following.
 	AccountFollowingGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) ([]apimodel.Account, gtserror.WithCode)
+	// AccountDirectoryGet returns a page of discoverable accounts for the profile directory.
+	AccountDirectoryGet(ctx context.Context, authed *oauth.Auth, local bool, newest bool, offset int, limit int) ([]apimodel.Account, gtserror.WithCode)
 	// AccountRelationshipGet returns a relationship model describing the relationship of the targetAccount to the Authed account.
 	AccountRelationshipGet(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// AccountFollowCreate handles a follow request to an account, either remote or local.
@@ -109,9 +127,29 @@ type Processor interface {
 	AccountBlockCreate(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
 	// AccountBlockRemove handles the removal of a block from authed account to target account, either remote or local.
 	AccountBlockRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// AccountMuteCreate handles the creation or updating of a mute from authed account to target account.
+	AccountMuteCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountMuteRequest) (*apimodel.Relationship, gtserror.WithCode)
+	// AccountMuteRemove handles the removal of a mute from authed account to target account.
+	AccountMuteRemove(ctx context.Context, authed *oauth.Auth, targetAccountID string) (*apimodel.Relationship, gtserror.WithCode)
+	// AccountExportCreate requests a new export of all the data of the authed account, which will be built asynchronously.
+	AccountExportCreate(ctx context.Context, authed *oauth.Auth) (*apimodel.AccountExport, gtserror.WithCode)
+	// AccountExportsGet returns all exports of the authed account, newest first.
+	AccountExportsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.AccountExport, gtserror.WithCode)
+	// AccountExportArchiveGet returns the zip archive of the export with the given ID, if it's owned by the authed account.
+	AccountExportArchiveGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Content, gtserror.WithCode)
+	// AccountImport asynchronously imports follows or blocks for the authed account from the CSV file in the given form.
+	AccountImport(ctx context.Context, authed *oauth.Auth, form *apimodel.AccountImportRequest) gtserror.WithCode
 
 	// AdminAccountAction handles the creation/execution of an action on an account.
 	AdminAccountAction(ctx context.Context, authed *oauth.Auth, form *apimodel.AdminAccountActionRequest) gtserror.WithCode
+	// AdminAccountActionUndo handles reversing a previous action on an account, eg., unsilencing it.
+	AdminAccountActionUndo(ctx context.Context, authed *oauth.Auth, form *apimodel.AdminAccountActionRequest) (*apimodel.AdminAccountInfo, gtserror.WithCode)
+	// AdminAccountsGet returns a page of the admin view of local accounts with the given status, eg., those pending approval.
+	AdminAccountsGet(ctx context.Context, authed *oauth.Auth, status string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// AdminAccountApprove approves the pending sign-up of the given account.
+	AdminAccountApprove(ctx context.Context, authed *oauth.Auth, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
+	// AdminAccountReject rejects the pending sign-up of the given account, and deletes it.
+	AdminAccountReject(ctx context.Context, authed *oauth.Auth, targetAccountID string, message string) (*apimodel.AdminAccountInfo, gtserror.WithCode)
 	// AdminEmojiCreate handles the creation of a new instance emoji by an admin, using the given form.
 	AdminEmojiCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.EmojiCreateRequest) (*apimodel.Emoji, gtserror.WithCode)
 	// AdminEmojisGet allows admins to view emojis based on various filters.
@@ -126,6 +164,16 @@ type Processor interface {
 	AdminEmojiUpdate(ctx context.Context, id string, form *apimodel.EmojiUpdateRequest) (*apimodel.AdminEmoji, gtserror.WithCode)
 	// AdminEmojiCategoriesGet gets a list of all existing emoji categories.
 	AdminEmojiCategoriesGet(ctx context.Context) ([]*apimodel.EmojiCategory, gtserror.WithCode)
+	// AdminDomainAllowCreate handles the creation of a new domain allow by an admin, using the given form.
+	AdminDomainAllowCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainAllowCreateRequest) (*apimodel.DomainAllow, gtserror.WithCode)
+	// AdminDomainAllowsImport handles the import of multiple domain allows by an admin, using the given form.
+	AdminDomainAllowsImport(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainAllowCreateRequest) ([]*apimodel.DomainAllow, gtserror.WithCode)
+	// AdminDomainAllowsGet returns a list of currently allowed domains.
+	AdminDomainAllowsGet(ctx context.Context, authed *oauth.Auth, export bool) ([]*apimodel.DomainAllow, gtserror.WithCode)
+	// AdminDomainAllowGet returns one domain allow, specified by ID.
+	AdminDomainAllowGet(ctx context.Context, authed *oauth.Auth, id string, export bool) (*apimodel.DomainAllow, gtserror.WithCode)
+	// AdminDomainAllowDelete deletes one domain allow, specified by ID, returning the deleted domain allow.
+	AdminDomainAllowDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainAllow, gtserror.WithCode)
 	// AdminDomainBlockCreate handles the creation of a new domain block by an admin, using the given form.
 	AdminDomainBlockCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainBlockCreateRequest) (*apimodel.DomainBlock, gtserror.WithCode)
 	// AdminDomainBlocksImport handles the import of multiple domain blocks by an admin, using the given form.
@@ -136,6 +184,27 @@ type Processor interface {
 	AdminDomainBlockGet(ctx context.Context, authed *oauth.Auth, id string, export bool) (*apimodel.DomainBlock, gtserror.WithCode)
 	// AdminDomainBlockDelete deletes one domain block, specified by ID, returning the deleted domain block.
 	AdminDomainBlockDelete(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainBlock, gtserror.WithCode)
+	// AdminDomainBlockSubscriptionCreate creates a new domain block subscription, and syncs it right away.
+	AdminDomainBlockSubscriptionCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.DomainBlockSubscriptionCreateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	// AdminDomainBlockSubscriptionsGet returns all domain block subscriptions.
+	AdminDomainBlockSubscriptionsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	// AdminDomainBlockSubscriptionGet returns one domain block subscription, specified by ID.
+	AdminDomainBlockSubscriptionGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	// AdminDomainBlockSubscriptionUpdate updates one domain block subscription, specified by ID, using the given form.
+	AdminDomainBlockSubscriptionUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.DomainBlockSubscriptionUpdateRequest) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	// AdminDomainBlockSubscriptionDelete deletes one domain block subscription, specified by ID. If removeBlocks
+	// is true, the domain blocks it created are removed too; otherwise they're kept as unowned blocks.
+	AdminDomainBlockSubscriptionDelete(ctx context.Context, authed *oauth.Auth, id string, removeBlocks bool) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	// AdminDomainBlockSubscriptionSync fetches the blocklist of one domain block subscription, specified by ID, and syncs domain blocks with it.
+	AdminDomainBlockSubscriptionSync(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.DomainBlockSubscription, gtserror.WithCode)
+	// AdminDeliveriesGet returns a page of queued federation deliveries, optionally only those for the given domain.
+	AdminDeliveriesGet(ctx context.Context, authed *oauth.Auth, domain string, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// AdminDeliveriesPurge removes queued federation deliveries for the given domain (or all if domain is empty).
+	AdminDeliveriesPurge(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.AdminDeliveriesPurgeResponse, gtserror.WithCode)
+	// AdminInstancesGet returns the admin view of known remote instances, optionally only those which are currently failing.
+	AdminInstancesGet(ctx context.Context, authed *oauth.Auth, failing bool) ([]*apimodel.AdminInstance, gtserror.WithCode)
+	// AdminInstanceGet returns the admin view of one remote instance, specified by domain.
+	AdminInstanceGet(ctx context.Context, authed *oauth.Auth, domain string) (*apimodel.AdminInstance, gtserror.WithCode)
 	// AdminMediaRemotePrune triggers a prune of remote media according to the given number of mediaRemoteCacheDays
 	AdminMediaPrune(ctx context.Context, mediaRemoteCacheDays int) gtserror.WithCode
 	// AdminMediaRefetch triggers a refetch of remote media for the given domain (or all if domain is empty).
@@ -154,6 +223,14 @@ type Processor interface {
 	// BlocksGet returns a list of accounts blocked by the requesting account.
 	BlocksGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.BlocksResponse, gtserror.WithCode)
 
+	// ConversationsGet returns a page of direct message conversations belonging to the authed account.
+	ConversationsGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ConversationRead marks the conversation with the given ID as read, if it belongs to the authed account.
+	ConversationRead(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Conversation, gtserror.WithCode)
+	// ConversationDelete removes the conversation with the given ID from the authed account's conversations.
+	// The statuses in the conversation are not deleted.
+	ConversationDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+
 	// CustomEmojisGet returns an array of info about the custom emojis on this server
 	CustomEmojisGet(ctx context.Context) ([]*apimodel.Emoji, gtserror.WithCode)
 
@@ -178,6 +255,65 @@ type Processor interface {
 	// It should already be ascertained that the requesting account is authenticated and an admin.
 	InstancePatch(ctx context.Context, form *apimodel.InstanceSettingsUpdateRequest) (*apimodel.Instance, gtserror.WithCode)
 
+	// FiltersV1Get returns all keyword filters owned by the authed account, in v1 format.
+	FiltersV1Get(ctx context.Context, authed *oauth.Auth) ([]*apimodel.Filter, gtserror.WithCode)
+	// FilterV1Get returns one keyword filter owned by the authed account, in v1 format.
+	FilterV1Get(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Filter, gtserror.WithCode)
+	// FilterV1Create creates a new keyword filter for the authed account, and returns it in v1 format.
+	FilterV1Create(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// FilterV1Update updates one keyword filter owned by the authed account, and returns it in v1 format.
+	FilterV1Update(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterCreateUpdateRequestV1) (*apimodel.Filter, gtserror.WithCode)
+	// FilterV1Delete deletes one keyword filter owned by the authed account.
+	FilterV1Delete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// FiltersV2Get returns all filters owned by the authed account, in v2 format.
+	FiltersV2Get(ctx context.Context, authed *oauth.Auth) ([]*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Get returns one filter owned by the authed account, in v2 format.
+	FilterV2Get(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Create creates a new filter (and optionally its keywords) for the authed account.
+	FilterV2Create(ctx context.Context, authed *oauth.Auth, form *apimodel.FilterCreateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Update updates one filter owned by the authed account.
+	FilterV2Update(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterUpdateRequestV2) (*apimodel.FilterV2, gtserror.WithCode)
+	// FilterV2Delete deletes one filter owned by the authed account, along with all of its keywords.
+	FilterV2Delete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// FilterKeywordsGet returns all keywords of one filter owned by the authed account.
+	FilterKeywordsGet(ctx context.Context, authed *oauth.Auth, filterID string) ([]*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordGet returns one filter keyword owned by the authed account.
+	FilterKeywordGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordCreate adds a keyword to one filter owned by the authed account.
+	FilterKeywordCreate(ctx context.Context, authed *oauth.Auth, filterID string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordUpdate updates one filter keyword owned by the authed account.
+	FilterKeywordUpdate(ctx context.Context, authed *oauth.Auth, id string, form *apimodel.FilterKeywordCreateUpdateRequest) (*apimodel.FilterKeyword, gtserror.WithCode)
+	// FilterKeywordDelete deletes one filter keyword owned by the authed account.
+	FilterKeywordDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+
+	// ListCreate creates a new list for the authed account, with the given title and replies policy.
+	ListCreate(ctx context.Context, authed *oauth.Auth, title string, repliesPolicy gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode)
+	// ListGet returns one list owned by the authed account, specified by id.
+	ListGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.List, gtserror.WithCode)
+	// ListsGet returns all lists owned by the authed account.
+	ListsGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.List, gtserror.WithCode)
+	// ListUpdate updates the title and/or replies policy of one list owned by the authed account.
+	ListUpdate(ctx context.Context, authed *oauth.Auth, id string, title *string, repliesPolicy *gtsmodel.RepliesPolicy) (*apimodel.List, gtserror.WithCode)
+	// ListDelete deletes one list owned by the authed account, along with all of its entries.
+	ListDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+	// ListAccountsGet returns a pageable response of accounts contained in the given list.
+	ListAccountsGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ListAccountsAdd adds the given (followed) accounts to the given list.
+	ListAccountsAdd(ctx context.Context, authed *oauth.Auth, listID string, targetAccountIDs []string) gtserror.WithCode
+	// ListAccountsRemove removes the given accounts from the given list.
+	ListAccountsRemove(ctx context.Context, authed *oauth.Auth, listID string, targetAccountIDs []string) gtserror.WithCode
+
+	// InviteCreate creates a new invite code for the authed account, if invites are enabled and the account may create them.
+	InviteCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.InviteCreateRequest) (*apimodel.Invite, gtserror.WithCode)
+	// InvitesGet returns all invites created by the authed account.
+	InvitesGet(ctx context.Context, authed *oauth.Auth) ([]*apimodel.Invite, gtserror.WithCode)
+	// InviteExpire makes one invite created by the authed account, specified by id, unusable from now on.
+	InviteExpire(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.Invite, gtserror.WithCode)
+	// InviteGetUsable returns the invite with the given code, if it can still be used to sign up, for serving on the web.
+	InviteGetUsable(ctx context.Context, code string) (*gtsmodel.Invite, gtserror.WithCode)
+	// InviteSignup creates a new user and account with the invite with the given code, bypassing closed registrations.
+	InviteSignup(ctx context.Context, code string, form *apimodel.AccountCreateRequest) (*gtsmodel.User, gtserror.WithCode)
+
 	// MediaCreate handles the creation of a media attachment, using the given form.
 	MediaCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AttachmentRequest) (*apimodel.Attachment, gtserror.WithCode)
 	// MediaGet handles the GET of a media attachment with the given ID
@@ -185,11 +321,39 @@ type Processor interface {
 	// MediaUpdate handles the PUT of a media attachment with the given ID and form
 	MediaUpdate(ctx context.Context, authed *oauth.Auth, attachmentID string, form *apimodel.AttachmentUpdateRequest) (*apimodel.Attachment, gtserror.WithCode)
 
+	// MutesGet returns a list of accounts muted by the requesting account.
+	MutesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+
 	// NotificationsGet
 	NotificationsGet(ctx context.Context, authed *oauth.Auth, excludeTypes []string, limit int, maxID string, sinceID string) (*apimodel.PageableResponse, gtserror.WithCode)
 	// NotificationsClear
 	NotificationsClear(ctx context.Context, authed *oauth.Auth) gtserror.WithCode
 
+	// PollGet returns the poll with the given ID, if it's visible to the authed account.
+	PollGet(ctx context.Context, authed *oauth.Auth, pollID string) (*apimodel.Poll, gtserror.WithCode)
+	// PollVote casts a vote by the authed account for the given choices in the poll with the given ID.
+	PollVote(ctx context.Context, authed *oauth.Auth, pollID string, choices []int) (*apimodel.Poll, gtserror.WithCode)
+
+	// PushSubscriptionGet returns the Web Push subscription of the authed token.
+	PushSubscriptionGet(ctx context.Context, authed *oauth.Auth) (*apimodel.PushSubscription, gtserror.WithCode)
+	// PushSubscriptionCreate creates a Web Push subscription for the authed token, replacing any existing one.
+	PushSubscriptionCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// PushSubscriptionUpdate updates the alerts of the Web Push subscription of the authed token.
+	PushSubscriptionUpdate(ctx context.Context, authed *oauth.Auth, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// PushSubscriptionDelete removes the Web Push subscription of the authed token.
+	PushSubscriptionDelete(ctx context.Context, authed *oauth.Auth) gtserror.WithCode
+
+	// ScheduledStatusCreate schedules a new status for the authed account, using the given form.
+	ScheduledStatusCreate(ctx context.Context, authed *oauth.Auth, form *apimodel.AdvancedStatusCreateForm) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// ScheduledStatusGet returns the scheduled status with the given ID, if it's owned by the authed account.
+	ScheduledStatusGet(ctx context.Context, authed *oauth.Auth, id string) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// ScheduledStatusesGet returns a page of scheduled statuses owned by the authed account.
+	ScheduledStatusesGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ScheduledStatusUpdate moves the scheduled status with the given ID to the given scheduled time.
+	ScheduledStatusUpdate(ctx context.Context, authed *oauth.Auth, id string, scheduledAt string) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// ScheduledStatusDelete cancels the scheduled status with the given ID.
+	ScheduledStatusDelete(ctx context.Context, authed *oauth.Auth, id string) gtserror.WithCode
+
 	OAuthHandleTokenRequest(r *http.Request) (map[string]interface{}, gtserror.WithCode)
 	OAuthHandleAuthorizeRequest(w http.ResponseWriter, r *http.Request) gtserror.WithCode
 	OAuthValidateBearerToken(r *http.Request) (oauth2.TokenInfo, error)
@@ -217,15 +381,40 @@ type Processor interface {
 	StatusUnfave(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// StatusGetContext returns the context (previous and following posts) from the given status ID
 	StatusGetContext(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Context, gtserror.WithCode)
+	// StatusEdit processes the edit of a given status, returning the updated status if the edit goes through.
+	StatusEdit(ctx context.Context, authed *oauth.Auth, targetStatusID string, form *apimodel.StatusEditRequest) (*apimodel.Status, gtserror.WithCode)
+	// StatusHistoryGet returns all revisions of the given status, oldest first, taking account of privacy settings and blocks etc.
+	StatusHistoryGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) ([]*apimodel.StatusEdit, gtserror.WithCode)
+	// StatusSourceGet returns the plain-text source of the given status, for editing.
+	StatusSourceGet(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.StatusSource, gtserror.WithCode)
 	// StatusBookmark process a bookmark for a status
 	StatusBookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
 	// StatusUnbookmark removes a bookmark for a status
 	StatusUnbookmark(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusMute mutes notifications from the thread that the given status belongs to, for the requesting account.
+	StatusMute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+	// StatusUnmute unmutes the thread that the given status belongs to, for the requesting account.
+	StatusUnmute(ctx context.Context, authed *oauth.Auth, targetStatusID string) (*apimodel.Status, gtserror.WithCode)
+
+	// TagGet returns the tag with the given name, marked with whether or not the authed account follows it.
+	TagGet(ctx context.Context, authed *oauth.Auth, name string) (*apimodel.Tag, gtserror.WithCode)
+	// TagFollow makes the authed account follow the tag with the given name.
+	TagFollow(ctx context.Context, authed *oauth.Auth, name string) (*apimodel.Tag, gtserror.WithCode)
+	// TagUnfollow makes the authed account stop following the tag with the given name.
+	TagUnfollow(ctx context.Context, authed *oauth.Auth, name string) (*apimodel.Tag, gtserror.WithCode)
+	// FollowedTagsGet returns a pageable response of tags followed by the authed account.
+	FollowedTagsGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// TagWebStatusesGet returns public statuses by local accounts using the tag with the given name, for serving on the web.
+	TagWebStatusesGet(ctx context.Context, name string, maxID string) (*apimodel.Tag, *apimodel.PageableResponse, gtserror.WithCode)
 
 	// HomeTimelineGet returns statuses from the home timeline, with the given filters/parameters.
 	HomeTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
 	// PublicTimelineGet returns statuses from the public/local timeline, with the given filters/parameters.
 	PublicTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
+	// ListTimelineGet returns statuses from the list timeline with the given listID, with the given filters/parameters.
+	ListTimelineGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// TagTimelineGet returns public statuses using the tag with the given name, with the given filters/parameters.
+	TagTimelineGet(ctx context.Context, authed *oauth.Auth, name string, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode)
 	// FavedTimelineGet returns faved statuses, with the given filters/parameters.
 	FavedTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
 
@@ -304,21 +493,33 @@ type processor struct {
 	mediaManager    media.Manager
 	storage         *storage.Driver
 	statusTimelines timeline.Manager
+	listTimelines   timeline.Manager
 	db              db.DB
 	filter          visibility.Filter
+	statusFilter    statusfilter.Filter
+	webPushSender   webpush.Sender
 
 	/*
 		SUB-PROCESSORS
 	*/
 
-	accountProcessor    account.Processor
-	adminProcessor      admin.Processor
-	statusProcessor     status.Processor
-	streamingProcessor  streaming.Processor
-	mediaProcessor      mediaProcessor.Processor
-	userProcessor       user.Processor
-	federationProcessor federationProcessor.Processor
-	reportProcessor     report.Processor
+	accountProcessor      account.Processor
+	adminProcessor        admin.Processor
+	conversationProcessor conversation.Processor
+	exportProcessor       export.Processor
+	filterProcessor       filterProcessor.Processor
+	inviteProcessor       invite.Processor
+	listProcessor         list.Processor
+	pollProcessor         poll.Processor
+	pushProcessor         push.Processor
+	scheduledProcessor    scheduledstatus.Processor
+	statusProcessor       status.Processor
+	streamingProcessor    streaming.Processor
+	tagProcessor          tag.Processor
+	mediaProcessor        mediaProcessor.Processor
+	userProcessor         user.Processor
+	federationProcessor   federationProcessor.Processor
+	reportProcessor       report.Processor
 }
 
 // NewProcessor returns a new Processor.
@@ -330,6 +531,8 @@ func NewProcessor(
 	storage *storage.Driver,
 	db db.DB,
 	emailSender email.Sender,
+	webPushSender webpush.Sender,
+	relmeVerifier relme.Verifier,
 	clientWorker *concurrency.WorkerPool[messages.FromClientAPI],
 	fedWorker *concurrency.WorkerPool[messages.FromFederator],
 ) Processor {
@@ -337,13 +540,25 @@ func NewProcessor(
 
 	statusProcessor := status.New(db, tc, clientWorker, parseMentionFunc)
 	streamingProcessor := streaming.New(db, oauthServer)
-	accountProcessor := account.New(db, tc, mediaManager, oauthServer, clientWorker, federator, parseMentionFunc)
+	accountProcessor := account.New(db, tc, mediaManager, oauthServer, clientWorker, federator, parseMentionFunc, relmeVerifier)
 	adminProcessor := admin.New(db, tc, mediaManager, federator.TransportController(), storage, clientWorker)
 	mediaProcessor := mediaProcessor.New(db, tc, mediaManager, federator.TransportController(), storage)
 	userProcessor := user.New(db, emailSender)
 	federationProcessor := federationProcessor.New(db, tc, federator)
 	reportProcessor := report.New(db, tc, clientWorker)
+	pollProcessor := poll.New(db, tc, clientWorker)
+	pushProcessor := push.New(db, tc)
+	scheduledProcessor := scheduledstatus.New(db, tc, statusProcessor)
+	conversationProcessor := conversation.New(db, tc)
+	exportProcessor := export.New(db, tc, storage, federator, accountProcessor)
 	filter := visibility.NewFilter(db)
+	statusFilter := statusfilter.NewFilter(db, tc)
+	listTimelines := timeline.NewManager(ListGrabFunction(db), ListFilterFunction(db, filter), ListPrepareFunction(db, tc, statusFilter), StatusSkipInsertFunction())
+	listProcessor := list.New(db, tc, listTimelines)
+	statusTimelines := timeline.NewManager(StatusGrabFunction(db), StatusFilterFunction(db, filter), StatusPrepareFunction(db, tc, statusFilter), StatusSkipInsertFunction())
+	filterProcessor := filterProcessor.New(db, tc, statusTimelines, listTimelines)
+	tagProcessor := tag.New(db, tc, statusTimelines)
+	inviteProcessor := invite.New(db, tc, clientWorker)
 
 	return &processor{
 		clientWorker: clientWorker,
@@ -354,18 +569,30 @@ func NewProcessor(
 		oauthServer:     oauthServer,
 		mediaManager:    mediaManager,
 		storage:         storage,
-		statusTimelines: timeline.NewManager(StatusGrabFunction(db), StatusFilterFunction(db, filter), StatusPrepareFunction(db, tc), StatusSkipInsertFunction()),
+		statusTimelines: statusTimelines,
+		listTimelines:   listTimelines,
 		db:              db,
 		filter:          visibility.NewFilter(db),
-
-		accountProcessor:    accountProcessor,
-		adminProcessor:      adminProcessor,
-		statusProcessor:     statusProcessor,
-		streamingProcessor:  streamingProcessor,
-		mediaProcessor:      mediaProcessor,
-		userProcessor:       userProcessor,
-		federationProcessor: federationProcessor,
-		reportProcessor:     reportProcessor,
+		statusFilter:    statusFilter,
+		webPushSender:   webPushSender,
+
+		accountProcessor:      accountProcessor,
+		adminProcessor:        adminProcessor,
+		conversationProcessor: conversationProcessor,
+		exportProcessor:       exportProcessor,
+		filterProcessor:       filterProcessor,
+		inviteProcessor:       inviteProcessor,
+		listProcessor:         listProcessor,
+		pollProcessor:         pollProcessor,
+		pushProcessor:         pushProcessor,
+		scheduledProcessor:    scheduledProcessor,
+		statusProcessor:       statusProcessor,
+		streamingProcessor:    streamingProcessor,
+		tagProcessor:          tagProcessor,
+		mediaProcessor:        mediaProcessor,
+		userProcessor:         userProcessor,
+		federationProcessor:   federationProcessor,
+		reportProcessor:       reportProcessor,
 	}
 }
 
@@ -388,11 +615,42 @@ func (p *processor) Start() error {
 		return err
 	}
 
+	// Start list timelines
+	if err := p.listTimelines.Start(); err != nil {
+		return err
+	}
+
+	// Start closing expired polls
+	if err := p.pollProcessor.Start(); err != nil {
+		return err
+	}
+
+	// Start publishing scheduled statuses
+	if err := p.scheduledProcessor.Start(); err != nil {
+		return err
+	}
+
+	// Start syncing domain block subscriptions
+	if err := p.adminProcessor.Start(); err != nil {
+		return err
+	}
+
+	// Start re-verifying remote profile fields
+	if err := p.accountProcessor.Start(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 // Stop stops the processor cleanly, finishing handling any remaining messages before closing down.
 func (p *processor) Stop() error {
+	// Stop publishing scheduled statuses first,
+	// so nothing new is queued on the client worker
+	if err := p.scheduledProcessor.Stop(); err != nil {
+		return err
+	}
+
 	if err := p.clientWorker.Stop(); err != nil {
 		return err
 	}
@@ -405,5 +663,21 @@ func (p *processor) Stop() error {
 		return err
 	}
 
+	if err := p.listTimelines.Stop(); err != nil {
+		return err
+	}
+
+	if err := p.pollProcessor.Stop(); err != nil {
+		return err
+	}
+
+	if err := p.adminProcessor.Stop(); err != nil {
+		return err
+	}
+
+	if err := p.accountProcessor.Stop(); err != nil {
+		return err
+	}
+
 	return nil
 }
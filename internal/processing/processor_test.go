@@ -32,6 +32,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/storage"
 	"github.com/superseriousbusiness/gotosocial/internal/transport"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
 	"github.com/superseriousbusiness/gotosocial/testrig"
 )
 
@@ -47,20 +48,25 @@ type ProcessingStandardTestSuite struct {
 	federator           federation.Federator
 	oauthServer         oauth.Server
 	emailSender         email.Sender
+	sentEmails          map[string]string
+	webPushSender       webpush.Sender
+	sentPushes          map[string]string
 
 	// standard suite models
-	testTokens       map[string]*gtsmodel.Token
-	testClients      map[string]*gtsmodel.Client
-	testApplications map[string]*gtsmodel.Application
-	testUsers        map[string]*gtsmodel.User
-	testAccounts     map[string]*gtsmodel.Account
-	testAttachments  map[string]*gtsmodel.MediaAttachment
-	testStatuses     map[string]*gtsmodel.Status
-	testTags         map[string]*gtsmodel.Tag
-	testMentions     map[string]*gtsmodel.Mention
-	testAutheds      map[string]*oauth.Auth
-	testBlocks       map[string]*gtsmodel.Block
-	testActivities   map[string]testrig.ActivityWithSignature
+	testTokens        map[string]*gtsmodel.Token
+	testClients       map[string]*gtsmodel.Client
+	testApplications  map[string]*gtsmodel.Application
+	testUsers         map[string]*gtsmodel.User
+	testAccounts      map[string]*gtsmodel.Account
+	testAttachments   map[string]*gtsmodel.MediaAttachment
+	testStatuses      map[string]*gtsmodel.Status
+	testTags          map[string]*gtsmodel.Tag
+	testMentions      map[string]*gtsmodel.Mention
+	testAutheds       map[string]*oauth.Auth
+	testBlocks        map[string]*gtsmodel.Block
+	testActivities    map[string]testrig.ActivityWithSignature
+	testConversations map[string]*gtsmodel.Conversation
+	testReports       map[string]*gtsmodel.Report
 
 	processor processing.Processor
 }
@@ -83,6 +89,8 @@ func (suite *ProcessingStandardTestSuite) SetupSuite() {
 		},
 	}
 	suite.testBlocks = testrig.NewTestBlocks()
+	suite.testConversations = testrig.NewTestConversations()
+	suite.testReports = testrig.NewTestReports()
 }
 
 func (suite *ProcessingStandardTestSuite) SetupTest() {
@@ -102,9 +110,12 @@ func (suite *ProcessingStandardTestSuite) SetupTest() {
 	suite.mediaManager = testrig.NewTestMediaManager(suite.db, suite.storage)
 	suite.federator = testrig.NewTestFederator(suite.db, suite.transportController, suite.storage, suite.mediaManager, fedWorker)
 	suite.oauthServer = testrig.NewTestOauthServer(suite.db)
-	suite.emailSender = testrig.NewEmailSender("../../web/template/", nil)
+	suite.sentEmails = make(map[string]string)
+	suite.emailSender = testrig.NewEmailSender("../../web/template/", suite.sentEmails)
+	suite.sentPushes = make(map[string]string)
+	suite.webPushSender = testrig.NewWebPushSender(suite.db, suite.sentPushes)
 
-	suite.processor = processing.NewProcessor(suite.typeconverter, suite.federator, suite.oauthServer, suite.mediaManager, suite.storage, suite.db, suite.emailSender, clientWorker, fedWorker)
+	suite.processor = processing.NewProcessor(suite.typeconverter, suite.federator, suite.oauthServer, suite.mediaManager, suite.storage, suite.db, suite.emailSender, suite.webPushSender, testrig.NewRelMeVerifier(nil), clientWorker, fedWorker)
 
 	testrig.StandardDBSetup(suite.db, suite.testAccounts)
 	testrig.StandardStorageSetup(suite.storage, "../../testrig/media")
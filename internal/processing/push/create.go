@@ -0,0 +1,139 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+)
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	if form.Subscription == nil {
+		err := errors.New("no subscription provided")
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := validateEndpoint(form.Subscription.Endpoint); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	if err := webpush.ValidateKeys(form.Subscription.Keys.P256dh, form.Subscription.Keys.Auth); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	token, errWithCode := p.getToken(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	subscriptionID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	// alerts which aren't set are disabled
+	alertDefault := false
+	subscription := &gtsmodel.WebPushSubscription{
+		ID:                 subscriptionID,
+		AccountID:          account.ID,
+		TokenID:            token.ID,
+		Endpoint:           form.Subscription.Endpoint,
+		Auth:               form.Subscription.Keys.Auth,
+		P256dh:             form.Subscription.Keys.P256dh,
+		AlertFollow:        &alertDefault,
+		AlertFollowRequest: &alertDefault,
+		AlertFavourite:     &alertDefault,
+		AlertMention:       &alertDefault,
+		AlertReblog:        &alertDefault,
+		AlertPoll:          &alertDefault,
+		AlertStatus:        &alertDefault,
+	}
+
+	if form.Data != nil {
+		applyAlerts(subscription, form.Data.Alerts)
+	}
+
+	if err := p.db.PutWebPushSubscription(ctx, subscription); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error putting push subscription: %s", err))
+	}
+
+	return p.apiPushSubscription(ctx, subscription)
+}
+
+// validateEndpoint checks that the given push endpoint is an absolute http(s) URL.
+func validateEndpoint(endpoint string) error {
+	if endpoint == "" {
+		return errors.New("no subscription endpoint provided")
+	}
+
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("subscription endpoint %s could not be parsed: %s", endpoint, err)
+	}
+
+	if endpointURL.Scheme != "https" && endpointURL.Scheme != "http" {
+		return fmt.Errorf("subscription endpoint %s is not an http(s) URL", endpoint)
+	}
+
+	if endpointURL.Host == "" {
+		return fmt.Errorf("subscription endpoint %s has no host", endpoint)
+	}
+
+	return nil
+}
+
+// applyAlerts sets the alerts of the given subscription to those which
+// are set in alerts, and returns the names of the columns which changed.
+func applyAlerts(subscription *gtsmodel.WebPushSubscription, alerts *apimodel.PushSubscriptionRequestAlerts) []string {
+	if alerts == nil {
+		return nil
+	}
+
+	columns := []string{}
+	for _, a := range []struct {
+		column string
+		field  **bool
+		value  *bool
+	}{
+		{"alert_follow", &subscription.AlertFollow, alerts.Follow},
+		{"alert_follow_request", &subscription.AlertFollowRequest, alerts.FollowRequest},
+		{"alert_favourite", &subscription.AlertFavourite, alerts.Favourite},
+		{"alert_mention", &subscription.AlertMention, alerts.Mention},
+		{"alert_reblog", &subscription.AlertReblog, alerts.Reblog},
+		{"alert_poll", &subscription.AlertPoll, alerts.Poll},
+		{"alert_status", &subscription.AlertStatus, alerts.Status},
+	} {
+		if a.value == nil {
+			continue
+		}
+		value := *a.value
+		*a.field = &value
+		columns = append(columns, a.column)
+	}
+
+	return columns
+}
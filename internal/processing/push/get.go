@@ -0,0 +1,72 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, accessToken string) (*apimodel.PushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	subscription, err := p.db.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err, "no push subscription exists for this access token")
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting push subscription: %s", err))
+	}
+
+	if subscription.AccountID != account.ID {
+		err := errors.New("push subscription does not belong to this account")
+		return nil, gtserror.NewErrorNotFound(err, "no push subscription exists for this access token")
+	}
+
+	return p.apiPushSubscription(ctx, subscription)
+}
+
+// getToken fetches the OAuth token with the given access token.
+func (p *processor) getToken(ctx context.Context, accessToken string) (*gtsmodel.Token, gtserror.WithCode) {
+	token := &gtsmodel.Token{}
+	if err := p.db.GetWhere(ctx, []db.Where{{Key: "access", Value: accessToken}}, token); err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorUnauthorized(err, "access token not found")
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting token: %s", err))
+	}
+	return token, nil
+}
+
+func (p *processor) apiPushSubscription(ctx context.Context, subscription *gtsmodel.WebPushSubscription) (*apimodel.PushSubscription, gtserror.WithCode) {
+	apiSubscription, err := p.tc.WebPushSubscriptionToAPIPushSubscription(ctx, subscription)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting push subscription %s to frontend representation: %s", subscription.ID, err))
+	}
+	return apiSubscription, nil
+}
@@ -0,0 +1,57 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Processor wraps a bunch of functions for processing Web Push subscriptions.
+//
+// Each OAuth token can have at most one Web Push subscription,
+// so subscriptions are looked up by the access token of the request.
+type Processor interface {
+	// Get returns the Web Push subscription of the given access token.
+	Get(ctx context.Context, account *gtsmodel.Account, accessToken string) (*apimodel.PushSubscription, gtserror.WithCode)
+	// Create creates a new Web Push subscription for the given access token, replacing any existing one.
+	Create(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionCreateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// Update updates the alerts of the Web Push subscription of the given access token.
+	Update(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode)
+	// Delete removes the Web Push subscription of the given access token, if it has one.
+	Delete(ctx context.Context, account *gtsmodel.Account, accessToken string) gtserror.WithCode
+}
+
+type processor struct {
+	db db.DB
+	tc typeutils.TypeConverter
+}
+
+// New returns a new push processor.
+func New(db db.DB, tc typeutils.TypeConverter) Processor {
+	return &processor{
+		db: db,
+		tc: tc,
+	}
+}
@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (p *processor) Update(ctx context.Context, account *gtsmodel.Account, accessToken string, form *apimodel.PushSubscriptionUpdateRequest) (*apimodel.PushSubscription, gtserror.WithCode) {
+	token, errWithCode := p.getToken(ctx, accessToken)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	subscription, err := p.db.GetWebPushSubscriptionByTokenID(ctx, token.ID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err, "no push subscription exists for this access token")
+		}
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error getting push subscription: %s", err))
+	}
+
+	if subscription.AccountID != account.ID {
+		err := errors.New("push subscription does not belong to this account")
+		return nil, gtserror.NewErrorNotFound(err, "no push subscription exists for this access token")
+	}
+
+	if form.Data == nil {
+		// nothing to update
+		return p.apiPushSubscription(ctx, subscription)
+	}
+
+	if columns := applyAlerts(subscription, form.Data.Alerts); len(columns) != 0 {
+		if err := p.db.UpdateWebPushSubscription(ctx, subscription, columns...); err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error updating push subscription: %s", err))
+		}
+	}
+
+	return p.apiPushSubscription(ctx, subscription)
+}
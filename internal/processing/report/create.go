@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, form *apimodel.ReportCreateRequest) (*apimodel.Report, gtserror.WithCode) {
@@ -92,6 +93,7 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, form
 		GTSModel:       report,
 		OriginAccount:  account,
 		TargetAccount:  targetAccount,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	apiReport, err := p.tc.ReportToAPIReport(ctx, report)
@@ -0,0 +1,148 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+)
+
+func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.ScheduledStatus, gtserror.WithCode) {
+	scheduledAt, errWithCode := p.parseScheduledAt(ctx, account.ID, form.ScheduledAt, nil)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	// Run the parts of the form which can be checked
+	// now through the usual status processing, so that
+	// the caller finds out about problems straight away
+	// rather than when the status comes to be published.
+	// The processed status itself is thrown away.
+	checkStatus := &gtsmodel.Status{}
+
+	if errWithCode := p.statusProcessor.ProcessReplyToID(ctx, form, account.ID, checkStatus); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if errWithCode := p.statusProcessor.ProcessMediaIDs(ctx, form, account.ID, checkStatus); errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.statusProcessor.ProcessVisibility(ctx, form, account.Privacy, checkStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	scheduledStatusID, err := id.NewULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	sensitive := form.Sensitive
+	scheduledStatus := &gtsmodel.ScheduledStatus{
+		ID:            scheduledStatusID,
+		AccountID:     account.ID,
+		Account:       account,
+		ScheduledAt:   scheduledAt,
+		Text:          form.Status,
+		SpoilerText:   form.SpoilerText,
+		Sensitive:     &sensitive,
+		Visibility:    checkStatus.Visibility,
+		Language:      form.Language,
+		Format:        string(form.Format),
+		InReplyToID:   form.InReplyToID,
+		AttachmentIDs: checkStatus.AttachmentIDs,
+		Attachments:   checkStatus.Attachments,
+		Federated:     form.Federated,
+		Boostable:     form.Boostable,
+		Replyable:     form.Replyable,
+		Likeable:      form.Likeable,
+		ApplicationID: application.ID,
+		Application:   application,
+	}
+
+	if form.Poll != nil {
+		multiple := form.Poll.Multiple
+		hideTotals := form.Poll.HideTotals
+		scheduledStatus.PollOptions = form.Poll.Options
+		scheduledStatus.PollExpiresIn = form.Poll.ExpiresIn
+		scheduledStatus.PollMultiple = &multiple
+		scheduledStatus.PollHideTotals = &hideTotals
+	}
+
+	if err := p.db.PutScheduledStatus(ctx, scheduledStatus); err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("Create: error putting scheduled status: %w", err))
+	}
+
+	return p.apiScheduledStatus(ctx, scheduledStatus)
+}
+
+// parseScheduledAt parses the given scheduled time, and makes sure that
+// the given account is allowed to schedule a status for that time. If an
+// existing scheduled status is being rescheduled, it should be passed as
+// existing, so that it isn't counted against the account's limits.
+func (p *processor) parseScheduledAt(ctx context.Context, accountID string, scheduledAtString string, existing *gtsmodel.ScheduledStatus) (time.Time, gtserror.WithCode) {
+	scheduledAt, err := time.Parse(time.RFC3339, scheduledAtString)
+	if err != nil {
+		err = fmt.Errorf("scheduled_at %s could not be parsed as an ISO 8601 datetime: %w", scheduledAtString, err)
+		return time.Time{}, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+	scheduledAt = scheduledAt.UTC()
+
+	if scheduledAt.Before(time.Now().Add(minimumScheduleOffset)) {
+		err := fmt.Errorf("scheduled_at must be at least %d minutes in the future", int(minimumScheduleOffset.Minutes()))
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	total, err := p.db.CountScheduledStatusesForAccountID(ctx, accountID, time.Time{}, time.Time{})
+	if err != nil {
+		return time.Time{}, gtserror.NewErrorInternalError(fmt.Errorf("error counting scheduled statuses: %w", err))
+	}
+
+	if existing != nil {
+		total--
+	}
+
+	if total >= totalLimit {
+		err := fmt.Errorf("you cannot have more than %d scheduled statuses", totalLimit)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	dayStart := scheduledAt.Truncate(24 * time.Hour)
+	daily, err := p.db.CountScheduledStatusesForAccountID(ctx, accountID, dayStart, dayStart.Add(24*time.Hour))
+	if err != nil {
+		return time.Time{}, gtserror.NewErrorInternalError(fmt.Errorf("error counting scheduled statuses: %w", err))
+	}
+
+	if existing != nil && !existing.ScheduledAt.Before(dayStart) && existing.ScheduledAt.Before(dayStart.Add(24*time.Hour)) {
+		daily--
+	}
+
+	if daily >= dailyLimit {
+		err := fmt.Errorf("you cannot schedule more than %d statuses for the same day", dailyLimit)
+		return time.Time{}, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+
+	return scheduledAt, nil
+}
@@ -0,0 +1,125 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type ScheduledStatusCreateTestSuite struct {
+	ScheduledStatusStandardTestSuite
+}
+
+func (suite *ScheduledStatusCreateTestSuite) TestCreateScheduledStatus() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testApplication := suite.testApplications["application_1"]
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+	scheduledAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this is a status from an hour in the future",
+			MediaIDs:    []string{testAttachment.ID},
+			SpoilerText: "time travel",
+			Visibility:  apimodel.VisibilityUnlisted,
+			ScheduledAt: scheduledAt.Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(ctx, testAccount, testApplication, form)
+	suite.NoError(errWithCode)
+	suite.NotEmpty(apiScheduledStatus.ID)
+	suite.Equal(scheduledAt.Format("2006-01-02T15:04:05.000Z"), apiScheduledStatus.ScheduledAt)
+	suite.Equal("this is a status from an hour in the future", apiScheduledStatus.Params.Text)
+	suite.Equal("time travel", apiScheduledStatus.Params.SpoilerText)
+	suite.Equal("unlisted", apiScheduledStatus.Params.Visibility)
+	suite.Equal(testApplication.ID, apiScheduledStatus.Params.ApplicationID)
+	suite.Len(apiScheduledStatus.MediaAttachments, 1)
+	suite.Equal(testAttachment.ID, apiScheduledStatus.MediaAttachments[0].ID)
+
+	// the attachment should now be taken
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Equal(apiScheduledStatus.ID, dbAttachment.ScheduledStatusID)
+
+	// nothing should have been published yet
+	select {
+	case msg := <-suite.clientMsgs:
+		suite.FailNow("unexpected message", "%+v", msg)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func (suite *ScheduledStatusCreateTestSuite) TestCreateScheduledStatusTooSoon() {
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "this is a status from a minute in the future",
+			ScheduledAt: time.Now().Add(time.Minute).Format(time.RFC3339),
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(context.Background(), suite.testAccounts["local_account_1"], suite.testApplications["application_1"], form)
+	suite.Nil(apiScheduledStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+	suite.Equal("Unprocessable Entity: scheduled_at must be at least 5 minutes in the future", errWithCode.Safe())
+}
+
+func (suite *ScheduledStatusCreateTestSuite) TestCreateScheduledStatusBadDate() {
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      "when is this??",
+			ScheduledAt: "next tuesday",
+		},
+	}
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Create(context.Background(), suite.testAccounts["local_account_1"], suite.testApplications["application_1"], form)
+	suite.Nil(apiScheduledStatus)
+	suite.Equal(http.StatusBadRequest, errWithCode.Code())
+}
+
+func (suite *ScheduledStatusCreateTestSuite) TestUpdateDeleteScheduledStatus() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testScheduledStatus := suite.testScheduledStatuses["local_account_1_scheduled_status_1"]
+	scheduledAt := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	// other accounts can't see or touch it
+	_, errWithCode := suite.scheduledStatus.Update(ctx, suite.testAccounts["local_account_2"], testScheduledStatus.ID, scheduledAt.Format(time.RFC3339))
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+
+	apiScheduledStatus, errWithCode := suite.scheduledStatus.Update(ctx, testAccount, testScheduledStatus.ID, scheduledAt.Format(time.RFC3339))
+	suite.NoError(errWithCode)
+	suite.Equal(scheduledAt.Format("2006-01-02T15:04:05.000Z"), apiScheduledStatus.ScheduledAt)
+
+	suite.NoError(suite.scheduledStatus.Delete(ctx, testAccount, testScheduledStatus.ID))
+
+	_, errWithCode = suite.scheduledStatus.Get(ctx, testAccount, testScheduledStatus.ID)
+	suite.Equal(http.StatusNotFound, errWithCode.Code())
+}
+
+func TestScheduledStatusCreateTestSuite(t *testing.T) {
+	suite.Run(t, &ScheduledStatusCreateTestSuite{})
+}
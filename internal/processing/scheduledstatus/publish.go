@@ -0,0 +1,104 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (p *processor) PublishDue(ctx context.Context) error {
+	scheduledStatuses, err := p.db.GetScheduledStatusesDueBefore(ctx, time.Now())
+	if err != nil {
+		return fmt.Errorf("PublishDue: db error getting due scheduled statuses: %s", err)
+	}
+
+	for _, scheduledStatus := range scheduledStatuses {
+		// Remove the scheduled status before publishing, so that
+		// it can't be published twice, and so that its attachments
+		// are released to be attached to the status proper.
+		if err := p.db.DeleteScheduledStatusByID(ctx, scheduledStatus.ID); err != nil {
+			return fmt.Errorf("PublishDue: db error deleting scheduled status %s: %s", scheduledStatus.ID, err)
+		}
+
+		if err := p.publish(ctx, scheduledStatus); err != nil {
+			log.Errorf("PublishDue: error publishing scheduled status %s: %s", scheduledStatus.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// publish creates a status from the given scheduled status, using the same
+// processing path as a status created through the client API, so that it's
+// stored, timelined, and federated in the usual way.
+func (p *processor) publish(ctx context.Context, scheduledStatus *gtsmodel.ScheduledStatus) error {
+	account, err := p.db.GetAccountByID(ctx, scheduledStatus.AccountID)
+	if err != nil {
+		return fmt.Errorf("error getting account %s: %w", scheduledStatus.AccountID, err)
+	}
+
+	if !account.SuspendedAt.IsZero() {
+		return fmt.Errorf("account %s is suspended", account.ID)
+	}
+
+	application := &gtsmodel.Application{}
+	if err := p.db.GetByID(ctx, scheduledStatus.ApplicationID, application); err != nil {
+		return fmt.Errorf("error getting application %s: %w", scheduledStatus.ApplicationID, err)
+	}
+
+	form := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:      scheduledStatus.Text,
+			MediaIDs:    scheduledStatus.AttachmentIDs,
+			InReplyToID: scheduledStatus.InReplyToID,
+			Sensitive:   scheduledStatus.Sensitive != nil && *scheduledStatus.Sensitive,
+			SpoilerText: scheduledStatus.SpoilerText,
+			Visibility:  p.tc.VisToAPIVis(ctx, scheduledStatus.Visibility),
+			Language:    scheduledStatus.Language,
+			Format:      apimodel.StatusFormat(scheduledStatus.Format),
+		},
+		AdvancedVisibilityFlagsForm: apimodel.AdvancedVisibilityFlagsForm{
+			Federated: scheduledStatus.Federated,
+			Boostable: scheduledStatus.Boostable,
+			Replyable: scheduledStatus.Replyable,
+			Likeable:  scheduledStatus.Likeable,
+		},
+	}
+
+	if len(scheduledStatus.PollOptions) != 0 {
+		form.Poll = &apimodel.PollRequest{
+			Options:    scheduledStatus.PollOptions,
+			ExpiresIn:  scheduledStatus.PollExpiresIn,
+			Multiple:   scheduledStatus.PollMultiple != nil && *scheduledStatus.PollMultiple,
+			HideTotals: scheduledStatus.PollHideTotals != nil && *scheduledStatus.PollHideTotals,
+		}
+	}
+
+	if _, errWithCode := p.statusProcessor.Create(ctx, account, application, form); errWithCode != nil {
+		return errWithCode
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type ScheduledStatusPublishTestSuite struct {
+	ScheduledStatusStandardTestSuite
+}
+
+func (suite *ScheduledStatusPublishTestSuite) TestPublishDue() {
+	ctx := context.Background()
+	testAccount := suite.testAccounts["local_account_1"]
+	testAttachment := suite.testAttachments["local_account_1_unattached_1"]
+
+	// schedule a status which is already due, ie.,
+	// one that came due while the instance was down
+	scheduledStatus := &gtsmodel.ScheduledStatus{
+		ID:            "01GSM0A1V9PQ0H8SEY6K2H9FQ7",
+		AccountID:     testAccount.ID,
+		ScheduledAt:   time.Now().Add(-1 * time.Minute),
+		Text:          "better late than never",
+		Visibility:    gtsmodel.VisibilityPublic,
+		AttachmentIDs: []string{testAttachment.ID},
+		ApplicationID: suite.testApplications["application_1"].ID,
+	}
+	suite.NoError(suite.db.PutScheduledStatus(ctx, scheduledStatus))
+
+	suite.NoError(suite.scheduledStatus.PublishDue(ctx))
+
+	// the status should have gone through the client API worker
+	var status *gtsmodel.Status
+	select {
+	case msg := <-suite.clientMsgs:
+		suite.Equal(ap.ObjectNote, msg.APObjectType)
+		suite.Equal(ap.ActivityCreate, msg.APActivityType)
+		suite.Equal(testAccount.ID, msg.OriginAccount.ID)
+		var ok bool
+		status, ok = msg.GTSModel.(*gtsmodel.Status)
+		suite.True(ok)
+	case <-time.After(5 * time.Second):
+		suite.FailNow("timed out waiting for status create message")
+	}
+
+	// and be in the database with its attachment
+	dbStatus, err := suite.db.GetStatusByID(ctx, status.ID)
+	suite.NoError(err)
+	suite.Equal("better late than never", dbStatus.Text)
+	suite.Equal(gtsmodel.VisibilityPublic, dbStatus.Visibility)
+	suite.Equal([]string{testAttachment.ID}, dbStatus.AttachmentIDs)
+
+	dbAttachment, err := suite.db.GetAttachmentByID(ctx, testAttachment.ID)
+	suite.NoError(err)
+	suite.Equal(status.ID, dbAttachment.StatusID)
+	suite.Empty(dbAttachment.ScheduledStatusID)
+
+	// the scheduled status should be gone
+	_, err = suite.db.GetScheduledStatusByID(ctx, scheduledStatus.ID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	// while the one scheduled for later should still be there
+	_, err = suite.db.GetScheduledStatusByID(ctx, suite.testScheduledStatuses["local_account_1_scheduled_status_1"].ID)
+	suite.NoError(err)
+
+	// publishing again should be a no-op
+	suite.NoError(suite.scheduledStatus.PublishDue(ctx))
+	select {
+	case msg := <-suite.clientMsgs:
+		suite.FailNow("unexpected message", "%+v", msg)
+	case <-time.After(500 * time.Millisecond):
+	}
+}
+
+func TestScheduledStatusPublishTestSuite(t *testing.T) {
+	suite.Run(t, &ScheduledStatusPublishTestSuite{})
+}
@@ -0,0 +1,100 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus
+
+import (
+	"context"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+const (
+	// publishInterval is the interval at which
+	// due scheduled statuses are checked for and published.
+	publishInterval = 30 * time.Second
+
+	// minimumScheduleOffset is how far in the future
+	// a status must be scheduled for, at minimum.
+	minimumScheduleOffset = 5 * time.Minute
+
+	// totalLimit is the maximum number of statuses
+	// one account can have scheduled at any one time.
+	totalLimit = 300
+
+	// dailyLimit is the maximum number of statuses one
+	// account can have scheduled within any one (UTC) day.
+	dailyLimit = 25
+)
+
+// Processor wraps a bunch of functions for processing scheduled statuses.
+type Processor interface {
+	// Create schedules a new status for the given account, using the given form, returning the api model representation of the scheduled status.
+	Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// Get returns the scheduled status with the given ID, if it's owned by the given account.
+	Get(ctx context.Context, account *gtsmodel.Account, id string) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// GetAll returns a page of scheduled statuses owned by the given account.
+	GetAll(ctx context.Context, account *gtsmodel.Account, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// Update moves the scheduled status with the given ID to the given scheduled time, if it's owned by the given account.
+	Update(ctx context.Context, account *gtsmodel.Account, id string, scheduledAt string) (*apimodel.ScheduledStatus, gtserror.WithCode)
+	// Delete cancels the scheduled status with the given ID, if it's owned by the given account.
+	Delete(ctx context.Context, account *gtsmodel.Account, id string) gtserror.WithCode
+	// PublishDue creates statuses from all scheduled statuses whose scheduled time has passed.
+	PublishDue(ctx context.Context) error
+	// Start starts publishing due scheduled statuses at regular intervals.
+	Start() error
+	// Stop stops publishing due scheduled statuses.
+	Stop() error
+}
+
+type processor struct {
+	db              db.DB
+	tc              typeutils.TypeConverter
+	statusProcessor status.Processor
+	publisher       *concurrency.TickerWorker
+}
+
+// New returns a new scheduled status processor.
+func New(db db.DB, tc typeutils.TypeConverter, statusProcessor status.Processor) Processor {
+	p := &processor{
+		db:              db,
+		tc:              tc,
+		statusProcessor: statusProcessor,
+	}
+	p.publisher = concurrency.NewTickerWorker("publishing scheduled statuses", publishInterval, p.PublishDue)
+
+	// Statuses which came due while we were
+	// shut down should go out straight away.
+	p.publisher.RunOnStart = true
+	return p
+}
+
+func (p *processor) Start() error {
+	return p.publisher.Start()
+}
+
+func (p *processor) Stop() error {
+	return p.publisher.Stop()
+}
@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package scheduledstatus_test
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/scheduledstatus"
+	"github.com/superseriousbusiness/gotosocial/internal/processing/status"
+	"github.com/superseriousbusiness/gotosocial/internal/storage"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type ScheduledStatusStandardTestSuite struct {
+	suite.Suite
+	db            db.DB
+	typeConverter typeutils.TypeConverter
+	storage       *storage.Driver
+	clientWorker  *concurrency.WorkerPool[messages.FromClientAPI]
+	clientMsgs    chan messages.FromClientAPI
+
+	// standard suite models
+	testApplications      map[string]*gtsmodel.Application
+	testAccounts          map[string]*gtsmodel.Account
+	testAttachments       map[string]*gtsmodel.MediaAttachment
+	testScheduledStatuses map[string]*gtsmodel.ScheduledStatus
+
+	// module being tested
+	scheduledStatus scheduledstatus.Processor
+}
+
+func (suite *ScheduledStatusStandardTestSuite) SetupSuite() {
+	suite.testApplications = testrig.NewTestApplications()
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testAttachments = testrig.NewTestAttachments()
+	suite.testScheduledStatuses = testrig.NewTestScheduledStatuses()
+}
+
+func (suite *ScheduledStatusStandardTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+
+	suite.db = testrig.NewTestDB()
+	suite.typeConverter = testrig.NewTestTypeConverter(suite.db)
+	suite.storage = testrig.NewInMemoryStorage()
+	suite.clientWorker = concurrency.NewWorkerPool[messages.FromClientAPI](-1, -1)
+	suite.clientMsgs = make(chan messages.FromClientAPI, 16)
+	suite.clientWorker.SetProcessor(func(ctx context.Context, msg messages.FromClientAPI) error {
+		suite.clientMsgs <- msg
+		return nil
+	})
+	suite.NoError(suite.clientWorker.Start())
+
+	tc := testrig.NewTestTransportController(testrig.NewMockHTTPClient(nil, "../../../testrig/media"), suite.db, fedWorker)
+	mediaManager := testrig.NewTestMediaManager(suite.db, suite.storage)
+	federator := testrig.NewTestFederator(suite.db, tc, suite.storage, mediaManager, fedWorker)
+	statusProcessor := status.New(suite.db, suite.typeConverter, suite.clientWorker, processing.GetParseMentionFunc(suite.db, federator))
+	suite.scheduledStatus = scheduledstatus.New(suite.db, suite.typeConverter, statusProcessor)
+
+	testrig.StandardDBSetup(suite.db, suite.testAccounts)
+	testrig.StandardStorageSetup(suite.storage, "../../../testrig/media")
+}
+
+func (suite *ScheduledStatusStandardTestSuite) TearDownTest() {
+	suite.NoError(suite.clientWorker.Stop())
+	testrig.StandardDBTeardown(suite.db)
+	testrig.StandardStorageTeardown(suite.storage)
+}
@@ -23,10 +23,13 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 	"strings"
+	"unicode"
 
 	"codeberg.org/gruf/go-kv"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/dereferencing"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
@@ -36,6 +39,23 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
+const (
+	// search types, for searching only
+	// for one type of result at a time.
+	searchTypeAccounts = "accounts"
+	searchTypeHashtags = "hashtags"
+	searchTypeStatuses = "statuses"
+
+	// searchTagHistoryDays is the number of days
+	// of usage history to include with hashtags.
+	searchTagHistoryDays = 7
+
+	// searchTextMaxPages is the maximum number of pages
+	// of matching statuses to go through when searching
+	// statuses by text, before giving up.
+	searchTextMaxPages = 5
+)
+
 // Implementation note: in this function, we tend to log errors
 // at debug level rather than return them. This is because the
 // search has a sort of fallthrough logic: if we can't get a result
@@ -63,17 +83,25 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		Hashtags: []apimodel.Tag{},
 	}
 
-	// currently the search will only ever return one result,
-	// so return nothing if the offset is greater than 0
-	if search.Offset > 0 {
-		return searchResult, nil
-	}
-
 	foundAccounts := []*gtsmodel.Account{}
 	foundStatuses := []*gtsmodel.Status{}
+	textStatuses := []*gtsmodel.Status{}
+	foundTags := []*gtsmodel.Tag{}
 
 	var foundOne bool
 
+	// searching by mention or by URI will only ever return one
+	// result, so only bother doing it for the first page of results
+	firstPage := search.Offset == 0 && search.MaxID == "" && search.MinID == ""
+
+	// what kind of results does the caller want?
+	wantAccounts := search.Type == "" || search.Type == searchTypeAccounts
+	wantStatuses := search.Type == "" || search.Type == searchTypeStatuses
+	wantHashtags := search.Type == "" || search.Type == searchTypeHashtags
+
+	uri, err := url.Parse(query)
+	isURI := err == nil && (uri.Scheme == "https" || uri.Scheme == "http")
+
 	/*
 		SEARCH BY MENTION
 		check if the query is something like @whatever_username@example.org -- this means it's likely a remote account
@@ -83,7 +111,7 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		maybeNamestring = "@" + maybeNamestring
 	}
 
-	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); err == nil {
+	if username, domain, err := util.ExtractNamestringParts(maybeNamestring); err == nil && firstPage && wantAccounts {
 		l.Trace("search term is a mention, looking it up...")
 		foundAccount, err := p.searchAccountByMention(ctx, authed, username, domain, search.Resolve)
 		if err != nil {
@@ -92,56 +120,111 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 				// return a proper error only if it wasn't just not retrievable
 				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up account: %w", err))
 			}
-			return searchResult, nil
+		} else {
+			foundAccounts = append(foundAccounts, foundAccount)
+			foundOne = true
+			l.Trace("got an account by searching by mention")
 		}
-
-		foundAccounts = append(foundAccounts, foundAccount)
-		foundOne = true
-		l.Trace("got an account by searching by mention")
 	}
 
 	/*
 		SEARCH BY URI
 		check if the query is a URI with a recognizable scheme and dereference it
 	*/
-	if !foundOne {
-		if uri, err := url.Parse(query); err == nil {
-			if uri.Scheme == "https" || uri.Scheme == "http" {
-				l.Trace("search term is a uri, looking it up...")
-				// check if it's a status...
-				foundStatus, err := p.searchStatusByURI(ctx, authed, uri)
-				if err != nil {
-					var (
-						errNotRetrievable *dereferencing.ErrNotRetrievable
-						errWrongType      *dereferencing.ErrWrongType
-					)
-					if !errors.As(err, &errNotRetrievable) && !errors.As(err, &errWrongType) {
-						return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up status: %w", err))
-					}
-				} else {
-					foundStatuses = append(foundStatuses, foundStatus)
-					foundOne = true
-					l.Trace("got a status by searching by URI")
-				}
+	if !foundOne && firstPage && isURI {
+		l.Trace("search term is a uri, looking it up...")
+		// check if it's a status...
+		foundStatus, err := p.searchStatusByURI(ctx, authed, uri)
+		if err != nil {
+			var (
+				errNotRetrievable *dereferencing.ErrNotRetrievable
+				errWrongType      *dereferencing.ErrWrongType
+			)
+			if !errors.As(err, &errNotRetrievable) && !errors.As(err, &errWrongType) {
+				return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up status: %w", err))
+			}
+		} else {
+			foundStatuses = append(foundStatuses, foundStatus)
+			foundOne = true
+			l.Trace("got a status by searching by URI")
+		}
 
-				// ... or an account
-				if !foundOne {
-					foundAccount, err := p.searchAccountByURI(ctx, authed, uri, search.Resolve)
-					if err != nil {
-						var (
-							errNotRetrievable *dereferencing.ErrNotRetrievable
-							errWrongType      *dereferencing.ErrWrongType
-						)
-						if !errors.As(err, &errNotRetrievable) && !errors.As(err, &errWrongType) {
-							return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up account: %w", err))
-						}
-					} else {
-						foundAccounts = append(foundAccounts, foundAccount)
-						foundOne = true
-						l.Trace("got an account by searching by URI")
-					}
+		// ... or an account
+		if !foundOne {
+			foundAccount, err := p.searchAccountByURI(ctx, authed, uri, search.Resolve)
+			if err != nil {
+				var (
+					errNotRetrievable *dereferencing.ErrNotRetrievable
+					errWrongType      *dereferencing.ErrWrongType
+				)
+				if !errors.As(err, &errNotRetrievable) && !errors.As(err, &errWrongType) {
+					return nil, gtserror.NewErrorInternalError(fmt.Errorf("error looking up account: %w", err))
 				}
+			} else {
+				foundAccounts = append(foundAccounts, foundAccount)
+				foundOne = true
+				l.Trace("got an account by searching by URI")
+			}
+		}
+	}
+
+	/*
+		SEARCH ACCOUNTS BY NAME
+		if the caller wants accounts, look for known accounts with a username or display name starting with the query
+	*/
+	if wantAccounts && !isURI {
+		l.Trace("searching accounts by name...")
+		accounts, err := p.db.SearchForAccounts(ctx, authed.Account.ID, query, search.Following, search.Offset, search.Limit)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error searching accounts: %w", err))
+		}
+
+		for _, account := range accounts {
+			if len(foundAccounts) == search.Limit {
+				break
 			}
+
+			// we may already have this one from the mention lookup
+			if len(foundAccounts) != 0 && foundAccounts[0].ID == account.ID {
+				continue
+			}
+
+			foundAccounts = append(foundAccounts, account)
+			foundOne = true
+		}
+	}
+
+	/*
+		SEARCH HASHTAGS
+		if the caller wants hashtags, and the query could be a hashtag, look for tags starting with the query
+	*/
+	if name := strings.TrimPrefix(query, "#"); wantHashtags && !isURI && isHashtagName(name) {
+		l.Trace("searching hashtags...")
+		foundTags, err = p.db.SearchForTags(ctx, name, search.Offset, search.Limit)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error searching hashtags: %w", err))
+		}
+
+		if len(foundTags) != 0 {
+			foundOne = true
+		}
+	}
+
+	/*
+		SEARCH BY TEXT
+		if the caller wants statuses and we didn't find one by URI, search through the text of statuses on this instance
+	*/
+	if len(foundStatuses) == 0 && wantStatuses {
+		l.Trace("searching statuses by text...")
+		var err error
+		textStatuses, err = p.searchStatusesByText(ctx, authed, query, search)
+		if err != nil {
+			return nil, gtserror.NewErrorInternalError(fmt.Errorf("error searching statuses: %w", err))
+		}
+
+		if len(textStatuses) != 0 {
+			foundOne = true
+			l.Tracef("got %d statuses by searching by text", len(textStatuses))
 		}
 	}
 
@@ -155,6 +238,14 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		FROM HERE ON we have our search results, it's just a matter of filtering them according to what this user is allowed to see,
 		and then converting them into our frontend format.
 	*/
+	if !wantAccounts {
+		foundAccounts = nil
+	}
+
+	if !wantStatuses {
+		foundStatuses = nil
+	}
+
 	for _, foundAccount := range foundAccounts {
 		// make sure there's no block in either direction between the account and the requester
 		blocked, err := p.db.IsBlocked(ctx, authed.Account.ID, foundAccount.ID, true)
@@ -199,9 +290,114 @@ func (p *processor) SearchGet(ctx context.Context, authed *oauth.Auth, search *a
 		searchResult.Statuses = append(searchResult.Statuses, *apiStatus)
 	}
 
+	// statuses found by text have already been filtered for visibility
+	for _, textStatus := range textStatuses {
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, textStatus, authed.Account)
+		if err != nil {
+			err = fmt.Errorf("SearchGet: error converting status %s to api status: %s", textStatus.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		searchResult.Statuses = append(searchResult.Statuses, *apiStatus)
+	}
+
+	for _, foundTag := range foundTags {
+		apiTag, err := p.tc.TagToAPITag(ctx, foundTag)
+		if err != nil {
+			err = fmt.Errorf("SearchGet: error converting tag %s to api tag: %s", foundTag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		history, err := p.db.GetTagHistory(ctx, foundTag.ID, searchTagHistoryDays)
+		if err != nil {
+			err = fmt.Errorf("SearchGet: error getting history of tag %s: %s", foundTag.ID, err)
+			return nil, gtserror.NewErrorInternalError(err)
+		}
+
+		apiTag.History = make([]apimodel.TagHistory, 0, len(history))
+		for _, day := range history {
+			apiTag.History = append(apiTag.History, apimodel.TagHistory{
+				Day:      strconv.FormatInt(day.Day.Unix(), 10),
+				Uses:     strconv.Itoa(day.Uses),
+				Accounts: strconv.Itoa(day.Accounts),
+			})
+		}
+
+		searchResult.Hashtags = append(searchResult.Hashtags, apiTag)
+	}
+
 	return searchResult, nil
 }
 
+// isHashtagName returns true if the given
+// string could be (the start of) a hashtag.
+func isHashtagName(name string) bool {
+	if name == "" {
+		return false
+	}
+
+	for _, r := range name {
+		if !unicode.IsLetter(r) && !unicode.IsNumber(r) && r != '_' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// searchStatusesByText performs a full-text search through local statuses,
+// returning up to search.Limit statuses that are visible to the requester,
+// after skipping the first search.Offset visible statuses.
+func (p *processor) searchStatusesByText(ctx context.Context, authed *oauth.Auth, query string, search *apimodel.SearchQuery) ([]*gtsmodel.Status, error) {
+	var (
+		statuses = make([]*gtsmodel.Status, 0, search.Limit)
+		skip     = search.Offset
+		maxID    = search.MaxID
+	)
+
+	// Some of the matching statuses may not be visible to the requester,
+	// so keep fetching pages until we've got enough visible statuses, or
+	// we've run out of matches. Give up after a few pages though, so that
+	// a search that only matches invisible statuses stays cheap.
+	for i := 0; i < searchTextMaxPages && len(statuses) < search.Limit; i++ {
+		page, err := p.db.SearchForStatuses(ctx, query, search.AccountID, maxID, search.MinID, search.Limit+skip)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, err
+		}
+
+		if len(page) == 0 {
+			// no more matches
+			break
+		}
+
+		// next page starts from the oldest status of this one
+		maxID = page[len(page)-1].ID
+
+		for _, status := range page {
+			visible, err := p.filter.StatusVisible(ctx, status, authed.Account)
+			if err != nil {
+				return nil, fmt.Errorf("error checking visibility of status %s for account %s: %w", status.ID, authed.Account.ID, err)
+			}
+
+			if !visible {
+				continue
+			}
+
+			if skip > 0 {
+				skip--
+				continue
+			}
+
+			statuses = append(statuses, status)
+			if len(statuses) == search.Limit {
+				break
+			}
+		}
+	}
+
+	return statuses, nil
+}
+
 func (p *processor) searchStatusByURI(ctx context.Context, authed *oauth.Auth, uri *url.URL) (*gtsmodel.Status, error) {
 	status, statusable, err := p.federator.GetStatus(transport.WithFastfail(ctx), authed.Account.Username, uri, true, true)
 	if err != nil {
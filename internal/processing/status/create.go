@@ -31,6 +31,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/messages"
 	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
+	"go.opentelemetry.io/otel/trace"
 )
 
 func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, application *gtsmodel.Application, form *apimodel.AdvancedStatusCreateForm) (*apimodel.Status, gtserror.WithCode) {
@@ -68,6 +69,10 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		return nil, errWithCode
 	}
 
+	if errWithCode := p.ProcessPoll(ctx, form, newStatus); errWithCode != nil {
+		return nil, errWithCode
+	}
+
 	if err := p.ProcessVisibility(ctx, form, account.Privacy, newStatus); err != nil {
 		return nil, gtserror.NewErrorInternalError(err)
 	}
@@ -103,6 +108,7 @@ func (p *processor) Create(ctx context.Context, account *gtsmodel.Account, appli
 		APActivityType: ap.ActivityCreate,
 		GTSModel:       newStatus,
 		OriginAccount:  account,
+		TraceContext:   trace.SpanContextFromContext(ctx),
 	})
 
 	// return the frontend representation of the new status to the submitter
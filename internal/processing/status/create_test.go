@@ -21,8 +21,10 @@ package status_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -209,6 +211,52 @@ func (suite *StatusCreateTestSuite) TestProcessMediaDescriptionTooShort() {
 	suite.Nil(apiStatus)
 }
 
+func (suite *StatusCreateTestSuite) TestProcessStatusWithPoll() {
+	ctx := context.Background()
+
+	creatingAccount := suite.testAccounts["local_account_1"]
+	creatingApplication := suite.testApplications["application_1"]
+
+	statusCreateForm := &apimodel.AdvancedStatusCreateForm{
+		StatusCreateRequest: apimodel.StatusCreateRequest{
+			Status:   "which is better?",
+			MediaIDs: []string{},
+			Poll: &apimodel.PollRequest{
+				Options:    []string{"cats", "dogs", "<b>turtles</b>"},
+				ExpiresIn:  3600,
+				Multiple:   true,
+				HideTotals: true,
+			},
+			InReplyToID: "",
+			Sensitive:   false,
+			SpoilerText: "",
+			Visibility:  apimodel.VisibilityPublic,
+			ScheduledAt: "",
+			Language:    "en",
+			Format:      apimodel.StatusFormatPlain,
+		},
+	}
+
+	apiStatus, err := suite.status.Create(ctx, creatingAccount, creatingApplication, statusCreateForm)
+	suite.NoError(err)
+	suite.NotNil(apiStatus)
+	suite.NotNil(apiStatus.Poll)
+	suite.True(apiStatus.Poll.Multiple)
+	suite.False(apiStatus.Poll.Expired)
+	suite.Len(apiStatus.Poll.Options, 3)
+	suite.Equal("turtles", apiStatus.Poll.Options[2].Title)
+
+	// the poll should be stored alongside the status
+	dbStatus, dbErr := suite.db.GetStatusByID(ctx, apiStatus.ID)
+	suite.NoError(dbErr)
+	suite.Equal(ap.ActivityQuestion, dbStatus.ActivityStreamsType)
+	suite.NotNil(dbStatus.Poll)
+	suite.Equal(apiStatus.Poll.ID, dbStatus.PollID)
+	suite.Equal([]int{0, 0, 0}, dbStatus.Poll.Votes)
+	suite.True(*dbStatus.Poll.HideCounts)
+	suite.WithinDuration(dbStatus.CreatedAt.Add(time.Hour), dbStatus.Poll.ExpiresAt, time.Second)
+}
+
 func TestStatusCreateTestSuite(t *testing.T) {
 	suite.Run(t, new(StatusCreateTestSuite))
 }
@@ -0,0 +1,129 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package status_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+)
+
+type StatusEditTestSuite struct {
+	StatusStandardTestSuite
+}
+
+func (suite *StatusEditTestSuite) TestEdit() {
+	ctx := context.Background()
+
+	editingAccount := suite.testAccounts["local_account_1"]
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, editingAccount, targetStatus.ID, &apimodel.StatusEditRequest{
+		Status:      "hello everyone! (edited)",
+		SpoilerText: "edited",
+		Format:      apimodel.StatusFormatPlain,
+	})
+	suite.NoError(errWithCode)
+	suite.Equal(targetStatus.ID, apiStatus.ID)
+	suite.Equal("<p>hello everyone! (edited)</p>", apiStatus.Content)
+	suite.Equal("edited", apiStatus.SpoilerText)
+	suite.Equal("en", *apiStatus.Language)
+	suite.NotNil(apiStatus.EditedAt)
+
+	// the edit history should now contain the original + the edit
+	history, errWithCode := suite.status.HistoryGet(ctx, editingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Len(history, 2)
+	suite.Equal(targetStatus.Content, history[0].Content)
+	suite.Equal(targetStatus.ContentWarning, history[0].SpoilerText)
+	suite.Equal("<p>hello everyone! (edited)</p>", history[1].Content)
+	suite.Equal("edited", history[1].SpoilerText)
+	suite.Equal(editingAccount.ID, history[1].Account.ID)
+
+	source, errWithCode := suite.status.SourceGet(ctx, editingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Equal("hello everyone! (edited)", source.Text)
+	suite.Equal("edited", source.SpoilerText)
+}
+
+func (suite *StatusEditTestSuite) TestEditKeepMedia() {
+	ctx := context.Background()
+
+	editingAccount := suite.testAccounts["admin_account"]
+	targetStatus := suite.testStatuses["admin_account_status_1"]
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, editingAccount, targetStatus.ID, &apimodel.StatusEditRequest{
+		Status:   "hello world! first post on the instance, now with edits!",
+		MediaIDs: targetStatus.AttachmentIDs,
+		Format:   apimodel.StatusFormatPlain,
+	})
+	suite.NoError(errWithCode)
+	suite.Len(apiStatus.MediaAttachments, 1)
+	suite.Equal(targetStatus.AttachmentIDs[0], apiStatus.MediaAttachments[0].ID)
+
+	// the previous revision should still show the media too
+	history, errWithCode := suite.status.HistoryGet(ctx, editingAccount, targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Len(history, 2)
+	suite.Len(history[0].MediaAttachments, 1)
+	suite.Len(history[0].Emojis, 1)
+	suite.Empty(history[1].Emojis)
+}
+
+func (suite *StatusEditTestSuite) TestEditNotOwner() {
+	ctx := context.Background()
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, suite.testAccounts["local_account_2"], suite.testStatuses["local_account_1_status_1"].ID, &apimodel.StatusEditRequest{
+		Status: "this isn't my status",
+	})
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusForbidden, errWithCode.Code())
+}
+
+func (suite *StatusEditTestSuite) TestEditPoll() {
+	ctx := context.Background()
+
+	apiStatus, errWithCode := suite.status.Edit(ctx, suite.testAccounts["local_account_1"], suite.testStatuses["local_account_1_status_1"].ID, &apimodel.StatusEditRequest{
+		Status: "now with a poll",
+		Poll: &apimodel.PollRequest{
+			Options:   []string{"yes", "no"},
+			ExpiresIn: 3600,
+		},
+	})
+	suite.Nil(apiStatus)
+	suite.Equal(http.StatusUnprocessableEntity, errWithCode.Code())
+}
+
+func (suite *StatusEditTestSuite) TestHistoryNoEdits() {
+	ctx := context.Background()
+
+	targetStatus := suite.testStatuses["local_account_1_status_1"]
+
+	history, errWithCode := suite.status.HistoryGet(ctx, suite.testAccounts["local_account_2"], targetStatus.ID)
+	suite.NoError(errWithCode)
+	suite.Len(history, 1)
+	suite.Equal(targetStatus.Content, history[0].Content)
+}
+
+func TestStatusEditTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusEditTestSuite))
+}
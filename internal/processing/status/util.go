@@ -22,13 +22,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -180,7 +184,8 @@ func (p *processor) ProcessMediaIDs(ctx context.Context, form *apimodel.Advanced
 			return gtserror.NewErrorBadRequest(err, err.Error())
 		}
 
-		if attachment.StatusID != "" || attachment.ScheduledStatusID != "" {
+		// media already attached to this status (eg., when editing) is fine
+		if (attachment.StatusID != "" && attachment.StatusID != status.ID) || attachment.ScheduledStatusID != "" {
 			err = fmt.Errorf("ProcessMediaIDs: media with id %s is already attached to a status", mediaID)
 			return gtserror.NewErrorBadRequest(err, err.Error())
 		}
@@ -200,6 +205,42 @@ func (p *processor) ProcessMediaIDs(ctx context.Context, form *apimodel.Advanced
 	return nil
 }
 
+func (p *processor) ProcessPoll(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, status *gtsmodel.Status) gtserror.WithCode {
+	if form.Poll == nil {
+		return nil
+	}
+
+	pollID, err := id.NewULID()
+	if err != nil {
+		return gtserror.NewErrorInternalError(err)
+	}
+
+	options := make([]string, 0, len(form.Poll.Options))
+	for _, option := range form.Poll.Options {
+		options = append(options, text.SanitizePlaintext(option))
+	}
+
+	multiple := form.Poll.Multiple
+	hideCounts := form.Poll.HideTotals
+
+	status.Poll = &gtsmodel.Poll{
+		ID:         pollID,
+		CreatedAt:  status.CreatedAt,
+		UpdatedAt:  status.CreatedAt,
+		Multiple:   &multiple,
+		HideCounts: &hideCounts,
+		Options:    options,
+		Votes:      make([]int, len(options)),
+		StatusID:   status.ID,
+		Status:     status,
+		ExpiresAt:  status.CreatedAt.Add(time.Duration(form.Poll.ExpiresIn) * time.Second),
+	}
+	status.PollID = pollID
+	status.ActivityStreamsType = ap.ActivityQuestion
+
+	return nil
+}
+
 func (p *processor) ProcessLanguage(ctx context.Context, form *apimodel.AdvancedStatusCreateForm, accountDefaultLanguage string, status *gtsmodel.Status) error {
 	if form.Language != "" {
 		status.Language = form.Language
@@ -217,6 +258,18 @@ func (p *processor) ProcessMentions(ctx context.Context, form *apimodel.Advanced
 	mentions := []*gtsmodel.Mention{}
 	mentionIDs := []string{}
 
+	// if the status already has mentions (ie., it's being edited),
+	// we want to reuse them rather than creating new ones
+	existingMentions := []*gtsmodel.Mention{}
+	if len(status.MentionIDs) != 0 {
+		var err error
+		existingMentions, err = p.db.GetMentions(ctx, status.MentionIDs)
+		if err != nil {
+			return fmt.Errorf("ProcessMentions: error getting existing mentions: %s", err)
+		}
+	}
+
+mentionsLoop:
 	for _, mentionedAccountName := range mentionedAccountNames {
 		gtsMention, err := p.parseMention(ctx, mentionedAccountName, accountID, status.ID)
 		if err != nil {
@@ -224,6 +277,14 @@ func (p *processor) ProcessMentions(ctx context.Context, form *apimodel.Advanced
 			continue
 		}
 
+		for _, existing := range existingMentions {
+			if existing.TargetAccountID == gtsMention.TargetAccountID {
+				mentions = append(mentions, existing)
+				mentionIDs = append(mentionIDs, existing.ID)
+				continue mentionsLoop
+			}
+		}
+
 		if err := p.db.Put(ctx, gtsMention); err != nil {
 			log.Errorf("ProcessMentions: error putting mention in db: %s", err)
 		}
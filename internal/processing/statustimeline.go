@@ -22,6 +22,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
@@ -29,9 +30,11 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 )
 
@@ -80,7 +83,7 @@ func StatusFilterFunction(database db.DB, filter visibility.Filter) timeline.Fil
 }
 
 // StatusPrepareFunction returns a function that satisfies the PrepareFunction interface in internal/timeline.
-func StatusPrepareFunction(database db.DB, tc typeutils.TypeConverter) timeline.PrepareFunction {
+func StatusPrepareFunction(database db.DB, tc typeutils.TypeConverter, statusFilter statusfilter.Filter) timeline.PrepareFunction {
 	return func(ctx context.Context, timelineAccountID string, itemID string) (timeline.Preparable, error) {
 		status, err := database.GetStatusByID(ctx, itemID)
 		if err != nil {
@@ -92,10 +95,32 @@ func StatusPrepareFunction(database db.DB, tc typeutils.TypeConverter) timeline.
 			return nil, fmt.Errorf("statusPrepareFunction: error getting account with id %s", timelineAccountID)
 		}
 
-		return tc.StatusToAPIStatus(ctx, status, requestingAccount)
+		return prepareFilteredStatus(ctx, tc, statusFilter, status, requestingAccount)
 	}
 }
 
+// prepareFilteredStatus converts the given status to its api representation for
+// the given account, applying any of the account's keyword filters for the home
+// context. If the status should be hidden, timeline.ErrNotPreparable is returned.
+func prepareFilteredStatus(ctx context.Context, tc typeutils.TypeConverter, statusFilter statusfilter.Filter, status *gtsmodel.Status, requestingAccount *gtsmodel.Account) (timeline.Preparable, error) {
+	filterResults, hide, err := statusFilter.StatusFilterResults(ctx, status, requestingAccount, gtsmodel.FilterContextHome)
+	if err != nil {
+		return nil, fmt.Errorf("prepareFilteredStatus: error filtering status with id %s: %w", status.ID, err)
+	}
+
+	if hide {
+		return nil, timeline.ErrNotPreparable
+	}
+
+	apiStatus, err := tc.StatusToAPIStatus(ctx, status, requestingAccount)
+	if err != nil {
+		return nil, err
+	}
+	apiStatus.Filtered = filterResults
+
+	return apiStatus, nil
+}
+
 // StatusSkipInsertFunction returns a function that satisifes the SkipInsertFunction interface in internal/timeline.
 func StatusSkipInsertFunction() timeline.SkipInsertFunction {
 	return func(
@@ -137,6 +162,109 @@ func StatusSkipInsertFunction() timeline.SkipInsertFunction {
 	}
 }
 
+// ListGrabFunction returns a function that satisfies the GrabFunction interface in internal/timeline.
+// The timelineAccountID passed to it is expected to be the ID of a list, rather than the ID of an account.
+func ListGrabFunction(database db.DB) timeline.GrabFunction {
+	return func(ctx context.Context, listID string, maxID string, sinceID string, minID string, limit int) ([]timeline.Timelineable, bool, error) {
+		statuses, err := database.GetListTimeline(ctx, listID, maxID, sinceID, minID, limit)
+		if err != nil {
+			if err == db.ErrNoEntries {
+				return nil, true, nil // we just don't have enough statuses left in the db so return stop = true
+			}
+			return nil, false, fmt.Errorf("listGrabFunction: error getting statuses from db: %s", err)
+		}
+
+		if len(statuses) == 0 {
+			return nil, true, nil
+		}
+
+		items := []timeline.Timelineable{}
+		for _, s := range statuses {
+			items = append(items, s)
+		}
+
+		return items, false, nil
+	}
+}
+
+// ListFilterFunction returns a function that satisfies the FilterFunction interface in internal/timeline.
+// The timelineAccountID passed to it is expected to be the ID of a list, rather than the ID of an account.
+func ListFilterFunction(database db.DB, filter visibility.Filter) timeline.FilterFunction {
+	return func(ctx context.Context, listID string, item timeline.Timelineable) (shouldIndex bool, err error) {
+		status, ok := item.(*gtsmodel.Status)
+		if !ok {
+			return false, errors.New("listFilterFunction: could not convert item to *gtsmodel.Status")
+		}
+
+		list, err := database.GetListByID(ctx, listID)
+		if err != nil {
+			return false, fmt.Errorf("listFilterFunction: error getting list with id %s: %s", listID, err)
+		}
+
+		requestingAccount, err := database.GetAccountByID(ctx, list.AccountID)
+		if err != nil {
+			return false, fmt.Errorf("listFilterFunction: error getting account with id %s", list.AccountID)
+		}
+
+		timelineable, err := filter.StatusHometimelineable(ctx, status, requestingAccount)
+		if err != nil {
+			log.Warnf("error checking hometimelineability of status %s for list %s: %s", status.ID, listID, err)
+			return false, nil // we don't return the error here because we want to just skip this item if something goes wrong
+		}
+
+		if !timelineable {
+			return false, nil
+		}
+
+		if status.InReplyToAccountID == "" || status.InReplyToAccountID == status.AccountID || status.InReplyToAccountID == list.AccountID {
+			// not a reply to anyone else, so the replies policy doesn't apply
+			return true, nil
+		}
+
+		switch list.RepliesPolicy {
+		case gtsmodel.RepliesPolicyNone:
+			return false, nil
+		case gtsmodel.RepliesPolicyList:
+			included, err := database.ListIncludesAccount(ctx, listID, status.InReplyToAccountID)
+			if err != nil {
+				log.Warnf("error checking whether list %s includes account %s: %s", listID, status.InReplyToAccountID, err)
+				return false, nil
+			}
+			return included, nil
+		default:
+			following, err := database.IsFollowing(ctx, requestingAccount, &gtsmodel.Account{ID: status.InReplyToAccountID})
+			if err != nil {
+				log.Warnf("error checking whether account %s follows account %s: %s", requestingAccount.ID, status.InReplyToAccountID, err)
+				return false, nil
+			}
+			return following, nil
+		}
+	}
+}
+
+// ListPrepareFunction returns a function that satisfies the PrepareFunction interface in internal/timeline.
+// The timelineAccountID passed to it is expected to be the ID of a list, rather than the ID of an account.
+func ListPrepareFunction(database db.DB, tc typeutils.TypeConverter, statusFilter statusfilter.Filter) timeline.PrepareFunction {
+	return func(ctx context.Context, listID string, itemID string) (timeline.Preparable, error) {
+		status, err := database.GetStatusByID(ctx, itemID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting status with id %s", itemID)
+		}
+
+		list, err := database.GetListByID(ctx, listID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting list with id %s", listID)
+		}
+
+		requestingAccount, err := database.GetAccountByID(ctx, list.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("listPrepareFunction: error getting account with id %s", list.AccountID)
+		}
+
+		return prepareFilteredStatus(ctx, tc, statusFilter, status, requestingAccount)
+	}
+}
+
 func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
 	preparedItems, err := p.statusTimelines.GetTimeline(ctx, authed.Account.ID, maxID, sinceID, minID, limit, local)
 	if err != nil {
@@ -172,6 +300,55 @@ func (p *processor) HomeTimelineGet(ctx context.Context, authed *oauth.Auth, max
 	})
 }
 
+func (p *processor) ListTimelineGet(ctx context.Context, authed *oauth.Auth, listID string, maxID string, sinceID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	// Ensure list exists + is owned by this account
+	list, err := p.db.GetListByID(ctx, listID)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil, gtserror.NewErrorNotFound(err)
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if list.AccountID != authed.Account.ID {
+		err = fmt.Errorf("list with id %s does not belong to account %s", list.ID, authed.Account.ID)
+		return nil, gtserror.NewErrorNotFound(err)
+	}
+
+	preparedItems, err := p.listTimelines.GetTimeline(ctx, listID, maxID, sinceID, minID, limit, false)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(preparedItems)
+
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := []interface{}{}
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+	for i, item := range preparedItems {
+		if i == count-1 {
+			nextMaxIDValue = item.GetID()
+		}
+
+		if i == 0 {
+			prevMinIDValue = item.GetID()
+		}
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/timelines/list/" + listID,
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
 func (p *processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
 	statuses, err := p.db.GetPublicTimeline(ctx, maxID, sinceID, minID, limit, local)
 	if err != nil {
@@ -217,6 +394,65 @@ func (p *processor) PublicTimelineGet(ctx context.Context, authed *oauth.Auth, m
 	})
 }
 
+func (p *processor) TagTimelineGet(ctx context.Context, authed *oauth.Auth, name string, maxID string, sinceID string, minID string, limit int, local bool) (*apimodel.PageableResponse, gtserror.WithCode) {
+	name = strings.TrimPrefix(name, "#")
+	if err := validate.TagName(name); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	tag, err := p.db.GetTagByName(ctx, name)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			// nobody has used this tag yet
+			return util.EmptyPageableResponse(), nil
+		}
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	statuses, err := p.db.GetTagTimeline(ctx, tag.ID, maxID, sinceID, minID, limit, local)
+	if err != nil {
+		if err == db.ErrNoEntries {
+			// there are just no entries left
+			return util.EmptyPageableResponse(), nil
+		}
+		// there's an actual error
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	filtered, err := p.filterTagStatuses(ctx, authed, statuses)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(filtered)
+
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := []interface{}{}
+	nextMaxIDValue := ""
+	prevMinIDValue := ""
+	for i, item := range filtered {
+		if i == count-1 {
+			nextMaxIDValue = item.GetID()
+		}
+
+		if i == 0 {
+			prevMinIDValue = item.GetID()
+		}
+		items = append(items, item)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/timelines/tag/" + name,
+		NextMaxIDValue: nextMaxIDValue,
+		PrevMinIDValue: prevMinIDValue,
+		Limit:          limit,
+	})
+}
+
 func (p *processor) FavedTimelineGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
 	statuses, nextMaxID, prevMinID, err := p.db.GetFavedTimeline(ctx, authed.Account.ID, maxID, minID, limit)
 	if err != nil {
@@ -278,6 +514,61 @@ func (p *processor) filterPublicStatuses(ctx context.Context, authed *oauth.Auth
 			continue
 		}
 
+		filterResults, hide, err := p.statusFilter.StatusFilterResults(ctx, s, authed.Account, gtsmodel.FilterContextPublic)
+		if err != nil {
+			log.Debugf("filterPublicStatuses: skipping status %s because of an error checking keyword filters: %s", s.ID, err)
+			continue
+		}
+		if hide {
+			continue
+		}
+		apiStatus.Filtered = filterResults
+
+		apiStatuses = append(apiStatuses, apiStatus)
+	}
+
+	return apiStatuses, nil
+}
+
+// filterTagStatuses is like filterPublicStatuses, but it allows replies,
+// since people often use tags in replies when discussing a topic.
+func (p *processor) filterTagStatuses(ctx context.Context, authed *oauth.Auth, statuses []*gtsmodel.Status) ([]*apimodel.Status, error) {
+	apiStatuses := []*apimodel.Status{}
+	for _, s := range statuses {
+		visible, err := p.filter.StatusVisible(ctx, s, authed.Account)
+		if err != nil {
+			log.Debugf("filterTagStatuses: skipping status %s because of an error checking status visibility: %s", s.ID, err)
+			continue
+		}
+		if !visible {
+			continue
+		}
+
+		muted, err := p.filter.StatusMuted(ctx, s, authed.Account)
+		if err != nil {
+			log.Debugf("filterTagStatuses: skipping status %s because of an error checking mutes: %s", s.ID, err)
+			continue
+		}
+		if muted {
+			continue
+		}
+
+		apiStatus, err := p.tc.StatusToAPIStatus(ctx, s, authed.Account)
+		if err != nil {
+			log.Debugf("filterTagStatuses: skipping status %s because it couldn't be converted to its api representation: %s", s.ID, err)
+			continue
+		}
+
+		filterResults, hide, err := p.statusFilter.StatusFilterResults(ctx, s, authed.Account, gtsmodel.FilterContextPublic)
+		if err != nil {
+			log.Debugf("filterTagStatuses: skipping status %s because of an error checking keyword filters: %s", s.ID, err)
+			continue
+		}
+		if hide {
+			continue
+		}
+		apiStatus.Filtered = filterResults
+
 		apiStatuses = append(apiStatuses, apiStatus)
 	}
 
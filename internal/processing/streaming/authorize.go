@@ -25,6 +25,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
 )
 
 func (p *processor) AuthorizeStreamingRequest(ctx context.Context, accessToken string) (*gtsmodel.Account, gtserror.WithCode) {
@@ -34,6 +35,11 @@ func (p *processor) AuthorizeStreamingRequest(ctx context.Context, accessToken s
 		return nil, gtserror.NewErrorUnauthorized(err)
 	}
 
+	if !oauth.ScopeGrants(ti.GetScope(), oauth.ScopeReadStreaming) {
+		err := fmt.Errorf("token scope %q does not include required scope %q", ti.GetScope(), oauth.ScopeReadStreaming)
+		return nil, gtserror.NewErrorForbidden(err, err.Error())
+	}
+
 	uid := ti.GetUserID()
 	if uid == "" {
 		err := fmt.Errorf("no userid in token")
@@ -0,0 +1,47 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package processing
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/oauth"
+)
+
+func (p *processor) TagGet(ctx context.Context, authed *oauth.Auth, name string) (*apimodel.Tag, gtserror.WithCode) {
+	return p.tagProcessor.Get(ctx, authed.Account, name)
+}
+
+func (p *processor) TagFollow(ctx context.Context, authed *oauth.Auth, name string) (*apimodel.Tag, gtserror.WithCode) {
+	return p.tagProcessor.Follow(ctx, authed.Account, name)
+}
+
+func (p *processor) TagUnfollow(ctx context.Context, authed *oauth.Auth, name string) (*apimodel.Tag, gtserror.WithCode) {
+	return p.tagProcessor.Unfollow(ctx, authed.Account, name)
+}
+
+func (p *processor) FollowedTagsGet(ctx context.Context, authed *oauth.Auth, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	return p.tagProcessor.GetFollowed(ctx, authed.Account, maxID, minID, limit)
+}
+
+func (p *processor) TagWebStatusesGet(ctx context.Context, name string, maxID string) (*apimodel.Tag, *apimodel.PageableResponse, gtserror.WithCode) {
+	return p.tagProcessor.WebStatusesGet(ctx, name, maxID)
+}
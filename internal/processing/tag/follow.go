@@ -0,0 +1,93 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/validate"
+)
+
+func (p *processor) Follow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	name = trimTagName(name)
+	if err := validate.TagName(name); err != nil {
+		return nil, gtserror.NewErrorBadRequest(err, err.Error())
+	}
+
+	// Get the tag if it exists, or create it
+	// if it's never been used on this instance.
+	tags, err := p.db.TagStringsToTags(ctx, []string{name}, account.ID)
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error getting tag %s: %w", name, err))
+	}
+
+	if len(tags) == 0 {
+		// TagStringsToTags drops unuseable tags.
+		err := fmt.Errorf("tag %s is not useable on this instance", name)
+		return nil, gtserror.NewErrorUnprocessableEntity(err, err.Error())
+	}
+	tag := tags[0]
+
+	if err := p.db.Put(ctx, tag); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting tag %s: %w", name, err))
+	}
+
+	followedTagID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	if err := p.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        followedTagID,
+		AccountID: account.ID,
+		TagID:     tag.ID,
+	}); err != nil && !errors.Is(err, db.ErrAlreadyExists) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error putting tag follow: %w", err))
+	}
+
+	// Drop the home timeline so that it's regrabbed,
+	// including recent statuses that use the tag.
+	p.statusTimelines.RemoveTimeline(ctx, account.ID)
+
+	return p.apiTag(ctx, tag, true)
+}
+
+func (p *processor) Unfollow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	if err := p.db.DeleteFollowedTag(ctx, account.ID, tag.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("error deleting tag follow: %w", err))
+	}
+
+	// Drop the home timeline so that it's regrabbed,
+	// without statuses that were only there via the tag.
+	p.statusTimelines.RemoveTimeline(ctx, account.ID)
+
+	return p.apiTag(ctx, tag, false)
+}
@@ -0,0 +1,132 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tag
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
+)
+
+func (p *processor) Get(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, errWithCode
+	}
+
+	following := false
+	if _, err := p.db.GetFollowedTag(ctx, account.ID, tag.ID); err == nil {
+		following = true
+	} else if !errors.Is(err, db.ErrNoEntries) {
+		return nil, gtserror.NewErrorInternalError(fmt.Errorf("db error checking tag follow: %w", err))
+	}
+
+	return p.apiTag(ctx, tag, following)
+}
+
+func (p *processor) GetFollowed(ctx context.Context, account *gtsmodel.Account, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode) {
+	followedTags, err := p.db.GetFollowedTagsForAccountID(ctx, account.ID, maxID, minID, limit)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		err = fmt.Errorf("GetFollowed: error getting followed tags: %w", err)
+		return nil, gtserror.NewErrorInternalError(err)
+	}
+
+	count := len(followedTags)
+	if count == 0 {
+		return util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, followedTag := range followedTags {
+		apiTag, errWithCode := p.apiTag(ctx, followedTag.Tag, true)
+		if errWithCode != nil {
+			log.Debugf("GetFollowed: skipping tag %s: %v", followedTag.TagID, errWithCode)
+			continue
+		}
+
+		items = append(items, apiTag)
+	}
+
+	return util.PackagePageableResponse(util.PageableResponseParams{
+		Items:          items,
+		Path:           "api/v1/followed_tags",
+		NextMaxIDValue: followedTags[count-1].ID,
+		PrevMinIDValue: followedTags[0].ID,
+		Limit:          limit,
+	})
+}
+
+func (p *processor) WebStatusesGet(ctx context.Context, name string, maxID string) (*apimodel.Tag, *apimodel.PageableResponse, gtserror.WithCode) {
+	tag, errWithCode := p.getTag(ctx, name)
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+
+	apiTag, errWithCode := p.apiTag(ctx, tag, false)
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+	// Following makes no sense for a logged-out visitor.
+	apiTag.Following = nil
+
+	statuses, err := p.db.GetTagTimeline(ctx, tag.ID, maxID, "", "", 10, true)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, nil, gtserror.NewErrorInternalError(fmt.Errorf("WebStatusesGet: error getting statuses: %w", err))
+	}
+
+	count := len(statuses)
+	if count == 0 {
+		return apiTag, util.EmptyPageableResponse(), nil
+	}
+
+	items := make([]interface{}, 0, count)
+	for _, s := range statuses {
+		if s.Federated != nil && !*s.Federated {
+			// Local-only statuses aren't shown on the web.
+			continue
+		}
+
+		item, err := p.tc.StatusToAPIStatus(ctx, s, nil)
+		if err != nil {
+			return nil, nil, gtserror.NewErrorInternalError(fmt.Errorf("error converting status to api: %w", err))
+		}
+
+		items = append(items, item)
+	}
+
+	resp, errWithCode := util.PackagePageableResponse(util.PageableResponseParams{
+		Items:            items,
+		Path:             "/tags/" + tag.Name,
+		NextMaxIDValue:   statuses[count-1].ID,
+		PrevMinIDValue:   statuses[0].ID,
+		ExtraQueryParams: []string{},
+	})
+	if errWithCode != nil {
+		return nil, nil, errWithCode
+	}
+
+	return apiTag, resp, nil
+}
@@ -0,0 +1,63 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tag
+
+import (
+	"context"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Processor wraps a bunch of functions for processing hashtags and tag follows.
+type Processor interface {
+	// Get returns the api model of the tag with the given name, marked
+	// with whether or not the given account follows it.
+	Get(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode)
+	// Follow makes the given account follow the tag with the given name,
+	// creating the tag first if we haven't seen it used anywhere yet.
+	Follow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode)
+	// Unfollow makes the given account stop following the tag with the given name.
+	Unfollow(ctx context.Context, account *gtsmodel.Account, name string) (*apimodel.Tag, gtserror.WithCode)
+	// GetFollowed returns tags followed by the given account, sorted by when they were followed (newest first).
+	// The additional parameters can be used for paging.
+	GetFollowed(ctx context.Context, account *gtsmodel.Account, maxID string, minID string, limit int) (*apimodel.PageableResponse, gtserror.WithCode)
+	// WebStatusesGet returns the tag with the given name, and a page of public statuses from local accounts that use it.
+	// It's suitable for serving statuses on the web to a visitor who isn't logged in.
+	WebStatusesGet(ctx context.Context, name string, maxID string) (*apimodel.Tag, *apimodel.PageableResponse, gtserror.WithCode)
+}
+
+type processor struct {
+	db              db.DB
+	tc              typeutils.TypeConverter
+	statusTimelines timeline.Manager
+}
+
+// New returns a new tag processor.
+func New(db db.DB, tc typeutils.TypeConverter, statusTimelines timeline.Manager) Processor {
+	return &processor{
+		db:              db,
+		tc:              tc,
+		statusTimelines: statusTimelines,
+	}
+}
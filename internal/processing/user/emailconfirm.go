@@ -36,26 +36,44 @@ import (
 var oneWeek = 168 * time.Hour
 
 func (p *processor) SendConfirmEmail(ctx context.Context, user *gtsmodel.User, username string) error {
-	if user.UnconfirmedEmail == "" || user.UnconfirmedEmail == user.Email {
-		// user has already confirmed this email address, so there's nothing to do
-		return nil
+	return p.sendConfirmEmail(ctx, user, username, false, "")
+}
+
+func (p *processor) SendApprovedEmail(ctx context.Context, user *gtsmodel.User, username string, message string) error {
+	return p.sendConfirmEmail(ctx, user, username, true, message)
+}
+
+func (p *processor) sendConfirmEmail(ctx context.Context, user *gtsmodel.User, username string, approved bool, message string) error {
+	toAddress := user.UnconfirmedEmail
+	confirmed := toAddress == "" || toAddress == user.Email
+	if confirmed {
+		if !approved {
+			// user has already confirmed this email address, so there's nothing to do
+			return nil
+		}
+
+		// still let the user know they've been approved,
+		// there's just nothing left for them to confirm
+		toAddress = user.Email
 	}
 
-	// We need a token and a link for the user to click on.
-	// We'll use a uuid as our token since it's basically impossible to guess.
-	// From the uuid package we use (which uses crypto/rand under the hood):
-	//      Randomly generated UUIDs have 122 random bits.  One's annual risk of being
-	//      hit by a meteorite is estimated to be one chance in 17 billion, that
-	//      means the probability is about 0.00000000006 (6 × 10−11),
-	//      equivalent to the odds of creating a few tens of trillions of UUIDs in a
-	//      year and having one duplicate.
-	confirmationToken := uuid.NewString()
-	confirmationLink := uris.GenerateURIForEmailConfirm(confirmationToken)
+	var confirmationToken, confirmationLink string
+	if !confirmed {
+		// We need a token and a link for the user to click on.
+		// We'll use a uuid as our token since it's basically impossible to guess.
+		// From the uuid package we use (which uses crypto/rand under the hood):
+		//      Randomly generated UUIDs have 122 random bits.  One's annual risk of being
+		//      hit by a meteorite is estimated to be one chance in 17 billion, that
+		//      means the probability is about 0.00000000006 (6 × 10−11),
+		//      equivalent to the odds of creating a few tens of trillions of UUIDs in a
+		//      year and having one duplicate.
+		confirmationToken = uuid.NewString()
+		confirmationLink = uris.GenerateURIForEmailConfirm(confirmationToken)
+	}
 
 	// pull our instance entry from the database so we can greet the user nicely in the email
-	instance := &gtsmodel.Instance{}
-	host := config.GetHost()
-	if err := p.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: host}}, instance); err != nil {
+	instance, err := p.getInstance(ctx)
+	if err != nil {
 		return fmt.Errorf("SendConfirmEmail: error getting instance: %s", err)
 	}
 
@@ -65,18 +83,24 @@ func (p *processor) SendConfirmEmail(ctx context.Context, user *gtsmodel.User, u
 		InstanceURL:  instance.URI,
 		InstanceName: instance.Title,
 		ConfirmLink:  confirmationLink,
+		Approved:     approved,
+		Message:      message,
 	}
-	if err := p.emailSender.SendConfirmEmail(user.UnconfirmedEmail, confirmData); err != nil {
-		return fmt.Errorf("SendConfirmEmail: error sending to email address %s belonging to user %s: %s", user.UnconfirmedEmail, username, err)
+	if err := p.emailSender.SendConfirmEmail(toAddress, confirmData); err != nil {
+		return fmt.Errorf("SendConfirmEmail: error sending to email address %s belonging to user %s: %s", toAddress, username, err)
 	}
 
 	// email sent, now we need to update the user entry with the token we just sent them
-	updatingColumns := []string{"confirmation_sent_at", "confirmation_token", "last_emailed_at", "updated_at"}
-	user.ConfirmationSentAt = time.Now()
-	user.ConfirmationToken = confirmationToken
+	updatingColumns := []string{"last_emailed_at", "updated_at"}
 	user.LastEmailedAt = time.Now()
 	user.UpdatedAt = time.Now()
 
+	if !confirmed {
+		updatingColumns = append(updatingColumns, "confirmation_sent_at", "confirmation_token")
+		user.ConfirmationSentAt = time.Now()
+		user.ConfirmationToken = confirmationToken
+	}
+
 	if err := p.db.UpdateByID(ctx, user, user.ID, updatingColumns...); err != nil {
 		return fmt.Errorf("SendConfirmEmail: error updating user entry after email sent: %s", err)
 	}
@@ -84,6 +108,46 @@ func (p *processor) SendConfirmEmail(ctx context.Context, user *gtsmodel.User, u
 	return nil
 }
 
+func (p *processor) SendRejectedEmail(ctx context.Context, user *gtsmodel.User, username string, message string) error {
+	// the user may or may not have confirmed their address yet
+	toAddress := user.Email
+	if toAddress == "" {
+		toAddress = user.UnconfirmedEmail
+	}
+
+	if toAddress == "" {
+		// nowhere to send the email to, so there's nothing to do
+		return nil
+	}
+
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return fmt.Errorf("SendRejectedEmail: error getting instance: %s", err)
+	}
+
+	rejectData := email.RejectData{
+		Username:     username,
+		InstanceURL:  instance.URI,
+		InstanceName: instance.Title,
+		Message:      message,
+	}
+	if err := p.emailSender.SendRejectEmail(toAddress, rejectData); err != nil {
+		return fmt.Errorf("SendRejectedEmail: error sending to email address %s belonging to user %s: %s", toAddress, username, err)
+	}
+
+	return nil
+}
+
+// getInstance pulls our own instance entry from the database.
+func (p *processor) getInstance(ctx context.Context) (*gtsmodel.Instance, error) {
+	instance := &gtsmodel.Instance{}
+	host := config.GetHost()
+	if err := p.db.GetWhere(ctx, []db.Where{{Key: "domain", Value: host}}, instance); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
 func (p *processor) ConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode) {
 	if token == "" {
 		return nil, gtserror.NewErrorNotFound(errors.New("no token provided"))
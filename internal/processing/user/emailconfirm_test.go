@@ -61,6 +61,52 @@ func (suite *EmailConfirmTestSuite) TestSendConfirmEmail() {
 	suite.WithinDuration(time.Now(), user.ConfirmationSentAt, 1*time.Minute)
 }
 
+func (suite *EmailConfirmTestSuite) TestSendApprovedEmail() {
+	user := suite.testUsers["unconfirmed_account"]
+
+	err := suite.user.SendApprovedEmail(context.Background(), user, "weed_lord420", "welcome!")
+	suite.NoError(err)
+
+	suite.Len(suite.sentEmails, 1)
+	email, ok := suite.sentEmails["weed_lord420@example.org"]
+	suite.True(ok)
+
+	// a token should be set on the user
+	token := user.ConfirmationToken
+	suite.NotEmpty(token)
+
+	// email should contain the message and the token
+	emailShould := fmt.Sprintf("To: weed_lord420@example.org\r\nSubject: GoToSocial Account Request Approved\r\n\r\nHello weed_lord420!\r\n\r\nYour request for an account on http://localhost:8080 has been approved by the moderators.\r\n\r\nMessage from the moderators:\r\n\r\nwelcome!\r\n\r\nWe just need to confirm that this is your email address. To confirm your email, paste the following in your browser's address bar:\r\n\r\nhttp://localhost:8080/confirm_email?token=%s\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of http://localhost:8080\r\n\r\n", token)
+	suite.Equal(emailShould, email)
+	suite.WithinDuration(time.Now(), user.ConfirmationSentAt, 1*time.Minute)
+}
+
+func (suite *EmailConfirmTestSuite) TestSendApprovedEmailAlreadyConfirmed() {
+	user := suite.testUsers["local_account_1"]
+
+	err := suite.user.SendApprovedEmail(context.Background(), user, "the_mighty_zork", "")
+	suite.NoError(err)
+
+	// zork should still be told about the approval, but without a new token
+	suite.Len(suite.sentEmails, 1)
+	email, ok := suite.sentEmails["zork@example.org"]
+	suite.True(ok)
+	suite.Equal("To: zork@example.org\r\nSubject: GoToSocial Account Request Approved\r\n\r\nHello the_mighty_zork!\r\n\r\nYour request for an account on http://localhost:8080 has been approved by the moderators.\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of http://localhost:8080\r\n\r\n", email)
+	suite.Empty(user.ConfirmationToken)
+}
+
+func (suite *EmailConfirmTestSuite) TestSendRejectedEmail() {
+	user := suite.testUsers["unconfirmed_account"]
+
+	err := suite.user.SendRejectedEmail(context.Background(), user, "weed_lord420", "")
+	suite.NoError(err)
+
+	suite.Len(suite.sentEmails, 1)
+	email, ok := suite.sentEmails["weed_lord420@example.org"]
+	suite.True(ok)
+	suite.Equal("To: weed_lord420@example.org\r\nSubject: GoToSocial Account Request Rejected\r\n\r\nHello weed_lord420!\r\n\r\nYou are receiving this mail because you've requested an account on http://localhost:8080, but the moderators have rejected your request.\r\n\r\nAny information you submitted with your request has been removed from http://localhost:8080.\r\n\r\nIf you believe you've been sent this email in error, feel free to ignore it, or contact the administrator of http://localhost:8080.\r\n\r\n", email)
+}
+
 func (suite *EmailConfirmTestSuite) TestConfirmEmail() {
 	ctx := context.Background()
 
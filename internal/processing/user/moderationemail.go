@@ -0,0 +1,71 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package user
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/email"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// moderationActions describes each admin action type to the user it was taken against.
+var moderationActions = map[gtsmodel.AdminActionType]string{
+	gtsmodel.AdminActionNone:        "They have sent you a warning. No other action has been taken on your account.",
+	gtsmodel.AdminActionDisable:     "Your login has been disabled. Your account and posts are still stored, but you can't log in, and your posts are hidden.",
+	gtsmodel.AdminActionSensitive:   "Your account has been marked as sensitive. All media you post will be hidden behind a content warning.",
+	gtsmodel.AdminActionSilence:     "Your account has been limited. Only people who already follow you will see your posts and interactions.",
+	gtsmodel.AdminActionSuspend:     "Your account has been suspended. Your posts and media have been removed, and you can no longer log in.",
+	gtsmodel.AdminActionEnable:      "Your login has been enabled again.",
+	gtsmodel.AdminActionUnsensitive: "Your account is no longer marked as sensitive.",
+	gtsmodel.AdminActionUnsilence:   "Your account is no longer limited.",
+}
+
+func (p *processor) SendModerationEmail(ctx context.Context, user *gtsmodel.User, username string, actionType gtsmodel.AdminActionType, message string) error {
+	// only send to confirmed addresses
+	toAddress := user.Email
+	if toAddress == "" {
+		// nowhere to send the email to, so there's nothing to do
+		return nil
+	}
+
+	action, ok := moderationActions[actionType]
+	if !ok {
+		return fmt.Errorf("SendModerationEmail: admin action type %s can't be emailed", actionType)
+	}
+
+	instance, err := p.getInstance(ctx)
+	if err != nil {
+		return fmt.Errorf("SendModerationEmail: error getting instance: %s", err)
+	}
+
+	moderationData := email.ModerationData{
+		Username:     username,
+		InstanceURL:  instance.URI,
+		InstanceName: instance.Title,
+		Action:       action,
+		Message:      message,
+	}
+	if err := p.emailSender.SendModerationEmail(toAddress, moderationData); err != nil {
+		return fmt.Errorf("SendModerationEmail: error sending to email address %s belonging to user %s: %s", toAddress, username, err)
+	}
+
+	return nil
+}
@@ -34,6 +34,12 @@ type Processor interface {
 	ChangePassword(ctx context.Context, user *gtsmodel.User, oldPassword string, newPassword string) gtserror.WithCode
 	// SendConfirmEmail sends a 'confirm-your-email-address' type email to a user.
 	SendConfirmEmail(ctx context.Context, user *gtsmodel.User, username string) error
+	// SendApprovedEmail sends a 'your-sign-up-was-approved' type email to a user, which also asks them to confirm their email address.
+	SendApprovedEmail(ctx context.Context, user *gtsmodel.User, username string, message string) error
+	// SendRejectedEmail sends a 'your-sign-up-was-rejected' type email to a user.
+	SendRejectedEmail(ctx context.Context, user *gtsmodel.User, username string, message string) error
+	// SendModerationEmail sends a 'the-moderators-took-action-on-your-account' type email to a user.
+	SendModerationEmail(ctx context.Context, user *gtsmodel.User, username string, actionType gtsmodel.AdminActionType, message string) error
 	// ConfirmEmail confirms an email address using the given token.
 	ConfirmEmail(ctx context.Context, token string) (*gtsmodel.User, gtserror.WithCode)
 }
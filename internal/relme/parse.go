@@ -0,0 +1,145 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package relme
+
+import (
+	"io"
+	"net/url"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// FieldLink returns the http(s) link contained in the given profile field value,
+// or nil if the value isn't a link. Values may be plain text (local accounts) or
+// html (remote accounts), in which case the text content of the html is used.
+func FieldLink(value string) *url.URL {
+	var text strings.Builder
+
+	z := html.NewTokenizer(strings.NewReader(value))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+
+		if tt == html.TextToken {
+			text.Write(z.Text())
+		}
+	}
+
+	link, err := url.Parse(strings.TrimSpace(text.String()))
+	if err != nil {
+		return nil
+	}
+
+	if (link.Scheme != "http" && link.Scheme != "https") || link.Host == "" {
+		return nil
+	}
+
+	return link
+}
+
+// HasRelMeLink parses html from the given reader, and returns true if it contains
+// an <a> or <link> element with rel="me" whose href points to one of the targets.
+// Relative hrefs are resolved against the url of the page itself.
+func HasRelMeLink(r io.Reader, page *url.URL, targets []string) bool {
+	z := html.NewTokenizer(r)
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			// io.EOF or a parse error, either way we're done
+			return false
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			if !hasAttr {
+				continue
+			}
+
+			if a := atom.Lookup(name); a != atom.A && a != atom.Link {
+				continue
+			}
+
+			var rel, href string
+			for {
+				key, val, more := z.TagAttr()
+				switch string(key) {
+				case "rel":
+					rel = string(val)
+				case "href":
+					href = string(val)
+				}
+
+				if !more {
+					break
+				}
+			}
+
+			if isRelMe(rel) && linksTo(page, href, targets) {
+				return true
+			}
+		}
+	}
+}
+
+// isRelMe returns true if the given rel attribute contains "me".
+func isRelMe(rel string) bool {
+	for _, r := range strings.Fields(rel) {
+		if strings.EqualFold(r, "me") {
+			return true
+		}
+	}
+	return false
+}
+
+// linksTo returns true if href, resolved against
+// the page url, points to one of the targets.
+func linksTo(page *url.URL, href string, targets []string) bool {
+	if href == "" {
+		return false
+	}
+
+	hrefURL, err := page.Parse(href)
+	if err != nil {
+		return false
+	}
+
+	for _, target := range targets {
+		targetURL, err := url.Parse(target)
+		if err != nil || target == "" {
+			continue
+		}
+
+		if sameURL(hrefURL, targetURL) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// sameURL compares two urls, ignoring case of the host and trailing slashes.
+func sameURL(a *url.URL, b *url.URL) bool {
+	return a.Scheme == b.Scheme &&
+		strings.EqualFold(a.Host, b.Host) &&
+		strings.TrimSuffix(a.Path, "/") == strings.TrimSuffix(b.Path, "/") &&
+		a.RawQuery == b.RawQuery
+}
@@ -0,0 +1,152 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package relme verifies links in account profile fields, by checking whether
+// the linked page contains a rel="me" link pointing back to the account.
+package relme
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"time"
+
+	"codeberg.org/gruf/go-bytesize"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// maxPageSize is the maximum amount of a linked page
+	// that will be read while looking for rel="me" links.
+	maxPageSize = int64(1 * bytesize.MiB)
+
+	// fetchTimeout is the maximum amount of time
+	// to spend fetching one linked page.
+	fetchTimeout = 30 * time.Second
+)
+
+// HTTPClient is the subset of httpclient.Client used to fetch linked pages.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Verifier contains functions for verifying links in profile fields.
+type Verifier interface {
+	// VerifyFields checks every field of the given account that contains a link, setting or
+	// clearing the VerifiedAt of each field according to whether the linked page contains a
+	// rel="me" link back to the account. Returns true if any field of the account was changed.
+	//
+	// The account is not updated in the database, that's up to the caller.
+	VerifyFields(ctx context.Context, account *gtsmodel.Account) bool
+}
+
+// NewVerifier returns a new Verifier, which will fetch linked pages using the given http client.
+func NewVerifier(client HTTPClient) Verifier {
+	return &verifier{
+		client: client,
+	}
+}
+
+type verifier struct {
+	client HTTPClient
+}
+
+func (v *verifier) VerifyFields(ctx context.Context, account *gtsmodel.Account) bool {
+	var changed bool
+
+	// we accept links to either of these as pointing back to the account
+	targets := []string{account.URL, account.URI}
+
+	for i := range account.Fields {
+		field := &account.Fields[i]
+
+		var verified bool
+		if link := FieldLink(field.Value); link != nil {
+			var err error
+			verified, err = v.verifyLink(ctx, link, targets)
+			if err != nil {
+				log.Debugf("VerifyFields: couldn't verify link %s for account %s: %s", link, account.URI, err)
+			}
+		}
+
+		switch {
+		case verified && field.VerifiedAt.IsZero():
+			// newly verified field
+			field.VerifiedAt = time.Now()
+			changed = true
+		case !verified && !field.VerifiedAt.IsZero():
+			// field not verified (anymore)
+			field.VerifiedAt = time.Time{}
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// verifyLink fetches the given link, and returns true if the page
+// it points to contains a rel="me" link to one of the targets.
+func (v *verifier) verifyLink(ctx context.Context, link *url.URL, targets []string) (bool, error) {
+	ctx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, link.String(), nil)
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Accept", "text/html")
+	req.Header.Set("User-Agent", fmt.Sprintf("%s (+%s://%s) gotosocial/%s", config.GetApplicationName(), config.GetProtocol(), config.GetHost(), config.GetSoftwareVersion()))
+
+	rsp, err := v.client.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer rsp.Body.Close()
+
+	if rsp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("GET request to %s failed (%d): %s", link, rsp.StatusCode, rsp.Status)
+	}
+
+	if contentType := rsp.Header.Get("Content-Type"); contentType != "" {
+		if mediaType, _, err := mime.ParseMediaType(contentType); err != nil || (mediaType != "text/html" && mediaType != "application/xhtml+xml") {
+			return false, fmt.Errorf("response from %s had unexpected content type %s", link, contentType)
+		}
+	}
+
+	return HasRelMeLink(io.LimitReader(rsp.Body, maxPageSize), link, targets), nil
+}
+
+// KeepVerified copies the VerifiedAt of each previous field to any field in
+// fields with the same name and value, so that unchanged fields stay verified
+// when an account's fields are replaced.
+func KeepVerified(previous []gtsmodel.Field, fields []gtsmodel.Field) {
+	for i := range fields {
+		for _, p := range previous {
+			if p.Name == fields[i].Name && p.Value == fields[i].Value {
+				fields[i].VerifiedAt = p.VerifiedAt
+				break
+			}
+		}
+	}
+}
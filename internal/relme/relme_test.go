@@ -0,0 +1,157 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package relme_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type clientFunc func(req *http.Request) (*http.Response, error)
+
+func (f clientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+type RelMeTestSuite struct {
+	suite.Suite
+}
+
+func (suite *RelMeTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+}
+
+func (suite *RelMeTestSuite) TestFieldLink() {
+	for value, expected := range map[string]string{
+		"https://example.org/about": "https://example.org/about",
+		" http://example.org ":      "http://example.org",
+		"example.org":               "",
+		"she/her":                   "",
+		"ftp://example.org/file":    "",
+		"https://":                  "",
+		`<a href="https://example.org/about" rel="me nofollow noopener noreferrer" target="_blank"><span class="invisible">https://</span><span class="">example.org/about</span><span class="invisible"></span></a>`: "https://example.org/about",
+	} {
+		link := relme.FieldLink(value)
+		if expected == "" {
+			suite.Nil(link, value)
+			continue
+		}
+
+		if suite.NotNil(link, value) {
+			suite.Equal(expected, link.String())
+		}
+	}
+}
+
+func (suite *RelMeTestSuite) TestHasRelMeLink() {
+	page, _ := url.Parse("https://example.org/about")
+	targets := []string{"http://localhost:8080/@the_mighty_zork", "http://localhost:8080/users/the_mighty_zork"}
+
+	for html, expected := range map[string]bool{
+		`<html><head><link rel="me" href="http://localhost:8080/@the_mighty_zork"></head></html>`:   true,
+		`<a rel="nofollow ME" href="http://LOCALHOST:8080/users/the_mighty_zork/">zork</a>`:         true,
+		`<a rel="me" href="http://localhost:8080/@someone_else">someone else</a>`:                   false,
+		`<a href="http://localhost:8080/@the_mighty_zork">no rel</a>`:                               false,
+		`<div rel="me" href="http://localhost:8080/@the_mighty_zork">wrong element</div>`:           false,
+		`<a rel="me" href="/@the_mighty_zork">relative to example.org</a>`:                          false,
+		`<p>not a link <a rel="me" href="http://localhost:8080/@the_mighty_zork"/> but this is</p>`: true,
+	} {
+		suite.Equal(expected, relme.HasRelMeLink(strings.NewReader(html), page, targets), html)
+	}
+}
+
+func (suite *RelMeTestSuite) TestVerifyFields() {
+	pages := map[string]string{
+		"https://example.org/about":   `<html><body><a rel="me" href="http://localhost:8080/@the_mighty_zork">me on gts</a></body></html>`,
+		"https://example.org/nothing": `<html><body>nothing to see here</body></html>`,
+	}
+
+	verifier := relme.NewVerifier(clientFunc(func(req *http.Request) (*http.Response, error) {
+		page, ok := pages[req.URL.String()]
+		if !ok {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     "404 Not Found",
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     "200 OK",
+			Header:     http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+			Body:       io.NopCloser(strings.NewReader(page)),
+		}, nil
+	}))
+
+	previouslyVerified := time.Now().Add(-24 * time.Hour)
+	account := &gtsmodel.Account{
+		URI: "http://localhost:8080/users/the_mighty_zork",
+		URL: "http://localhost:8080/@the_mighty_zork",
+		Fields: []gtsmodel.Field{
+			{Name: "pronouns", Value: "they/them"},
+			{Name: "website", Value: "https://example.org/about"},
+			{Name: "old website", Value: "https://example.org/nothing", VerifiedAt: previouslyVerified},
+			{Name: "gone", Value: "https://example.org/gone"},
+		},
+	}
+
+	changed := verifier.VerifyFields(context.Background(), account)
+	suite.True(changed)
+	suite.True(account.Fields[0].VerifiedAt.IsZero())
+	suite.WithinDuration(time.Now(), account.Fields[1].VerifiedAt, 10*time.Second)
+	suite.True(account.Fields[2].VerifiedAt.IsZero())
+	suite.True(account.Fields[3].VerifiedAt.IsZero())
+
+	// nothing changes second time around
+	verifiedAt := account.Fields[1].VerifiedAt
+	changed = verifier.VerifyFields(context.Background(), account)
+	suite.False(changed)
+	suite.Equal(verifiedAt, account.Fields[1].VerifiedAt)
+}
+
+func (suite *RelMeTestSuite) TestKeepVerified() {
+	verifiedAt := time.Now().Add(-1 * time.Hour)
+	previous := []gtsmodel.Field{
+		{Name: "website", Value: "https://example.org/about", VerifiedAt: verifiedAt},
+		{Name: "blog", Value: "https://blog.example.org", VerifiedAt: verifiedAt},
+	}
+	fields := []gtsmodel.Field{
+		{Name: "website", Value: "https://example.org/about"},
+		{Name: "blog", Value: "https://new-blog.example.org"},
+	}
+
+	relme.KeepVerified(previous, fields)
+	suite.Equal(verifiedAt, fields[0].VerifiedAt)
+	suite.True(fields[1].VerifiedAt.IsZero())
+}
+
+func TestRelMeTestSuite(t *testing.T) {
+	suite.Run(t, &RelMeTestSuite{})
+}
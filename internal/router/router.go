@@ -30,6 +30,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
 	"golang.org/x/crypto/acme/autocert"
 )
 
@@ -162,6 +163,13 @@ func New(ctx context.Context) (Router, error) {
 	engine := gin.New()
 	engine.MaxMultipartMemory = maxMultipartMemory
 
+	if config.GetTracingEnabled() {
+		// Wrap every request in a tracing span. This is attached
+		// here rather than with the other global middlewares so
+		// that the span covers the time spent in all of them.
+		engine.Use(tracing.InstrumentGin())
+	}
+
 	// set up IP forwarding via x-forward-* headers.
 	trustedProxies := config.GetTrustedProxies()
 	if err := engine.SetTrustedProxies(trustedProxies); err != nil {
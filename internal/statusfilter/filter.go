@@ -0,0 +1,56 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statusfilter
+
+import (
+	"context"
+
+	"codeberg.org/gruf/go-cache/v3"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+)
+
+// Filter packages up logic for checking statuses against the keyword filters set up by an account.
+type Filter interface {
+	// StatusFilterResults checks targetStatus against the unexpired keyword filters of requestingAccount which
+	// apply in the given context. It returns a result for each filter that matched, and a bool indicating
+	// whether the status should be hidden from requestingAccount entirely, ie., whether any matching filter
+	// has the 'hide' action. If the status is a boost, the boosted status is checked instead.
+	StatusFilterResults(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account, filterContext gtsmodel.FilterContext) ([]apimodel.FilterResult, bool, error)
+}
+
+type filter struct {
+	db db.DB
+	tc typeutils.TypeConverter
+
+	// keywords caches the compiled regular
+	// expression for each filter keyword ID.
+	keywords cache.Cache[string, *keywordMatcher]
+}
+
+// NewFilter returns a new Filter interface that will use the provided database and type converter.
+func NewFilter(db db.DB, tc typeutils.TypeConverter) Filter {
+	return &filter{
+		db:       db,
+		tc:       tc,
+		keywords: cache.New[string, *keywordMatcher](0, 1000, 0),
+	}
+}
@@ -0,0 +1,206 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statusfilter
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// wordChars is the set of characters considered to be part of a word when
+// doing whole word matching. This mirrors Mastodon, which uses the POSIX
+// [[:word:]] character class: letters, marks, decimal numbers, and connector punctuation.
+const wordChars = `\pL\pM\p{Nd}\p{Pc}`
+
+// isWordChar returns true if r is in the wordChars set.
+func isWordChar(r rune) bool {
+	return unicode.In(r, unicode.L, unicode.M, unicode.Nd, unicode.Pc)
+}
+
+func (f *filter) StatusFilterResults(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account, filterContext gtsmodel.FilterContext) ([]apimodel.FilterResult, bool, error) {
+	if requestingAccount == nil {
+		// nobody to filter for
+		return nil, false, nil
+	}
+
+	if targetStatus.AccountID == requestingAccount.ID {
+		// never filter an account's own statuses
+		return nil, false, nil
+	}
+
+	filters, err := f.db.GetFiltersForAccountID(ctx, requestingAccount.ID)
+	if err != nil {
+		return nil, false, fmt.Errorf("StatusFilterResults: error getting filters for account %s: %w", requestingAccount.ID, err)
+	}
+
+	if len(filters) == 0 {
+		// nothing to do
+		return nil, false, nil
+	}
+
+	if targetStatus.BoostOfID != "" {
+		// check the content of the boosted status instead of the (empty) boost wrapper
+		if targetStatus.BoostOf == nil {
+			boostOf, err := f.db.GetStatusByID(ctx, targetStatus.BoostOfID)
+			if err != nil {
+				return nil, false, fmt.Errorf("StatusFilterResults: error getting boosted status %s: %w", targetStatus.BoostOfID, err)
+			}
+			targetStatus.BoostOf = boostOf
+		}
+		targetStatus = targetStatus.BoostOf
+
+		if targetStatus.AccountID == requestingAccount.ID {
+			// boost of the account's own status
+			return nil, false, nil
+		}
+	}
+
+	fields := statusFields(targetStatus)
+	now := time.Now()
+
+	var results []apimodel.FilterResult
+
+	for _, filter := range filters {
+		if filter.Expired(now) || !filter.AppliesTo(filterContext) {
+			continue
+		}
+
+		keywordMatches := []string{}
+		for _, keyword := range filter.Keywords {
+			matcher, err := f.keywordRegexp(keyword)
+			if err != nil {
+				return nil, false, fmt.Errorf("StatusFilterResults: error compiling filter keyword %s: %w", keyword.ID, err)
+			}
+
+			for _, field := range fields {
+				if matcher.MatchString(field) {
+					keywordMatches = append(keywordMatches, keyword.Keyword)
+					break
+				}
+			}
+		}
+
+		if len(keywordMatches) == 0 {
+			continue
+		}
+
+		if filter.Action == gtsmodel.FilterActionHide {
+			// no point checking further, the
+			// status won't be shown anyway
+			return nil, true, nil
+		}
+
+		apiFilter, err := f.tc.FilterToAPIFilterV2(ctx, filter)
+		if err != nil {
+			return nil, false, fmt.Errorf("StatusFilterResults: error converting filter %s: %w", filter.ID, err)
+		}
+
+		results = append(results, apimodel.FilterResult{
+			Filter:         *apiFilter,
+			KeywordMatches: keywordMatches,
+			StatusMatches:  []string{},
+		})
+	}
+
+	return results, false, nil
+}
+
+// statusFields returns the plaintext fields
+// of the given status to check keywords against.
+func statusFields(status *gtsmodel.Status) []string {
+	fields := []string{}
+
+	if status.ContentWarning != "" {
+		fields = append(fields, status.ContentWarning)
+	}
+
+	if status.Content != "" {
+		fields = append(fields, text.SanitizePlaintext(status.Content))
+	}
+
+	for _, attachment := range status.Attachments {
+		if attachment.Description != "" {
+			fields = append(fields, attachment.Description)
+		}
+	}
+
+	return fields
+}
+
+// keywordMatcher is a compiled keyword regular expression, along
+// with the time its keyword was last updated, so that the cached
+// expression can be thrown away when the keyword changes.
+type keywordMatcher struct {
+	updatedAt time.Time
+	regexp    *regexp.Regexp
+}
+
+// keywordRegexp returns the regular expression for the given keyword, compiling
+// it only if it isn't cached yet or the keyword has been updated since it was.
+func (f *filter) keywordRegexp(keyword *gtsmodel.FilterKeyword) (*regexp.Regexp, error) {
+	if matcher, ok := f.keywords.Get(keyword.ID); ok && matcher.updatedAt.Equal(keyword.UpdatedAt) {
+		return matcher.regexp, nil
+	}
+
+	re, err := compileKeyword(keyword)
+	if err != nil {
+		return nil, err
+	}
+
+	f.keywords.Set(keyword.ID, &keywordMatcher{
+		updatedAt: keyword.UpdatedAt,
+		regexp:    re,
+	})
+
+	return re, nil
+}
+
+// compileKeyword returns a case-insensitive regular expression for the given keyword.
+//
+// If the keyword is whole word, then a match will only be reported if a keyword that
+// starts with a word character isn't directly preceded by another word character, and
+// a keyword that ends with a word character isn't directly followed by one.
+func compileKeyword(keyword *gtsmodel.FilterKeyword) (*regexp.Regexp, error) {
+	var b strings.Builder
+	b.WriteString("(?i)")
+
+	wholeWord := keyword.WholeWord != nil && *keyword.WholeWord
+	first, _ := utf8.DecodeRuneInString(keyword.Keyword)
+	if wholeWord && isWordChar(first) {
+		b.WriteString(`(?:^|[^` + wordChars + `])`)
+	}
+
+	b.WriteString(regexp.QuoteMeta(keyword.Keyword))
+
+	last, _ := utf8.DecodeLastRuneInString(keyword.Keyword)
+	if wholeWord && isWordChar(last) {
+		b.WriteString(`(?:$|[^` + wordChars + `])`)
+	}
+
+	return regexp.Compile(b.String())
+}
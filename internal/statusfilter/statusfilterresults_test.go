@@ -0,0 +1,189 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package statusfilter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+type StatusFilterResultsTestSuite struct {
+	FilterStandardTestSuite
+}
+
+// filterableStatus returns a copy of a status
+// from local_account_2 with the given content.
+func (suite *StatusFilterResultsTestSuite) filterableStatus(content string) *gtsmodel.Status {
+	status := &gtsmodel.Status{}
+	*status = *suite.testStatuses["local_account_2_status_1"]
+	status.Content = content
+	return status
+}
+
+func (suite *StatusFilterResultsTestSuite) TestWarnMatch() {
+	status := suite.filterableStatus("<p>i saw a fnord today</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, hide, err := suite.filter.StatusFilterResults(context.Background(), status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.False(hide)
+	suite.Len(results, 1)
+	suite.Equal(suite.testFilters["local_account_1_filter_1"].ID, results[0].Filter.ID)
+	suite.Equal([]string{"fnord"}, results[0].KeywordMatches)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestCaseInsensitiveMatch() {
+	status := suite.filterableStatus("<p>FNORD!</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, _, err := suite.filter.StatusFilterResults(context.Background(), status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.Len(results, 1)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestContentWarningMatch() {
+	status := suite.filterableStatus("<p>nothing to see here</p>")
+	status.ContentWarning = "fnord spoilers"
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, _, err := suite.filter.StatusFilterResults(context.Background(), status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.Len(results, 1)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestWholeWordNoMatch() {
+	// "fnords" should not match the whole-word keyword "fnord".
+	status := suite.filterableStatus("<p>so many fnords</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, hide, err := suite.filter.StatusFilterResults(context.Background(), status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.False(hide)
+	suite.Empty(results)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestPartialWordMatch() {
+	ctx := context.Background()
+	keyword := suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+
+	wholeWord := false
+	keyword.WholeWord = &wholeWord
+	if err := suite.db.UpdateFilterKeyword(ctx, keyword, "whole_word"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status := suite.filterableStatus("<p>so many fnords</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, _, err := suite.filter.StatusFilterResults(ctx, status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.Len(results, 1)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestKeywordUpdatedAfterMatching() {
+	ctx := context.Background()
+	status := suite.filterableStatus("<p>so many fnords</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	// whole word keyword is compiled and cached, and doesn't match
+	results, _, err := suite.filter.StatusFilterResults(ctx, status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.Empty(results)
+
+	keyword := &gtsmodel.FilterKeyword{}
+	*keyword = *suite.testFilterKeywords["local_account_1_filter_1_keyword_1"]
+	wholeWord := false
+	keyword.WholeWord = &wholeWord
+	if err := suite.db.UpdateFilterKeyword(ctx, keyword, "whole_word"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// the cached keyword is out of date now, so it should be compiled again
+	results, _, err = suite.filter.StatusFilterResults(ctx, status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.Len(results, 1)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestOtherContextNoMatch() {
+	status := suite.filterableStatus("<p>i saw a fnord today</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, hide, err := suite.filter.StatusFilterResults(context.Background(), status, requestingAccount, gtsmodel.FilterContextNotifications)
+	suite.NoError(err)
+	suite.False(hide)
+	suite.Empty(results)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestHideMatch() {
+	ctx := context.Background()
+	filter := suite.testFilters["local_account_1_filter_1"]
+
+	filter.Action = gtsmodel.FilterActionHide
+	if err := suite.db.UpdateFilter(ctx, filter, "action"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status := suite.filterableStatus("<p>i saw a fnord today</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, hide, err := suite.filter.StatusFilterResults(ctx, status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.True(hide)
+	suite.Empty(results)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestExpiredNoMatch() {
+	ctx := context.Background()
+	filter := suite.testFilters["local_account_1_filter_1"]
+
+	filter.ExpiresAt = time.Now().Add(-1 * time.Hour)
+	if err := suite.db.UpdateFilter(ctx, filter, "expires_at"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	status := suite.filterableStatus("<p>i saw a fnord today</p>")
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	results, hide, err := suite.filter.StatusFilterResults(ctx, status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.False(hide)
+	suite.Empty(results)
+}
+
+func (suite *StatusFilterResultsTestSuite) TestOwnStatusNoMatch() {
+	requestingAccount := suite.testAccounts["local_account_1"]
+
+	// An account's own statuses are never filtered.
+	status := suite.filterableStatus("<p>i saw a fnord today</p>")
+	status.AccountID = requestingAccount.ID
+	status.Account = requestingAccount
+
+	results, hide, err := suite.filter.StatusFilterResults(context.Background(), status, requestingAccount, gtsmodel.FilterContextHome)
+	suite.NoError(err)
+	suite.False(hide)
+	suite.Empty(results)
+}
+
+func TestStatusFilterResultsTestSuite(t *testing.T) {
+	suite.Run(t, new(StatusFilterResultsTestSuite))
+}
@@ -27,6 +27,10 @@ const (
 	EventTypeUpdate string = "update"
 	// EventTypeDelete -- something should be deleted from a user
 	EventTypeDelete string = "delete"
+	// EventTypeStatusUpdate -- a user should be shown an edit of a status in their timeline
+	EventTypeStatusUpdate string = "status.update"
+	// EventTypeConversation -- a user should be shown an updated direct message conversation
+	EventTypeConversation string = "conversation"
 )
 
 const (
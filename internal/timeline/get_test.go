@@ -27,6 +27,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
 	"github.com/superseriousbusiness/gotosocial/internal/timeline"
 	"github.com/superseriousbusiness/gotosocial/internal/visibility"
 	"github.com/superseriousbusiness/gotosocial/testrig"
@@ -57,7 +58,7 @@ func (suite *GetTestSuite) SetupTest() {
 		suite.testAccounts["local_account_1"].ID,
 		processing.StatusGrabFunction(suite.db),
 		processing.StatusFilterFunction(suite.db, suite.filter),
-		processing.StatusPrepareFunction(suite.db, suite.tc),
+		processing.StatusPrepareFunction(suite.db, suite.tc, statusfilter.NewFilter(suite.db, suite.tc)),
 		processing.StatusSkipInsertFunction(),
 	)
 	if err != nil {
@@ -191,6 +191,11 @@ func (t *timeline) IndexAndPrepareOne(ctx context.Context, statusID string, boos
 
 	if inserted {
 		if err := t.prepare(ctx, statusID); err != nil {
+			if errors.Is(err, ErrNotPreparable) {
+				// item is indexed but shouldn't be shown, so
+				// don't report it as having been inserted
+				return false, nil
+			}
 			return inserted, fmt.Errorf("IndexAndPrepareOne: error preparing: %s", err)
 		}
 	}
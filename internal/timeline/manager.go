@@ -70,8 +70,14 @@ type Manager interface {
 	Remove(ctx context.Context, timelineAccountID string, itemID string) (int, error)
 	// WipeItemFromAllTimelines removes one item from the index and prepared items of all timelines
 	WipeItemFromAllTimelines(ctx context.Context, itemID string) error
+	// RefreshItemInAllTimelines re-prepares one item (and any boosts of it) in the prepared items of all timelines.
+	// This is useful when an item has been changed (eg., a status has been edited) after it was prepared.
+	RefreshItemInAllTimelines(ctx context.Context, itemID string) error
 	// WipeStatusesFromAccountID removes all items by the given accountID from the timelineAccountID's timelines.
 	WipeItemsFromAccountID(ctx context.Context, timelineAccountID string, accountID string) error
+	// RemoveTimeline drops the whole timeline for the given timelineAccountID, indexed and prepared items included.
+	// The timeline will be recreated and indexed from scratch the next time it's requested.
+	RemoveTimeline(ctx context.Context, timelineAccountID string)
 	// Start starts hourly cleanup jobs for this timeline manager.
 	Start() error
 	// Stop stops the timeline manager (currently a stub, doesn't do anything).
@@ -243,6 +249,29 @@ func (m *manager) WipeItemFromAllTimelines(ctx context.Context, statusID string)
 	return err
 }
 
+func (m *manager) RefreshItemInAllTimelines(ctx context.Context, statusID string) error {
+	errors := []string{}
+	m.accountTimelines.Range(func(k interface{}, i interface{}) bool {
+		t, ok := i.(Timeline)
+		if !ok {
+			panic("couldn't parse entry as Timeline, this should never happen so panic")
+		}
+
+		if _, err := t.Refresh(ctx, statusID); err != nil {
+			errors = append(errors, err.Error())
+		}
+
+		return true
+	})
+
+	var err error
+	if len(errors) > 0 {
+		err = fmt.Errorf("one or more errors refreshing status %s in all timelines: %s", statusID, strings.Join(errors, ";"))
+	}
+
+	return err
+}
+
 func (m *manager) WipeItemsFromAccountID(ctx context.Context, timelineAccountID string, accountID string) error {
 	t, err := m.getOrCreateTimeline(ctx, timelineAccountID)
 	if err != nil {
@@ -253,6 +282,10 @@ func (m *manager) WipeItemsFromAccountID(ctx context.Context, timelineAccountID
 	return err
 }
 
+func (m *manager) RemoveTimeline(ctx context.Context, timelineAccountID string) {
+	m.accountTimelines.Delete(timelineAccountID)
+}
+
 func (m *manager) getOrCreateTimeline(ctx context.Context, timelineAccountID string) (Timeline, error) {
 	var t Timeline
 	i, ok := m.accountTimelines.Load(timelineAccountID)
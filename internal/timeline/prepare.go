@@ -64,11 +64,11 @@ prepareloop:
 
 		if err := t.prepare(ctx, entry.itemID); err != nil {
 			// there's been an error
-			if err != db.ErrNoEntries {
+			if err != db.ErrNoEntries && !errors.Is(err, ErrNotPreparable) {
 				// it's a real error
 				return fmt.Errorf("PrepareFromTop: error preparing status with id %s: %s", entry.itemID, err)
 			}
-			// the status just doesn't exist (anymore) so continue to the next one
+			// the status just doesn't exist (anymore) or shouldn't be shown, so continue to the next one
 			continue
 		}
 
@@ -147,11 +147,11 @@ prepareloop:
 		if preparing {
 			if err := t.prepare(ctx, entry.itemID); err != nil {
 				// there's been an error
-				if err != db.ErrNoEntries {
+				if err != db.ErrNoEntries && !errors.Is(err, ErrNotPreparable) {
 					// it's a real error
 					return fmt.Errorf("prepareBehind: error preparing item with id %s: %s", entry.itemID, err)
 				}
-				// the status just doesn't exist (anymore) so continue to the next one
+				// the status just doesn't exist (anymore) or shouldn't be shown, so continue to the next one
 				continue
 			}
 			if prepared == amount {
@@ -202,11 +202,11 @@ prepareloop:
 		if preparing {
 			if err := t.prepare(ctx, entry.itemID); err != nil {
 				// there's been an error
-				if err != db.ErrNoEntries {
+				if err != db.ErrNoEntries && !errors.Is(err, ErrNotPreparable) {
 					// it's a real error
 					return fmt.Errorf("prepareBefore: error preparing status with id %s: %s", entry.itemID, err)
 				}
-				// the status just doesn't exist (anymore) so continue to the next one
+				// the status just doesn't exist (anymore) or shouldn't be shown, so continue to the next one
 				continue
 			}
 			if prepared == amount {
@@ -0,0 +1,80 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package timeline
+
+import (
+	"container/list"
+	"context"
+	"errors"
+
+	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+func (t *timeline) Refresh(ctx context.Context, itemID string) (int, error) {
+	l := log.WithFields(kv.Fields{
+		{"accountTimeline", t.accountID},
+		{"itemID", itemID},
+	}...)
+
+	t.Lock()
+	defer t.Unlock()
+	var refreshed int
+
+	if t.preparedItems == nil || t.preparedItems.data == nil {
+		return refreshed, nil
+	}
+
+	// re-prepare entr(ies) in prepared posts; only prepared
+	// entries need touching since indexed entries hold no content
+	removePrepared := []*list.Element{}
+	for e := t.preparedItems.data.Front(); e != nil; e = e.Next() {
+		entry, ok := e.Value.(*preparedItemsEntry)
+		if !ok {
+			return refreshed, errors.New("Refresh: could not parse e as a preparedPostsEntry")
+		}
+
+		if entry.itemID != itemID && entry.boostOfID != itemID {
+			continue
+		}
+
+		prepared, err := t.prepareFunction(ctx, t.accountID, entry.itemID)
+		if err != nil {
+			if err != db.ErrNoEntries && !errors.Is(err, ErrNotPreparable) {
+				// it's a real error
+				return refreshed, err
+			}
+			// the item just doesn't exist (anymore) or shouldn't be shown, so drop it
+			removePrepared = append(removePrepared, e)
+			continue
+		}
+
+		l.Debug("refreshed item in preparedPosts")
+		entry.prepared = prepared
+		refreshed++
+	}
+
+	for _, e := range removePrepared {
+		t.preparedItems.data.Remove(e)
+	}
+
+	l.Debugf("refreshed %d entries", refreshed)
+	return refreshed, nil
+}
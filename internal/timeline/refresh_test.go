@@ -0,0 +1,129 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package timeline_test
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/processing"
+	"github.com/superseriousbusiness/gotosocial/internal/statusfilter"
+	"github.com/superseriousbusiness/gotosocial/internal/timeline"
+	"github.com/superseriousbusiness/gotosocial/internal/visibility"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type RefreshTestSuite struct {
+	TimelineStandardTestSuite
+}
+
+func (suite *RefreshTestSuite) SetupSuite() {
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testStatuses = testrig.NewTestStatuses()
+}
+
+func (suite *RefreshTestSuite) SetupTest() {
+	testrig.InitTestLog()
+	testrig.InitTestConfig()
+
+	suite.db = testrig.NewTestDB()
+	suite.tc = testrig.NewTestTypeConverter(suite.db)
+	suite.filter = visibility.NewFilter(suite.db)
+
+	testrig.StandardDBSetup(suite.db, nil)
+
+	// let's take local_account_1 as the timeline owner
+	tl, err := timeline.NewTimeline(
+		context.Background(),
+		suite.testAccounts["local_account_1"].ID,
+		processing.StatusGrabFunction(suite.db),
+		processing.StatusFilterFunction(suite.db, suite.filter),
+		processing.StatusPrepareFunction(suite.db, suite.tc, statusfilter.NewFilter(suite.db, suite.tc)),
+		processing.StatusSkipInsertFunction(),
+	)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// put the status IDs in a determinate order since we can't trust a map to keep its order
+	statuses := []*gtsmodel.Status{}
+	for _, s := range suite.testStatuses {
+		statuses = append(statuses, s)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].ID > statuses[j].ID
+	})
+
+	// prepare the timeline by just shoving all test statuses in it -- let's not be fussy about who sees what
+	for _, s := range statuses {
+		_, err := tl.IndexAndPrepareOne(context.Background(), s.GetID(), s.BoostOfID, s.AccountID, s.BoostOfAccountID)
+		if err != nil {
+			suite.FailNow(err.Error())
+		}
+	}
+
+	suite.timeline = tl
+}
+
+func (suite *RefreshTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *RefreshTestSuite) TestRefresh() {
+	ctx := context.Background()
+
+	// change the content of a status that's already prepared
+	editedStatus := &gtsmodel.Status{}
+	*editedStatus = *suite.testStatuses["admin_account_status_1"]
+	editedStatus.Content = "this content has been edited"
+	if err := suite.db.UpdateStatus(ctx, editedStatus); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	refreshed, err := suite.timeline.Refresh(ctx, editedStatus.ID)
+	suite.NoError(err)
+	suite.Equal(1, refreshed)
+
+	statuses, err := suite.timeline.Get(ctx, 40, "", "", "", false)
+	suite.NoError(err)
+
+	// the prepared status should now have the new content
+	var found bool
+	for _, s := range statuses {
+		if apiStatus := s.(*apimodel.Status); apiStatus.ID == editedStatus.ID {
+			suite.Equal("this content has been edited", apiStatus.Content)
+			found = true
+		}
+	}
+	suite.True(found)
+}
+
+func (suite *RefreshTestSuite) TestRefreshNotInTimeline() {
+	refreshed, err := suite.timeline.Refresh(context.Background(), "01GSB0ZV0NZ5S1VQDJXQ8TG1K4")
+	suite.NoError(err)
+	suite.Zero(refreshed)
+}
+
+func TestRefreshTestSuite(t *testing.T) {
+	suite.Run(t, new(RefreshTestSuite))
+}
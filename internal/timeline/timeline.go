@@ -20,10 +20,15 @@ package timeline
 
 import (
 	"context"
+	"errors"
 	"sync"
 	"time"
 )
 
+// ErrNotPreparable can be returned by a PrepareFunction to indicate
+// that the given item should be skipped rather than prepared.
+var ErrNotPreparable = errors.New("item not preparable")
+
 // GrabFunction is used by a Timeline to grab more items to index.
 //
 // It should be provided to NewTimeline when the caller is creating a timeline
@@ -46,6 +51,9 @@ type FilterFunction func(ctx context.Context, timelineAccountID string, item Tim
 // PrepareFunction converts a Timelineable into a Preparable.
 //
 // For example, this might result in the converstion of a *gtsmodel.Status with the given itemID into a serializable *apimodel.Status.
+//
+// If the item turns out to be unsuitable for showing in the timeline at preparation time (eg., it's been
+// keyword filtered by the timeline owner), ErrNotPreparable should be returned, and the item will be skipped.
 type PrepareFunction func(ctx context.Context, timelineAccountID string, itemID string) (Preparable, error)
 
 // SkipInsertFunction indicates whether a new item about to be inserted in the prepared list should be skipped,
@@ -127,6 +135,13 @@ type Timeline interface {
 	//
 	// The returned int indicates the amount of entries that were removed.
 	RemoveAllBy(ctx context.Context, accountID string) (int, error)
+	// Refresh re-prepares any prepared entries for the given itemID, or for boosts of it,
+	// so that subsequent calls to Get return an up-to-date representation of the item.
+	//
+	// Entries that can no longer be prepared are removed.
+	//
+	// The returned int indicates the amount of entries that were refreshed.
+	Refresh(ctx context.Context, itemID string) (int, error)
 }
 
 // timeline fulfils the Timeline interface
@@ -0,0 +1,91 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tracing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// BunHook returns a bun query hook which wraps each database query in a
+// client span, tagged with its operation and table. The dbType should be
+// the configured database type, ie., "postgres" or "sqlite".
+func BunHook(dbType string) bun.QueryHook {
+	system := semconv.DBSystemKey.String(dbType)
+	switch dbType {
+	case "postgres":
+		system = semconv.DBSystemPostgreSQL
+	case "sqlite":
+		system = semconv.DBSystemSqlite
+	}
+	return &bunHook{system: system}
+}
+
+// bunHook implements bun.QueryHook
+type bunHook struct {
+	system attribute.KeyValue
+}
+
+func (h *bunHook) BeforeQuery(ctx context.Context, event *bun.QueryEvent) context.Context {
+	operation := strings.ToUpper(event.Operation())
+	attrs := []attribute.KeyValue{
+		h.system,
+		semconv.DBOperationKey.String(operation),
+	}
+
+	// NOTE: we deliberately never record the query itself,
+	// as bun formats argument values directly into it, and
+	// these could be password hashes, tokens, private posts...
+	if event.IQuery != nil {
+		if table := strings.Trim(event.IQuery.GetTableName(), `"`); table != "" {
+			attrs = append(attrs, semconv.DBSQLTableKey.String(table))
+		}
+	}
+
+	ctx, _ = Tracer().Start(ctx, "db "+operation,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithTimestamp(event.StartTime),
+		trace.WithAttributes(attrs...),
+	)
+	return ctx
+}
+
+func (h *bunHook) AfterQuery(ctx context.Context, event *bun.QueryEvent) {
+	span := trace.SpanFromContext(ctx)
+	if !span.IsRecording() {
+		return
+	}
+
+	// No rows is an expected result for most
+	// lookups, so don't mark the span as failed.
+	if err := event.Err; err != nil && !errors.Is(err, sql.ErrNoRows) {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,86 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tracing
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// InstrumentGin returns a gin middleware which wraps each request in a server
+// span named after its matched route. Each request starts a new trace, unless
+// trusting incoming trace context is enabled, in which case any trace context
+// passed in by a tracing reverse proxy is continued.
+func InstrumentGin() gin.HandlerFunc {
+	trustIncoming := config.GetTracingTrustIncoming()
+
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+
+		// Only continue trace from incoming headers if we've been
+		// told to: otherwise any client could join our spans to a
+		// trace of their choosing by sending its traceparent.
+		if trustIncoming {
+			ctx = otel.GetTextMapPropagator().Extract(
+				ctx,
+				propagation.HeaderCarrier(c.Request.Header),
+			)
+		}
+
+		// Use the route template rather than the request path
+		// so that eg., every status ID doesn't get its own name.
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		// NOTE: as with request logging, we only record
+		// the path and never the query parameters, which
+		// could contain API keys or other sensitive data.
+		ctx, span := Tracer().Start(ctx, c.Request.Method+" "+route,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				semconv.HTTPMethodKey.String(c.Request.Method),
+				semconv.HTTPRouteKey.String(route),
+				semconv.HTTPTargetKey.String(c.Request.URL.Path),
+				semconv.HTTPUserAgentKey.String(c.Request.UserAgent()),
+			),
+		)
+		defer span.End()
+
+		// Pass span context on to handlers
+		c.Request = c.Request.WithContext(ctx)
+
+		// Process request
+		c.Next()
+
+		code := c.Writer.Status()
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(code))
+		if code >= 500 {
+			span.SetStatus(codes.Error, http.StatusText(code))
+		}
+	}
+}
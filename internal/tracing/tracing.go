@@ -0,0 +1,118 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+// Package tracing provides OpenTelemetry tracing for GoToSocial, exporting
+// spans over OTLP/HTTP to a collector such as Jaeger, Tempo or otelcol.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName is the name given to
+// the tracer used for all GoToSocial spans.
+const instrumentationName = "github.com/superseriousbusiness/gotosocial"
+
+// provider is the tracer provider set by Initialize(),
+// kept so that it can be flushed on Shutdown().
+var provider *sdktrace.TracerProvider
+
+// Initialize will set up the global OpenTelemetry tracer provider
+// and propagator, exporting spans over OTLP/HTTP to the configured
+// endpoint. It's a no-op if tracing is not enabled in config.
+func Initialize() error {
+	if !config.GetTracingEnabled() {
+		return nil
+	}
+
+	opts := []otlptracehttp.Option{}
+	if endpoint := config.GetTracingEndpoint(); endpoint != "" {
+		// If unset, the exporter falls back to the standard
+		// OTEL_EXPORTER_OTLP_ENDPOINT env var, or localhost:4318.
+		opts = append(opts, otlptracehttp.WithEndpoint(endpoint))
+	}
+	if config.GetTracingInsecureTransport() {
+		opts = append(opts, otlptracehttp.WithInsecure())
+	}
+
+	// The exporter doesn't connect until spans are
+	// exported, so this won't fail on a down collector.
+	exporter, err := otlptrace.New(
+		context.Background(),
+		otlptracehttp.NewClient(opts...),
+	)
+	if err != nil {
+		return fmt.Errorf("error creating otlp trace exporter: %w", err)
+	}
+
+	// Route export errors (eg., collector unreachable)
+	// to our own logger rather than the stdlib logger.
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(func(err error) {
+		log.Errorf("tracing: %v", err)
+	}))
+
+	SetExporter(exporter, sdktrace.WithBatcher(exporter))
+	return nil
+}
+
+// SetExporter sets the global tracer provider to one using the
+// given span exporter and span processor option. This is exposed
+// so that tests can export spans to an in-memory exporter, using
+// sdktrace.WithSyncer() so that spans are available immediately.
+func SetExporter(exporter sdktrace.SpanExporter, processor sdktrace.TracerProviderOption) {
+	res := resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceNameKey.String(config.GetApplicationName()),
+		semconv.ServiceVersionKey.String(config.GetSoftwareVersion()),
+	)
+
+	provider = sdktrace.NewTracerProvider(
+		processor,
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+}
+
+// Shutdown flushes any spans not yet exported, and stops the
+// tracer provider. It's a no-op if tracing was never initialized.
+func Shutdown(ctx context.Context) error {
+	if provider == nil {
+		return nil
+	}
+	return provider.Shutdown(ctx)
+}
+
+// Tracer returns the tracer to use for GoToSocial spans. When tracing
+// is not enabled, this is a no-op tracer which records nothing.
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
@@ -0,0 +1,226 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package tracing_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type TracingTestSuite struct {
+	suite.Suite
+	exporter *tracetest.InMemoryExporter
+}
+
+func (suite *TracingTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.exporter = tracetest.NewInMemoryExporter()
+	tracing.SetExporter(suite.exporter, sdktrace.WithSyncer(suite.exporter))
+}
+
+// span returns the first exported span with the given name, failing the test if there isn't one.
+func (suite *TracingTestSuite) span(name string) tracetest.SpanStub {
+	return suite.spanWhere(name, func(tracetest.SpanStub) bool { return true })
+}
+
+// spanWhere returns the first exported span with the given name which
+// also satisfies the given match function, failing the test if there isn't one.
+func (suite *TracingTestSuite) spanWhere(name string, match func(tracetest.SpanStub) bool) tracetest.SpanStub {
+	var (
+		span  tracetest.SpanStub
+		found bool
+	)
+
+	// Spans from worker pools are ended
+	// asynchronously, so wait for them.
+	testrig.WaitFor(func() bool {
+		for _, s := range suite.exporter.GetSpans() {
+			if s.Name == name && match(s) {
+				span, found = s, true
+				return true
+			}
+		}
+		return false
+	})
+
+	if !found {
+		suite.FailNow("span not found: " + name)
+	}
+	return span
+}
+
+// attr returns the value of the given attribute key on the span.
+func (suite *TracingTestSuite) attr(span tracetest.SpanStub, key string) attribute.Value {
+	for _, kv := range span.Attributes {
+		if string(kv.Key) == key {
+			return kv.Value
+		}
+	}
+	suite.FailNow("attribute not found: " + key)
+	return attribute.Value{}
+}
+
+func (suite *TracingTestSuite) TestGinSpan() {
+	var handlerSpanContext trace.SpanContext
+	config.SetTracingTrustIncoming(true)
+
+	engine := gin.New()
+	engine.Use(tracing.InstrumentGin())
+	engine.GET("/api/v1/statuses/:id", func(c *gin.Context) {
+		handlerSpanContext = trace.SpanContextFromContext(c.Request.Context())
+		c.Status(http.StatusInternalServerError)
+	})
+
+	// Pretend we're behind a proxy which is already tracing.
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/statuses/01F8MHAMCHF6Y01D6BJHSRDNAM?secret=hunter2", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	engine.ServeHTTP(httptest.NewRecorder(), r)
+
+	span := suite.span("GET /api/v1/statuses/:id")
+	suite.Equal(trace.SpanKindServer, span.SpanKind)
+	suite.Equal("/api/v1/statuses/:id", suite.attr(span, "http.route").AsString())
+	suite.Equal("/api/v1/statuses/01F8MHAMCHF6Y01D6BJHSRDNAM", suite.attr(span, "http.target").AsString())
+	suite.Equal(int64(http.StatusInternalServerError), suite.attr(span, "http.status_code").AsInt64())
+	suite.Equal(codes.Error, span.Status.Code)
+
+	// Span should continue the proxy's trace,
+	// and be passed on to the handler.
+	suite.Equal("4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+	suite.Equal("00f067aa0ba902b7", span.Parent.SpanID().String())
+	suite.Equal(span.SpanContext.SpanID(), handlerSpanContext.SpanID())
+}
+
+func (suite *TracingTestSuite) TestGinSpanUntrustedTraceparent() {
+	engine := gin.New()
+	engine.Use(tracing.InstrumentGin())
+	engine.GET("/api/v1/statuses/:id", func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	// Trusting incoming trace context is off by
+	// default, so this should start a new trace.
+	r := httptest.NewRequest(http.MethodGet, "/api/v1/statuses/01F8MHAMCHF6Y01D6BJHSRDNAM", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	engine.ServeHTTP(httptest.NewRecorder(), r)
+
+	span := suite.span("GET /api/v1/statuses/:id")
+	suite.NotEqual("4bf92f3577b34da6a3ce929d0e0e4736", span.SpanContext.TraceID().String())
+	suite.False(span.Parent.IsValid())
+}
+
+func (suite *TracingTestSuite) TestBunSpan() {
+	config.SetTracingEnabled(true)
+
+	testDB := testrig.NewTestDB()
+	defer testrig.StandardDBTeardown(testDB)
+	testrig.CreateTestTables(testDB)
+
+	_, err := testDB.GetAccountByID(context.Background(), "01GT9A3CW5J9Q1B6VYJAQYPJQ5")
+	suite.ErrorIs(err, db.ErrNoEntries)
+
+	span := suite.spanWhere("db SELECT", func(s tracetest.SpanStub) bool {
+		for _, kv := range s.Attributes {
+			if kv.Key == "db.sql.table" && kv.Value.AsString() == "accounts" {
+				return true
+			}
+		}
+		return false
+	})
+	suite.Equal(trace.SpanKindClient, span.SpanKind)
+	suite.Equal("sqlite", suite.attr(span, "db.system").AsString())
+	suite.Equal("SELECT", suite.attr(span, "db.operation").AsString())
+
+	// The query is never recorded, as it contains argument values.
+	for _, kv := range span.Attributes {
+		suite.NotEqual(attribute.Key("db.statement"), kv.Key)
+	}
+
+	// No rows is an expected result, not a failure.
+	suite.Equal(codes.Unset, span.Status.Code)
+}
+
+func (suite *TracingTestSuite) TestWorkerSpan() {
+	pool := concurrency.NewWorkerPool[messages.FromClientAPI](1, 1)
+	pool.SetProcessor(func(ctx context.Context, msg messages.FromClientAPI) error {
+		return nil
+	})
+	suite.NoError(pool.Start())
+	defer func() { suite.NoError(pool.Stop()) }()
+
+	// Queue a message as though from within a request.
+	_, parent := tracing.Tracer().Start(context.Background(), "POST /api/v1/statuses")
+	pool.Queue(messages.FromClientAPI{
+		TraceContext: parent.SpanContext(),
+	})
+	parent.End()
+
+	span := suite.span("worker.Worker[messages.FromClientAPI]")
+	suite.Equal(trace.SpanKindConsumer, span.SpanKind)
+	suite.Equal(parent.SpanContext().TraceID(), span.SpanContext.TraceID())
+	suite.Equal(parent.SpanContext().SpanID(), span.Parent.SpanID())
+}
+
+func (suite *TracingTestSuite) TestTransportSpan() {
+	testDB := testrig.NewTestDB()
+	defer testrig.StandardDBTeardown(testDB)
+	testrig.StandardDBSetup(testDB, nil)
+
+	fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+	client := testrig.NewMockHTTPClient(nil, "../../testrig/media")
+	controller := testrig.NewTestTransportController(client, testDB, fedWorker)
+
+	tp, err := controller.NewTransportForUsername(context.Background(), "the_mighty_zork")
+	suite.NoError(err)
+
+	err = tp.Deliver(context.Background(), []byte(`{}`), testrig.URLMustParse("http://example.org/users/some_user/inbox"))
+	suite.NoError(err)
+
+	span := suite.span("transport POST")
+	suite.Equal(trace.SpanKindClient, span.SpanKind)
+	suite.Equal("example.org", suite.attr(span, "net.peer.name").AsString())
+	suite.Equal("http://localhost:8080/users/the_mighty_zork/main-key", suite.attr(span, "gotosocial.signature.key_id").AsString())
+	suite.Equal(int64(1), suite.attr(span, "gotosocial.transport.attempt").AsInt64())
+	suite.Equal(int64(http.StatusOK), suite.attr(span, "http.status_code").AsInt64())
+
+	suite.Len(span.Events, 1)
+	suite.Equal("signed request", span.Events[0].Name)
+	suite.NotEmpty(span.Events[0].Attributes[0].Value.AsString())
+}
+
+func TestTracingTestSuite(t *testing.T) {
+	suite.Run(t, &TracingTestSuite{})
+}
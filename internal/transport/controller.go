@@ -25,12 +25,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"sync"
 	"time"
 
 	"codeberg.org/gruf/go-byteutil"
 	"codeberg.org/gruf/go-cache/v3"
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/federation/federatingdb"
@@ -44,6 +46,17 @@ type Controller interface {
 
 	// NewTransportForUsername searches for account with username, and returns result of .NewTransport().
 	NewTransportForUsername(ctx context.Context, username string) (Transport, error)
+
+	// RetryDeliveries makes another attempt at queued deliveries which are due to be retried.
+	// Deliveries end up in the queue when they fail in a way that suggests the remote host is
+	// only temporarily unavailable; they're retried with exponential backoff for up to DeliveryMaxAge.
+	RetryDeliveries(ctx context.Context) error
+
+	// Start starts retrying queued deliveries at regular intervals.
+	Start() error
+
+	// Stop stops retrying queued deliveries.
+	Stop() error
 }
 
 type controller struct {
@@ -53,7 +66,10 @@ type controller struct {
 	client    pub.HttpClient
 	trspCache cache.Cache[string, *transport]
 	badHosts  cache.Cache[string, struct{}]
+	hosts     cache.Cache[string, hostHealth]
+	hostsMu   sync.Mutex
 	userAgent string
+	retrier   *concurrency.TickerWorker
 }
 
 // NewController returns an implementation of the Controller interface for creating new transports
@@ -70,8 +86,10 @@ func NewController(db db.DB, federatingDB federatingdb.DB, clock pub.Clock, clie
 		client:    client,
 		trspCache: cache.New[string, *transport](0, 100, 0),
 		badHosts:  cache.New[string, struct{}](0, 1000, 0),
+		hosts:     cache.New[string, hostHealth](0, 1000, 0),
 		userAgent: fmt.Sprintf("%s (+%s://%s) gotosocial/%s", applicationName, proto, host, version),
 	}
+	c.retrier = concurrency.NewTickerWorker("retrying queued deliveries", deliveryRetryInterval, c.RetryDeliveries)
 
 	// Transport cache has TTL=1hr freq=1min
 	c.trspCache.SetTTL(time.Hour, false)
@@ -85,9 +103,23 @@ func NewController(db db.DB, federatingDB federatingdb.DB, clock pub.Clock, clie
 		log.Panic("failed to start transport controller cache")
 	}
 
+	// Host health cache has TTL=5min freq=1min
+	c.hosts.SetTTL(5*time.Minute, false)
+	if !c.hosts.Start(time.Minute) {
+		log.Panic("failed to start transport controller cache")
+	}
+
 	return c
 }
 
+func (c *controller) Start() error {
+	return c.retrier.Start()
+}
+
+func (c *controller) Stop() error {
+	return c.retrier.Stop()
+}
+
 func (c *controller) NewTransport(pubKeyID string, privkey *rsa.PrivateKey) (Transport, error) {
 	// Generate public key string for cache key
 	//
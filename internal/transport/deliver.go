@@ -21,6 +21,7 @@ package transport
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
@@ -29,6 +30,8 @@ import (
 
 	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
 )
 
 func (t *transport) BatchDeliver(ctx context.Context, b []byte, recipients []*url.URL) error {
@@ -73,6 +76,39 @@ func (t *transport) Deliver(ctx context.Context, b []byte, to *url.URL) error {
 		return nil
 	}
 
+	// if we don't federate with the 'to' host, just skip this delivery
+	if err := t.checkDomainBlocked(ctx, to.Hostname()); err != nil {
+		if errors.Is(err, ErrDomainBlocked) {
+			log.Debugf("Deliver: skipping delivery to %s: %s", to, err)
+			return nil
+		}
+		return err
+	}
+
+	err := t.deliver(ctx, b, to)
+	if errors.Is(err, ErrHostUnreachable) {
+		// there's no point in queueing deliveries
+		// to a host that's been gone for days
+		log.Debugf("Deliver: skipping delivery to %s: %s", to, err)
+		return nil
+	}
+
+	// record delivery result against the remote domain
+	metrics.RecordDelivery(to.Host, err)
+	if err == nil || !shouldQueue(err) {
+		return err
+	}
+
+	// the remote host may just be having a bad time,
+	// so queue this delivery to be retried later on
+	if qErr := t.queueDelivery(ctx, b, to, err); qErr != nil {
+		return fmt.Errorf("%s; %w", err, qErr)
+	}
+
+	return fmt.Errorf("%w (queued for retry)", err)
+}
+
+func (t *transport) deliver(ctx context.Context, b []byte, to *url.URL) error {
 	urlStr := to.String()
 
 	req, err := http.NewRequestWithContext(ctx, "POST", urlStr, bytes.NewReader(b))
@@ -92,7 +128,7 @@ func (t *transport) Deliver(ctx context.Context, b []byte, to *url.URL) error {
 
 	if code := resp.StatusCode; code != http.StatusOK &&
 		code != http.StatusCreated && code != http.StatusAccepted {
-		return fmt.Errorf("POST request to %s failed (%d): %s", urlStr, resp.StatusCode, resp.Status)
+		return fmt.Errorf("%w: POST request to %s failed (%d): %s", errDeliveryRejected, urlStr, resp.StatusCode, resp.Status)
 	}
 
 	return nil
@@ -37,6 +37,11 @@ import (
 // dereference it.
 var ErrGone = errors.New("remote resource returned HTTP code 410 GONE")
 
+// ErrDomainBlocked is returned from transport functions when the remote host is one
+// this instance doesn't federate with, either because it's domain blocked, or because
+// the instance is in allowlist mode and the host hasn't been explicitly allowed.
+var ErrDomainBlocked = errors.New("federation with remote host is not permitted")
+
 func (t *transport) Dereference(ctx context.Context, iri *url.URL) ([]byte, error) {
 	// if the request is to us, we can shortcut for certain URIs rather than going through
 	// the normal request flow, thereby saving time and energy
@@ -52,6 +57,11 @@ func (t *transport) Dereference(ctx context.Context, iri *url.URL) ([]byte, erro
 		}
 	}
 
+	// Make sure we're allowed to talk to this host at all
+	if err := t.checkDomainBlocked(ctx, iri.Hostname()); err != nil {
+		return nil, err
+	}
+
 	// Build IRI just once
 	iriStr := iri.String()
 
@@ -38,6 +38,11 @@ import (
 )
 
 func (t *transport) DereferenceInstance(ctx context.Context, iri *url.URL) (*gtsmodel.Instance, error) {
+	// Make sure we're allowed to talk to this host at all
+	if err := t.checkDomainBlocked(ctx, iri.Hostname()); err != nil {
+		return nil, err
+	}
+
 	var i *gtsmodel.Instance
 	var err error
 
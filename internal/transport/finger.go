@@ -28,6 +28,11 @@ import (
 )
 
 func (t *transport) Finger(ctx context.Context, targetUsername string, targetDomain string) ([]byte, error) {
+	// Make sure we're allowed to talk to this domain at all
+	if err := t.checkDomainBlocked(ctx, targetDomain); err != nil {
+		return nil, err
+	}
+
 	// Prepare URL string
 	urlStr := "https://" +
 		targetDomain +
@@ -0,0 +1,238 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+const (
+	// UnreachableAfter is how long requests to a host must have been
+	// failing for before we consider it unreachable, and stop making
+	// requests to it, save for the occasional probe.
+	UnreachableAfter = 48 * time.Hour
+
+	// unreachableProbeInterval is how often one request
+	// is let through to an unreachable host, to check
+	// whether it has come back.
+	unreachableProbeInterval = 6 * time.Hour
+)
+
+// ErrHostUnreachable is returned from transport functions when the remote host has
+// been failing requests for longer than UnreachableAfter, and isn't due to be probed.
+var ErrHostUnreachable = errors.New("remote host is unreachable")
+
+// Unreachable returns whether the given instance has been
+// failing requests for long enough to be considered unreachable.
+func Unreachable(instance *gtsmodel.Instance) bool {
+	return hostHealth{
+		failures:     instance.Failures,
+		failingSince: instance.FailingSince,
+	}.unreachable(time.Now())
+}
+
+// hostHealth is the cached availability of one remote host.
+type hostHealth struct {
+	failures     int
+	failingSince time.Time
+	lastFailedAt time.Time
+}
+
+func (h hostHealth) unreachable(now time.Time) bool {
+	return h.failures > 0 && now.Sub(h.failingSince) > UnreachableAfter
+}
+
+// loadHostHealth returns the availability of the given host, from the
+// cache if possible, otherwise from its instance entry. It must be called
+// WITHOUT hostsMu held, so that requests to every other host aren't held
+// up behind the database lookup.
+func (c *controller) loadHostHealth(ctx context.Context, host string) hostHealth {
+	if h, ok := c.hosts.Get(host); ok {
+		return h
+	}
+
+	var h hostHealth
+	instance, err := c.db.GetInstance(ctx, host)
+	switch {
+	case err == nil:
+		h = hostHealth{
+			failures:     instance.Failures,
+			failingSince: instance.FailingSince,
+			lastFailedAt: instance.LastFailedAt,
+		}
+	case !errors.Is(err, db.ErrNoEntries):
+		// don't hold up requests just because we can't tell
+		log.Errorf("loadHostHealth: db error getting instance %s: %s", host, err)
+		return h
+	}
+
+	if !c.hosts.Add(host, h) {
+		// Someone else got there first, and
+		// may have updated it since, so use theirs.
+		if cached, ok := c.hosts.Get(host); ok {
+			return cached
+		}
+	}
+
+	return h
+}
+
+// getHostHealth returns the cached availability of the given host, falling
+// back to the given value, as returned by loadHostHealth, if it has dropped
+// out of the cache since. Must be called with hostsMu held.
+func (c *controller) getHostHealth(host string, loaded hostHealth) hostHealth {
+	if h, ok := c.hosts.Get(host); ok {
+		return h
+	}
+	return loaded
+}
+
+// checkReachable returns ErrHostUnreachable if the given host has been
+// failing requests for longer than UnreachableAfter. Once in a while,
+// one request is let through anyway, to probe whether the host is back.
+func (c *controller) checkReachable(ctx context.Context, host string) error {
+	now := time.Now()
+	loaded := c.loadHostHealth(ctx, host)
+	if !loaded.unreachable(now) {
+		return nil
+	}
+
+	c.hostsMu.Lock()
+	defer c.hostsMu.Unlock()
+
+	h := c.getHostHealth(host, loaded)
+	if !h.unreachable(now) {
+		return nil
+	}
+
+	if now.Sub(h.lastFailedAt) >= unreachableProbeInterval {
+		// Let this request through as a probe. Pretend it
+		// already failed, so no others follow it until then.
+		h.lastFailedAt = now
+		c.hosts.Set(host, h)
+		return nil
+	}
+
+	return fmt.Errorf("%w: %s (failing since %s)", ErrHostUnreachable, host, h.failingSince.Format(time.RFC3339))
+}
+
+// recordFailure records a failed request to the host of the given
+// url against its instance entry, creating the entry if necessary.
+func (c *controller) recordFailure(ctx context.Context, u *url.URL, reqErr error) {
+	host := u.Hostname()
+	now := time.Now()
+	loaded := c.loadHostHealth(ctx, host)
+
+	c.hostsMu.Lock()
+	h := c.getHostHealth(host, loaded)
+	wasUnreachable := h.unreachable(now)
+	if h.failures == 0 {
+		h.failingSince = now
+	}
+	h.failures++
+	h.lastFailedAt = now
+	c.hosts.Set(host, h)
+	c.hostsMu.Unlock()
+
+	if !wasUnreachable && h.unreachable(now) {
+		log.Warnf("recordFailure: requests to %s have been failing since %s, considering it unreachable", host, h.failingSince.Format(time.RFC3339))
+	}
+
+	instance, err := c.db.GetInstance(ctx, host)
+	if err != nil {
+		if !errors.Is(err, db.ErrNoEntries) {
+			log.Errorf("recordFailure: db error getting instance %s: %s", host, err)
+			return
+		}
+
+		// we've never heard from this instance
+		// before, so create a minimal entry for it
+		instanceID, err := id.NewRandomULID()
+		if err != nil {
+			log.Errorf("recordFailure: error creating id for instance %s: %s", host, err)
+			return
+		}
+
+		instance = &gtsmodel.Instance{
+			ID:           instanceID,
+			Domain:       host,
+			URI:          u.Scheme + "://" + u.Host,
+			Failures:     h.failures,
+			FailingSince: h.failingSince,
+			LastFailedAt: h.lastFailedAt,
+			LastFailure:  reqErr.Error(),
+		}
+
+		if err := c.db.Put(ctx, instance); err != nil {
+			log.Errorf("recordFailure: db error creating instance %s: %s", host, err)
+		}
+		return
+	}
+
+	instance.Failures = h.failures
+	instance.FailingSince = h.failingSince
+	instance.LastFailedAt = h.lastFailedAt
+	instance.LastFailure = reqErr.Error()
+	if err := c.db.UpdateInstance(ctx, instance, "failures", "failing_since", "last_failed_at", "last_failure"); err != nil {
+		log.Errorf("recordFailure: db error updating instance %s: %s", host, err)
+	}
+}
+
+// recordSuccess records a successful request to the given
+// host, clearing any run of failures on its instance entry.
+func (c *controller) recordSuccess(ctx context.Context, host string) {
+	loaded := c.loadHostHealth(ctx, host)
+	if loaded.failures == 0 {
+		// nothing to clear
+		return
+	}
+
+	c.hostsMu.Lock()
+	h := c.getHostHealth(host, loaded)
+	if h.failures == 0 {
+		// nothing to clear
+		c.hostsMu.Unlock()
+		return
+	}
+	c.hosts.Set(host, hostHealth{lastFailedAt: h.lastFailedAt})
+	c.hostsMu.Unlock()
+
+	log.Infof("recordSuccess: %s is reachable again after %d failed requests", host, h.failures)
+
+	instance, err := c.db.GetInstance(ctx, host)
+	if err != nil {
+		log.Errorf("recordSuccess: db error getting instance %s: %s", host, err)
+		return
+	}
+
+	instance.Failures = 0
+	instance.FailingSince = time.Time{}
+	if err := c.db.UpdateInstance(ctx, instance, "failures", "failing_since"); err != nil {
+		log.Errorf("recordSuccess: db error updating instance %s: %s", host, err)
+	}
+}
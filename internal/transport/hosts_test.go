@@ -0,0 +1,188 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type HostsTestSuite struct {
+	suite.Suite
+	db     db.DB
+	client *testrig.MockHTTPClient
+
+	// whether the mock client should
+	// fail to connect to any host
+	down     bool
+	requests int
+}
+
+func (suite *HostsTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB()
+	testrig.StandardDBSetup(suite.db, nil)
+
+	suite.down = false
+	suite.requests = 0
+	suite.client = testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		suite.requests++
+		if suite.down {
+			return nil, errors.New("dial tcp: connect: connection refused")
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Status:     http.StatusText(http.StatusOK),
+			Body:       io.NopCloser(bytes.NewReader([]byte(`{}`))),
+		}, nil
+	}, "../../testrig/media")
+}
+
+func (suite *HostsTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+// newTransport returns a transport from a fresh
+// controller, so that nothing is cached.
+func (suite *HostsTestSuite) newTransport() transport.Transport {
+	fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+	controller := testrig.NewTestTransportController(suite.client, suite.db, fedWorker)
+
+	tp, err := controller.NewTransportForUsername(context.Background(), "the_mighty_zork")
+	suite.NoError(err)
+	return tp
+}
+
+func (suite *HostsTestSuite) dereference(tp transport.Transport, uri string) error {
+	_, err := tp.Dereference(transport.WithFastfail(context.Background()), testrig.URLMustParse(uri))
+	return err
+}
+
+func (suite *HostsTestSuite) TestRecordFailures() {
+	ctx := context.Background()
+	tp := suite.newTransport()
+
+	suite.down = true
+	suite.Error(suite.dereference(tp, "https://example.org/users/some_user"))
+	suite.Error(suite.dereference(tp, "https://example.org/users/some_user"))
+
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.Equal(2, instance.Failures)
+	suite.WithinDuration(time.Now(), instance.FailingSince, time.Minute)
+	suite.WithinDuration(time.Now(), instance.LastFailedAt, time.Minute)
+	suite.Contains(instance.LastFailure, "connection refused")
+
+	// a host we haven't heard from before gets an instance entry
+	suite.Error(suite.dereference(tp, "https://unknown.example.org/users/someone"))
+	instance, err = suite.db.GetInstance(ctx, "unknown.example.org")
+	suite.NoError(err)
+	suite.Equal("https://unknown.example.org", instance.URI)
+	suite.Equal(1, instance.Failures)
+
+	// one success clears the failures, but keeps the last one around
+	suite.down = false
+	suite.NoError(suite.dereference(tp, "https://example.org/users/some_user"))
+	instance, err = suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.Zero(instance.Failures)
+	suite.True(instance.FailingSince.IsZero())
+	suite.Contains(instance.LastFailure, "connection refused")
+}
+
+func (suite *HostsTestSuite) TestSkipUnreachable() {
+	ctx := context.Background()
+
+	// example.org has been down for days, and was
+	// last probed within the last couple of hours
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	instance.Failures = 100
+	instance.FailingSince = time.Now().Add(-transport.UnreachableAfter - time.Hour)
+	instance.LastFailedAt = time.Now().Add(-2 * time.Hour)
+	instance.LastFailure = "dial tcp: connect: connection refused"
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "failures", "failing_since", "last_failed_at", "last_failure"))
+	suite.True(transport.Unreachable(instance))
+
+	tp := suite.newTransport()
+	err = suite.dereference(tp, "https://example.org/users/some_user")
+	suite.ErrorIs(err, transport.ErrHostUnreachable)
+	suite.Zero(suite.requests)
+
+	// deliveries are dropped without being queued
+	suite.NoError(tp.Deliver(ctx, []byte(`{}`), testrig.URLMustParse("https://example.org/users/some_user/inbox")))
+	suite.Zero(suite.requests)
+	count, err := suite.db.CountDeliveries(ctx, "")
+	suite.NoError(err)
+	suite.Zero(count)
+
+	// once a probe is due, exactly one request goes through
+	instance.LastFailedAt = time.Now().Add(-7 * time.Hour)
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "last_failed_at"))
+
+	tp = suite.newTransport()
+	suite.down = true
+	suite.Error(suite.dereference(tp, "https://example.org/users/some_user"))
+	suite.Equal(1, suite.requests)
+	suite.ErrorIs(suite.dereference(tp, "https://example.org/users/some_user"), transport.ErrHostUnreachable)
+	suite.Equal(1, suite.requests)
+
+	instance, err = suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.Equal(101, instance.Failures)
+}
+
+func (suite *HostsTestSuite) TestProbeRecovers() {
+	ctx := context.Background()
+
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	instance.Failures = 100
+	instance.FailingSince = time.Now().Add(-transport.UnreachableAfter - time.Hour)
+	instance.LastFailedAt = time.Now().Add(-7 * time.Hour)
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "failures", "failing_since", "last_failed_at", "last_failure"))
+
+	tp := suite.newTransport()
+	suite.NoError(suite.dereference(tp, "https://example.org/users/some_user"))
+	suite.NoError(suite.dereference(tp, "https://example.org/users/some_user"))
+	suite.Equal(2, suite.requests)
+
+	instance, err = suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	suite.Zero(instance.Failures)
+	suite.False(transport.Unreachable(instance))
+}
+
+func TestHostsTestSuite(t *testing.T) {
+	suite.Run(t, new(HostsTestSuite))
+}
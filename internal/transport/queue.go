@@ -0,0 +1,278 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	errorsv2 "codeberg.org/gruf/go-errors/v2"
+	"codeberg.org/gruf/go-kv"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/metrics"
+)
+
+const (
+	// deliveryBackoffBase is how long we wait before retrying
+	// a failed delivery for the first time. Each further failed
+	// attempt doubles the wait, up to deliveryBackoffMax.
+	deliveryBackoffBase = 5 * time.Minute
+
+	// deliveryBackoffMax is the longest we will ever
+	// wait between two attempts of the same delivery.
+	deliveryBackoffMax = 12 * time.Hour
+
+	// DeliveryMaxAge is how long a delivery is retried for before we give up.
+	// When a delivery this old fails once more, its target domain is considered
+	// unreachable and every delivery queued for that domain is dropped.
+	DeliveryMaxAge = 7 * 24 * time.Hour
+
+	// deliveryBatchSize is the maximum number of queued
+	// deliveries attempted in one call to RetryDeliveries.
+	deliveryBatchSize = 200
+
+	// deliveryRetryInterval is the interval at which
+	// the delivery queue is checked for deliveries that
+	// are due to be retried.
+	deliveryRetryInterval = 1 * time.Minute
+)
+
+// errDeliveryRejected is wrapped by delivery errors where the remote
+// server answered, but refused the activity. There's no use in retrying these.
+var errDeliveryRejected = errors.New("delivery rejected")
+
+// shouldQueue returns whether a delivery that failed
+// with the given error is worth retrying later on.
+func shouldQueue(err error) bool {
+	return !errors.Is(err, errDeliveryRejected) && !errorsv2.Is(err,
+		httpclient.ErrInvalidRequest,
+		httpclient.ErrBodyTooLarge,
+		httpclient.ErrReservedAddr,
+	)
+}
+
+// deliveryBackoff returns how long to wait before
+// the next attempt of a delivery that has already
+// been attempted the given number of times.
+func deliveryBackoff(attempts int) time.Duration {
+	backoff := deliveryBackoffBase
+	for i := 1; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= deliveryBackoffMax {
+			return deliveryBackoffMax
+		}
+	}
+	return backoff
+}
+
+// queueDelivery puts a delivery which failed with the given error
+// in the delivery queue, so that it will be retried with backoff.
+func (t *transport) queueDelivery(ctx context.Context, b []byte, to *url.URL, deliverErr error) error {
+	deliveryID, err := id.NewULID()
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	delivery := &gtsmodel.Delivery{
+		ID:            deliveryID,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+		PubKeyID:      t.pubKeyID,
+		TargetURI:     to.String(),
+		TargetDomain:  to.Hostname(),
+		Payload:       b,
+		Attempts:      1,
+		NextAttemptAt: now.Add(deliveryBackoff(1)),
+		LastError:     deliverErr.Error(),
+	}
+
+	if err := t.controller.db.PutDelivery(ctx, delivery); err != nil {
+		return fmt.Errorf("error queueing delivery to %s: %w", to, err)
+	}
+
+	return nil
+}
+
+func (c *controller) RetryDeliveries(ctx context.Context) error {
+	now := time.Now()
+
+	deliveries, err := c.db.GetDeliveriesDueBefore(ctx, now, deliveryBatchSize)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			return nil
+		}
+		return fmt.Errorf("RetryDeliveries: db error getting due deliveries: %w", err)
+	}
+
+	// domains which failed during this run, with
+	// the time their failed delivery was pushed back to
+	failed := make(map[string]time.Time)
+
+	for _, delivery := range deliveries {
+		if next, ok := failed[delivery.TargetDomain]; ok {
+			// don't hammer a domain we've just seen fail,
+			// wait along with the delivery that failed
+			delivery.NextAttemptAt = next
+			if err := c.db.UpdateDelivery(ctx, delivery, "next_attempt_at"); err != nil {
+				log.Errorf("RetryDeliveries: db error updating delivery %s: %s", delivery.ID, err)
+			}
+			continue
+		}
+
+		requeued, err := c.retryDelivery(ctx, delivery)
+		if err != nil {
+			log.Errorf("RetryDeliveries: error retrying delivery %s: %s", delivery.ID, err)
+			continue
+		}
+
+		if requeued {
+			failed[delivery.TargetDomain] = delivery.NextAttemptAt
+		}
+	}
+
+	return nil
+}
+
+// retryDelivery makes one more attempt at the given queued delivery, and then
+// either removes it from the queue, or pushes its next attempt back. If the
+// delivery has been failing for longer than DeliveryMaxAge, the whole queue
+// for its target domain is dropped.
+//
+// The returned bool is true if the attempt failed and the delivery is still queued.
+func (c *controller) retryDelivery(ctx context.Context, delivery *gtsmodel.Delivery) (bool, error) {
+	l := log.WithFields(kv.Fields{
+		{"id", delivery.ID},
+		{"to", delivery.TargetURI},
+		{"attempts", delivery.Attempts},
+	}...)
+
+	t, err := c.transportForDelivery(ctx, delivery)
+	if err != nil {
+		if errors.Is(err, db.ErrNoEntries) {
+			// the signing account is gone, so this can never be sent
+			l.Info("dropping delivery for account which no longer exists")
+			return false, c.dropDelivery(ctx, delivery)
+		}
+		return false, err
+	}
+
+	to, err := url.Parse(delivery.TargetURI)
+	if err != nil {
+		l.Infof("dropping delivery with unparseable target: %s", err)
+		return false, c.dropDelivery(ctx, delivery)
+	}
+
+	if err := t.checkDomainBlocked(ctx, to.Hostname()); err != nil {
+		if errors.Is(err, ErrDomainBlocked) {
+			l.Info("dropping delivery to domain which is now blocked")
+			return false, c.dropDelivery(ctx, delivery)
+		}
+		return false, err
+	}
+
+	// Make just the one attempt; the queue itself takes care of backoff.
+	deliverErr := t.deliver(WithFastfail(ctx), delivery.Payload, to)
+	if errors.Is(deliverErr, ErrHostUnreachable) {
+		// Nothing was actually sent, so this doesn't count as
+		// an attempt; just check back once a probe may be due.
+		delivery.NextAttemptAt = time.Now().Add(unreachableProbeInterval)
+		l.Debugf("host unreachable, next attempt at %s", delivery.NextAttemptAt)
+
+		if err := c.db.UpdateDelivery(ctx, delivery, "next_attempt_at"); err != nil {
+			return false, fmt.Errorf("db error updating delivery: %w", err)
+		}
+		return true, nil
+	}
+	metrics.RecordDelivery(to.Host, deliverErr)
+
+	if deliverErr == nil {
+		l.Info("queued delivery succeeded")
+		if err := c.dropDelivery(ctx, delivery); err != nil {
+			return false, err
+		}
+
+		// The domain is evidently back up, so there's
+		// no point in waiting to retry the rest of its queue.
+		if err := c.db.RescheduleDeliveriesForDomain(ctx, delivery.TargetDomain, time.Now()); err != nil {
+			return false, fmt.Errorf("db error rescheduling deliveries to %s: %w", delivery.TargetDomain, err)
+		}
+		return false, nil
+	}
+
+	if !shouldQueue(deliverErr) {
+		l.Infof("dropping delivery which was rejected: %s", deliverErr)
+		return false, c.dropDelivery(ctx, delivery)
+	}
+
+	if time.Since(delivery.CreatedAt) > DeliveryMaxAge {
+		l.Warnf("giving up on unreachable domain %s after %s: %s", delivery.TargetDomain, DeliveryMaxAge, deliverErr)
+		if _, err := c.db.DeleteDeliveries(ctx, delivery.TargetDomain); err != nil {
+			return false, fmt.Errorf("db error dropping deliveries to %s: %w", delivery.TargetDomain, err)
+		}
+		return false, nil
+	}
+
+	delivery.Attempts++
+	delivery.NextAttemptAt = time.Now().Add(deliveryBackoff(delivery.Attempts))
+	delivery.LastError = deliverErr.Error()
+	l.Debugf("queued delivery failed, next attempt at %s: %s", delivery.NextAttemptAt, deliverErr)
+
+	if err := c.db.UpdateDelivery(ctx, delivery, "attempts", "next_attempt_at", "last_error"); err != nil {
+		return false, fmt.Errorf("db error updating delivery: %w", err)
+	}
+
+	return true, nil
+}
+
+// transportForDelivery returns a transport that
+// signs with the key of the account that queued
+// the given delivery.
+func (c *controller) transportForDelivery(ctx context.Context, delivery *gtsmodel.Delivery) (*transport, error) {
+	account, err := c.db.GetAccountByPubkeyID(ctx, delivery.PubKeyID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting account with key %s: %w", delivery.PubKeyID, err)
+	}
+
+	if account.PrivateKey == nil {
+		return nil, fmt.Errorf("account %s has no private key", account.ID)
+	}
+
+	t, err := c.NewTransport(account.PublicKeyURI, account.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.(*transport), nil
+}
+
+// dropDelivery removes the given delivery from the queue.
+func (c *controller) dropDelivery(ctx context.Context, delivery *gtsmodel.Delivery) error {
+	if err := c.db.DeleteDeliveryByID(ctx, delivery.ID); err != nil {
+		return fmt.Errorf("db error deleting delivery: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,211 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package transport_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+	"github.com/superseriousbusiness/gotosocial/internal/concurrency"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/messages"
+	"github.com/superseriousbusiness/gotosocial/internal/transport"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+)
+
+type QueueTestSuite struct {
+	suite.Suite
+	db         db.DB
+	controller transport.Controller
+	transport  transport.Transport
+
+	// status code returned by the mock
+	// client for every delivery
+	status   int
+	requests int
+}
+
+func (suite *QueueTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB()
+	testrig.StandardDBSetup(suite.db, nil)
+
+	suite.status = http.StatusAccepted
+	suite.requests = 0
+	client := testrig.NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		suite.requests++
+		return &http.Response{
+			StatusCode: suite.status,
+			Status:     http.StatusText(suite.status),
+			Body:       io.NopCloser(bytes.NewReader(nil)),
+		}, nil
+	}, "../../testrig/media")
+
+	fedWorker := concurrency.NewWorkerPool[messages.FromFederator](-1, -1)
+	suite.controller = testrig.NewTestTransportController(client, suite.db, fedWorker)
+
+	var err error
+	suite.transport, err = suite.controller.NewTransportForUsername(context.Background(), "the_mighty_zork")
+	suite.NoError(err)
+}
+
+func (suite *QueueTestSuite) TearDownTest() {
+	testrig.StandardDBTeardown(suite.db)
+}
+
+func (suite *QueueTestSuite) deliver(inbox string) error {
+	return suite.transport.Deliver(transport.WithFastfail(context.Background()), []byte(`{"type":"Create"}`), testrig.URLMustParse(inbox))
+}
+
+func (suite *QueueTestSuite) queue() []*gtsmodel.Delivery {
+	deliveries, err := suite.db.GetDeliveries(context.Background(), "", "", "", 0)
+	suite.NoError(err)
+	return deliveries
+}
+
+func (suite *QueueTestSuite) TestDeliverQueuesOnServerError() {
+	suite.status = http.StatusServiceUnavailable
+
+	err := suite.deliver("https://example.org/users/some_user/inbox")
+	suite.ErrorContains(err, "queued for retry")
+
+	queue := suite.queue()
+	suite.Len(queue, 1)
+	suite.Equal("https://example.org/users/some_user/inbox", queue[0].TargetURI)
+	suite.Equal("example.org", queue[0].TargetDomain)
+	suite.Equal("http://localhost:8080/users/the_mighty_zork/main-key", queue[0].PubKeyID)
+	suite.Equal(`{"type":"Create"}`, string(queue[0].Payload))
+	suite.Equal(1, queue[0].Attempts)
+	suite.WithinDuration(time.Now().Add(5*time.Minute), queue[0].NextAttemptAt, time.Minute)
+}
+
+func (suite *QueueTestSuite) TestDeliverDoesNotQueueRejection() {
+	suite.status = http.StatusForbidden
+
+	err := suite.deliver("https://example.org/users/some_user/inbox")
+	suite.Error(err)
+	suite.NotContains(err.Error(), "queued for retry")
+	suite.Empty(suite.queue())
+}
+
+func (suite *QueueTestSuite) TestRetryDeliveries() {
+	ctx := context.Background()
+	suite.status = http.StatusServiceUnavailable
+	suite.Error(suite.deliver("https://example.org/users/some_user/inbox"))
+	suite.Error(suite.deliver("https://example.org/users/another_user/inbox"))
+
+	// nothing is due yet
+	suite.requests = 0
+	suite.NoError(suite.controller.RetryDeliveries(ctx))
+	suite.Zero(suite.requests)
+
+	// make everything due, and fail again: only one request should be made,
+	// and the other delivery should wait along with it, keeping its attempts
+	for _, delivery := range suite.queue() {
+		delivery.NextAttemptAt = time.Now().Add(-time.Second)
+		suite.NoError(suite.db.UpdateDelivery(ctx, delivery, "next_attempt_at"))
+	}
+	suite.NoError(suite.controller.RetryDeliveries(ctx))
+	suite.Equal(1, suite.requests)
+
+	queue := suite.queue()
+	suite.Len(queue, 2)
+	suite.Equal(queue[0].NextAttemptAt.Unix(), queue[1].NextAttemptAt.Unix())
+	suite.WithinDuration(time.Now().Add(10*time.Minute), queue[0].NextAttemptAt, time.Minute)
+	suite.Equal(3, queue[0].Attempts+queue[1].Attempts)
+
+	// make one due, and succeed: the other one should be brought
+	// forward and, being due straight away, sent as well
+	queue[1].NextAttemptAt = time.Now().Add(-time.Second)
+	suite.NoError(suite.db.UpdateDelivery(ctx, queue[1], "next_attempt_at"))
+	suite.status = http.StatusAccepted
+	suite.NoError(suite.controller.RetryDeliveries(ctx))
+	suite.Equal(2, suite.requests)
+	suite.Len(suite.queue(), 1)
+
+	suite.NoError(suite.controller.RetryDeliveries(ctx))
+	suite.Equal(3, suite.requests)
+	suite.Empty(suite.queue())
+}
+
+func (suite *QueueTestSuite) TestRetryDeliveriesGivesUpOnDomain() {
+	ctx := context.Background()
+	suite.status = http.StatusServiceUnavailable
+	suite.Error(suite.deliver("https://example.org/users/some_user/inbox"))
+	suite.Error(suite.deliver("https://example.org/users/another_user/inbox"))
+	suite.Error(suite.deliver("https://fossbros-anonymous.io/users/foss_satan/inbox"))
+
+	// the first delivery to example.org has been failing for over a week
+	oldest := suite.queue()[2]
+	oldest.CreatedAt = time.Now().Add(-transport.DeliveryMaxAge - time.Hour)
+	oldest.NextAttemptAt = time.Now().Add(-time.Second)
+	suite.NoError(suite.db.UpdateDelivery(ctx, oldest, "created_at", "next_attempt_at"))
+
+	suite.NoError(suite.controller.RetryDeliveries(ctx))
+
+	queue := suite.queue()
+	suite.Len(queue, 1)
+	suite.Equal("fossbros-anonymous.io", queue[0].TargetDomain)
+}
+
+func (suite *QueueTestSuite) TestRetryDeliveriesSkipsUnreachable() {
+	ctx := context.Background()
+
+	// example.org has been down for days, and
+	// was probed within the last couple of hours
+	instance, err := suite.db.GetInstance(ctx, "example.org")
+	suite.NoError(err)
+	instance.Failures = 100
+	instance.FailingSince = time.Now().Add(-transport.UnreachableAfter - time.Hour)
+	instance.LastFailedAt = time.Now().Add(-2 * time.Hour)
+	suite.NoError(suite.db.UpdateInstance(ctx, instance, "failures", "failing_since", "last_failed_at"))
+
+	delivery := &gtsmodel.Delivery{
+		ID:            "01GTCBZ8VX6Y2C4EZDGEM4KNRR",
+		CreatedAt:     time.Now().Add(-time.Hour),
+		PubKeyID:      "http://localhost:8080/users/the_mighty_zork/main-key",
+		TargetURI:     "https://example.org/users/some_user/inbox",
+		TargetDomain:  "example.org",
+		Payload:       []byte(`{"type":"Create"}`),
+		Attempts:      3,
+		NextAttemptAt: time.Now().Add(-time.Second),
+	}
+	suite.NoError(suite.db.PutDelivery(ctx, delivery))
+
+	// nothing is sent, and it doesn't count as an attempt
+	suite.NoError(suite.controller.RetryDeliveries(ctx))
+	suite.Zero(suite.requests)
+
+	queue := suite.queue()
+	suite.Len(queue, 1)
+	suite.Equal(3, queue[0].Attempts)
+	suite.WithinDuration(time.Now().Add(6*time.Hour), queue[0].NextAttemptAt, time.Minute)
+}
+
+func TestQueueTestSuite(t *testing.T) {
+	suite.Run(t, new(QueueTestSuite))
+}
@@ -37,6 +37,11 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Transport implements the pub.Transport interface with some additional functionality for fetching remote media.
@@ -83,6 +88,20 @@ type transport struct {
 	signerMu   sync.Mutex
 }
 
+// checkDomainBlocked returns ErrDomainBlocked if this instance doesn't federate with the given host.
+func (t *transport) checkDomainBlocked(ctx context.Context, host string) error {
+	blocked, err := t.controller.db.IsDomainBlocked(ctx, host)
+	if err != nil {
+		return fmt.Errorf("error checking domain block for %s: %w", host, err)
+	}
+
+	if blocked {
+		return fmt.Errorf("%w: %s", ErrDomainBlocked, host)
+	}
+
+	return nil
+}
+
 // GET will perform given http request using transport client, retrying on certain preset errors, or if status code is among retryOn.
 func (t *transport) GET(r *http.Request, retryOn ...int) (*http.Response, error) {
 	if r.Method != http.MethodGet {
@@ -103,7 +122,7 @@ func (t *transport) POST(r *http.Request, body []byte, retryOn ...int) (*http.Re
 	}, retryOn...)
 }
 
-func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryOn ...int) (*http.Response, error) {
+func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryOn ...int) (rsp *http.Response, err error) {
 	const maxRetries = 5
 
 	var (
@@ -112,7 +131,35 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 
 		// Get request hostname
 		host = r.URL.Hostname()
+
+		// Error of the latest failed attempt
+		lastErr error
+	)
+
+	// Start a span covering this request and all of its retries.
+	// NOTE: we deliberately don't inject the trace context into the
+	// outgoing request headers, as remote instances have no business
+	// knowing our internal trace IDs.
+	ctx, span := tracing.Tracer().Start(r.Context(), "transport "+r.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPMethodKey.String(r.Method),
+			semconv.HTTPURLKey.String(r.URL.String()),
+			semconv.NetPeerNameKey.String(host),
+			attribute.String("gotosocial.signature.key_id", t.pubKeyID),
+		),
 	)
+	defer func() {
+		if rsp != nil {
+			span.SetAttributes(semconv.HTTPStatusCodeKey.Int(rsp.StatusCode))
+		}
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+	r = r.WithContext(ctx)
 
 	// Check if recently reached max retries for this host
 	// so we don't need to bother reattempting it. The only
@@ -120,9 +167,18 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 	// domain resolution type errors, so this cached result
 	// indicates this server is likely having issues.
 	if t.controller.badHosts.Has(host) {
+		span.SetAttributes(attribute.Bool("gotosocial.transport.bad_host", true))
 		return nil, errors.New("too many failed attempts")
 	}
 
+	// Check whether this host has been failing for so long
+	// that it's considered unreachable, in which case we
+	// only bother it with the occasional probe request.
+	if err := t.controller.checkReachable(ctx, host); err != nil {
+		span.SetAttributes(attribute.Bool("gotosocial.transport.unreachable", true))
+		return nil, err
+	}
+
 	// Check whether request should fast fail, we check this
 	// before loop as each context.Value() requires mutex lock.
 	fastFail := IsFastfail(r.Context())
@@ -137,6 +193,9 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 	r.Header.Set("User-Agent", t.controller.userAgent)
 
 	for i := 0; i < maxRetries; i++ {
+		// Record attempt number on span, overwritten on each retry
+		span.SetAttributes(attribute.Int("gotosocial.transport.attempt", i+1))
+
 		// Reset signing header fields
 		now := t.controller.clock.Now().UTC()
 		r.Header.Set("Date", now.Format("Mon, 02 Jan 2006 15:04:05")+" GMT")
@@ -148,6 +207,10 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 			return nil, err
 		}
 
+		span.AddEvent("signed request", trace.WithAttributes(
+			attribute.String("gotosocial.signature.algorithm", signatureAlgorithm(r.Header.Get("Signature"))),
+		))
+
 		l.Infof("performing request")
 
 		// Attempt to perform request
@@ -159,11 +222,13 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 			if code := rsp.StatusCode; code < 500 &&
 				code != http.StatusTooManyRequests &&
 				!containsInt(retryOn, rsp.StatusCode) {
+				t.controller.recordSuccess(ctx, host)
 				return rsp, nil
 			}
 
 			// Generate error from status code for logging
 			err = errors.New(`http response "` + rsp.Status + `"`)
+			_ = rsp.Body.Close()
 		} else if errorsv2.Is(err,
 			context.DeadlineExceeded,
 			context.Canceled,
@@ -178,13 +243,22 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 			return nil, err
 		} else if errors.As(err, &x509.UnknownAuthorityError{}) {
 			// Unknown authority errors we do NOT recover from
+			t.controller.recordFailure(ctx, r.URL, err)
 			return nil, err
-		} else if fastFail {
+		}
+
+		if fastFail {
 			// on fast-fail, don't bother backoff/retry
+			t.controller.recordFailure(ctx, r.URL, err)
 			return nil, fmt.Errorf("%w (fast fail)", err)
 		}
 
+		lastErr = err
 		l.Errorf("backing off for %s after http request error: %v", backoff.String(), err)
+		span.AddEvent("backing off", trace.WithAttributes(
+			attribute.String("gotosocial.transport.backoff", backoff.String()),
+			attribute.String("error", err.Error()),
+		))
 
 		select {
 		// Request ctx cancelled
@@ -199,6 +273,7 @@ func (t *transport) do(r *http.Request, signer func(*http.Request) error, retryO
 
 	// Add "bad" entry for this host
 	t.controller.badHosts.Set(host, struct{}{})
+	t.controller.recordFailure(ctx, r.URL, lastErr)
 
 	return nil, errors.New("transport reached max retries")
 }
@@ -239,6 +314,18 @@ func (t *transport) safesign(sign func()) {
 	sign()
 }
 
+// signatureAlgorithm returns the value of the algorithm
+// parameter in the given http signature header, if any.
+func signatureAlgorithm(signature string) string {
+	for _, param := range strings.Split(signature, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if ok && k == "algorithm" {
+			return strings.Trim(v, `"`)
+		}
+	}
+	return ""
+}
+
 // containsInt checks if slice contains check.
 func containsInt(slice []int, check int) bool {
 	for _, i := range slice {
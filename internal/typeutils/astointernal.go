@@ -29,7 +29,9 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/db"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
 	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
@@ -98,7 +100,13 @@ func (c *converter) ASRepresentationToAccount(ctx context.Context, accountable a
 		acct.Emojis = emojis
 	}
 
-	// TODO: fields aka attachment array
+	// fields aka attachment array
+	for _, field := range ap.ExtractFields(accountable) {
+		acct.Fields = append(acct.Fields, gtsmodel.Field{
+			Name:  text.SanitizePlaintext(field.Name),
+			Value: text.SanitizeHTML(field.Value),
+		})
+	}
 
 	// note aka summary
 	note, err := ap.ExtractSummary(accountable)
@@ -206,7 +214,15 @@ func (c *converter) ASRepresentationToAccount(ctx context.Context, accountable a
 
 	// TODO: FeaturedTagsURI
 
-	// TODO: alsoKnownAs
+	// AlsoKnownAs
+	for _, aka := range ap.ExtractAlsoKnownAs(accountable) {
+		acct.AlsoKnownAs = append(acct.AlsoKnownAs, aka.String())
+	}
+
+	// MovedToURI
+	if movedTo := ap.ExtractMovedTo(accountable); movedTo != nil {
+		acct.MovedToURI = movedTo.String()
+	}
 
 	// publicKey
 	pkey, pkeyURL, err := ap.ExtractPublicKeyForOwner(accountable, uri)
@@ -286,6 +302,9 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 		status.UpdatedAt = published
 	}
 
+	// when was this status last edited, if ever?
+	status.EditedAt = ap.ExtractUpdated(statusable)
+
 	// which account posted this status?
 	// if we don't know the account yet we can dereference it later
 	attributedTo, err := ap.ExtractAttributedTo(statusable)
@@ -349,6 +368,23 @@ func (c *converter) ASStatusToStatus(ctx context.Context, statusable ap.Statusab
 	sensitive := ap.ExtractSensitive(statusable)
 	status.Sensitive = &sensitive
 
+	// poll, if this status is a question
+	if pollable, ok := statusable.(ap.Pollable); ok {
+		poll, err := ap.ExtractPoll(pollable)
+		if err != nil {
+			return nil, fmt.Errorf("ASStatusToStatus: error extracting poll: %w", err)
+		}
+
+		pollID, err := id.NewULID()
+		if err != nil {
+			return nil, err
+		}
+		poll.ID = pollID
+		poll.Status = status
+		status.Poll = poll
+		status.PollID = pollID
+	}
+
 	// language
 	// we might be able to extract this from the contentMap field
 
@@ -71,6 +71,34 @@ func (suite *ASToInternalTestSuite) TestParsePerson() {
 	suite.False(*acct.Locked)
 }
 
+func (suite *ASToInternalTestSuite) TestParsePersonWithAliasesAndMove() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["remote_account_1"]
+	testAccount.AlsoKnownAs = []string{"https://example.org/users/old_satan", "https://example.org/users/older_satan"}
+	testAccount.MovedToURI = "https://example.org/users/new_satan"
+
+	// round trip the account through json,
+	// to make sure unknown properties survive
+	asPerson, err := suite.typeconverter.AccountToAS(context.Background(), testAccount)
+	suite.NoError(err)
+
+	ser, err := streams.Serialize(asPerson)
+	suite.NoError(err)
+
+	bytes, err := json.Marshal(ser)
+	suite.NoError(err)
+
+	accountable, ok := suite.jsonToType(string(bytes)).(ap.Accountable)
+	suite.True(ok)
+
+	// update so that the stored account isn't just returned as-is
+	acct, err := suite.typeconverter.ASRepresentationToAccount(context.Background(), accountable, "", true)
+	suite.NoError(err)
+
+	suite.Equal([]string{"https://example.org/users/old_satan", "https://example.org/users/older_satan"}, acct.AlsoKnownAs)
+	suite.Equal("https://example.org/users/new_satan", acct.MovedToURI)
+}
+
 func (suite *ASToInternalTestSuite) TestParsePersonWithSharedInbox() {
 	testPerson := suite.testPeople["https://turnip.farm/users/turniplover6969"]
 
@@ -134,6 +162,14 @@ func (suite *ASToInternalTestSuite) TestParseGargron() {
 	acct, err := suite.typeconverter.ASRepresentationToAccount(context.Background(), rep, "", false)
 	suite.NoError(err)
 	suite.Equal("https://mastodon.social/inbox", *acct.SharedInboxURI)
+
+	// PropertyValue attachments are parsed as fields, the IdentityProof is skipped
+	if suite.Len(acct.Fields, 2) {
+		suite.Equal("Patreon", acct.Fields[0].Name)
+		suite.Equal(`<a href="https://www.patreon.com/mastodon" rel="me nofollow noopener noreferrer" target="_blank"><span class="invisible">https://www.</span><span class="">patreon.com/mastodon</span><span class="invisible"></span></a>`, acct.Fields[0].Value)
+		suite.Equal("Homepage", acct.Fields[1].Name)
+		suite.True(acct.Fields[1].VerifiedAt.IsZero())
+	}
 }
 
 func (suite *ASToInternalTestSuite) TestParseReplyWithMention() {
@@ -53,6 +53,9 @@ type TypeConverter interface {
 	// something goes wrong. The returned account will be a bare minimum representation of the account. This function should be used
 	// when someone wants to view an account they've blocked.
 	AccountToAPIAccountBlocked(ctx context.Context, account *gtsmodel.Account) (*apimodel.Account, error)
+	// AccountToAdminAPIAccount takes a db model account as a param, and returns the admin view of that account,
+	// including user-level information for local accounts. Serve it only to admins.
+	AccountToAdminAPIAccount(ctx context.Context, account *gtsmodel.Account) (*apimodel.AdminAccountInfo, error)
 	// AppToAPIAppSensitive takes a db model application as a param, and returns a populated apitype application, or an error
 	// if something goes wrong. The returned application should be ready to serialize on an API level, and may have sensitive fields
 	// (such as client id and client secret), so serve it only to an authorized user who should have permission to see it.
@@ -87,10 +90,48 @@ type TypeConverter interface {
 	NotificationToAPINotification(ctx context.Context, n *gtsmodel.Notification) (*apimodel.Notification, error)
 	// DomainBlockToAPIDomainBlock converts a gts model domin block into a api domain block, for serving at /api/v1/admin/domain_blocks
 	DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel.DomainBlock, export bool) (*apimodel.DomainBlock, error)
+	// DomainAllowToAPIDomainAllow converts a gts model domain allow into a api domain allow, for serving at /api/v1/admin/domain_allows
+	DomainAllowToAPIDomainAllow(ctx context.Context, a *gtsmodel.DomainAllow, export bool) (*apimodel.DomainAllow, error)
+	// DomainBlockSubscriptionToAPIDomainBlockSubscription converts one gts model domain block subscription into an api model
+	// domain block subscription, for serving at /api/v1/admin/domain_block_subscriptions
+	DomainBlockSubscriptionToAPIDomainBlockSubscription(ctx context.Context, s *gtsmodel.DomainBlockSubscription) (*apimodel.DomainBlockSubscription, error)
+	// InstanceToAdminAPIInstance converts a gts model instance into an admin view instance, for serving at /api/v1/admin/instances
+	InstanceToAdminAPIInstance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.AdminInstance, error)
+	// DeliveryToAdminAPIDelivery converts a gts model queued delivery into an admin view delivery, for serving at /api/v1/admin/deliveries
+	DeliveryToAdminAPIDelivery(ctx context.Context, d *gtsmodel.Delivery) (*apimodel.AdminDelivery, error)
 	// ReportToAPIReport converts a gts model report into an api model report, for serving at /api/v1/reports
 	ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error)
 	// ReportToAdminAPIReport converts a gts model report into an admin view report, for serving at /api/v1/admin/reports
 	ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Report, requestingAccount *gtsmodel.Account) (*apimodel.AdminReport, error)
+	// InviteToAPIInvite converts one gts model invite into an api model invite, for serving at /api/v1/invites
+	InviteToAPIInvite(ctx context.Context, i *gtsmodel.Invite) (*apimodel.Invite, error)
+	// ListToAPIList converts one gts model list into an api model list, for serving at /api/v1/lists/{id}
+	ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error)
+	// FilterKeywordToAPIFilterV1 converts one gts model filter keyword (and its parent filter) into an api model v1 filter, for serving at /api/v1/filters/{id}
+	FilterKeywordToAPIFilterV1(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.Filter, error)
+	// FilterToAPIFilterV2 converts one gts model filter into an api model v2 filter, for serving at /api/v2/filters/{id}
+	FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*apimodel.FilterV2, error)
+	// FilterKeywordToAPIFilterKeyword converts one gts model filter keyword into an api model filter keyword, for serving at /api/v2/filters/keywords/{id}
+	FilterKeywordToAPIFilterKeyword(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, error)
+	// StatusEditToAPIStatusEdit converts one gts model status edit (ie., a revision of a status) into its api representation,
+	// for serving at /api/v1/statuses/{id}/history
+	StatusEditToAPIStatusEdit(ctx context.Context, e *gtsmodel.StatusEdit) (*apimodel.StatusEdit, error)
+	// PollToAPIPoll converts one gts model poll into an api model poll, for serving at /api/v1/polls/{id}
+	//
+	// Requesting account can be nil.
+	PollToAPIPoll(ctx context.Context, requestingAccount *gtsmodel.Account, p *gtsmodel.Poll) (*apimodel.Poll, error)
+	// ScheduledStatusToAPIScheduledStatus converts one gts model scheduled status into an api model scheduled status,
+	// for serving at /api/v1/scheduled_statuses/{id}
+	ScheduledStatusToAPIScheduledStatus(ctx context.Context, s *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, error)
+	// ConversationToAPIConversation converts one gts model conversation into an api model conversation,
+	// for serving at /api/v1/conversations, from the point of view of its owner, requestingAccount.
+	ConversationToAPIConversation(ctx context.Context, conversation *gtsmodel.Conversation, requestingAccount *gtsmodel.Account) (*apimodel.Conversation, error)
+	// WebPushSubscriptionToAPIPushSubscription converts one gts model web push subscription into an api model push subscription,
+	// for serving at /api/v1/push/subscription
+	WebPushSubscriptionToAPIPushSubscription(ctx context.Context, s *gtsmodel.WebPushSubscription) (*apimodel.PushSubscription, error)
+	// AccountExportToAPIAccountExport converts one gts model account export into an api model account export,
+	// for serving at /api/v1/exports
+	AccountExportToAPIAccountExport(ctx context.Context, e *gtsmodel.AccountExport) (*apimodel.AccountExport, error)
 
 	/*
 		INTERNAL (gts) MODEL TO FRONTEND (rss) MODEL
@@ -156,8 +197,9 @@ type TypeConverter interface {
 	// suitable for serving to requesters to whom we want to give as little information as possible because
 	// we don't trust them (yet).
 	AccountToASMinimal(ctx context.Context, a *gtsmodel.Account) (vocab.ActivityStreamsPerson, error)
-	// StatusToAS converts a gts model status into an activity streams note, suitable for federation
-	StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error)
+	// StatusToAS converts a gts model status into an activity streams note, suitable for federation.
+	// If the status has a poll attached, it will be converted into an activity streams question instead.
+	StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error)
 	// FollowToASFollow converts a gts model Follow into an activity streams Follow, suitable for federation
 	FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error)
 	// MentionToAS converts a gts model mention into an activity streams Mention, suitable for federation
@@ -166,12 +208,17 @@ type TypeConverter interface {
 	EmojiToAS(ctx context.Context, e *gtsmodel.Emoji) (vocab.TootEmoji, error)
 	// AttachmentToAS converts a gts model media attachment into an activity streams Attachment, suitable for federation
 	AttachmentToAS(ctx context.Context, a *gtsmodel.MediaAttachment) (vocab.ActivityStreamsDocument, error)
+	// PollVoteToASCreates converts a gts model poll vote into one activityStreams CREATE per chosen option, each wrapping
+	// a NOTE named after that option, as expected by Mastodon and friends. Suitable for federation to the poll's author.
+	PollVoteToASCreates(ctx context.Context, vote *gtsmodel.PollVote) ([]vocab.ActivityStreamsCreate, error)
 	// FaveToAS converts a gts model status fave into an activityStreams LIKE, suitable for federation.
 	FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab.ActivityStreamsLike, error)
 	// BoostToAS converts a gts model boost into an activityStreams ANNOUNCE, suitable for federation
 	BoostToAS(ctx context.Context, boostWrapperStatus *gtsmodel.Status, boostingAccount *gtsmodel.Account, boostedAccount *gtsmodel.Account) (vocab.ActivityStreamsAnnounce, error)
 	// BlockToAS converts a gts model block into an activityStreams BLOCK, suitable for federation.
 	BlockToAS(ctx context.Context, block *gtsmodel.Block) (vocab.ActivityStreamsBlock, error)
+	// AccountToASMove converts a gts model account that has moved into an activityStreams MOVE, suitable for federation.
+	AccountToASMove(ctx context.Context, account *gtsmodel.Account) (vocab.ActivityStreamsMove, error)
 	// StatusToASRepliesCollection converts a gts model status into an activityStreams REPLIES collection.
 	StatusToASRepliesCollection(ctx context.Context, status *gtsmodel.Status, onlyOtherAccounts bool) (vocab.ActivityStreamsCollection, error)
 	// StatusURIsToASRepliesPage returns a collection page with appropriate next/part of pagination.
@@ -199,6 +246,9 @@ type TypeConverter interface {
 	FollowRequestToFollow(ctx context.Context, f *gtsmodel.FollowRequest) *gtsmodel.Follow
 	// StatusToBoost wraps the given status into a boosting status.
 	StatusToBoost(ctx context.Context, s *gtsmodel.Status, boostingAccount *gtsmodel.Account) (*gtsmodel.Status, error)
+	// StatusToStatusEdit snapshots the current revision of the given status into a status edit, suitable for storing
+	// before the status is edited, or for serving as the latest entry in the status' history.
+	StatusToStatusEdit(ctx context.Context, s *gtsmodel.Status) (*gtsmodel.StatusEdit, error)
 
 	/*
 		WRAPPER CONVENIENCE FUNCTIONS
@@ -206,12 +256,12 @@ type TypeConverter interface {
 
 	// WrapPersonInUpdate
 	WrapPersonInUpdate(person vocab.ActivityStreamsPerson, originAccount *gtsmodel.Account) (vocab.ActivityStreamsUpdate, error)
-	// WrapNoteInCreate wraps a Note with a Create activity.
+	// WrapStatusableInCreate wraps a Note or Question with a Create activity.
 	//
-	// If objectIRIOnly is set to true, then the function won't put the *entire* note in the Object field of the Create,
-	// but just the AP URI of the note. This is useful in cases where you want to give a remote server something to dereference,
+	// If objectIRIOnly is set to true, then the function won't put the *entire* status in the Object field of the Create,
+	// but just the AP URI of the status. This is useful in cases where you want to give a remote server something to dereference,
 	// and still have control over whether or not they're allowed to actually see the contents.
-	WrapNoteInCreate(note vocab.ActivityStreamsNote, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
+	WrapStatusableInCreate(status ap.Statusable, objectIRIOnly bool) (vocab.ActivityStreamsCreate, error)
 }
 
 type converter struct {
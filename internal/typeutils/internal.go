@@ -93,3 +93,35 @@ func (c *converter) StatusToBoost(ctx context.Context, s *gtsmodel.Status, boost
 
 	return boostWrapperStatus, nil
 }
+
+func (c *converter) StatusToStatusEdit(ctx context.Context, s *gtsmodel.Status) (*gtsmodel.StatusEdit, error) {
+	editID, err := id.NewULID()
+	if err != nil {
+		return nil, err
+	}
+
+	// this revision was written either when the
+	// status was last edited, or when it was created
+	createdAt := s.EditedAt
+	if createdAt.IsZero() {
+		createdAt = s.CreatedAt
+	}
+
+	sensitive := s.Sensitive != nil && *s.Sensitive
+
+	return &gtsmodel.StatusEdit{
+		ID:             editID,
+		CreatedAt:      createdAt,
+		StatusID:       s.ID,
+		Status:         s,
+		AccountID:      s.AccountID,
+		Account:        s.Account,
+		Content:        s.Content,
+		Text:           s.Text,
+		ContentWarning: s.ContentWarning,
+		Sensitive:      &sensitive,
+		Language:       s.Language,
+		AttachmentIDs:  append([]string{}, s.AttachmentIDs...),
+		EmojiIDs:       append([]string{}, s.EmojiIDs...),
+	}, nil
+}
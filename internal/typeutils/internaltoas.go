@@ -24,14 +24,18 @@ import (
 	"encoding/pem"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/superseriousbusiness/activity/pub"
 	"github.com/superseriousbusiness/activity/streams"
 	"github.com/superseriousbusiness/activity/streams/vocab"
+	"github.com/superseriousbusiness/gotosocial/internal/ap"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
 	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/id"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 )
 
 // Converts a gts model account into an Activity Streams person type.
@@ -116,6 +120,20 @@ func (c *converter) AccountToAS(ctx context.Context, a *gtsmodel.Account) (vocab
 	// featuredTags
 	// NOT IMPLEMENTED
 
+	// alsoKnownAs
+	// Aliases of this account, which it may have moved from.
+	// Not part of the vocabulary that go-fed knows about, so
+	// it's set on the unknown properties of the person.
+	if len(a.AlsoKnownAs) != 0 {
+		person.GetUnknownProperties()[ap.PropertyAlsoKnownAs] = a.AlsoKnownAs
+	}
+
+	// movedTo
+	// The account this account has moved to, if any.
+	if a.MovedToURI != "" {
+		person.GetUnknownProperties()[ap.PropertyMovedTo] = a.MovedToURI
+	}
+
 	// preferredUsername
 	// Used for Webfinger lookup. Must be unique on the domain, and must correspond to a Webfinger acct: URI.
 	preferredUsernameProp := streams.NewActivityStreamsPreferredUsernameProperty()
@@ -380,7 +398,7 @@ func (c *converter) AccountToASMinimal(ctx context.Context, a *gtsmodel.Account)
 	return person, nil
 }
 
-func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.ActivityStreamsNote, error) {
+func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (ap.Statusable, error) {
 	// ensure prerequisites here before we get stuck in
 
 	// check if author account is already attached to status and attach it if not
@@ -438,6 +456,13 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 	publishedProp.Set(s.CreatedAt)
 	status.SetActivityStreamsPublished(publishedProp)
 
+	// updated
+	if !s.EditedAt.IsZero() {
+		updatedProp := streams.NewActivityStreamsUpdatedProperty()
+		updatedProp.Set(s.EditedAt)
+		status.SetActivityStreamsUpdated(updatedProp)
+	}
+
 	// url
 	if s.URL != "" {
 		sURL, err := url.Parse(s.URL)
@@ -611,12 +636,107 @@ func (c *converter) StatusToAS(ctx context.Context, s *gtsmodel.Status) (vocab.A
 
 	// sensitive
 	sensitiveProp := streams.NewActivityStreamsSensitiveProperty()
-	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive)
+	sensitiveProp.AppendXMLSchemaBoolean(*s.Sensitive || !s.Account.SensitizedAt.IsZero())
 	status.SetActivityStreamsSensitive(sensitiveProp)
 
+	// poll -- statuses with polls are federated as Questions
+	if s.PollID != "" {
+		if s.Poll == nil {
+			poll, err := c.db.GetPollByID(ctx, s.PollID)
+			if err != nil {
+				return nil, fmt.Errorf("StatusToAS: error getting poll %s from database: %s", s.PollID, err)
+			}
+			s.Poll = poll
+		}
+		return pollNoteToQuestion(status, s.Poll), nil
+	}
+
 	return status, nil
 }
 
+// pollNoteToQuestion converts the given status note into a question,
+// by copying over all the note properties, and adding poll properties
+// derived from the given poll.
+func pollNoteToQuestion(note vocab.ActivityStreamsNote, poll *gtsmodel.Poll) vocab.ActivityStreamsQuestion {
+	question := streams.NewActivityStreamsQuestion()
+	question.SetJSONLDId(note.GetJSONLDId())
+	question.SetActivityStreamsSummary(note.GetActivityStreamsSummary())
+	question.SetActivityStreamsInReplyTo(note.GetActivityStreamsInReplyTo())
+	question.SetActivityStreamsPublished(note.GetActivityStreamsPublished())
+	question.SetActivityStreamsUpdated(note.GetActivityStreamsUpdated())
+	question.SetActivityStreamsUrl(note.GetActivityStreamsUrl())
+	question.SetActivityStreamsAttributedTo(note.GetActivityStreamsAttributedTo())
+	question.SetActivityStreamsTag(note.GetActivityStreamsTag())
+	question.SetActivityStreamsTo(note.GetActivityStreamsTo())
+	question.SetActivityStreamsCc(note.GetActivityStreamsCc())
+	question.SetActivityStreamsContent(note.GetActivityStreamsContent())
+	question.SetActivityStreamsAttachment(note.GetActivityStreamsAttachment())
+	question.SetActivityStreamsReplies(note.GetActivityStreamsReplies())
+	question.SetActivityStreamsSensitive(note.GetActivityStreamsSensitive())
+
+	// vote counts are hidden while the poll runs, if requested
+	showCounts := poll.Closed() || poll.Expired(time.Now()) || poll.HideCounts == nil || !*poll.HideCounts
+
+	// options are oneOf for single choice polls, anyOf for multiple choice
+	oneOfProp := streams.NewActivityStreamsOneOfProperty()
+	anyOfProp := streams.NewActivityStreamsAnyOfProperty()
+	for i, option := range poll.Options {
+		optionNote := streams.NewActivityStreamsNote()
+
+		nameProp := streams.NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(option)
+		optionNote.SetActivityStreamsName(nameProp)
+
+		var votes int
+		if showCounts && i < len(poll.Votes) {
+			votes = poll.Votes[i]
+		}
+		repliesCollection := streams.NewActivityStreamsCollection()
+		totalItemsProp := streams.NewActivityStreamsTotalItemsProperty()
+		totalItemsProp.Set(votes)
+		repliesCollection.SetActivityStreamsTotalItems(totalItemsProp)
+		repliesProp := streams.NewActivityStreamsRepliesProperty()
+		repliesProp.SetActivityStreamsCollection(repliesCollection)
+		optionNote.SetActivityStreamsReplies(repliesProp)
+
+		if poll.Multiple != nil && *poll.Multiple {
+			anyOfProp.AppendActivityStreamsNote(optionNote)
+		} else {
+			oneOfProp.AppendActivityStreamsNote(optionNote)
+		}
+	}
+
+	if oneOfProp.Len() > 0 {
+		question.SetActivityStreamsOneOf(oneOfProp)
+	}
+	if anyOfProp.Len() > 0 {
+		question.SetActivityStreamsAnyOf(anyOfProp)
+	}
+
+	// endTime
+	if !poll.ExpiresAt.IsZero() {
+		endTimeProp := streams.NewActivityStreamsEndTimeProperty()
+		endTimeProp.Set(poll.ExpiresAt)
+		question.SetActivityStreamsEndTime(endTimeProp)
+	}
+
+	// closed
+	if poll.Closed() {
+		closedProp := streams.NewActivityStreamsClosedProperty()
+		closedProp.AppendXMLSchemaDateTime(poll.ClosedAt)
+		question.SetActivityStreamsClosed(closedProp)
+	}
+
+	// votersCount
+	if showCounts {
+		votersCountProp := streams.NewTootVotersCountProperty()
+		votersCountProp.Set(poll.Voters)
+		question.SetTootVotersCount(votersCountProp)
+	}
+
+	return question
+}
+
 func (c *converter) FollowToAS(ctx context.Context, f *gtsmodel.Follow, originAccount *gtsmodel.Account, targetAccount *gtsmodel.Account) (vocab.ActivityStreamsFollow, error) {
 	// parse out the various URIs we need for this
 	// origin account (who's doing the follow)
@@ -805,6 +925,104 @@ We want to end up with something like this:
 "type": "Like"
 }
 */
+func (c *converter) PollVoteToASCreates(ctx context.Context, vote *gtsmodel.PollVote) ([]vocab.ActivityStreamsCreate, error) {
+	if vote.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, vote.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error fetching voting account from database: %s", err)
+		}
+		vote.Account = a
+	}
+
+	if vote.Poll == nil {
+		p, err := c.db.GetPollByID(ctx, vote.PollID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error fetching poll from database: %s", err)
+		}
+		vote.Poll = p
+	}
+
+	if vote.Poll.Status == nil {
+		s, err := c.db.GetStatusByID(ctx, vote.Poll.StatusID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error fetching poll status from database: %s", err)
+		}
+		vote.Poll.Status = s
+	}
+
+	if vote.Poll.Status.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, vote.Poll.Status.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error fetching poll author account from database: %s", err)
+		}
+		vote.Poll.Status.Account = a
+	}
+
+	actorIRI, err := url.Parse(vote.Account.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing uri %s: %s", vote.Account.URI, err)
+	}
+
+	inReplyToIRI, err := url.Parse(vote.Poll.Status.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing uri %s: %s", vote.Poll.Status.URI, err)
+	}
+
+	toIRI, err := url.Parse(vote.Poll.Status.Account.URI)
+	if err != nil {
+		return nil, fmt.Errorf("PollVoteToASCreates: error parsing uri %s: %s", vote.Poll.Status.Account.URI, err)
+	}
+
+	creates := make([]vocab.ActivityStreamsCreate, 0, len(vote.Choices))
+	for i, choice := range vote.Choices {
+		if choice < 0 || choice >= len(vote.Poll.Options) {
+			return nil, fmt.Errorf("PollVoteToASCreates: vote choice %d out of range", choice)
+		}
+
+		note := streams.NewActivityStreamsNote()
+
+		// set the ID property to a fragment of the voting account's URI,
+		// since votes are never dereferenced and don't need their own endpoint
+		idProp := streams.NewJSONLDIdProperty()
+		idIRI, err := url.Parse(fmt.Sprintf("%s#votes/%s/%d", vote.Account.URI, vote.ID, i))
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error parsing vote uri: %s", err)
+		}
+		idProp.SetIRI(idIRI)
+		note.SetJSONLDId(idProp)
+
+		// the name of the note is the name of the chosen option
+		nameProp := streams.NewActivityStreamsNameProperty()
+		nameProp.AppendXMLSchemaString(vote.Poll.Options[choice])
+		note.SetActivityStreamsName(nameProp)
+
+		attributedToProp := streams.NewActivityStreamsAttributedToProperty()
+		attributedToProp.AppendIRI(actorIRI)
+		note.SetActivityStreamsAttributedTo(attributedToProp)
+
+		inReplyToProp := streams.NewActivityStreamsInReplyToProperty()
+		inReplyToProp.AppendIRI(inReplyToIRI)
+		note.SetActivityStreamsInReplyTo(inReplyToProp)
+
+		publishedProp := streams.NewActivityStreamsPublishedProperty()
+		publishedProp.Set(vote.CreatedAt)
+		note.SetActivityStreamsPublished(publishedProp)
+
+		// votes are only addressed to the author of the poll
+		toProp := streams.NewActivityStreamsToProperty()
+		toProp.AppendIRI(toIRI)
+		note.SetActivityStreamsTo(toProp)
+
+		create, err := c.WrapStatusableInCreate(note, false)
+		if err != nil {
+			return nil, fmt.Errorf("PollVoteToASCreates: error wrapping vote in create: %s", err)
+		}
+		creates = append(creates, create)
+	}
+
+	return creates, nil
+}
+
 func (c *converter) FaveToAS(ctx context.Context, f *gtsmodel.StatusFave) (vocab.ActivityStreamsLike, error) {
 	// check if targetStatus is already pinned to this fave, and fetch it if not
 	if f.Status == nil {
@@ -1022,6 +1240,79 @@ func (c *converter) BlockToAS(ctx context.Context, b *gtsmodel.Block) (vocab.Act
 	return block, nil
 }
 
+/*
+the goal is to end up with something like this:
+
+	{
+		"@context": "https://www.w3.org/ns/activitystreams",
+		"actor": "https://example.org/users/old_account",
+		"id": "https://example.org/users/old_account/moves/01GSG8XK5ZNNHDA6CD9TTRF7BM",
+		"object": "https://example.org/users/old_account",
+		"target": "https://some_other.instance/users/new_account",
+		"to": "https://example.org/users/old_account/followers",
+		"type": "Move"
+	}
+*/
+func (c *converter) AccountToASMove(ctx context.Context, a *gtsmodel.Account) (vocab.ActivityStreamsMove, error) {
+	if a.MovedToURI == "" {
+		return nil, fmt.Errorf("AccountToASMove: account %s has not moved", a.ID)
+	}
+
+	accountIRI, err := url.Parse(a.URI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", a.URI, err)
+	}
+
+	targetIRI, err := url.Parse(a.MovedToURI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", a.MovedToURI, err)
+	}
+
+	followersIRI, err := url.Parse(a.FollowersURI)
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing uri %s: %s", a.FollowersURI, err)
+	}
+
+	moveID, err := id.NewRandomULID()
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error generating id: %s", err)
+	}
+
+	idIRI, err := url.Parse(uris.GenerateURIForMove(a.Username, moveID))
+	if err != nil {
+		return nil, fmt.Errorf("AccountToASMove: error parsing move uri: %s", err)
+	}
+
+	move := streams.NewActivityStreamsMove()
+
+	// set the ID property to a freshly generated move URI
+	idProp := streams.NewJSONLDIdProperty()
+	idProp.Set(idIRI)
+	move.SetJSONLDId(idProp)
+
+	// set the actor property to the moving account's URI
+	actorProp := streams.NewActivityStreamsActorProperty()
+	actorProp.AppendIRI(accountIRI)
+	move.SetActivityStreamsActor(actorProp)
+
+	// the object of the move is the moving account itself
+	objectProp := streams.NewActivityStreamsObjectProperty()
+	objectProp.AppendIRI(accountIRI)
+	move.SetActivityStreamsObject(objectProp)
+
+	// the target is the account being moved to
+	targetProp := streams.NewActivityStreamsTargetProperty()
+	targetProp.AppendIRI(targetIRI)
+	move.SetActivityStreamsTarget(targetProp)
+
+	// address the move to followers of the moving account
+	toProp := streams.NewActivityStreamsToProperty()
+	toProp.AppendIRI(followersIRI)
+	move.SetActivityStreamsTo(toProp)
+
+	return move, nil
+}
+
 /*
 the goal is to end up with something like this:
 
@@ -1220,7 +1511,7 @@ func (c *converter) StatusesToASOutboxPage(ctx context.Context, outboxID string,
 			return nil, err
 		}
 
-		create, err := c.WrapNoteInCreate(note, true)
+		create, err := c.WrapStatusableInCreate(note, true)
 		if err != nil {
 			return nil, err
 		}
@@ -197,6 +197,61 @@ func (suite *InternalToASTestSuite) TestAccountToASWithSharedInbox() {
 }`, trimmed)
 }
 
+func (suite *InternalToASTestSuite) TestAccountToASWithAliasesAndMove() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+	testAccount.AlsoKnownAs = []string{"http://fossbros-anonymous.io/users/foss_satan"}
+	testAccount.MovedToURI = "http://fossbros-anonymous.io/users/foss_satan"
+
+	asPerson, err := suite.typeconverter.AccountToAS(context.Background(), testAccount)
+	suite.NoError(err)
+
+	ser, err := streams.Serialize(asPerson)
+	suite.NoError(err)
+
+	bytes, err := json.Marshal(ser)
+	suite.NoError(err)
+
+	suite.Contains(string(bytes), `"alsoKnownAs":["http://fossbros-anonymous.io/users/foss_satan"]`)
+	suite.Contains(string(bytes), `"movedTo":"http://fossbros-anonymous.io/users/foss_satan"`)
+}
+
+func (suite *InternalToASTestSuite) TestAccountToASMove() {
+	testAccount := &gtsmodel.Account{}
+	*testAccount = *suite.testAccounts["local_account_1"] // take zork for this test
+	testAccount.MovedToURI = "http://fossbros-anonymous.io/users/foss_satan"
+
+	asMove, err := suite.typeconverter.AccountToASMove(context.Background(), testAccount)
+	suite.NoError(err)
+
+	ser, err := streams.Serialize(asMove)
+	suite.NoError(err)
+
+	// the id is randomly generated so check it separately
+	suite.True(strings.HasPrefix(ser["id"].(string), "http://localhost:8080/users/the_mighty_zork#moves/"))
+	delete(ser, "id")
+
+	bytes, err := json.MarshalIndent(ser, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "@context": "https://www.w3.org/ns/activitystreams",
+  "actor": "http://localhost:8080/users/the_mighty_zork",
+  "object": "http://localhost:8080/users/the_mighty_zork",
+  "target": "http://fossbros-anonymous.io/users/foss_satan",
+  "to": "http://localhost:8080/users/the_mighty_zork/followers",
+  "type": "Move"
+}`, string(bytes))
+}
+
+func (suite *InternalToASTestSuite) TestAccountToASMoveNotMoved() {
+	testAccount := suite.testAccounts["local_account_1"]
+
+	asMove, err := suite.typeconverter.AccountToASMove(context.Background(), testAccount)
+	suite.Error(err)
+	suite.Nil(asMove)
+}
+
 func (suite *InternalToASTestSuite) TestOutboxToASCollection() {
 	testAccount := suite.testAccounts["admin_account"]
 	ctx := context.Background()
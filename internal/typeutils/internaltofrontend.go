@@ -25,6 +25,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"time"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
@@ -33,6 +34,7 @@ import (
 	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/log"
 	"github.com/superseriousbusiness/gotosocial/internal/media"
+	"github.com/superseriousbusiness/gotosocial/internal/uris"
 	"github.com/superseriousbusiness/gotosocial/internal/util"
 )
 
@@ -79,6 +81,7 @@ func (c *converter) AccountToAPIAccountSensitive(ctx context.Context, a *gtsmode
 		Note:                a.NoteRaw,
 		Fields:              apiAccount.Fields,
 		FollowRequestsCount: frc,
+		AlsoKnownAsURIs:     a.AlsoKnownAs,
 	}
 
 	return apiAccount, nil
@@ -223,6 +226,28 @@ func (c *converter) AccountToAPIAccountPublic(ctx context.Context, a *gtsmodel.A
 		Role:           role,
 	}
 
+	// if this account has moved, include the account it
+	// moved to, but only one level deep, to avoid loops
+	if a.MovedToAccountID != "" && a.MovedToAccountID != a.ID {
+		if a.MovedToAccount == nil {
+			movedTo, err := c.db.GetAccountByID(ctx, a.MovedToAccountID)
+			if err != nil {
+				log.Errorf("AccountToAPIAccountPublic: error getting moved to account with id %s: %s", a.MovedToAccountID, err)
+			}
+			a.MovedToAccount = movedTo
+		}
+		if a.MovedToAccount != nil {
+			movedTo := *a.MovedToAccount
+			movedTo.MovedToAccountID = ""
+			movedTo.MovedToAccount = nil
+			apiMovedTo, err := c.AccountToAPIAccountPublic(ctx, &movedTo)
+			if err != nil {
+				log.Errorf("AccountToAPIAccountPublic: error converting moved to account with id %s: %s", a.MovedToAccountID, err)
+			}
+			accountFrontend.Moved = apiMovedTo
+		}
+	}
+
 	c.ensureAvatar(accountFrontend)
 	c.ensureHeader(accountFrontend)
 
@@ -266,10 +291,9 @@ func (c *converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		inviteRequest          *string
 		approved               bool
 		disabled               bool
-		silenced               bool
-		suspended              bool
 		role                   apimodel.AccountRole = apimodel.AccountRoleUser // assume user by default
 		createdByApplicationID string
+		invitedByAccountID     string
 	)
 
 	// take user-level information if possible
@@ -301,9 +325,18 @@ func (c *converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		confirmed = !user.ConfirmedAt.IsZero()
 		approved = *user.Approved
 		disabled = *user.Disabled
-		silenced = !user.Account.SilencedAt.IsZero()
-		suspended = !user.Account.SuspendedAt.IsZero()
 		createdByApplicationID = user.CreatedByApplicationID
+
+		if user.InviteID != "" {
+			// the invite tells us who let this account in
+			invite, err := c.db.GetInviteByID(ctx, user.InviteID)
+			if err != nil && !errors.Is(err, db.ErrNoEntries) {
+				return nil, fmt.Errorf("AccountToAdminAPIAccount: error getting invite %s for account id %s: %w", user.InviteID, a.ID, err)
+			}
+			if invite != nil {
+				invitedByAccountID = invite.AccountID
+			}
+		}
 	}
 
 	apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
@@ -325,11 +358,12 @@ func (c *converter) AccountToAdminAPIAccount(ctx context.Context, a *gtsmodel.Ac
 		Confirmed:              confirmed,
 		Approved:               approved,
 		Disabled:               disabled,
-		Silenced:               silenced,
-		Suspended:              suspended,
+		Silenced:               !a.SilencedAt.IsZero(),
+		Sensitized:             !a.SensitizedAt.IsZero(),
+		Suspended:              !a.SuspendedAt.IsZero(),
 		Account:                apiAccount,
 		CreatedByApplicationID: createdByApplicationID,
-		InvitedByAccountID:     "", // not implemented (yet)
+		InvitedByAccountID:     invitedByAccountID,
 	}, nil
 }
 
@@ -411,6 +445,14 @@ func (c *converter) AttachmentToAPIAttachment(ctx context.Context, a *gtsmodel.M
 			apiAttachment.Meta.Original.FrameRate = fr + "/1"
 		}
 
+		if i := a.FileMeta.Original.Bitrate; i != nil {
+			apiAttachment.Meta.Original.Bitrate = int(*i)
+		}
+	case gtsmodel.FileTypeAudio:
+		if i := a.FileMeta.Original.Duration; i != nil {
+			apiAttachment.Meta.Original.Duration = *i
+		}
+
 		if i := a.FileMeta.Original.Bitrate; i != nil {
 			apiAttachment.Meta.Original.Bitrate = int(*i)
 		}
@@ -605,17 +647,40 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		interacts = &statusInteractions{}
 	}
 
+	// an admin may have forced all media of this account to be marked as
+	// sensitive, but the author can still see how they posted the status
+	sensitive := *s.Sensitive
+	if !s.Account.SensitizedAt.IsZero() && (requestingAccount == nil || requestingAccount.ID != s.AccountID) {
+		sensitive = true
+	}
+
 	var language *string
 	if s.Language != "" {
 		language = &s.Language
 	}
 
+	var apiPoll *apimodel.Poll
+	if s.PollID != "" {
+		if s.Poll == nil {
+			s.Poll, err = c.db.GetPollByID(ctx, s.PollID)
+			if err != nil {
+				return nil, fmt.Errorf("error getting status poll: %w", err)
+			}
+		}
+
+		s.Poll.Status = s
+		apiPoll, err = c.PollToAPIPoll(ctx, requestingAccount, s.Poll)
+		if err != nil {
+			return nil, fmt.Errorf("error converting status poll: %w", err)
+		}
+	}
+
 	apiStatus := &apimodel.Status{
 		ID:                 s.ID,
 		CreatedAt:          util.FormatISO8601(s.CreatedAt),
 		InReplyToID:        nil,
 		InReplyToAccountID: nil,
-		Sensitive:          *s.Sensitive,
+		Sensitive:          sensitive,
 		SpoilerText:        s.ContentWarning,
 		Visibility:         c.VisToAPIVis(ctx, s.Visibility),
 		Language:           language,
@@ -638,7 +703,7 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		Tags:               apiTags,
 		Emojis:             apiEmojis,
 		Card:               nil, // TODO: implement cards
-		Poll:               nil, // TODO: implement polls
+		Poll:               apiPoll,
 		Text:               s.Text,
 	}
 
@@ -653,6 +718,11 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 		apiStatus.InReplyToAccountID = &i
 	}
 
+	if !s.EditedAt.IsZero() {
+		editedAt := util.FormatISO8601(s.EditedAt)
+		apiStatus.EditedAt = &editedAt
+	}
+
 	if apiRebloggedStatus != nil {
 		apiStatus.Reblog = &apimodel.StatusReblogged{Status: apiRebloggedStatus}
 	}
@@ -660,6 +730,43 @@ func (c *converter) StatusToAPIStatus(ctx context.Context, s *gtsmodel.Status, r
 	return apiStatus, nil
 }
 
+func (c *converter) StatusEditToAPIStatusEdit(ctx context.Context, e *gtsmodel.StatusEdit) (*apimodel.StatusEdit, error) {
+	if e.Account == nil {
+		a, err := c.db.GetAccountByID(ctx, e.AccountID)
+		if err != nil {
+			return nil, fmt.Errorf("StatusEditToAPIStatusEdit: error getting status edit author: %w", err)
+		}
+		e.Account = a
+	}
+
+	apiAuthorAccount, err := c.AccountToAPIAccountPublic(ctx, e.Account)
+	if err != nil {
+		return nil, fmt.Errorf("StatusEditToAPIStatusEdit: error parsing account of status edit author: %w", err)
+	}
+
+	// convert attachments of this revision to frontend api model attachments
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, nil, e.AttachmentIDs)
+	if err != nil {
+		log.Errorf("error converting status edit attachments: %v", err)
+	}
+
+	// convert emojis of this revision to frontend api model emojis
+	apiEmojis, err := c.convertEmojisToAPIEmojis(ctx, nil, e.EmojiIDs)
+	if err != nil {
+		log.Errorf("error converting status edit emojis: %v", err)
+	}
+
+	return &apimodel.StatusEdit{
+		Content:          e.Content,
+		SpoilerText:      e.ContentWarning,
+		Sensitive:        e.Sensitive != nil && *e.Sensitive,
+		CreatedAt:        util.FormatISO8601(e.CreatedAt),
+		Account:          apiAuthorAccount,
+		MediaAttachments: apiAttachments,
+		Emojis:           apiEmojis,
+	}, nil
+}
+
 // VisToapi converts a gts visibility into its api equivalent
 func (c *converter) VisToAPIVis(ctx context.Context, m gtsmodel.Visibility) apimodel.Visibility {
 	switch m {
@@ -730,7 +837,7 @@ func (c *converter) InstanceToAPIInstance(ctx context.Context, i *gtsmodel.Insta
 
 		mi.Registrations = config.GetAccountsRegistrationOpen()
 		mi.ApprovalRequired = config.GetAccountsApprovalRequired()
-		mi.InvitesEnabled = false // TODO
+		mi.InvitesEnabled = config.GetAccountsInvitesEnabled()
 		mi.MaxTootChars = uint(config.GetStatusesMaxChars())
 		mi.URLS = &apimodel.InstanceURLs{
 			StreamingAPI: "wss://" + host,
@@ -884,6 +991,122 @@ func (c *converter) DomainBlockToAPIDomainBlock(ctx context.Context, b *gtsmodel
 	return domainBlock, nil
 }
 
+func (c *converter) DomainAllowToAPIDomainAllow(ctx context.Context, a *gtsmodel.DomainAllow, export bool) (*apimodel.DomainAllow, error) {
+	domainAllow := &apimodel.DomainAllow{
+		Domain: apimodel.Domain{
+			Domain:        a.Domain,
+			PublicComment: a.PublicComment,
+		},
+	}
+
+	// if we're exporting a domain allow, return it with minimal information attached
+	if !export {
+		domainAllow.ID = a.ID
+		domainAllow.Obfuscate = *a.Obfuscate
+		domainAllow.PrivateComment = a.PrivateComment
+		domainAllow.CreatedBy = a.CreatedByAccountID
+		domainAllow.CreatedAt = util.FormatISO8601(a.CreatedAt)
+	}
+
+	return domainAllow, nil
+}
+
+func (c *converter) DomainBlockSubscriptionToAPIDomainBlockSubscription(ctx context.Context, s *gtsmodel.DomainBlockSubscription) (*apimodel.DomainBlockSubscription, error) {
+	blocks, err := c.db.GetDomainBlocksBySubscriptionID(ctx, s.ID)
+	if err != nil {
+		return nil, fmt.Errorf("DomainBlockSubscriptionToAPIDomainBlockSubscription: error getting domain blocks: %w", err)
+	}
+
+	subscription := &apimodel.DomainBlockSubscription{
+		ID:          s.ID,
+		Title:       s.Title,
+		URI:         s.URI,
+		ContentType: string(s.ContentType),
+		Obfuscate:   s.Obfuscate != nil && *s.Obfuscate,
+		CreatedBy:   s.CreatedByAccountID,
+		CreatedAt:   util.FormatISO8601(s.CreatedAt),
+		Error:       s.Error,
+		Conflicts:   s.Conflicts,
+		Count:       len(blocks),
+	}
+
+	if subscription.Conflicts == nil {
+		subscription.Conflicts = []string{}
+	}
+
+	if !s.FetchedAt.IsZero() {
+		subscription.FetchedAt = util.FormatISO8601(s.FetchedAt)
+	}
+
+	if !s.SuccessfullyFetchedAt.IsZero() {
+		subscription.SuccessfullyFetchedAt = util.FormatISO8601(s.SuccessfullyFetchedAt)
+	}
+
+	return subscription, nil
+}
+
+func (c *converter) InstanceToAdminAPIInstance(ctx context.Context, i *gtsmodel.Instance) (*apimodel.AdminInstance, error) {
+	instance := &apimodel.AdminInstance{
+		ID:          i.ID,
+		Domain:      i.Domain,
+		URI:         i.URI,
+		Title:       i.Title,
+		Version:     i.Version,
+		CreatedAt:   util.FormatISO8601(i.CreatedAt),
+		Failures:    i.Failures,
+		LastFailure: i.LastFailure,
+	}
+
+	if !i.SuspendedAt.IsZero() {
+		suspendedAt := util.FormatISO8601(i.SuspendedAt)
+		instance.SuspendedAt = &suspendedAt
+	}
+
+	if !i.FailingSince.IsZero() {
+		failingSince := util.FormatISO8601(i.FailingSince)
+		instance.FailingSince = &failingSince
+	}
+
+	if !i.LastFailedAt.IsZero() {
+		lastFailedAt := util.FormatISO8601(i.LastFailedAt)
+		instance.LastFailedAt = &lastFailedAt
+	}
+
+	return instance, nil
+}
+
+func (c *converter) DeliveryToAdminAPIDelivery(ctx context.Context, d *gtsmodel.Delivery) (*apimodel.AdminDelivery, error) {
+	return &apimodel.AdminDelivery{
+		ID:            d.ID,
+		TargetURI:     d.TargetURI,
+		TargetDomain:  d.TargetDomain,
+		KeyID:         d.PubKeyID,
+		Attempts:      d.Attempts,
+		LastError:     d.LastError,
+		CreatedAt:     util.FormatISO8601(d.CreatedAt),
+		NextAttemptAt: util.FormatISO8601(d.NextAttemptAt),
+	}, nil
+}
+
+func (c *converter) InviteToAPIInvite(ctx context.Context, i *gtsmodel.Invite) (*apimodel.Invite, error) {
+	var expiresAt *string
+	if !i.ExpiresAt.IsZero() {
+		e := util.FormatISO8601(i.ExpiresAt)
+		expiresAt = &e
+	}
+
+	return &apimodel.Invite{
+		ID:        i.ID,
+		Code:      i.Code,
+		URL:       uris.GenerateURIForInvite(i.Code),
+		MaxUses:   i.MaxUses,
+		Uses:      i.Uses,
+		CreatedAt: util.FormatISO8601(i.CreatedAt),
+		ExpiresAt: expiresAt,
+		Usable:    i.Usable(time.Now()),
+	}, nil
+}
+
 func (c *converter) ReportToAPIReport(ctx context.Context, r *gtsmodel.Report) (*apimodel.Report, error) {
 	report := &apimodel.Report{
 		ID:          r.ID,
@@ -1009,6 +1232,271 @@ func (c *converter) ReportToAdminAPIReport(ctx context.Context, r *gtsmodel.Repo
 	}, nil
 }
 
+func (c *converter) ListToAPIList(ctx context.Context, l *gtsmodel.List) (*apimodel.List, error) {
+	return &apimodel.List{
+		ID:            l.ID,
+		Title:         l.Title,
+		RepliesPolicy: string(l.RepliesPolicy),
+	}, nil
+}
+
+func (c *converter) FilterKeywordToAPIFilterV1(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.Filter, error) {
+	if k.Filter == nil {
+		return nil, fmt.Errorf("FilterKeywordToAPIFilterV1: filter keyword %s had no filter set", k.ID)
+	}
+
+	return &apimodel.Filter{
+		// v1 filters have a single keyword each, so use the keyword ID as the v1 filter ID.
+		ID:           k.ID,
+		Phrase:       k.Keyword,
+		Context:      filterToAPIFilterContexts(k.Filter),
+		WholeWord:    k.WholeWord != nil && *k.WholeWord,
+		ExpiresAt:    filterToAPIFilterExpiresAt(k.Filter),
+		Irreversible: k.Filter.Action == gtsmodel.FilterActionHide,
+	}, nil
+}
+
+func (c *converter) FilterToAPIFilterV2(ctx context.Context, f *gtsmodel.Filter) (*apimodel.FilterV2, error) {
+	apiKeywords := make([]apimodel.FilterKeyword, 0, len(f.Keywords))
+	for _, k := range f.Keywords {
+		apiKeyword, err := c.FilterKeywordToAPIFilterKeyword(ctx, k)
+		if err != nil {
+			return nil, err
+		}
+		apiKeywords = append(apiKeywords, *apiKeyword)
+	}
+
+	return &apimodel.FilterV2{
+		ID:           f.ID,
+		Title:        f.Title,
+		Context:      filterToAPIFilterContexts(f),
+		ExpiresAt:    filterToAPIFilterExpiresAt(f),
+		FilterAction: string(f.Action),
+		Keywords:     apiKeywords,
+		Statuses:     []interface{}{},
+	}, nil
+}
+
+func (c *converter) FilterKeywordToAPIFilterKeyword(ctx context.Context, k *gtsmodel.FilterKeyword) (*apimodel.FilterKeyword, error) {
+	return &apimodel.FilterKeyword{
+		ID:        k.ID,
+		Keyword:   k.Keyword,
+		WholeWord: k.WholeWord != nil && *k.WholeWord,
+	}, nil
+}
+
+func (c *converter) PollToAPIPoll(ctx context.Context, requestingAccount *gtsmodel.Account, p *gtsmodel.Poll) (*apimodel.Poll, error) {
+	if p.Status == nil {
+		s, err := c.db.GetStatusByID(ctx, p.StatusID)
+		if err != nil {
+			return nil, fmt.Errorf("PollToAPIPoll: error getting poll status: %w", err)
+		}
+		p.Status = s
+	}
+
+	var (
+		voted    bool
+		ownVotes []int
+		isAuthor bool
+	)
+
+	if requestingAccount != nil {
+		vote, err := c.db.GetPollVoteBy(ctx, p.ID, requestingAccount.ID)
+		if err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return nil, fmt.Errorf("PollToAPIPoll: error getting poll vote: %w", err)
+		}
+
+		if vote != nil {
+			voted = true
+			ownVotes = vote.Choices
+		}
+
+		isAuthor = requestingAccount.ID == p.Status.AccountID
+	}
+
+	expired := p.Closed() || p.Expired(time.Now())
+
+	// Vote counts are only hidden from
+	// other accounts while the poll runs.
+	showCounts := expired || isAuthor || p.HideCounts == nil || !*p.HideCounts
+
+	apiPoll := &apimodel.Poll{
+		ID:       p.ID,
+		Expired:  expired,
+		Multiple: p.Multiple != nil && *p.Multiple,
+		Voted:    voted,
+		OwnVotes: ownVotes,
+		Options:  make([]apimodel.PollOptions, len(p.Options)),
+		Emojis:   []apimodel.Emoji{},
+	}
+
+	if !p.ExpiresAt.IsZero() {
+		apiPoll.ExpiresAt = util.FormatISO8601(p.ExpiresAt)
+	}
+
+	if showCounts {
+		apiPoll.VotesCount = p.TotalVotes()
+		apiPoll.VotersCount = p.Voters
+	}
+
+	for i, option := range p.Options {
+		apiPoll.Options[i].Title = option
+		if showCounts && i < len(p.Votes) {
+			votesCount := p.Votes[i]
+			apiPoll.Options[i].VotesCount = &votesCount
+		}
+	}
+
+	return apiPoll, nil
+}
+
+func (c *converter) ScheduledStatusToAPIScheduledStatus(ctx context.Context, s *gtsmodel.ScheduledStatus) (*apimodel.ScheduledStatus, error) {
+	apiAttachments, err := c.convertAttachmentsToAPIAttachments(ctx, s.Attachments, s.AttachmentIDs)
+	if err != nil {
+		log.Errorf("error converting scheduled status attachments: %v", err)
+	}
+
+	params := &apimodel.StatusParams{
+		Text:          s.Text,
+		InReplyToID:   s.InReplyToID,
+		MediaIDs:      s.AttachmentIDs,
+		Sensitive:     s.Sensitive != nil && *s.Sensitive,
+		SpoilerText:   s.SpoilerText,
+		Visibility:    string(c.VisToAPIVis(ctx, s.Visibility)),
+		Language:      s.Language,
+		ApplicationID: s.ApplicationID,
+	}
+
+	if len(s.PollOptions) != 0 {
+		params.Poll = &apimodel.PollRequest{
+			Options:    s.PollOptions,
+			ExpiresIn:  s.PollExpiresIn,
+			Multiple:   s.PollMultiple != nil && *s.PollMultiple,
+			HideTotals: s.PollHideTotals != nil && *s.PollHideTotals,
+		}
+	}
+
+	return &apimodel.ScheduledStatus{
+		ID:               s.ID,
+		ScheduledAt:      util.FormatISO8601(s.ScheduledAt),
+		Params:           params,
+		MediaAttachments: apiAttachments,
+	}, nil
+}
+
+func (c *converter) ConversationToAPIConversation(ctx context.Context, conversation *gtsmodel.Conversation, requestingAccount *gtsmodel.Account) (*apimodel.Conversation, error) {
+	if conversation.LastStatus == nil {
+		lastStatus, err := c.db.GetStatusByID(ctx, conversation.LastStatusID)
+		if err != nil {
+			return nil, fmt.Errorf("ConversationToAPIConversation: error getting last status %s: %w", conversation.LastStatusID, err)
+		}
+		conversation.LastStatus = lastStatus
+	}
+
+	apiLastStatus, err := c.StatusToAPIStatus(ctx, conversation.LastStatus, requestingAccount)
+	if err != nil {
+		return nil, fmt.Errorf("ConversationToAPIConversation: error converting last status: %w", err)
+	}
+
+	if len(conversation.OtherAccounts) != len(conversation.OtherAccountIDs) {
+		conversation.OtherAccounts = make([]*gtsmodel.Account, 0, len(conversation.OtherAccountIDs))
+		for _, id := range conversation.OtherAccountIDs {
+			a, err := c.db.GetAccountByID(ctx, id)
+			if err != nil {
+				log.Errorf("ConversationToAPIConversation: error getting account %s: %v", id, err)
+				continue
+			}
+			conversation.OtherAccounts = append(conversation.OtherAccounts, a)
+		}
+	}
+
+	participants := conversation.OtherAccounts
+	if len(participants) == 0 {
+		// a conversation with nobody else in it
+		// is shown as being with the owner themself
+		participants = []*gtsmodel.Account{requestingAccount}
+	}
+
+	apiAccounts := make([]apimodel.Account, 0, len(participants))
+	for _, a := range participants {
+		apiAccount, err := c.AccountToAPIAccountPublic(ctx, a)
+		if err != nil {
+			log.Errorf("ConversationToAPIConversation: error converting account %s: %v", a.ID, err)
+			continue
+		}
+		apiAccounts = append(apiAccounts, *apiAccount)
+	}
+
+	return &apimodel.Conversation{
+		ID:         conversation.ID,
+		Accounts:   apiAccounts,
+		Unread:     conversation.Read == nil || !*conversation.Read,
+		LastStatus: apiLastStatus,
+	}, nil
+}
+
+func (c *converter) WebPushSubscriptionToAPIPushSubscription(ctx context.Context, s *gtsmodel.WebPushSubscription) (*apimodel.PushSubscription, error) {
+	vapidKeyPair, err := c.db.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("WebPushSubscriptionToAPIPushSubscription: error getting vapid key pair: %w", err)
+	}
+
+	return &apimodel.PushSubscription{
+		ID:        s.ID,
+		Endpoint:  s.Endpoint,
+		ServerKey: vapidKeyPair.Public,
+		Alerts: &apimodel.PushSubscriptionAlerts{
+			Follow:        s.AlertEnabled(gtsmodel.NotificationFollow),
+			FollowRequest: s.AlertEnabled(gtsmodel.NotificationFollowRequest),
+			Favourite:     s.AlertEnabled(gtsmodel.NotificationFave),
+			Mention:       s.AlertEnabled(gtsmodel.NotificationMention),
+			Reblog:        s.AlertEnabled(gtsmodel.NotificationReblog),
+			Poll:          s.AlertEnabled(gtsmodel.NotificationPoll),
+			Status:        s.AlertEnabled(gtsmodel.NotificationStatus),
+		},
+	}, nil
+}
+
+func (c *converter) AccountExportToAPIAccountExport(ctx context.Context, e *gtsmodel.AccountExport) (*apimodel.AccountExport, error) {
+	apiExport := &apimodel.AccountExport{
+		ID:        e.ID,
+		CreatedAt: util.FormatISO8601(e.CreatedAt),
+		State:     string(e.State),
+	}
+
+	if e.State == gtsmodel.AccountExportStateComplete {
+		apiExport.Size = e.Size
+		apiExport.CompletedAt = util.FormatISO8601(e.CompletedAt)
+		apiExport.URL = fmt.Sprintf("%s://%s/api/v1/exports/%s/archive", config.GetProtocol(), config.GetHost(), e.ID)
+	}
+
+	return apiExport, nil
+}
+
+func filterToAPIFilterContexts(f *gtsmodel.Filter) []string {
+	apiContexts := []string{}
+	for _, context := range []gtsmodel.FilterContext{
+		gtsmodel.FilterContextHome,
+		gtsmodel.FilterContextNotifications,
+		gtsmodel.FilterContextPublic,
+		gtsmodel.FilterContextThread,
+		gtsmodel.FilterContextAccount,
+	} {
+		if f.AppliesTo(context) {
+			apiContexts = append(apiContexts, string(context))
+		}
+	}
+	return apiContexts
+}
+
+func filterToAPIFilterExpiresAt(f *gtsmodel.Filter) *string {
+	if f.ExpiresAt.IsZero() {
+		return nil
+	}
+	expiresAt := util.FormatISO8601(f.ExpiresAt)
+	return &expiresAt
+}
+
 // convertAttachmentsToAPIAttachments will convert a slice of GTS model attachments to frontend API model attachments, falling back to IDs if no GTS models supplied.
 func (c *converter) convertAttachmentsToAPIAttachments(ctx context.Context, attachments []*gtsmodel.MediaAttachment, attachmentIDs []string) ([]apimodel.Attachment, error) {
 	var errs gtserror.MultiError
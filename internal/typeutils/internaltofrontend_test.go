@@ -303,6 +303,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontend() {
   ],
   "card": null,
   "poll": null,
+  "edited_at": null,
   "text": "hello world! #welcome ! first post on the instance :rainbow: !"
 }`, string(b))
 }
@@ -415,6 +416,7 @@ func (suite *InternalToFrontendTestSuite) TestStatusToFrontendUnknownLanguage()
   ],
   "card": null,
   "poll": null,
+  "edited_at": null,
   "text": "hello world! #welcome ! first post on the instance :rainbow: !"
 }`, string(b))
 }
@@ -454,6 +456,59 @@ func (suite *InternalToFrontendTestSuite) TestVideoAttachmentToFrontend() {
 }`, string(b))
 }
 
+func (suite *InternalToFrontendTestSuite) TestAudioAttachmentToFrontend() {
+	testAttachment := &gtsmodel.MediaAttachment{
+		ID:        "01GTQ8MJ6V5QVGCFBN2W8J4QH1",
+		URL:       "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01GTQ8MJ6V5QVGCFBN2W8J4QH1.mp3",
+		Type:      gtsmodel.FileTypeAudio,
+		AccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
+		FileMeta: gtsmodel.FileMeta{
+			Original: gtsmodel.Original{
+				Duration: testrig.Float32Ptr(1.0448979),
+				Bitrate:  testrig.Uint64Ptr(127706),
+			},
+			Small: gtsmodel.Small{
+				Width:  120,
+				Height: 120,
+				Size:   14400,
+				Aspect: 1,
+			},
+		},
+		Thumbnail: gtsmodel.Thumbnail{
+			URL: "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01GTQ8MJ6V5QVGCFBN2W8J4QH1.jpg",
+		},
+	}
+
+	apiAttachment, err := suite.typeconverter.AttachmentToAPIAttachment(context.Background(), testAttachment)
+	suite.NoError(err)
+
+	b, err := json.MarshalIndent(apiAttachment, "", "  ")
+	suite.NoError(err)
+
+	suite.Equal(`{
+  "id": "01GTQ8MJ6V5QVGCFBN2W8J4QH1",
+  "type": "audio",
+  "url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01GTQ8MJ6V5QVGCFBN2W8J4QH1.mp3",
+  "text_url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/original/01GTQ8MJ6V5QVGCFBN2W8J4QH1.mp3",
+  "preview_url": "http://localhost:8080/fileserver/01F8MH1H7YV1Z7D2C8K2730QBF/attachment/small/01GTQ8MJ6V5QVGCFBN2W8J4QH1.jpg",
+  "remote_url": null,
+  "preview_remote_url": null,
+  "meta": {
+    "original": {
+      "duration": 1.0448979,
+      "bitrate": 127706
+    },
+    "small": {
+      "width": 120,
+      "height": 120,
+      "size": "120x120",
+      "aspect": 1
+    }
+  },
+  "description": null
+}`, string(b))
+}
+
 func (suite *InternalToFrontendTestSuite) TestInstanceToFrontend() {
 	testInstance := &gtsmodel.Instance{
 		CreatedAt:        testrig.TimeMustParse("2021-10-20T11:36:45Z"),
@@ -723,6 +778,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -761,6 +817,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -801,6 +858,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -842,6 +900,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend1() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH17FWEB39HZJ76B6VXSKF",
@@ -906,6 +965,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     "approved": true,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5NBDF2MV7CTC4Q5128HF",
@@ -946,6 +1006,7 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
     "approved": false,
     "disabled": false,
     "silenced": false,
+    "sensitized": false,
     "suspended": false,
     "account": {
       "id": "01F8MH5ZK5VRH73AKHQM6Y9VNX",
@@ -1049,7 +1110,8 @@ func (suite *InternalToFrontendTestSuite) TestAdminReportToFrontend2() {
       "tags": [],
       "emojis": [],
       "card": null,
-      "poll": null
+      "poll": null,
+      "edited_at": null
     }
   ],
   "rule_ids": [],
@@ -22,27 +22,37 @@ import (
 	"errors"
 	"fmt"
 	"net/mail"
+	"net/url"
 	"strings"
 
 	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
 	"github.com/superseriousbusiness/gotosocial/internal/regexes"
+	"github.com/superseriousbusiness/gotosocial/internal/util"
 	pwv "github.com/wagslane/go-password-validator"
 	"golang.org/x/text/language"
 )
 
 const (
-	maximumPasswordLength         = 256
-	minimumPasswordEntropy        = 60 // dictates password strength. See https://github.com/wagslane/go-password-validator
-	minimumReasonLength           = 40
-	maximumReasonLength           = 500
-	maximumSiteTitleLength        = 40
-	maximumShortDescriptionLength = 500
-	maximumDescriptionLength      = 5000
-	maximumSiteTermsLength        = 5000
-	maximumUsernameLength         = 64
-	maximumCustomCSSLength        = 5000
-	maximumEmojiCategoryLength    = 64
+	maximumPasswordLength          = 256
+	minimumPasswordEntropy         = 60 // dictates password strength. See https://github.com/wagslane/go-password-validator
+	minimumReasonLength            = 40
+	maximumReasonLength            = 500
+	maximumSiteTitleLength         = 40
+	maximumShortDescriptionLength  = 500
+	maximumDescriptionLength       = 5000
+	maximumSiteTermsLength         = 5000
+	maximumUsernameLength          = 64
+	maximumCustomCSSLength         = 5000
+	maximumEmojiCategoryLength     = 64
+	maximumListTitleLength         = 200
+	maximumFilterKeywordLength     = 40
+	maximumFilterTitleLength       = 200
+	maximumTagNameLength           = 100
+	maximumSubscriptionTitleLength = 200
+	maximumProfileFields           = 4
+	maximumProfileFieldLength      = 255
 )
 
 // NewPassword returns an error if the given password is not sufficiently strong, or nil if it's ok.
@@ -138,6 +148,30 @@ func Note(note string) error {
 	return nil
 }
 
+// ProfileFields checks that the given profile fields are within spec: no more than
+// 4 fields, and no more than 255 characters per field name or value.
+func ProfileFields(fields []apimodel.UpdateField) error {
+	if length := len(fields); length > maximumProfileFields {
+		return fmt.Errorf("profile fields count should be no more than %d, but %d were provided", maximumProfileFields, length)
+	}
+
+	for _, field := range fields {
+		if field.Name != nil {
+			if length := len([]rune(*field.Name)); length > maximumProfileFieldLength {
+				return fmt.Errorf("profile field name should be no more than %d chars but given name was %d", maximumProfileFieldLength, length)
+			}
+		}
+
+		if field.Value != nil {
+			if length := len([]rune(*field.Value)); length > maximumProfileFieldLength {
+				return fmt.Errorf("profile field value should be no more than %d chars but given value was %d", maximumProfileFieldLength, length)
+			}
+		}
+	}
+
+	return nil
+}
+
 // Privacy checks that the desired privacy setting is valid
 func Privacy(privacy string) error {
 	if privacy == "" {
@@ -227,6 +261,153 @@ func SiteTerms(t string) error {
 	return nil
 }
 
+// ListTitle ensures that the given list title is within spec.
+func ListTitle(title string) error {
+	if title == "" {
+		return errors.New("list title must be provided, and must be no more than 200 chars")
+	}
+
+	if length := len([]rune(title)); length > maximumListTitleLength {
+		return fmt.Errorf("list title should be no more than %d chars but given title was %d", maximumListTitleLength, length)
+	}
+
+	return nil
+}
+
+// ListRepliesPolicy ensures that the given list replies policy is within spec.
+func ListRepliesPolicy(repliesPolicy gtsmodel.RepliesPolicy) error {
+	switch repliesPolicy {
+	case gtsmodel.RepliesPolicyFollowed, gtsmodel.RepliesPolicyList, gtsmodel.RepliesPolicyNone:
+		return nil
+	}
+	return fmt.Errorf("list replies_policy '%s' was not recognized, valid options are 'followed', 'list', 'none'", repliesPolicy)
+}
+
+// FilterKeyword ensures that the given filter keyword is within spec.
+func FilterKeyword(keyword string) error {
+	if strings.TrimSpace(keyword) == "" {
+		return fmt.Errorf("filter keyword must be provided, and must be no more than %d chars", maximumFilterKeywordLength)
+	}
+
+	if length := len([]rune(keyword)); length > maximumFilterKeywordLength {
+		return fmt.Errorf("filter keyword should be no more than %d chars but given keyword was %d", maximumFilterKeywordLength, length)
+	}
+
+	return nil
+}
+
+// FilterTitle ensures that the given filter title is within spec.
+func FilterTitle(title string) error {
+	if strings.TrimSpace(title) == "" {
+		return fmt.Errorf("filter title must be provided, and must be no more than %d chars", maximumFilterTitleLength)
+	}
+
+	if length := len([]rune(title)); length > maximumFilterTitleLength {
+		return fmt.Errorf("filter title should be no more than %d chars but given title was %d", maximumFilterTitleLength, length)
+	}
+
+	return nil
+}
+
+// FilterContexts ensures that the given filter contexts are
+// within spec: at least one must be given, and each must be known.
+func FilterContexts(contexts []string) error {
+	if len(contexts) == 0 {
+		return errors.New("at least one filter context must be provided")
+	}
+
+	for _, context := range contexts {
+		switch gtsmodel.FilterContext(context) {
+		case gtsmodel.FilterContextHome,
+			gtsmodel.FilterContextNotifications,
+			gtsmodel.FilterContextPublic,
+			gtsmodel.FilterContextThread,
+			gtsmodel.FilterContextAccount:
+			continue
+		}
+		return fmt.Errorf("filter context '%s' was not recognized, valid options are 'home', 'notifications', 'public', 'thread', 'account'", context)
+	}
+
+	return nil
+}
+
+// FilterAction ensures that the given filter action is within spec.
+func FilterAction(action gtsmodel.FilterAction) error {
+	switch action {
+	case gtsmodel.FilterActionWarn, gtsmodel.FilterActionHide:
+		return nil
+	}
+	return fmt.Errorf("filter action '%s' was not recognized, valid options are 'warn', 'hide'", action)
+}
+
+// TagName ensures that the given hashtag name (without the
+// leading '#') is within spec, and could be used in a status.
+func TagName(name string) error {
+	if name == "" {
+		return fmt.Errorf("tag name must be provided, and must be no more than %d chars", maximumTagNameLength)
+	}
+
+	if length := len([]rune(name)); length > maximumTagNameLength {
+		return fmt.Errorf("tag name should be no more than %d chars but given name was %d", maximumTagNameLength, length)
+	}
+
+	for _, r := range name {
+		if !util.IsPermittedInHashtag(r) {
+			return fmt.Errorf("tag name '%s' contained character '%c', which is not permitted in hashtags", name, r)
+		}
+	}
+
+	return nil
+}
+
+// DomainBlockSubscriptionTitle ensures that the given domain block subscription title is within spec.
+func DomainBlockSubscriptionTitle(title string) error {
+	if length := len([]rune(title)); length > maximumSubscriptionTitleLength {
+		return fmt.Errorf("subscription title should be no more than %d chars but given title was %d", maximumSubscriptionTitleLength, length)
+	}
+
+	return nil
+}
+
+// DomainBlockSubscriptionURI ensures that the given blocklist
+// URI is an absolute http, https, or file URI.
+func DomainBlockSubscriptionURI(uri string) error {
+	if uri == "" {
+		return errors.New("subscription uri must be provided")
+	}
+
+	u, err := url.Parse(uri)
+	if err != nil {
+		return fmt.Errorf("subscription uri '%s' could not be parsed: %w", uri, err)
+	}
+
+	switch u.Scheme {
+	case "http", "https":
+		if u.Host == "" {
+			return fmt.Errorf("subscription uri '%s' did not contain a host", uri)
+		}
+	case "file":
+		if u.Path == "" {
+			return fmt.Errorf("subscription uri '%s' did not contain a path", uri)
+		}
+	default:
+		return fmt.Errorf("subscription uri scheme '%s' was not recognized, valid options are 'http', 'https', 'file'", u.Scheme)
+	}
+
+	return nil
+}
+
+// DomainBlockSubscriptionContentType ensures that the given blocklist content type is within spec.
+func DomainBlockSubscriptionContentType(contentType gtsmodel.DomainBlockSubscriptionContent) error {
+	switch contentType {
+	case gtsmodel.DomainBlockSubscriptionContentPlain,
+		gtsmodel.DomainBlockSubscriptionContentCSV,
+		gtsmodel.DomainBlockSubscriptionContentJSON:
+		return nil
+	}
+	return fmt.Errorf("subscription content_type '%s' was not recognized, valid options are 'text/plain', 'text/csv', 'application/json'", contentType)
+}
+
 // ULID returns true if the passed string is a valid ULID.
 func ULID(i string) bool {
 	return regexes.ULID.MatchString(i)
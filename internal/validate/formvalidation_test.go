@@ -21,10 +21,12 @@ package validate_test
 import (
 	"errors"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
 	"github.com/superseriousbusiness/gotosocial/internal/validate"
 )
 
@@ -284,6 +286,44 @@ func (suite *ValidationTestSuite) TestValidateReason() {
 	}
 }
 
+func (suite *ValidationTestSuite) TestValidateDomainBlockSubscriptionURI() {
+	for _, uri := range []string{
+		"https://example.org/blocklist.csv",
+		"http://example.org/blocklist.txt",
+		"file:///etc/gotosocial/blocklist.json",
+	} {
+		suite.NoError(validate.DomainBlockSubscriptionURI(uri))
+	}
+
+	err := validate.DomainBlockSubscriptionURI("")
+	suite.EqualError(err, "subscription uri must be provided")
+
+	err = validate.DomainBlockSubscriptionURI("ftp://example.org/blocklist.txt")
+	suite.EqualError(err, "subscription uri scheme 'ftp' was not recognized, valid options are 'http', 'https', 'file'")
+
+	err = validate.DomainBlockSubscriptionURI("https:///blocklist.txt")
+	suite.EqualError(err, "subscription uri 'https:///blocklist.txt' did not contain a host")
+}
+
+func (suite *ValidationTestSuite) TestValidateProfileFields() {
+	name := "website"
+	value := "https://example.org"
+	tooLong := strings.Repeat("a", 256)
+
+	err := validate.ProfileFields([]apimodel.UpdateField{{Name: &name, Value: &value}})
+	suite.NoError(err)
+
+	err = validate.ProfileFields([]apimodel.UpdateField{{Name: &name, Value: &tooLong}})
+	suite.EqualError(err, "profile field value should be no more than 255 chars but given value was 256")
+
+	err = validate.ProfileFields([]apimodel.UpdateField{{Name: &tooLong, Value: &value}})
+	suite.EqualError(err, "profile field name should be no more than 255 chars but given name was 256")
+
+	fields := make([]apimodel.UpdateField, 5)
+	err = validate.ProfileFields(fields)
+	suite.EqualError(err, "profile fields count should be no more than 4, but 5 were provided")
+}
+
 func TestValidationTestSuite(t *testing.T) {
 	suite.Run(t, new(ValidationTestSuite))
 }
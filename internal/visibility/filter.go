@@ -50,6 +50,19 @@ type Filter interface {
 	//
 	// this function will call StatusVisible internally so it's not necessary to call it beforehand.
 	StatusBoostable(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error)
+
+	// StatusMuted returns true if targetStatus should be kept out of the timelines of the requesting account,
+	// because the requesting account has muted the author of the status, or of the status it boosts or replies to.
+	//
+	// This function doesn't check visibility: statuses of muted accounts can still be viewed directly.
+	StatusMuted(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error)
+
+	// StatusSilenced returns true if targetStatus should be kept out of the timelines of the requesting account,
+	// because an admin has silenced the author of the status, or of the status it boosts, and the requesting
+	// account doesn't follow them. The requesting account may be nil, in which case any silence applies.
+	//
+	// This function doesn't check visibility: statuses of silenced accounts can still be viewed directly.
+	StatusSilenced(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error)
 }
 
 type filter struct {
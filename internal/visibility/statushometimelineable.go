@@ -58,6 +58,26 @@ func (f *filter) StatusHometimelineable(ctx context.Context, targetStatus *gtsmo
 		return false, nil
 	}
 
+	muted, err := f.StatusMuted(ctx, targetStatus, timelineOwnerAccount)
+	if err != nil {
+		return false, fmt.Errorf("StatusHometimelineable: error checking mutes of status with id %s: %s", targetStatus.ID, err)
+	}
+
+	if muted {
+		l.Debug("status is not hometimelineable because the requester has muted a relevant account")
+		return false, nil
+	}
+
+	silenced, err := f.StatusSilenced(ctx, targetStatus, timelineOwnerAccount)
+	if err != nil {
+		return false, fmt.Errorf("StatusHometimelineable: error checking silences of status with id %s: %s", targetStatus.ID, err)
+	}
+
+	if silenced {
+		l.Debug("status is not hometimelineable because a relevant account is silenced and not followed by the requester")
+		return false, nil
+	}
+
 	for _, m := range targetStatus.Mentions {
 		if m.TargetAccountID == timelineOwnerAccount.ID {
 			// if we're mentioned we should be able to see the post
@@ -78,7 +98,19 @@ func (f *filter) StatusHometimelineable(ctx context.Context, targetStatus *gtsmo
 		return false, fmt.Errorf("StatusHometimelineable: error checking if %s follows %s: %s", timelineOwnerAccount.ID, targetStatus.AccountID, err)
 	}
 	if !following {
-		return false, nil
+		// we don't follow the originator, but we might
+		// follow one of the tags used in a public status
+		if targetStatus.Visibility != gtsmodel.VisibilityPublic || len(targetStatus.TagIDs) == 0 {
+			return false, nil
+		}
+
+		followingTag, err := f.db.IsFollowingAnyTag(ctx, timelineOwnerAccount.ID, targetStatus.TagIDs)
+		if err != nil {
+			return false, fmt.Errorf("StatusHometimelineable: error checking if %s follows any tags of status %s: %s", timelineOwnerAccount.ID, targetStatus.ID, err)
+		}
+		if !followingTag {
+			return false, nil
+		}
 	}
 
 	// Don't timeline a status whose parent hasn't been dereferenced yet or can't be dereferenced.
@@ -56,6 +56,27 @@ func (suite *StatusStatusHometimelineableTestSuite) TestFollowingStatusHometimel
 	suite.True(timelineable)
 }
 
+func (suite *StatusStatusHometimelineableTestSuite) TestMutedStatusNotHometimelineable() {
+	testStatus := suite.testStatuses["local_account_2_status_1"]
+	testAccount := suite.testAccounts["local_account_1"]
+	ctx := context.Background()
+
+	// zork mutes turtle
+	if err := suite.db.PutMute(ctx, &gtsmodel.UserMute{
+		ID:              "01GSZ7C2Q8H4VWN6TJ3E1RB5XK",
+		AccountID:       testAccount.ID,
+		TargetAccountID: testStatus.AccountID,
+		Notifications:   testrig.FalseBool(),
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err := suite.filter.StatusHometimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+
+	suite.False(timelineable)
+}
+
 func (suite *StatusStatusHometimelineableTestSuite) TestNotFollowingStatusHometimelineable() {
 	testStatus := suite.testStatuses["remote_account_1_status_1"]
 	testAccount := suite.testAccounts["local_account_1"]
@@ -67,6 +88,74 @@ func (suite *StatusStatusHometimelineableTestSuite) TestNotFollowingStatusHometi
 	suite.False(timelineable)
 }
 
+func (suite *StatusStatusHometimelineableTestSuite) TestFollowedTagStatusHometimelineable() {
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	testAccount := suite.testAccounts["local_account_2"]
+	ctx := context.Background()
+
+	// turtle doesn't follow admin, so the status shouldn't be timelineable yet
+	timelineable, err := suite.filter.StatusHometimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// follow #welcome, which admin used in the status
+	if err := suite.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        "01GSGVJ5BTBVGNVB9A0MBSNFJ5",
+		AccountID: testAccount.ID,
+		TagID:     suite.testTags["welcome"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	timelineable, err = suite.filter.StatusHometimelineable(ctx, testStatus, testAccount)
+	suite.NoError(err)
+	suite.True(timelineable)
+}
+
+func (suite *StatusStatusHometimelineableTestSuite) TestSilencedStatusHometimelineable() {
+	testStatus := suite.testStatuses["admin_account_status_1"]
+	ctx := context.Background()
+
+	// silence admin
+	admin := suite.testAccounts["admin_account"]
+	admin.SilencedAt = time.Now()
+	if err := suite.db.UpdateAccount(ctx, admin); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// turtle follows #welcome, which admin used in the status
+	if err := suite.db.PutFollowedTag(ctx, &gtsmodel.FollowedTag{
+		ID:        "01GSGVJ5BTBVGNVB9A0MBSNFJ5",
+		AccountID: suite.testAccounts["local_account_2"].ID,
+		TagID:     suite.testTags["welcome"].ID,
+	}); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	// zork follows admin, so should still see the status
+	timelineable, err := suite.filter.StatusHometimelineable(ctx, testStatus, suite.testAccounts["local_account_1"])
+	suite.NoError(err)
+	suite.True(timelineable)
+
+	timelineable, err = suite.filter.StatusPublictimelineable(ctx, testStatus, suite.testAccounts["local_account_1"])
+	suite.NoError(err)
+	suite.True(timelineable)
+
+	// turtle doesn't follow admin, so shouldn't see the status even though they follow the tag
+	timelineable, err = suite.filter.StatusHometimelineable(ctx, testStatus, suite.testAccounts["local_account_2"])
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	timelineable, err = suite.filter.StatusPublictimelineable(ctx, testStatus, suite.testAccounts["local_account_2"])
+	suite.NoError(err)
+	suite.False(timelineable)
+
+	// and neither should a logged-out visitor
+	timelineable, err = suite.filter.StatusPublictimelineable(ctx, testStatus, nil)
+	suite.NoError(err)
+	suite.False(timelineable)
+}
+
 func (suite *StatusStatusHometimelineableTestSuite) TestStatusTooNewNotTimelineable() {
 	testStatus := &gtsmodel.Status{}
 	*testStatus = *suite.testStatuses["local_account_1_status_1"]
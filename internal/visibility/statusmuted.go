@@ -0,0 +1,56 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package visibility
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (f *filter) StatusMuted(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error) {
+	// collect the accounts whose muting would hide this status
+	accountIDs := []string{targetStatus.AccountID}
+
+	if targetStatus.InReplyToAccountID != "" {
+		accountIDs = append(accountIDs, targetStatus.InReplyToAccountID)
+	}
+
+	if targetStatus.BoostOfAccountID != "" {
+		accountIDs = append(accountIDs, targetStatus.BoostOfAccountID)
+	}
+
+	for _, accountID := range accountIDs {
+		if accountID == requestingAccount.ID {
+			// you can't mute yourself
+			continue
+		}
+
+		if _, err := f.db.GetMute(ctx, requestingAccount.ID, accountID); err == nil {
+			return true, nil
+		} else if !errors.Is(err, db.ErrNoEntries) {
+			return false, fmt.Errorf("StatusMuted: error checking mute of account %s: %w", accountID, err)
+		}
+	}
+
+	return false, nil
+}
@@ -68,5 +68,27 @@ func (f *filter) StatusPublictimelineable(ctx context.Context, targetStatus *gts
 		return false, nil
 	}
 
+	silenced, err := f.StatusSilenced(ctx, targetStatus, timelineOwnerAccount)
+	if err != nil {
+		return false, fmt.Errorf("StatusPublictimelineable: error checking silences of status with id %s: %s", targetStatus.ID, err)
+	}
+
+	if silenced {
+		l.Debug("status is not publicTimelineable because its author is silenced and not followed by the requester")
+		return false, nil
+	}
+
+	if timelineOwnerAccount != nil {
+		muted, err := f.StatusMuted(ctx, targetStatus, timelineOwnerAccount)
+		if err != nil {
+			return false, fmt.Errorf("StatusPublictimelineable: error checking mutes of status with id %s: %s", targetStatus.ID, err)
+		}
+
+		if muted {
+			l.Debug("status is not publicTimelineable because the requester has muted a relevant account")
+			return false, nil
+		}
+	}
+
 	return true, nil
 }
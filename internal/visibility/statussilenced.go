@@ -0,0 +1,67 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package visibility
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (f *filter) StatusSilenced(ctx context.Context, targetStatus *gtsmodel.Status, requestingAccount *gtsmodel.Account) (bool, error) {
+	// collect the accounts whose silencing would limit this status
+	accountIDs := []string{targetStatus.AccountID}
+
+	if targetStatus.BoostOfAccountID != "" {
+		accountIDs = append(accountIDs, targetStatus.BoostOfAccountID)
+	}
+
+	for _, accountID := range accountIDs {
+		if requestingAccount != nil && accountID == requestingAccount.ID {
+			// you can always see your own statuses
+			continue
+		}
+
+		account, err := f.db.GetAccountByID(ctx, accountID)
+		if err != nil {
+			return false, fmt.Errorf("StatusSilenced: error getting account %s: %w", accountID, err)
+		}
+
+		if account.SilencedAt.IsZero() {
+			continue
+		}
+
+		if requestingAccount == nil {
+			// silenced accounts are only shown to their followers
+			return true, nil
+		}
+
+		following, err := f.db.IsFollowing(ctx, requestingAccount, account)
+		if err != nil {
+			return false, fmt.Errorf("StatusSilenced: error checking if %s follows %s: %w", requestingAccount.ID, accountID, err)
+		}
+
+		if !following {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
@@ -0,0 +1,139 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+func (m *Module) inviteGETHandler(c *gin.Context) {
+	instance, invite, ok := m.inviteCommon(c)
+	if !ok {
+		return
+	}
+
+	c.HTML(http.StatusOK, "invite.tmpl", gin.H{
+		"instance": instance,
+		"invite":   invite,
+		"form":     &apimodel.AccountCreateRequest{Locale: "en"},
+	})
+}
+
+func (m *Module) invitePOSTHandler(c *gin.Context) {
+	instance, invite, ok := m.inviteCommon(c)
+	if !ok {
+		return
+	}
+
+	instanceGet := func(ctx context.Context, domain string) (*apimodel.Instance, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	// show the form again with the problem at the top,
+	// keeping whatever the visitor already filled in
+	renderFormError := func(form *apimodel.AccountCreateRequest, code int, message string) {
+		form.Password = ""
+		c.HTML(code, "invite.tmpl", gin.H{
+			"instance": instance,
+			"invite":   invite,
+			"form":     form,
+			"error":    message,
+		})
+	}
+
+	form := &apimodel.AccountCreateRequest{}
+	if err := c.ShouldBind(form); err != nil {
+		renderFormError(form, http.StatusBadRequest, "please fill in every field, and agree to the rules of this instance")
+		return
+	}
+
+	signUpIP := net.ParseIP(c.ClientIP())
+	if signUpIP == nil {
+		err := errors.New("ip address could not be parsed from request")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), instanceGet)
+		return
+	}
+	form.IP = signUpIP
+
+	if _, errWithCode := m.processor.InviteSignup(c.Request.Context(), invite.Code, form); errWithCode != nil {
+		switch errWithCode.Code() {
+		case http.StatusBadRequest, http.StatusConflict:
+			renderFormError(form, errWithCode.Code(), errWithCode.Safe())
+		default:
+			apiutil.ErrorHandler(c, errWithCode, instanceGet)
+		}
+		return
+	}
+
+	c.HTML(http.StatusOK, "invite.tmpl", gin.H{
+		"instance": instance,
+		"invite":   invite,
+		"signedUp": true,
+		"username": form.Username,
+		"email":    form.Email,
+	})
+}
+
+// inviteCommon does the checks shared by the invite handlers, and fetches the instance
+// and the usable invite for the code in the path. If it returns false, an error has
+// already been written to the response.
+func (m *Module) inviteCommon(c *gin.Context) (*apimodel.Instance, *gtsmodel.Invite, bool) {
+	ctx := c.Request.Context()
+
+	code := c.Param(inviteCodeKey)
+	if code == "" {
+		err := errors.New("no invite code specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return nil, nil, false
+	}
+
+	host := config.GetHost()
+	instance, err := m.processor.InstanceGet(ctx, host)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGet)
+		return nil, nil, false
+	}
+
+	instanceGet := func(ctx context.Context, domain string) (*apimodel.Instance, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), instanceGet)
+		return nil, nil, false
+	}
+
+	invite, errWithCode := m.processor.InviteGetUsable(ctx, code)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, instanceGet)
+		return nil, nil, false
+	}
+
+	return instance, invite, true
+}
@@ -120,6 +120,16 @@ func (og *ogMeta) withStatus(status *apimodel.Status) *ogMeta {
 	return og
 }
 
+// withTag uses the given tag to build an ogMeta
+// struct specific to that tag. It's suitable for
+// serving at tag pages.
+func (og *ogMeta) withTag(tag *apimodel.Tag) *ogMeta {
+	og.Title = "#" + tag.Name + " - " + og.SiteName
+	og.URL = tag.URL
+	og.Description = parseDescription("Public posts tagged with #" + tag.Name + " on " + og.SiteName + ".")
+	return og
+}
+
 // parseTitle parses a page title from account and accountDomain
 func parseTitle(account *apimodel.Account, accountDomain string) string {
 	user := "@" + account.Acct + "@" + accountDomain
@@ -39,6 +39,7 @@ Disallow: /oauth/
 Disallow: /check_your_email
 Disallow: /wait_for_approval
 Disallow: /account_disabled
+Disallow: /invite/
 # well known stuff
 Disallow: /.well-known/
 # files
@@ -0,0 +1,90 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package web
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	apiutil "github.com/superseriousbusiness/gotosocial/internal/api/util"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+)
+
+func (m *Module) tagGETHandler(c *gin.Context) {
+	ctx := c.Request.Context()
+
+	tagName := c.Param(tagNameKey)
+	if tagName == "" {
+		err := errors.New("no tag name specified")
+		apiutil.ErrorHandler(c, gtserror.NewErrorBadRequest(err, err.Error()), m.processor.InstanceGet)
+		return
+	}
+
+	host := config.GetHost()
+	instance, err := m.processor.InstanceGet(ctx, host)
+	if err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorInternalError(err), m.processor.InstanceGet)
+		return
+	}
+
+	instanceGet := func(ctx context.Context, domain string) (*apimodel.Instance, gtserror.WithCode) {
+		return instance, nil
+	}
+
+	if _, err := apiutil.NegotiateAccept(c, apiutil.HTMLAcceptHeaders...); err != nil {
+		apiutil.ErrorHandler(c, gtserror.NewErrorNotAcceptable(err, err.Error()), instanceGet)
+		return
+	}
+
+	// we should only show the 'back to top' button if the
+	// visitor is paging through statuses
+	showBackToTop := false
+
+	maxStatusID := ""
+	maxStatusIDString := c.Query(MaxStatusIDKey)
+	if maxStatusIDString != "" {
+		maxStatusID = maxStatusIDString
+		showBackToTop = true
+	}
+
+	tag, statusResp, errWithCode := m.processor.TagWebStatusesGet(ctx, tagName, maxStatusID)
+	if errWithCode != nil {
+		apiutil.ErrorHandler(c, errWithCode, instanceGet)
+		return
+	}
+
+	c.HTML(http.StatusOK, "tag.tmpl", gin.H{
+		"instance":         instance,
+		"tag":              tag,
+		"ogMeta":           ogBase(instance).withTag(tag),
+		"statuses":         statusResp.Items,
+		"statuses_next":    statusResp.NextLink,
+		"show_back_to_top": showBackToTop,
+		"stylesheets": []string{
+			assetsPathPrefix + "/Fork-Awesome/css/fork-awesome.min.css",
+			distPathPrefix + "/status.css",
+			distPathPrefix + "/profile.css",
+		},
+		"javascript": []string{distPathPrefix + "/frontend.js"},
+	})
+}
@@ -37,6 +37,8 @@ const (
 	customCSSPath      = profilePath + "/custom.css"
 	rssFeedPath        = profilePath + "/feed.rss"
 	statusPath         = profilePath + "/statuses/:" + statusIDKey
+	tagPath            = "/tags/:" + tagNameKey
+	invitePath         = "/" + uris.InvitePath + "/:" + inviteCodeKey
 	assetsPathPrefix   = "/assets"
 	distPathPrefix     = assetsPathPrefix + "/dist"
 	settingsPathPrefix = "/settings"
@@ -44,9 +46,11 @@ const (
 	userPanelPath      = settingsPathPrefix + "/user"
 	adminPanelPath     = settingsPathPrefix + "/admin"
 
-	tokenParam  = "token"
-	usernameKey = "username"
-	statusIDKey = "status"
+	tokenParam    = "token"
+	usernameKey   = "username"
+	statusIDKey   = "status"
+	tagNameKey    = "tag_name"
+	inviteCodeKey = "code"
 
 	cacheControlHeader    = "Cache-Control"     // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control
 	cacheControlNoCache   = "no-cache"          // https://developer.mozilla.org/en-US/docs/Web/HTTP/Headers/Cache-Control#response_directives
@@ -97,7 +101,10 @@ func (m *Module) Route(r router.Router, mi ...gin.HandlerFunc) {
 	r.AttachHandler(http.MethodGet, customCSSPath, m.customCSSGETHandler)
 	r.AttachHandler(http.MethodGet, rssFeedPath, m.rssFeedGETHandler)
 	r.AttachHandler(http.MethodGet, statusPath, m.threadGETHandler)
+	r.AttachHandler(http.MethodGet, tagPath, m.tagGETHandler)
 	r.AttachHandler(http.MethodGet, confirmEmailPath, m.confirmEmailGETHandler)
+	r.AttachHandler(http.MethodGet, invitePath, m.inviteGETHandler)
+	r.AttachHandler(http.MethodPost, invitePath, m.invitePOSTHandler)
 	r.AttachHandler(http.MethodGet, robotsPath, m.robotsGETHandler)
 
 	r.AttachHandler(http.MethodGet, domainBlockListPath, m.domainBlockListGETHandler)
@@ -0,0 +1,210 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// recordSize is the record size used for the
+	// aes128gcm content coding. Pushes are always
+	// encrypted as a single record of this size.
+	recordSize = 4096
+
+	// authSecretLength is the expected length of a
+	// user agent's authentication secret (RFC 8291 3.2).
+	authSecretLength = 16
+
+	// saltLength is the length of the random salt
+	// used in the aes128gcm content coding header.
+	saltLength = 16
+
+	// gcmTagLength is the length of the authentication tag
+	// that AES-GCM appends to each encrypted record.
+	gcmTagLength = 16
+
+	// maxPlaintextLength is the longest plaintext that fits into one record,
+	// leaving room for the authentication tag and the padding delimiter.
+	maxPlaintextLength = recordSize - gcmTagLength - 1
+)
+
+// ValidateKeys checks that the given base64 encoded P-256 ECDH public key
+// and authentication secret from a user agent are usable for encrypting pushes.
+func ValidateKeys(p256dh string, auth string) error {
+	if _, err := decodePublicKey(p256dh); err != nil {
+		return err
+	}
+
+	if _, err := decodeAuthSecret(auth); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// encrypt encrypts the given plaintext for a user agent with the given
+// P-256 ECDH public key and authentication secret, using the aes128gcm
+// content coding (RFC 8188) as described by RFC 8291. The returned
+// slice includes the content coding header, and can be used as the
+// body of a push request as-is.
+func encrypt(plaintext []byte, uaPublic *publicKey, authSecret []byte) ([]byte, error) {
+	if len(plaintext) > maxPlaintextLength {
+		return nil, fmt.Errorf("plaintext length %d exceeds maximum %d", len(plaintext), maxPlaintextLength)
+	}
+
+	// generate a new ephemeral key pair for this push
+	curve := elliptic.P256()
+	asPrivate, asX, asY, err := elliptic.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("error generating ephemeral key: %w", err)
+	}
+	asPublic := elliptic.Marshal(curve, asX, asY)
+
+	salt := make([]byte, saltLength)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+
+	// the shared secret is the x coordinate of the product
+	// of our ephemeral private key and their public key
+	sharedX, _ := curve.ScalarMult(uaPublic.x, uaPublic.y, asPrivate)
+	ecdhSecret := sharedX.FillBytes(make([]byte, 32))
+
+	cek, nonce, err := deriveKeys(ecdhSecret, authSecret, salt, uaPublic.bytes, asPublic)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(cek)
+	if err != nil {
+		return nil, err
+	}
+
+	// content coding header: salt, record size, key id length, key id (RFC 8188 2.1)
+	header := make([]byte, 0, saltLength+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, recordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	// the only record is also the last, so it gets the 0x02 padding delimiter
+	record := make([]byte, 0, len(plaintext)+1)
+	record = append(record, plaintext...)
+	record = append(record, 0x02)
+
+	return gcm.Seal(header, nonce, record, nil), nil
+}
+
+// deriveKeys derives the content encryption key and nonce
+// for a push, as described in RFC 8291 3.4 and RFC 8188 2.2.
+func deriveKeys(ecdhSecret []byte, authSecret []byte, salt []byte, uaPublic []byte, asPublic []byte) ([]byte, []byte, error) {
+	keyInfo := make([]byte, 0, 14+len(uaPublic)+len(asPublic))
+	keyInfo = append(keyInfo, "WebPush: info\x00"...)
+	keyInfo = append(keyInfo, uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ecdhSecret, authSecret, keyInfo), ikm); err != nil {
+		return nil, nil, fmt.Errorf("error deriving input keying material: %w", err)
+	}
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+
+	cek := make([]byte, 16)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: aes128gcm\x00")), cek); err != nil {
+		return nil, nil, fmt.Errorf("error deriving content encryption key: %w", err)
+	}
+
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		return nil, nil, fmt.Errorf("error deriving nonce: %w", err)
+	}
+
+	return cek, nonce, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return cipher.NewGCM(block)
+}
+
+// publicKey is a P-256 public key, as
+// both curve coordinates and encoded bytes.
+type publicKey struct {
+	x     *big.Int
+	y     *big.Int
+	bytes []byte
+}
+
+// decodePublicKey decodes a user agent's base64 encoded,
+// uncompressed P-256 ECDH public key (SEC 1 2.3.3).
+func decodePublicKey(p256dh string) (*publicKey, error) {
+	b, err := decodeBase64(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("p256dh key was not valid base64: %w", err)
+	}
+
+	// Unmarshal also checks that the point is on the curve
+	x, y := elliptic.Unmarshal(elliptic.P256(), b)
+	if x == nil {
+		return nil, errors.New("p256dh key was not a valid P-256 public key")
+	}
+
+	return &publicKey{x: x, y: y, bytes: b}, nil
+}
+
+// decodeAuthSecret decodes a user agent's base64 encoded authentication secret.
+func decodeAuthSecret(auth string) ([]byte, error) {
+	b, err := decodeBase64(auth)
+	if err != nil {
+		return nil, fmt.Errorf("auth secret was not valid base64: %w", err)
+	}
+
+	if len(b) != authSecretLength {
+		return nil, errors.New("auth secret was not 16 bytes long")
+	}
+
+	return b, nil
+}
+
+// decodeBase64 decodes base64url, as specified for Web Push keys,
+// but also tolerates padding and the standard alphabet, since
+// not all clients are strict about what they send.
+func decodeBase64(s string) ([]byte, error) {
+	s = strings.TrimRight(s, "=")
+	s = strings.NewReplacer("+", "-", "/", "_").Replace(s)
+	return base64.RawURLEncoding.DecodeString(s)
+}
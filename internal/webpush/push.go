@@ -0,0 +1,152 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+	"github.com/superseriousbusiness/gotosocial/internal/text"
+)
+
+// maxBodyLength is the maximum length, in
+// characters, of the body text of a push.
+const maxBodyLength = 140
+
+// push is an unencrypted payload waiting to be delivered to a subscription.
+type push struct {
+	subscription *gtsmodel.WebPushSubscription
+	payload      []byte
+}
+
+// preparePushes gets the Web Push subscriptions of the notification's target account that want
+// alerts for the notification's type, and prepares an unencrypted payload for each of them.
+func preparePushes(ctx context.Context, dbService db.DB, notification *gtsmodel.Notification, apiNotification *apimodel.Notification) ([]*push, error) {
+	subscriptions, err := dbService.GetWebPushSubscriptionsByAccountID(ctx, notification.TargetAccountID)
+	if err != nil && !errors.Is(err, db.ErrNoEntries) {
+		return nil, fmt.Errorf("preparePushes: error getting subscriptions for account %s: %w", notification.TargetAccountID, err)
+	}
+
+	pushes := make([]*push, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		if !subscription.AlertEnabled(notification.NotificationType) {
+			continue
+		}
+
+		if notification.TargetAccount == nil {
+			targetAccount, err := dbService.GetAccountByID(ctx, notification.TargetAccountID)
+			if err != nil {
+				return nil, fmt.Errorf("preparePushes: error getting target account %s: %w", notification.TargetAccountID, err)
+			}
+			notification.TargetAccount = targetAccount
+		}
+
+		// each push includes the access token that the
+		// subscription was created with, so that the client
+		// can tell which of its accounts the push is for
+		token := &gtsmodel.Token{}
+		if err := dbService.GetByID(ctx, subscription.TokenID, token); err != nil {
+			if !errors.Is(err, db.ErrNoEntries) {
+				return nil, fmt.Errorf("preparePushes: error getting token for subscription %s: %w", subscription.ID, err)
+			}
+
+			// the token has been removed, so this subscription can't be used anymore
+			log.Debugf("preparePushes: removing web push subscription %s with missing token", subscription.ID)
+			if err := dbService.DeleteWebPushSubscriptionByID(ctx, subscription.ID); err != nil {
+				log.Errorf("preparePushes: error removing web push subscription %s: %s", subscription.ID, err)
+			}
+			continue
+		}
+
+		payload, err := json.Marshal(&apimodel.WebPushNotification{
+			AccessToken:      token.Access,
+			PreferredLocale:  notification.TargetAccount.Language,
+			NotificationID:   notification.ID,
+			NotificationType: string(notification.NotificationType),
+			Icon:             apiNotification.Account.Avatar,
+			Title:            pushTitle(apiNotification),
+			Body:             pushBody(apiNotification),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("preparePushes: error marshalling payload: %w", err)
+		}
+
+		pushes = append(pushes, &push{
+			subscription: subscription,
+			payload:      payload,
+		})
+	}
+
+	return pushes, nil
+}
+
+// pushTitle returns a short description of what happened in the given notification.
+func pushTitle(apiNotification *apimodel.Notification) string {
+	name := apiNotification.Account.DisplayName
+	if name == "" {
+		name = "@" + apiNotification.Account.Acct
+	}
+
+	switch gtsmodel.NotificationType(apiNotification.Type) {
+	case gtsmodel.NotificationFollow:
+		return name + " followed you"
+	case gtsmodel.NotificationFollowRequest:
+		return name + " requested to follow you"
+	case gtsmodel.NotificationMention:
+		return name + " mentioned you"
+	case gtsmodel.NotificationReblog:
+		return name + " boosted your post"
+	case gtsmodel.NotificationFave:
+		return name + " favourited your post"
+	case gtsmodel.NotificationPoll:
+		return "A poll you voted in or created has ended"
+	case gtsmodel.NotificationStatus:
+		return name + " just posted"
+	default:
+		return "New notification from " + name
+	}
+}
+
+// pushBody returns a short plaintext preview of the status
+// in the given notification, or of the notifying account's
+// bio if the notification doesn't have a status.
+func pushBody(apiNotification *apimodel.Notification) string {
+	var body string
+
+	switch {
+	case apiNotification.Status != nil && apiNotification.Status.SpoilerText != "":
+		body = apiNotification.Status.SpoilerText
+	case apiNotification.Status != nil:
+		body = text.SanitizePlaintext(apiNotification.Status.Content)
+	default:
+		body = text.SanitizePlaintext(apiNotification.Account.Note)
+	}
+
+	if runes := []rune(body); len(runes) > maxBodyLength {
+		body = string(runes[:maxBodyLength-1]) + "…"
+	}
+
+	return body
+}
@@ -0,0 +1,147 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtserror"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/log"
+)
+
+// ttl is how long, in seconds, a push service should
+// hold on to a push for a user agent that isn't online.
+const ttl = 48 * 60 * 60
+
+// Sender contains functions for sending Web Push notifications to local accounts.
+type Sender interface {
+	// Send pushes the given notification to each of the target account's Web Push
+	// subscriptions that want alerts for this type of notification.
+	Send(ctx context.Context, notification *gtsmodel.Notification, apiNotification *apimodel.Notification) error
+}
+
+// NewSender returns a new Web Push Sender, which will encrypt pushes
+// and deliver them to push services using the given http client.
+func NewSender(client *httpclient.Client, db db.DB) Sender {
+	return &sender{
+		client: client,
+		db:     db,
+	}
+}
+
+type sender struct {
+	client *httpclient.Client
+	db     db.DB
+}
+
+func (s *sender) Send(ctx context.Context, notification *gtsmodel.Notification, apiNotification *apimodel.Notification) error {
+	pushes, err := preparePushes(ctx, s.db, notification, apiNotification)
+	if err != nil {
+		return err
+	}
+
+	if len(pushes) == 0 {
+		// nothing to do
+		return nil
+	}
+
+	pair, err := s.db.GetVAPIDKeyPair(ctx)
+	if err != nil {
+		return fmt.Errorf("Send: error getting vapid key pair: %w", err)
+	}
+
+	var errs gtserror.MultiError
+	for _, p := range pushes {
+		if err := s.deliver(ctx, pair, p); err != nil {
+			errs.Appendf("error delivering push to subscription %s: %v", p.subscription.ID, err)
+		}
+	}
+
+	return errs.Combine()
+}
+
+// deliver encrypts the payload of the given push, and posts it to the subscription's endpoint.
+func (s *sender) deliver(ctx context.Context, pair *gtsmodel.VAPIDKeyPair, p *push) error {
+	endpoint, err := url.Parse(p.subscription.Endpoint)
+	if err != nil {
+		return fmt.Errorf("error parsing endpoint: %w", err)
+	}
+
+	uaPublic, err := decodePublicKey(p.subscription.P256dh)
+	if err != nil {
+		return err
+	}
+
+	authSecret, err := decodeAuthSecret(p.subscription.Auth)
+	if err != nil {
+		return err
+	}
+
+	body, err := encrypt(p.payload, uaPublic, authSecret)
+	if err != nil {
+		return fmt.Errorf("error encrypting payload: %w", err)
+	}
+
+	authorization, err := vapidAuthorization(pair, endpoint, time.Now())
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint.String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", strconv.Itoa(ttl))
+	req.Header.Set("Urgency", "normal")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch code := resp.StatusCode; {
+	case code == http.StatusNotFound || code == http.StatusGone:
+		// the subscription has expired or been
+		// unsubscribed, so we should stop using it
+		log.Debugf("deliver: removing expired web push subscription %s", p.subscription.ID)
+		if err := s.db.DeleteWebPushSubscriptionByID(ctx, p.subscription.ID); err != nil && !errors.Is(err, db.ErrNoEntries) {
+			return fmt.Errorf("error removing expired subscription: %w", err)
+		}
+		return nil
+	case code < 200 || code >= 300:
+		return fmt.Errorf("push service returned %s", resp.Status)
+	}
+
+	return nil
+}
@@ -0,0 +1,292 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush_test
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/suite"
+	apimodel "github.com/superseriousbusiness/gotosocial/internal/api/model"
+	"github.com/superseriousbusiness/gotosocial/internal/db"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+	"github.com/superseriousbusiness/gotosocial/internal/httpclient"
+	"github.com/superseriousbusiness/gotosocial/internal/typeutils"
+	"github.com/superseriousbusiness/gotosocial/internal/webpush"
+	"github.com/superseriousbusiness/gotosocial/testrig"
+	"golang.org/x/crypto/hkdf"
+)
+
+// receivedPush is a push received by the stand-in push service.
+type receivedPush struct {
+	header  http.Header
+	payload []byte
+}
+
+type SenderTestSuite struct {
+	suite.Suite
+	db db.DB
+	tc typeutils.TypeConverter
+
+	// stand-in push service
+	server     *httptest.Server
+	statusCode int
+	pushes     []*receivedPush
+	pushesMu   sync.Mutex
+
+	// keys of the user agent subscribed to the stand-in push service
+	uaPrivate  *ecdsa.PrivateKey
+	authSecret []byte
+
+	testTokens        map[string]*gtsmodel.Token
+	testAccounts      map[string]*gtsmodel.Account
+	testNotifications map[string]*gtsmodel.Notification
+	testWebPushSubs   map[string]*gtsmodel.WebPushSubscription
+
+	sender webpush.Sender
+}
+
+func (suite *SenderTestSuite) SetupSuite() {
+	suite.testTokens = testrig.NewTestTokens()
+	suite.testAccounts = testrig.NewTestAccounts()
+	suite.testNotifications = testrig.NewTestNotifications()
+	suite.testWebPushSubs = testrig.NewTestWebPushSubscriptions()
+}
+
+func (suite *SenderTestSuite) SetupTest() {
+	testrig.InitTestConfig()
+	testrig.InitTestLog()
+
+	suite.db = testrig.NewTestDB()
+	suite.tc = testrig.NewTestTypeConverter(suite.db)
+	testrig.StandardDBSetup(suite.db, nil)
+
+	suite.statusCode = http.StatusCreated
+	suite.pushes = nil
+	suite.server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		payload, err := suite.decrypt(r.Body)
+		suite.NoError(err)
+
+		suite.pushesMu.Lock()
+		suite.pushes = append(suite.pushes, &receivedPush{header: r.Header, payload: payload})
+		suite.pushesMu.Unlock()
+
+		rw.WriteHeader(suite.statusCode)
+	}))
+
+	// point the test subscription at the stand-in push service, with fresh user agent keys
+	uaPrivate, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+	suite.uaPrivate = uaPrivate
+	suite.authSecret = make([]byte, 16)
+	if _, err := io.ReadFull(rand.Reader, suite.authSecret); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	subscription := suite.testWebPushSubs["local_account_1_token_1"]
+	subscription.Endpoint = suite.server.URL + "/send/" + subscription.ID
+	subscription.P256dh = base64.RawURLEncoding.EncodeToString(elliptic.Marshal(elliptic.P256(), uaPrivate.X, uaPrivate.Y))
+	subscription.Auth = base64.RawURLEncoding.EncodeToString(suite.authSecret)
+	if err := suite.db.UpdateWebPushSubscription(context.Background(), subscription, "endpoint", "p256dh", "auth"); err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	suite.sender = webpush.NewSender(httpclient.New(httpclient.Config{
+		AllowRanges: []netip.Prefix{
+			// Loopback (used by stand-in push service)
+			netip.MustParsePrefix("127.0.0.1/8"),
+		},
+	}), suite.db)
+}
+
+func (suite *SenderTestSuite) TearDownTest() {
+	suite.server.Close()
+	testrig.StandardDBTeardown(suite.db)
+}
+
+// decrypt decrypts a single record aes128gcm encoded body
+// (RFC 8188) using the keys of the test user agent (RFC 8291).
+func (suite *SenderTestSuite) decrypt(body io.Reader) ([]byte, error) {
+	b, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(b) < 21 || len(b) < 21+int(b[20]) {
+		return nil, errors.New("body too short for content coding header")
+	}
+	salt := b[:16]
+	recordSize := binary.BigEndian.Uint32(b[16:20])
+	asPublic := b[21 : 21+int(b[20])]
+	ciphertext := b[21+int(b[20]):]
+	if recordSize != 4096 || len(ciphertext) > int(recordSize) {
+		return nil, errors.New("unexpected record size")
+	}
+
+	curve := elliptic.P256()
+	asX, asY := elliptic.Unmarshal(curve, asPublic)
+	if asX == nil {
+		return nil, errors.New("invalid application server key")
+	}
+	sharedX, _ := curve.ScalarMult(asX, asY, suite.uaPrivate.D.Bytes())
+	uaPublic := elliptic.Marshal(curve, suite.uaPrivate.X, suite.uaPrivate.Y)
+
+	keyInfo := append(append([]byte("WebPush: info\x00"), uaPublic...), asPublic...)
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, sharedX.FillBytes(make([]byte, 32)), suite.authSecret, keyInfo), ikm); err != nil {
+		return nil, err
+	}
+	cek := make([]byte, 16)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: aes128gcm\x00")), cek); err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, 12)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, ikm, salt, []byte("Content-Encoding: nonce\x00")), nonce); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	record, err := gcm.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// the last record ends with the 0x02 delimiter, followed by any padding
+	end := strings.LastIndexByte(string(record), 0x02)
+	if end == -1 {
+		return nil, errors.New("no padding delimiter in last record")
+	}
+	return record[:end], nil
+}
+
+func (suite *SenderTestSuite) send(notification *gtsmodel.Notification) error {
+	ctx := context.Background()
+
+	apiNotification, err := suite.tc.NotificationToAPINotification(ctx, notification)
+	if err != nil {
+		suite.FailNow(err.Error())
+	}
+
+	return suite.sender.Send(ctx, notification, apiNotification)
+}
+
+func (suite *SenderTestSuite) TestSendFave() {
+	notification := suite.testNotifications["local_account_1_like"]
+	suite.NoError(suite.send(notification))
+	suite.Len(suite.pushes, 1)
+
+	p := suite.pushes[0]
+	suite.Equal("aes128gcm", p.header.Get("Content-Encoding"))
+	suite.Equal("172800", p.header.Get("TTL"))
+
+	// the push should be signed with the instance vapid key
+	vapidKeyPair, err := suite.db.GetVAPIDKeyPair(context.Background())
+	suite.NoError(err)
+	authorization := p.header.Get("Authorization")
+	suite.True(strings.HasPrefix(authorization, "vapid t="))
+	suite.True(strings.HasSuffix(authorization, ", k="+vapidKeyPair.Public))
+
+	publicKeyBytes, err := base64.RawURLEncoding.DecodeString(vapidKeyPair.Public)
+	suite.NoError(err)
+	x, y := elliptic.Unmarshal(elliptic.P256(), publicKeyBytes)
+	suite.NotNil(x)
+
+	rawToken := strings.TrimSuffix(strings.TrimPrefix(authorization, "vapid t="), ", k="+vapidKeyPair.Public)
+	claims := jwt.MapClaims{}
+	_, err = jwt.ParseWithClaims(rawToken, claims, func(t *jwt.Token) (interface{}, error) {
+		return &ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, nil
+	})
+	suite.NoError(err)
+	suite.Equal(suite.server.URL, claims["aud"])
+	suite.Equal("http://localhost:8080", claims["sub"])
+
+	// the payload should describe the notification
+	payload := &apimodel.WebPushNotification{}
+	suite.NoError(json.Unmarshal(p.payload, payload))
+	suite.Equal(suite.testTokens["local_account_1"].Access, payload.AccessToken)
+	suite.Equal(notification.ID, payload.NotificationID)
+	suite.Equal("favourite", payload.NotificationType)
+	suite.Equal("@admin favourited your post", payload.Title)
+	// the status has a content warning, which is shown instead of its text
+	suite.Equal("introduction post", payload.Body)
+	suite.Equal("en", payload.PreferredLocale)
+}
+
+func (suite *SenderTestSuite) TestSendAlertDisabled() {
+	notification := &gtsmodel.Notification{}
+	*notification = *suite.testNotifications["local_account_1_like"]
+	notification.NotificationType = gtsmodel.NotificationReblog
+
+	// the subscription doesn't want reblog alerts
+	suite.NoError(suite.send(notification))
+	suite.Empty(suite.pushes)
+}
+
+func (suite *SenderTestSuite) TestSendExpiredSubscription() {
+	ctx := context.Background()
+	subscription := suite.testWebPushSubs["local_account_1_token_1"]
+
+	// the push service says the subscription is gone
+	suite.statusCode = http.StatusGone
+	suite.NoError(suite.send(suite.testNotifications["local_account_1_like"]))
+	suite.Len(suite.pushes, 1)
+
+	// so it should have been removed
+	_, err := suite.db.GetWebPushSubscriptionByTokenID(ctx, subscription.TokenID)
+	suite.ErrorIs(err, db.ErrNoEntries)
+}
+
+func (suite *SenderTestSuite) TestSendPushServiceError() {
+	suite.statusCode = http.StatusInternalServerError
+	suite.Error(suite.send(suite.testNotifications["local_account_1_like"]))
+
+	// the subscription should be kept for next time
+	_, err := suite.db.GetWebPushSubscriptionByTokenID(context.Background(), suite.testWebPushSubs["local_account_1_token_1"].TokenID)
+	suite.NoError(err)
+}
+
+func TestSenderTestSuite(t *testing.T) {
+	suite.Run(t, new(SenderTestSuite))
+}
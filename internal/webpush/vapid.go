@@ -0,0 +1,62 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package webpush
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/superseriousbusiness/gotosocial/internal/config"
+	"github.com/superseriousbusiness/gotosocial/internal/gtsmodel"
+)
+
+// vapidExpiry is how long VAPID tokens are valid for. RFC 8292 says this
+// must not be more than 24 hours from the time of the request.
+const vapidExpiry = 12 * time.Hour
+
+// vapidAuthorization returns the value of the Authorization header for a push
+// to the given endpoint, signed with the given VAPID key pair (RFC 8292).
+func vapidAuthorization(pair *gtsmodel.VAPIDKeyPair, endpoint *url.URL, now time.Time) (string, error) {
+	der, err := base64.RawURLEncoding.DecodeString(pair.Private)
+	if err != nil {
+		return "", fmt.Errorf("error decoding vapid private key: %w", err)
+	}
+
+	key, err := x509.ParseECPrivateKey(der)
+	if err != nil {
+		return "", fmt.Errorf("error parsing vapid private key: %w", err)
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.MapClaims{
+		"aud": endpoint.Scheme + "://" + endpoint.Host,
+		"exp": now.Add(vapidExpiry).Unix(),
+		"sub": config.GetProtocol() + "://" + config.GetHost(),
+	})
+
+	signed, err := token.SignedString(key)
+	if err != nil {
+		return "", fmt.Errorf("error signing vapid token: %w", err)
+	}
+
+	return "vapid t=" + signed + ", k=" + pair.Public, nil
+}
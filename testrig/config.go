@@ -67,11 +67,14 @@ var testDefaults = config.Configuration{
 	InstanceExposeSuspended:        true,
 	InstanceExposeSuspendedWeb:     true,
 	InstanceDeliverToSharedInboxes: true,
+	InstanceFederationMode:         config.InstanceFederationModeBlocklist,
 
 	AccountsRegistrationOpen: true,
 	AccountsApprovalRequired: true,
 	AccountsReasonRequired:   true,
 	AccountsAllowCustomCSS:   true,
+	AccountsInvitesEnabled:   true,
+	AccountsInvitesAdminOnly: false,
 
 	MediaImageMaxSize:        10485760, // 10mb
 	MediaVideoMaxSize:        41943040, // 40mb
@@ -117,6 +120,15 @@ var testDefaults = config.Configuration{
 	SyslogProtocol: "udp",
 	SyslogAddress:  "localhost:514",
 
+	MetricsEnabled:     false,
+	MetricsBindAddress: "",
+	MetricsAuthToken:   "",
+
+	TracingEnabled:           false,
+	TracingEndpoint:          "",
+	TracingInsecureTransport: false,
+	TracingTrustIncoming:     false,
+
 	AdvancedCookiesSamesite:      "lax",
 	AdvancedRateLimitRequests:    0, // disabled
 	AdvancedThrottlingMultiplier: 0, // disabled
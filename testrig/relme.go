@@ -0,0 +1,52 @@
+/*
+   GoToSocial
+   Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+   This program is free software: you can redistribute it and/or modify
+   it under the terms of the GNU Affero General Public License as published by
+   the Free Software Foundation, either version 3 of the License, or
+   (at your option) any later version.
+
+   This program is distributed in the hope that it will be useful,
+   but WITHOUT ANY WARRANTY; without even the implied warranty of
+   MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+   GNU Affero General Public License for more details.
+
+   You should have received a copy of the GNU Affero General Public License
+   along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+package testrig
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/superseriousbusiness/gotosocial/internal/relme"
+)
+
+// NewRelMeVerifier returns a rel="me" verifier that won't make any remote calls.
+//
+// Instead, pages is used as a map of url -> html page, for pages that the
+// verifier can fetch; fetching any other url will result in a 404.
+func NewRelMeVerifier(pages map[string]string) relme.Verifier {
+	return relme.NewVerifier(NewMockHTTPClient(func(req *http.Request) (*http.Response, error) {
+		page, ok := pages[req.URL.String()]
+		if !ok {
+			return &http.Response{
+				StatusCode: http.StatusNotFound,
+				Status:     "404 Not Found",
+				Body:       io.NopCloser(strings.NewReader("")),
+			}, nil
+		}
+
+		return &http.Response{
+			StatusCode:    http.StatusOK,
+			Status:        "200 OK",
+			Header:        http.Header{"Content-Type": []string{"text/html; charset=utf-8"}},
+			Body:          io.NopCloser(strings.NewReader(page)),
+			ContentLength: int64(len(page)),
+		}, nil
+	}, ""))
+}
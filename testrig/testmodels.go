@@ -113,7 +113,7 @@ func NewTestTokens() map[string]*gtsmodel.Token {
 			ClientID:        "01F8MGWSJCND9BWBD4WGJXBM93",
 			UserID:          "01F8MGWYWKVKS3VS8DV1AMYPGE",
 			RedirectURI:     "http://localhost:8080",
-			Scope:           "read write follow push admin",
+			Scope:           "read write follow push admin:read admin:write",
 			Access:          "AININALKNENFNF98717NAMG4LWE4NJITMWUXM2M4MTRHZDEX",
 			AccessCreateAt:  TimeMustParse("2022-06-10T15:22:08Z"),
 			AccessExpiresAt: TimeMustParse("2050-01-01T15:22:08Z"),
@@ -342,7 +342,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/localhost:8080/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/localhost:8080/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAs:             nil,
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -380,7 +380,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/weed_lord420/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/weed_lord420/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAs:             nil,
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/weed_lord420#main-key",
@@ -420,7 +420,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/admin/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/admin/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAs:             nil,
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			SensitizedAt:            time.Time{},
@@ -459,7 +459,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/the_mighty_zork/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/the_mighty_zork/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAs:             nil,
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/the_mighty_zork/main-key",
@@ -499,7 +499,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://localhost:8080/users/1happyturtle/following",
 			FeaturedCollectionURI:   "http://localhost:8080/users/1happyturtle/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAs:             nil,
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://localhost:8080/users/1happyturtle#main-key",
@@ -535,7 +535,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://fossbros-anonymous.io/users/foss_satan/following",
 			FeaturedCollectionURI: "http://fossbros-anonymous.io/users/foss_satan/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
+			AlsoKnownAs:           nil,
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://fossbros-anonymous.io/users/foss_satan/main-key",
@@ -571,7 +571,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:          "http://example.org/users/Some_User/following",
 			FeaturedCollectionURI: "http://example.org/users/Some_User/collections/featured",
 			ActorType:             ap.ActorPerson,
-			AlsoKnownAs:           "",
+			AlsoKnownAs:           nil,
 			PrivateKey:            &rsa.PrivateKey{},
 			PublicKey:             &rsa.PublicKey{},
 			PublicKeyURI:          "http://example.org/users/Some_User#main-key",
@@ -607,7 +607,7 @@ func NewTestAccounts() map[string]*gtsmodel.Account {
 			FollowingURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj/following",
 			FeaturedCollectionURI:   "http://thequeenisstillalive.technology/users/her_fuckin_maj/collections/featured",
 			ActorType:               ap.ActorPerson,
-			AlsoKnownAs:             "",
+			AlsoKnownAs:             nil,
 			PrivateKey:              &rsa.PrivateKey{},
 			PublicKey:               &rsa.PublicKey{},
 			PublicKeyURI:            "http://thequeenisstillalive.technology/users/her_fuckin_maj#main-key",
@@ -1188,6 +1188,21 @@ func NewTestInstances() map[string]*gtsmodel.Instance {
 	}
 }
 
+func NewTestDomainAllows() map[string]*gtsmodel.DomainAllow {
+	return map[string]*gtsmodel.DomainAllow{
+		"fossbros-anonymous.io": {
+			ID:                 "01GT5C2H6W0B9D4Z3XJY8RMQKE",
+			CreatedAt:          TimeMustParse("2023-02-26T10:12:03+01:00"),
+			UpdatedAt:          TimeMustParse("2023-02-26T10:12:03+01:00"),
+			Domain:             "fossbros-anonymous.io",
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+			PrivateComment:     "they're annoying but mostly harmless",
+			PublicComment:      "",
+			Obfuscate:          FalseBool(),
+		},
+	}
+}
+
 func NewTestDomainBlocks() map[string]*gtsmodel.DomainBlock {
 	return map[string]*gtsmodel.DomainBlock{
 		"replyguys.com": {
@@ -1678,6 +1693,7 @@ func NewTestStatuses() map[string]*gtsmodel.Status {
 			Boostable:                TrueBool(),
 			Replyable:                TrueBool(),
 			Likeable:                 TrueBool(),
+			PollID:                   "01GV1X9K0J8PX1MEGQNWD7Q3XZ",
 			ActivityStreamsType:      ap.ObjectNote,
 		},
 		"remote_account_1_status_1": {
@@ -1952,6 +1968,201 @@ func NewTestReports() map[string]*gtsmodel.Report {
 	}
 }
 
+func NewTestLists() map[string]*gtsmodel.List {
+	return map[string]*gtsmodel.List{
+		"local_account_1_list_1": {
+			ID:            "01H0G8E4Q2J3FE3JDWJ1CJ1CS0",
+			CreatedAt:     TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt:     TimeMustParse("2022-05-14T13:21:09+02:00"),
+			Title:         "Cool Ass Posters From This Instance",
+			AccountID:     "01F8MH1H7YV1Z7D2C8K2730QBF",
+			RepliesPolicy: gtsmodel.RepliesPolicyFollowed,
+		},
+	}
+}
+
+func NewTestListEntries() map[string]*gtsmodel.ListEntry {
+	return map[string]*gtsmodel.ListEntry{
+		"local_account_1_list_1_entry_1": {
+			ID:        "01H0G89MWVQE0M58VD2HQYMQWH",
+			CreatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
+			ListID:    "01H0G8E4Q2J3FE3JDWJ1CJ1CS0",
+			FollowID:  "01F8PYDCE8XE23GRE5DPZJDZDP",
+		},
+		"local_account_1_list_1_entry_2": {
+			ID:        "01H0G8FFM1AGQDRNGBGGX8CYJQ",
+			CreatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
+			ListID:    "01H0G8E4Q2J3FE3JDWJ1CJ1CS0",
+			FollowID:  "01F8PY8RHWRQZV038T4E8T9YK8",
+		},
+	}
+}
+
+func NewTestFilters() map[string]*gtsmodel.Filter {
+	return map[string]*gtsmodel.Filter{
+		"local_account_1_filter_1": {
+			ID:                   "01HN26VM6KZTW1ANNRVSBMA461",
+			CreatedAt:            TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt:            TimeMustParse("2022-05-14T13:21:09+02:00"),
+			AccountID:            "01F8MH1H7YV1Z7D2C8K2730QBF",
+			Title:                "fnord",
+			Action:               gtsmodel.FilterActionWarn,
+			ContextHome:          TrueBool(),
+			ContextNotifications: FalseBool(),
+			ContextPublic:        TrueBool(),
+			ContextThread:        FalseBool(),
+			ContextAccount:       FalseBool(),
+		},
+	}
+}
+
+func NewTestFilterKeywords() map[string]*gtsmodel.FilterKeyword {
+	return map[string]*gtsmodel.FilterKeyword{
+		"local_account_1_filter_1_keyword_1": {
+			ID:        "01HNEJNVZZVXJTRB3FX3K2B1YF",
+			CreatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt: TimeMustParse("2022-05-14T13:21:09+02:00"),
+			AccountID: "01F8MH1H7YV1Z7D2C8K2730QBF",
+			FilterID:  "01HN26VM6KZTW1ANNRVSBMA461",
+			Keyword:   "fnord",
+			WholeWord: TrueBool(),
+		},
+	}
+}
+
+func NewTestPolls() map[string]*gtsmodel.Poll {
+	return map[string]*gtsmodel.Poll{
+		"local_account_2_status_7_poll": {
+			ID:         "01GV1X9K0J8PX1MEGQNWD7Q3XZ",
+			CreatedAt:  TimeMustParse("2021-10-20T12:40:37+02:00"),
+			UpdatedAt:  TimeMustParse("2021-10-20T12:40:37+02:00"),
+			Multiple:   FalseBool(),
+			HideCounts: FalseBool(),
+			Options:    []string{"yes", "no"},
+			Votes:      []int{1, 0},
+			Voters:     1,
+			StatusID:   "01G20ZM733MGN8J344T4ZDDFY1",
+			ExpiresAt:  TimeMustParse("2099-10-20T12:40:37+02:00"),
+		},
+	}
+}
+
+func NewTestPollVotes() map[string]*gtsmodel.PollVote {
+	return map[string]*gtsmodel.PollVote{
+		"admin_account_local_account_2_status_7_poll": {
+			ID:        "01GV1XB2H4AEXR1GQ3XNKVXWKD",
+			CreatedAt: TimeMustParse("2021-10-21T10:12:52+02:00"),
+			Choices:   []int{0},
+			AccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+			PollID:    "01GV1X9K0J8PX1MEGQNWD7Q3XZ",
+		},
+	}
+}
+
+func NewTestScheduledStatuses() map[string]*gtsmodel.ScheduledStatus {
+	return map[string]*gtsmodel.ScheduledStatus{
+		"local_account_1_scheduled_status_1": {
+			ID:             "01GSKX3TRQ2CXVKJ5TE9S1TZQ8",
+			CreatedAt:      TimeMustParse("2022-05-14T13:21:09+02:00"),
+			UpdatedAt:      TimeMustParse("2022-05-14T13:21:09+02:00"),
+			AccountID:      "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ScheduledAt:    TimeMustParse("2099-05-14T13:21:09+02:00"),
+			Text:           "hello from the future!",
+			Sensitive:      FalseBool(),
+			Visibility:     gtsmodel.VisibilityPublic,
+			Language:       "en",
+			Format:         "plain",
+			AttachmentIDs:  []string{},
+			PollMultiple:   FalseBool(),
+			PollHideTotals: FalseBool(),
+			ApplicationID:  "01F8MGY43H3N2C8EWPR2FPYEXG",
+		},
+	}
+}
+
+// NewTestConversations returns a map of gts model conversations keyed by their name.
+func NewTestConversations() map[string]*gtsmodel.Conversation {
+	return map[string]*gtsmodel.Conversation{
+		"local_account_1_conversation_1": {
+			ID:               "01GSN4QCWJ8ZQ5XR0E2P3V5Y7H",
+			CreatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			UpdatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			AccountID:        "01F8MH1H7YV1Z7D2C8K2730QBF",
+			OtherAccountIDs:  []string{"01F8MH5NBDF2MV7CTC4Q5128HF"},
+			OtherAccountsKey: "01F8MH5NBDF2MV7CTC4Q5128HF",
+			ThreadID:         "01FN3VJGFH10KR7S2PB0GFJZYG",
+			LastStatusID:     "01FN3VJGFH10KR7S2PB0GFJZYG",
+			Read:             FalseBool(),
+		},
+		"local_account_2_conversation_1": {
+			ID:               "01GSN4T1KJ5C7XFVBTM7Q8RM6D",
+			CreatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			UpdatedAt:        TimeMustParse("2021-10-20T12:40:37+02:00"),
+			AccountID:        "01F8MH5NBDF2MV7CTC4Q5128HF",
+			OtherAccountIDs:  []string{"01F8MH1H7YV1Z7D2C8K2730QBF"},
+			OtherAccountsKey: "01F8MH1H7YV1Z7D2C8K2730QBF",
+			ThreadID:         "01FN3VJGFH10KR7S2PB0GFJZYG",
+			LastStatusID:     "01FN3VJGFH10KR7S2PB0GFJZYG",
+			Read:             TrueBool(),
+		},
+	}
+}
+
+// NewTestConversationToStatuses returns a map of gts model conversation to status links keyed by their name.
+func NewTestConversationToStatuses() map[string]*gtsmodel.ConversationToStatus {
+	return map[string]*gtsmodel.ConversationToStatus{
+		"local_account_1_conversation_1_local_account_2_status_6": {
+			ConversationID: "01GSN4QCWJ8ZQ5XR0E2P3V5Y7H",
+			StatusID:       "01FN3VJGFH10KR7S2PB0GFJZYG",
+		},
+		"local_account_2_conversation_1_local_account_2_status_6": {
+			ConversationID: "01GSN4T1KJ5C7XFVBTM7Q8RM6D",
+			StatusID:       "01FN3VJGFH10KR7S2PB0GFJZYG",
+		},
+	}
+}
+
+// NewTestWebPushSubscriptions returns a map of gts model Web Push subscriptions keyed by their name.
+func NewTestWebPushSubscriptions() map[string]*gtsmodel.WebPushSubscription {
+	return map[string]*gtsmodel.WebPushSubscription{
+		"local_account_1_token_1": {
+			ID:                 "01GT0QZ6S0M1XKB5W9J2E4HV8D",
+			CreatedAt:          TimeMustParse("2023-02-24T10:12:04Z"),
+			UpdatedAt:          TimeMustParse("2023-02-24T10:12:04Z"),
+			AccountID:          "01F8MH1H7YV1Z7D2C8K2730QBF",
+			TokenID:            "01F8MGTQW4DKTDF8SW5CT9HYGA",
+			Endpoint:           "https://push.example.org/send/01GT0QZ6S0M1XKB5W9J2E4HV8D",
+			Auth:               "N2HMomHvmQxZ34N7K7_mNA",
+			P256dh:             "BE5GwbFPmHn7O_4gDh1vsWGG43BULRswKuCCD7-iEPXSQJAx9Q3SVQYSP-E5CzDwDxwYDn35VvRreY4kPX-q330",
+			AlertFollow:        TrueBool(),
+			AlertFollowRequest: TrueBool(),
+			AlertFavourite:     TrueBool(),
+			AlertMention:       TrueBool(),
+			AlertReblog:        FalseBool(),
+			AlertPoll:          FalseBool(),
+			AlertStatus:        FalseBool(),
+		},
+	}
+}
+
+// NewTestDomainBlockSubscriptions returns a map of gts model domain block subscriptions keyed by their name.
+func NewTestDomainBlockSubscriptions() map[string]*gtsmodel.DomainBlockSubscription {
+	return map[string]*gtsmodel.DomainBlockSubscription{
+		"admin_plaintext": {
+			ID:                 "01GT3DV5Q8M2S7HKW0YB6JX4NC",
+			CreatedAt:          TimeMustParse("2023-02-25T09:41:16Z"),
+			UpdatedAt:          TimeMustParse("2023-02-25T09:41:16Z"),
+			Title:              "Shared blocklist",
+			URI:                "https://blocklists.example.org/blocklist.txt",
+			ContentType:        gtsmodel.DomainBlockSubscriptionContentPlain,
+			CreatedByAccountID: "01F8MH17FWEB39HZJ76B6VXSKF",
+			Obfuscate:          FalseBool(),
+		},
+	}
+}
+
 // ActivityWithSignature wraps a pub.Activity along with its signature headers, for testing.
 type ActivityWithSignature struct {
 	Activity        pub.Activity
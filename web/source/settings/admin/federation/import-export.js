@@ -109,7 +109,7 @@ module.exports = function ImportExport() {
 					<form onSubmit={submitParse}>
 						<TextArea
 							field={form.domains}
-							label="Domains, one per line (plaintext) or JSON"
+							label="Domains, one per line (plaintext), JSON, or a Mastodon CSV export"
 							placeholder={`google.com\nfacebook.com`}
 							rows={8}
 						/>
@@ -119,7 +119,7 @@ module.exports = function ImportExport() {
 							<button type="button" className="with-padding">
 								<label>
 									Import file
-									<input className="hidden" type="file" onChange={fileChanged} accept="application/json,text/plain" />
+									<input className="hidden" type="file" onChange={fileChanged} accept="application/json,text/plain,text/csv" />
 								</label>
 							</button>
 						</div>
@@ -37,6 +37,7 @@ const nav = {
 	"User": {
 		"Profile": require("./user/profile.js"),
 		"Settings": require("./user/settings.js"),
+		"Export and Import": require("./user/data.js"),
 	},
 	"Admin": {
 		adminOnly: true,
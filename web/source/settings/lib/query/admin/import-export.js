@@ -28,9 +28,65 @@ const {
 	unwrapRes
 } = require("../lib");
 
+// splitCSVLine splits one line of a CSV file into its fields,
+// taking double-quoted fields (with "" as an escaped quote) into account.
+function splitCSVLine(line) {
+	let fields = [];
+	let field = "";
+	let quoted = false;
+
+	for (let i = 0; i < line.length; i++) {
+		const c = line[i];
+		if (quoted) {
+			if (c == '"' && line[i + 1] == '"') {
+				field += '"';
+				i++;
+			} else if (c == '"') {
+				quoted = false;
+			} else {
+				field += c;
+			}
+		} else if (c == '"') {
+			quoted = true;
+		} else if (c == ",") {
+			fields.push(field);
+			field = "";
+		} else {
+			field += c;
+		}
+	}
+	fields.push(field);
+
+	return fields.map((f) => f.trim());
+}
+
+// parseMastodonCSV parses a domain blocks export from Mastodon, which
+// has a header row like `#domain,#severity,...,#public_comment,#obfuscate`.
+// Only suspensions are imported, since we don't do silencing.
+function parseMastodonCSV(list) {
+	const [header, ...lines] = list.split("\n").filter((line) => line.trim().length > 0);
+	const columns = splitCSVLine(header).map((name) => name.replace(/^#/, ""));
+
+	return lines.map((line) => {
+		const fields = splitCSVLine(line);
+		const entry = Object.fromEntries(columns.map((name, i) => [name, fields[i]]));
+
+		if (entry.severity != undefined && entry.severity != "suspend") {
+			return null;
+		}
+
+		return {
+			domain: entry.domain,
+			public_comment: entry.public_comment || undefined
+		};
+	}).filter((a) => a); // not `null`
+}
+
 function parseDomainList(list) {
 	if (list[0] == "[") {
 		return JSON.parse(list);
+	} else if (list.startsWith("#domain")) {
+		return parseMastodonCSV(list);
 	} else {
 		return list.split("\n").map((line) => {
 			let domain = line.trim();
@@ -38,6 +38,40 @@ const endpoints = (build) => ({
 			url: `/api/v1/user/password_change`,
 			body: data
 		})
+	}),
+	exports: build.query({
+		query: () => ({
+			url: `/api/v1/exports`
+		}),
+		providesTags: ["Export"]
+	}),
+	createExport: build.mutation({
+		query: () => ({
+			method: "POST",
+			url: `/api/v1/exports`
+		}),
+		invalidatesTags: ["Export"]
+	}),
+	downloadExport: build.mutation({
+		// archive downloads need the auth header, so they're fetched
+		// here and handed to the browser as an object url
+		query: (id) => ({
+			url: `/api/v1/exports/${id}/archive`,
+			responseHandler: (res) => {
+				if (!res.ok) {
+					return res.json();
+				}
+				return res.blob().then((blob) => URL.createObjectURL(blob));
+			}
+		})
+	}),
+	importData: build.mutation({
+		query: ({ data, type, mode }) => ({
+			method: "POST",
+			url: `/api/v1/imports`,
+			asForm: true,
+			body: { data, type, mode }
+		})
 	})
 });
 
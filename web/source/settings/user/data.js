@@ -0,0 +1,169 @@
+/*
+	GoToSocial
+	Copyright (C) 2021-2023 GoToSocial Authors admin@gotosocial.org
+
+	This program is free software: you can redistribute it and/or modify
+	it under the terms of the GNU Affero General Public License as published by
+	the Free Software Foundation, either version 3 of the License, or
+	(at your option) any later version.
+
+	This program is distributed in the hope that it will be useful,
+	but WITHOUT ANY WARRANTY; without even the implied warranty of
+	MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+	GNU Affero General Public License for more details.
+
+	You should have received a copy of the GNU Affero General Public License
+	along with this program.  If not, see <http://www.gnu.org/licenses/>.
+*/
+
+"use strict";
+
+const React = require("react");
+
+const query = require("../lib/query");
+
+const {
+	useFileInput,
+	useRadioInput
+} = require("../lib/form");
+
+const useFormSubmit = require("../lib/form/submit");
+
+const {
+	FileInput,
+	RadioGroup
+} = require("../components/form/inputs");
+
+const Loading = require("../components/loading");
+const { Error } = require("../components/error");
+const MutationButton = require("../components/form/mutation-button");
+
+module.exports = function UserData() {
+	return (
+		<>
+			<Export />
+			<Import />
+		</>
+	);
+};
+
+function Export() {
+	const [polling, setPolling] = React.useState(false);
+	const { data: exports, isLoading, isError, error } = query.useExportsQuery(undefined, {
+		pollingInterval: polling ? 5000 : 0
+	});
+	const [createExport, createResult] = query.useCreateExportMutation();
+
+	const latest = exports?.[0];
+
+	// keep checking in while an archive is being built
+	React.useEffect(() => {
+		setPolling(latest?.state == "processing");
+	}, [latest?.state]);
+
+	function submit(e) {
+		e.preventDefault();
+		createExport();
+	}
+
+	return (
+		<form className="user-export" onSubmit={submit}>
+			<h1>Export your data</h1>
+			<p>
+				Request an archive of your profile, posts and media, along with your follows, blocks, mutes and
+				bookmarks as CSV files. The CSV files can be imported here, or into Mastodon. Building the archive
+				can take a while; you can leave this page and come back later. You can request an archive once every 24 hours.
+			</p>
+			{isLoading && <Loading />}
+			{isError && <Error error={error} />}
+			{latest && <ExportStatus export={latest} />}
+			<MutationButton
+				label="Request archive"
+				result={createResult}
+				disabled={latest?.state == "processing"}
+			/>
+		</form>
+	);
+}
+
+function ExportStatus({ export: e }) {
+	const [download, downloadResult] = query.useDownloadExportMutation();
+
+	React.useEffect(() => {
+		if (downloadResult.data != undefined) {
+			const a = document.createElement("a");
+			a.href = downloadResult.data;
+			a.download = `export-${e.id}.zip`;
+			a.click();
+			URL.revokeObjectURL(downloadResult.data);
+		}
+	}, [downloadResult.data, e.id]);
+
+	const requested = new Date(e.created_at).toLocaleString();
+
+	if (e.state == "processing") {
+		return <p>Archive requested at {requested} is being built...</p>;
+	} else if (e.state == "failed") {
+		return <p>Building the archive requested at {requested} failed, please try again.</p>;
+	}
+
+	return (
+		<div className="export-status">
+			<p>
+				Archive requested at {requested} is ready ({Math.ceil(e.size / 1024 / 1024)} MiB).
+			</p>
+			<MutationButton
+				type="button"
+				label="Download archive"
+				result={downloadResult}
+				onClick={() => download(e.id)}
+			/>
+		</div>
+	);
+}
+
+function Import() {
+	const form = {
+		data: useFileInput("data"),
+		type: useRadioInput("type", {
+			defaultValue: "following",
+			options: {
+				following: "Following list",
+				blocks: "Blocking list"
+			}
+		}),
+		mode: useRadioInput("mode", {
+			defaultValue: "merge",
+			options: {
+				merge: "Merge: keep existing entries and add the imported ones",
+				overwrite: "Overwrite: replace existing entries with the imported ones"
+			}
+		})
+	};
+
+	const [submitForm, result] = useFormSubmit(form, query.useImportDataMutation(), { changedOnly: false });
+
+	return (
+		<form className="user-import" onSubmit={submitForm}>
+			<h1>Import data</h1>
+			<p>
+				Import a CSV file of accounts to follow or block, as exported from here or from Mastodon.
+				The import is processed in the background; accounts that can&apos;t be found are skipped.
+			</p>
+			<FileInput
+				field={form.data}
+				accept="text/csv,.csv"
+			/>
+			<RadioGroup
+				field={form.type}
+				label="Type of data"
+			/>
+			<RadioGroup
+				field={form.mode}
+				label="Import mode"
+			/>
+			<MutationButton label="Import" result={result} disabled={form.data.value == undefined} />
+			{result.isSuccess && <p>Import started, your follows or blocks will be updated shortly.</p>}
+		</form>
+	);
+}